@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // StatementType encodes the type of a SQL statement
@@ -58,12 +59,40 @@ const (
 	StmtCallProc
 	StmtRevert
 	StmtShowMigrationLogs
+	StmtGrant
+	StmtRevoke
+	StmtCreateUser
+	StmtAlterUser
+	StmtDropUser
+	StmtRenameUser
+	StmtCreateRole
+	StmtDropRole
+	StmtSetRole
+	StmtSetDefaultRole
+	StmtSetPassword
+	StmtXAStart
+	StmtXAEnd
+	StmtXAPrepare
+	StmtXACommit
+	StmtXARollback
+	StmtXARecover
+	StmtKill
+	StmtShutdown
+	StmtInstall
+	StmtUninstall
+	StmtChangeReplicationSource
+	StmtStartReplica
+	StmtStopReplica
+	StmtResetReplica
+	StmtResetMaster
+	StmtPurgeBinaryLogs
+	StmtDo
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
 func ASTToStatementType(stmt Statement) StatementType {
-	switch stmt.(type) {
-	case *Select, *Union:
+	switch stmt := stmt.(type) {
+	case *Select, *Union, *ValuesStatement, *TableStatement:
 		return StmtSelect
 	case *Insert:
 		return StmtInsert
@@ -83,7 +112,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtShowMigrationLogs
 	case *Use:
 		return StmtUse
-	case *OtherRead, *OtherAdmin, *Load:
+	case *OtherRead, *OtherAdmin, *Load, TableMaintenanceStatement:
 		return StmtOther
 	case Explain:
 		return StmtExplain
@@ -111,11 +140,76 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtStream
 	case *VStream:
 		return StmtVStream
+	case *Grant:
+		return StmtGrant
+	case *Revoke:
+		return StmtRevoke
+	case *CreateUser:
+		return StmtCreateUser
+	case *AlterUser:
+		return StmtAlterUser
+	case *DropUser:
+		return StmtDropUser
+	case *RenameUser:
+		return StmtRenameUser
+	case *CreateRole:
+		return StmtCreateRole
+	case *DropRole:
+		return StmtDropRole
+	case *SetRole:
+		return StmtSetRole
+	case *SetDefaultRole:
+		return StmtSetDefaultRole
+	case *SetPassword:
+		return StmtSetPassword
+	case *XATransaction:
+		return xaStatementType(stmt.Action)
+	case *Kill:
+		return StmtKill
+	case *Shutdown:
+		return StmtShutdown
+	case *InstallPlugin, *InstallComponent:
+		return StmtInstall
+	case *UninstallPlugin, *UninstallComponent:
+		return StmtUninstall
+	case *ChangeReplicationSource:
+		return StmtChangeReplicationSource
+	case *StartReplica:
+		return StmtStartReplica
+	case *StopReplica:
+		return StmtStopReplica
+	case *ResetReplica:
+		return StmtResetReplica
+	case *ResetMaster:
+		return StmtResetMaster
+	case *PurgeBinaryLogs:
+		return StmtPurgeBinaryLogs
+	case *DoStmt:
+		return StmtDo
 	default:
 		return StmtUnknown
 	}
 }
 
+// xaStatementType returns the StatementType of an XA statement with the given action.
+func xaStatementType(action XAAction) StatementType {
+	switch action {
+	case XAStart:
+		return StmtXAStart
+	case XAEnd:
+		return StmtXAEnd
+	case XAPrepare:
+		return StmtXAPrepare
+	case XACommit:
+		return StmtXACommit
+	case XARollback:
+		return StmtXARollback
+	case XARecover:
+		return StmtXARecover
+	}
+	return StmtUnknown
+}
+
 //CanNormalize takes Statement and returns if the statement can be normalized.
 func CanNormalize(stmt Statement) bool {
 	switch stmt.(type) {
@@ -180,7 +274,7 @@ func Preview(sql string) StatementType {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "values", "table":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -218,6 +312,18 @@ func Preview(sql string) StatementType {
 		return StmtRollback
 	}
 	switch loweredFirstWord {
+	case "create", "alter", "drop", "rename", "set":
+		if stmtType, ok := previewAccountStatement(trimmedNoComments); ok {
+			return stmtType
+		}
+	}
+	if loweredFirstWord == "xa" {
+		return previewXAStatement(trimmedNoComments)
+	}
+	if stmtType, ok := previewReplicationStatement(trimmedNoComments); ok {
+		return stmtType
+	}
+	switch loweredFirstWord {
 	case "create", "alter", "rename", "drop", "truncate":
 		return StmtDDL
 	case "flush":
@@ -230,18 +336,137 @@ func Preview(sql string) StatementType {
 		return StmtUse
 	case "describe", "desc", "explain":
 		return StmtExplain
-	case "analyze", "repair", "optimize":
+	case "analyze", "repair", "optimize", "check", "checksum":
 		return StmtOther
-	case "grant", "revoke":
-		return StmtPriv
+	case "grant":
+		return StmtGrant
+	case "revoke":
+		return StmtRevoke
 	case "release":
 		return StmtRelease
 	case "rollback":
 		return StmtSRollback
+	case "kill":
+		return StmtKill
+	case "shutdown":
+		return StmtShutdown
+	case "install":
+		return StmtInstall
+	case "uninstall":
+		return StmtUninstall
+	case "do":
+		return StmtDo
+	}
+	return StmtUnknown
+}
+
+// previewWords splits the query into lower case words for the statements
+// that Preview can only identify by their first words. The words are separated
+// by spaces and comments, and each word is cut at its first character that
+// can't be part of an unquoted identifier, so that "password='x'" gives
+// "password", while "password_history" and "@password" are not "password".
+func previewWords(sql string) []string {
+	var words []string
+	for sql = StripLeadingComments(sql); sql != ""; sql = StripLeadingComments(sql) {
+		end := strings.IndexFunc(sql, unicode.IsSpace)
+		if end < 0 {
+			end = len(sql)
+		}
+		if comment := strings.Index(sql[:end], "/*"); comment > 0 {
+			end = comment
+		}
+		word := sql[:end]
+		ident := strings.IndexFunc(word, func(r rune) bool {
+			return !(r < utf8.RuneSelf && (isLetter(uint16(r)) || isDigit(uint16(r))) || isIdentifierRune(r))
+		})
+		if ident >= 0 {
+			word = word[:ident]
+		}
+		words = append(words, strings.ToLower(word))
+		sql = sql[end:]
+	}
+	return words
+}
+
+// previewAccountStatement identifies the user and role management statements,
+// which start with the same words as DDL and SET statements.
+func previewAccountStatement(sql string) (StatementType, bool) {
+	words := previewWords(sql)
+	if len(words) < 2 {
+		return StmtUnknown, false
+	}
+	switch words[0] + " " + words[1] {
+	case "create user":
+		return StmtCreateUser, true
+	case "alter user":
+		return StmtAlterUser, true
+	case "drop user":
+		return StmtDropUser, true
+	case "rename user":
+		return StmtRenameUser, true
+	case "create role":
+		return StmtCreateRole, true
+	case "drop role":
+		return StmtDropRole, true
+	case "set role":
+		return StmtSetRole, true
+	case "set password":
+		return StmtSetPassword, true
+	case "set default":
+		if len(words) > 2 && words[2] == "role" {
+			return StmtSetDefaultRole, true
+		}
+	}
+	return StmtUnknown, false
+}
+
+// previewXAStatement identifies the XA statements by their second word.
+func previewXAStatement(sql string) StatementType {
+	words := previewWords(sql)
+	if len(words) < 2 {
+		return StmtUnknown
+	}
+	switch words[1] {
+	case "start", "begin":
+		return StmtXAStart
+	case "end":
+		return StmtXAEnd
+	case "prepare":
+		return StmtXAPrepare
+	case "commit":
+		return StmtXACommit
+	case "rollback":
+		return StmtXARollback
+	case "recover":
+		return StmtXARecover
 	}
 	return StmtUnknown
 }
 
+// previewReplicationStatement identifies the replication statements,
+// which start with the same words as other statements.
+func previewReplicationStatement(sql string) (StatementType, bool) {
+	words := previewWords(sql)
+	if len(words) < 2 {
+		return StmtUnknown, false
+	}
+	switch words[0] + " " + words[1] {
+	case "change replication", "change master":
+		return StmtChangeReplicationSource, true
+	case "start replica", "start slave":
+		return StmtStartReplica, true
+	case "stop replica", "stop slave":
+		return StmtStopReplica, true
+	case "reset replica", "reset slave":
+		return StmtResetReplica, true
+	case "reset master":
+		return StmtResetMaster, true
+	case "purge binary", "purge master":
+		return StmtPurgeBinaryLogs, true
+	}
+	return StmtUnknown, false
+}
+
 func (s StatementType) String() string {
 	switch s {
 	case StmtSelect:
@@ -294,6 +519,62 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtGrant:
+		return "GRANT"
+	case StmtRevoke:
+		return "REVOKE"
+	case StmtCreateUser:
+		return "CREATE_USER"
+	case StmtAlterUser:
+		return "ALTER_USER"
+	case StmtDropUser:
+		return "DROP_USER"
+	case StmtRenameUser:
+		return "RENAME_USER"
+	case StmtCreateRole:
+		return "CREATE_ROLE"
+	case StmtDropRole:
+		return "DROP_ROLE"
+	case StmtSetRole:
+		return "SET_ROLE"
+	case StmtSetDefaultRole:
+		return "SET_DEFAULT_ROLE"
+	case StmtSetPassword:
+		return "SET_PASSWORD"
+	case StmtXAStart:
+		return "XA_START"
+	case StmtXAEnd:
+		return "XA_END"
+	case StmtXAPrepare:
+		return "XA_PREPARE"
+	case StmtXACommit:
+		return "XA_COMMIT"
+	case StmtXARollback:
+		return "XA_ROLLBACK"
+	case StmtXARecover:
+		return "XA_RECOVER"
+	case StmtKill:
+		return "KILL"
+	case StmtShutdown:
+		return "SHUTDOWN"
+	case StmtInstall:
+		return "INSTALL"
+	case StmtUninstall:
+		return "UNINSTALL"
+	case StmtChangeReplicationSource:
+		return "CHANGE_REPLICATION_SOURCE"
+	case StmtStartReplica:
+		return "START_REPLICA"
+	case StmtStopReplica:
+		return "STOP_REPLICA"
+	case StmtResetReplica:
+		return "RESET_REPLICA"
+	case StmtResetMaster:
+		return "RESET_MASTER"
+	case StmtPurgeBinaryLogs:
+		return "PURGE_BINARY_LOGS"
+	case StmtDo:
+		return "DO"
 	default:
 		return "UNKNOWN"
 	}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPreview(t *testing.T) {
@@ -31,6 +32,8 @@ func TestPreview(t *testing.T) {
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
 		{"( select ...", StmtSelect},
+		{"values row(1)", StmtSelect},
+		{"table t", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
 		{"   update ...", StmtUpdate},
@@ -68,8 +71,50 @@ func TestPreview(t *testing.T) {
 		{"explain", StmtExplain},
 		{"repair", StmtOther},
 		{"optimize", StmtOther},
-		{"grant", StmtPriv},
-		{"revoke", StmtPriv},
+		{"check table t", StmtOther},
+		{"checksum table t extended", StmtOther},
+		{"grant", StmtGrant},
+		{"revoke", StmtRevoke},
+		{"create user 'u'@'%'", StmtCreateUser},
+		{"alter user u identified by 'x'", StmtAlterUser},
+		{"drop user u", StmtDropUser},
+		{"rename user u to v", StmtRenameUser},
+		{"create role r", StmtCreateRole},
+		{"drop role r", StmtDropRole},
+		{"set role all", StmtSetRole},
+		{"set default role r to u", StmtSetDefaultRole},
+		{"set password='x'", StmtSetPassword},
+		{"set /* c */ password = 'x'", StmtSetPassword},
+		{"set @password = 'x'", StmtSet},
+		{"set password_history = 5", StmtSet},
+		{"set @role = 1", StmtSet},
+		{"set @default = 1", StmtSet},
+		{"xa start 'x'", StmtXAStart},
+		{"XA BEGIN 'x' join", StmtXAStart},
+		{"xa end 'x'", StmtXAEnd},
+		{"xa prepare 'x'", StmtXAPrepare},
+		{"/* c */ xa commit 'x' one phase", StmtXACommit},
+		{"xa rollback 'x'", StmtXARollback},
+		{"xa recover", StmtXARecover},
+		{"xa", StmtUnknown},
+		{"kill 1", StmtKill},
+		{"/* c */ KILL QUERY 1", StmtKill},
+		{"shutdown", StmtShutdown},
+		{"install plugin p soname 'p.so'", StmtInstall},
+		{"uninstall component 'file://c'", StmtUninstall},
+		{"change replication source to source_host = 'h'", StmtChangeReplicationSource},
+		{"CHANGE MASTER TO master_host = 'h'", StmtChangeReplicationSource},
+		{"start replica", StmtStartReplica},
+		{"start slave io_thread", StmtStartReplica},
+		{"do sleep(1)", StmtDo},
+		{"stop replica", StmtStopReplica},
+		{"reset slave all", StmtResetReplica},
+		{"reset master", StmtResetMaster},
+		{"/* c */ purge binary logs to 'b'", StmtPurgeBinaryLogs},
+		{"start transaction", StmtBegin},
+		{"change", StmtUnknown},
+		{"set default_week_format = 1", StmtSet},
+		{"create table user (id int)", StmtDDL},
 		{"truncate", StmtDDL},
 		{"flush", StmtFlush},
 		{"unknown", StmtUnknown},
@@ -93,6 +138,62 @@ func TestPreview(t *testing.T) {
 	}
 }
 
+func TestASTToStatementType(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want StatementType
+	}{
+		{"select 1 from dual", StmtSelect},
+		{"values row(1, 2)", StmtSelect},
+		{"table t", StmtSelect},
+		{"grant select on t to u", StmtGrant},
+		{"revoke r from u", StmtRevoke},
+		{"create user u", StmtCreateUser},
+		{"alter user u account lock", StmtAlterUser},
+		{"drop user u", StmtDropUser},
+		{"rename user 'u'@'%' to 'v'@'%'", StmtRenameUser},
+		{"create role r", StmtCreateRole},
+		{"drop role r", StmtDropRole},
+		{"set role r", StmtSetRole},
+		{"set default role all to u", StmtSetDefaultRole},
+		{"set password for u = 'x'", StmtSetPassword},
+		{"set @password = 'x'", StmtSet},
+		{"set password_history = 5", StmtSet},
+		{"set @role = 1", StmtSet},
+		{"xa start 'x', 'y', 1", StmtXAStart},
+		{"xa end 'x' suspend", StmtXAEnd},
+		{"xa prepare 'x'", StmtXAPrepare},
+		{"xa commit 'x'", StmtXACommit},
+		{"xa rollback 'x'", StmtXARollback},
+		{"xa recover convert xid", StmtXARecover},
+		{"kill query 1", StmtKill},
+		{"shutdown", StmtShutdown},
+		{"install plugin p soname 'p.so'", StmtInstall},
+		{"install component 'file://c'", StmtInstall},
+		{"uninstall plugin p", StmtUninstall},
+		{"uninstall component 'file://c'", StmtUninstall},
+		{"change master to master_host = 'h'", StmtChangeReplicationSource},
+		{"start slave", StmtStartReplica},
+		{"stop replica sql_thread", StmtStopReplica},
+		{"reset replica", StmtResetReplica},
+		{"reset master to 1", StmtResetMaster},
+		{"purge master logs before now()", StmtPurgeBinaryLogs},
+		{"show binlog events", StmtShow},
+		{"do release_lock('x')", StmtDo},
+		{"load data infile 'x' into table t", StmtOther},
+		{"analyze table t", StmtOther},
+		{"optimize table t", StmtOther},
+		{"repair table t", StmtOther},
+		{"check table t", StmtOther},
+		{"checksum table t", StmtOther},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, ASTToStatementType(stmt), tcase.sql)
+	}
+}
+
 func TestIsDML(t *testing.T) {
 	testcases := []struct {
 		sql  string
@@ -336,3 +437,27 @@ func TestIsNull(t *testing.T) {
 		}
 	}
 }
+
+func TestIsLockingFuncInDo(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out bool
+	}{{
+		in:  "do get_lock('x', 10)",
+		out: true,
+	}, {
+		in:  "do 1, RELEASE_LOCK('x')",
+		out: true,
+	}, {
+		in: "do sleep(5)",
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		require.NoError(t, err)
+		out := false
+		for _, expr := range stmt.(*DoStmt).Exprs {
+			out = out || IsLockingFunc(expr)
+		}
+		assert.Equal(t, tc.out, out, tc.in)
+	}
+}
@@ -57,7 +57,7 @@ type (
 		SetOrderBy(OrderBy)
 		GetOrderBy() OrderBy
 		SetLimit(*Limit)
-		SetLock(lock Lock)
+		SetLock(lock LockClauses)
 		SetInto(into *SelectInto)
 		SetWith(with *With)
 		MakeDistinct()
@@ -108,6 +108,21 @@ type (
 		iExplain()
 	}
 
+	// TableMaintenanceStatement represents the ANALYZE, OPTIMIZE, REPAIR, CHECK
+	// and CHECKSUM TABLE statements.
+	TableMaintenanceStatement interface {
+		iTableMaintenanceStatement()
+		AffectedTables() TableNames
+		Statement
+	}
+
+	// CompoundStatement represents the statements that can only be used in the body
+	// of a stored program: BEGIN ... END blocks, DECLARE, flow control and SIGNAL.
+	CompoundStatement interface {
+		Statement
+		iCompoundStatement()
+	}
+
 	// AddConstraintDefinition represents a ADD CONSTRAINT alter option
 	AddConstraintDefinition struct {
 		ConstraintDefinition *ConstraintDefinition
@@ -237,12 +252,26 @@ type (
 		With bool
 	}
 
+	// BadDefinition is a placeholder for a column, index or constraint definition
+	// of a CREATE TABLE, or an option of an ALTER TABLE, with a syntax error.
+	// It is only returned by a parse that recovers from errors, see ParseScript.
+	BadDefinition struct {
+		_span
+		// SQL is the text of the definition.
+		SQL string
+		// Index is the number of definitions of the CREATE TABLE before this
+		// one, where it is formatted. It is not used for an ALTER TABLE option.
+		Index int
+	}
+
 	// Select represents a SELECT statement.
 	Select struct {
+		_span
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
 		SQLCalcFoundRows bool
+		WithRollup       bool
 		// The From field must be the first AST element of this struct so the rewriter sees it first
 		From        []TableExpr
 		Comments    *ParsedComments
@@ -251,9 +280,10 @@ type (
 		With        *With
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     WindowDefinitions
 		OrderBy     OrderBy
 		Limit       *Limit
-		Lock        Lock
+		Lock        LockClauses
 		Into        *SelectInto
 	}
 
@@ -266,6 +296,7 @@ type (
 		ExportOption string
 		Manifest     string
 		Overwrite    string
+		Variables    Columns
 	}
 
 	// SelectIntoType is an enum for SelectInto.Type
@@ -274,20 +305,67 @@ type (
 	// Lock is an enum for the type of lock in the statement
 	Lock int8
 
+	// LockClauses represents the locking clauses of a SELECT statement.
+	LockClauses []*LockClause
+
+	// LockClause represents a single locking clause, e.g. FOR UPDATE OF t NOWAIT.
+	// Tables and Wait are only used with FOR UPDATE and FOR SHARE.
+	LockClause struct {
+		Lock   Lock
+		Tables TableNames
+		Wait   LockWaitType
+	}
+
+	// LockWaitType is an enum for LockClause.Wait
+	LockWaitType int8
+
 	// Union represents a UNION statement.
+	// It also represents the INTERSECT and EXCEPT set operations, see Operator.
 	Union struct {
+		_span
 		Left     SelectStatement
 		Right    SelectStatement
+		Operator SetOperator
 		Distinct bool
 		OrderBy  OrderBy
 		With     *With
 		Limit    *Limit
-		Lock     Lock
+		Lock     LockClauses
+		Into     *SelectInto
+	}
+
+	// SetOperator is an enum for Union.Operator
+	SetOperator int8
+
+	// ValuesStatement represents a VALUES table value constructor,
+	// e.g. VALUES ROW(1, 2), ROW(3, 4).
+	ValuesStatement struct {
+		_span
+		Comments *ParsedComments
+		Rows     Values
+		With     *With
+		OrderBy  OrderBy
+		Limit    *Limit
+		Lock     LockClauses
+		Into     *SelectInto
+	}
+
+	// TableStatement represents a TABLE statement, e.g. TABLE t.
+	// It is equivalent to SELECT * FROM t.
+	TableStatement struct {
+		_span
+		Comments *ParsedComments
+		Table    TableName
+		With     *With
+		OrderBy  OrderBy
+		Limit    *Limit
+		Lock     LockClauses
 		Into     *SelectInto
 	}
 
 	// VStream represents a VSTREAM statement.
 	VStream struct {
+		_span
 		Comments   *ParsedComments
 		SelectExpr SelectExpr
 		Table      TableName
@@ -297,6 +375,7 @@ type (
 
 	// Stream represents a SELECT statement.
 	Stream struct {
+		_span
 		Comments   *ParsedComments
 		SelectExpr SelectExpr
 		Table      TableName
@@ -311,6 +390,7 @@ type (
 	// of the implications the deletion part may have on vindexes.
 	// If you add fields here, consider adding them to calls to validateUnshardedRoute.
 	Insert struct {
+		_span
 		Action     InsertAction
 		Comments   *ParsedComments
 		Ignore     Ignore
@@ -318,9 +398,17 @@ type (
 		Partitions Partitions
 		Columns    Columns
 		Rows       InsertRows
+		RowAlias   *RowAlias
 		OnDup      OnDup
 	}
 
+	// RowAlias represents the row and column aliases of an INSERT,
+	// e.g. AS new(a, b) in INSERT ... VALUES (...) AS new(a, b).
+	RowAlias struct {
+		TableName TableIdent
+		Columns   Columns
+	}
+
 	// Ignore represents whether ignore was specified or not
 	Ignore bool
 
@@ -330,6 +418,7 @@ type (
 	// Update represents an UPDATE statement.
 	// If you add fields here, consider adding them to calls to validateUnshardedRoute.
 	Update struct {
+		_span
 		With       *With
 		Comments   *ParsedComments
 		Ignore     Ignore
@@ -343,6 +432,7 @@ type (
 	// Delete represents a DELETE statement.
 	// If you add fields here, consider adding them to calls to validateUnshardedRoute.
 	Delete struct {
+		_span
 		With       *With
 		Ignore     Ignore
 		Comments   *ParsedComments
@@ -356,12 +446,14 @@ type (
 
 	// Set represents a SET statement.
 	Set struct {
+		_span
 		Comments *ParsedComments
 		Exprs    SetExprs
 	}
 
 	// SetTransaction represents a SET TRANSACTION statement.
 	SetTransaction struct {
+		_span
 		SQLNode
 		Comments        *ParsedComments
 		Scope           Scope
@@ -385,6 +477,7 @@ type (
 
 	// DropDatabase represents a DROP database statement.
 	DropDatabase struct {
+		_span
 		Comments *ParsedComments
 		DBName   TableIdent
 		IfExists bool
@@ -402,6 +495,7 @@ type (
 
 	// CreateDatabase represents a CREATE database statement.
 	CreateDatabase struct {
+		_span
 		Comments      *ParsedComments
 		DBName        TableIdent
 		IfNotExists   bool
@@ -411,6 +505,7 @@ type (
 
 	// AlterDatabase represents a ALTER database statement.
 	AlterDatabase struct {
+		_span
 		DBName              TableIdent
 		UpdateDataDirectory bool
 		AlterOptions        []DatabaseOption
@@ -419,6 +514,7 @@ type (
 
 	// Flush represents a FLUSH statement.
 	Flush struct {
+		_span
 		IsLocal      bool
 		FlushOptions []string
 		TableNames   TableNames
@@ -434,16 +530,19 @@ type (
 
 	// RenameTable represents a RENAME TABLE statement.
 	RenameTable struct {
+		_span
 		TablePairs []*RenameTablePair
 	}
 
 	// TruncateTable represents a TRUNCATE TABLE statement.
 	TruncateTable struct {
+		_span
 		Table TableName
 	}
 
 	// AlterVschema represents a ALTER VSCHEMA statement.
 	AlterVschema struct {
+		_span
 		Action DDLAction
 		Table  TableName
 
@@ -459,17 +558,20 @@ type (
 
 	// ShowMigrationLogs represents a SHOW VITESS_MIGRATION '<uuid>' LOGS statement
 	ShowMigrationLogs struct {
+		_span
 		UUID     string
 		Comments *ParsedComments
 	}
 
 	// ShowThrottledApps represents a SHOW VITESS_THROTTLED_APPS statement
 	ShowThrottledApps struct {
+		_span
 		Comments Comments
 	}
 
 	// RevertMigration represents a REVERT VITESS_MIGRATION statement
 	RevertMigration struct {
+		_span
 		UUID     string
 		Comments *ParsedComments
 	}
@@ -479,6 +581,7 @@ type (
 
 	// AlterMigration represents a ALTER VITESS_MIGRATION statement
 	AlterMigration struct {
+		_span
 		Type   AlterMigrationType
 		UUID   string
 		Expire string
@@ -487,6 +590,7 @@ type (
 
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
+		_span
 		Table           TableName
 		AlterOptions    []AlterOption
 		PartitionSpec   *PartitionSpec
@@ -497,6 +601,7 @@ type (
 
 	// DropTable represents a DROP TABLE statement.
 	DropTable struct {
+		_span
 		Temp       bool
 		FromTables TableNames
 		// The following fields are set if a DDL was fully analyzed.
@@ -506,6 +611,7 @@ type (
 
 	// DropView represents a DROP VIEW statement.
 	DropView struct {
+		_span
 		FromTables TableNames
 		IfExists   bool
 		Comments   *ParsedComments
@@ -513,6 +619,7 @@ type (
 
 	// CreateTable represents a CREATE TABLE statement.
 	CreateTable struct {
+		_span
 		Temp        bool
 		Table       TableName
 		IfNotExists bool
@@ -524,6 +631,7 @@ type (
 
 	// CreateView represents a CREATE VIEW query
 	CreateView struct {
+		_span
 		ViewName    TableName
 		Algorithm   string
 		Definer     *Definer
@@ -537,6 +645,7 @@ type (
 
 	// AlterView represents a ALTER VIEW query
 	AlterView struct {
+		_span
 		ViewName    TableName
 		Algorithm   string
 		Definer     *Definer
@@ -556,50 +665,386 @@ type (
 	// DDLAction is an enum for DDL.Action
 	DDLAction int8
 
-	// Load represents a LOAD statement
+	// CreateProcedure represents a CREATE PROCEDURE statement
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/create-procedure.html
+	CreateProcedure struct {
+		_span
+		Comments        *ParsedComments
+		Definer         *Definer
+		IfNotExists     bool
+		Name            TableName
+		Params          []*ProcParameter
+		Characteristics []*RoutineCharacteristic
+		Body            Statement
+	}
+
+	// CreateFunction represents a CREATE FUNCTION statement for a stored function
+	CreateFunction struct {
+		_span
+		Comments        *ParsedComments
+		Definer         *Definer
+		IfNotExists     bool
+		Name            TableName
+		Params          []*ProcParameter
+		Returns         ColumnType
+		Characteristics []*RoutineCharacteristic
+		Body            Statement
+	}
+
+	// ProcParameter represents a parameter of a stored procedure or function.
+	// Mode is always NoParameterMode for functions.
+	ProcParameter struct {
+		Mode ProcParameterMode
+		Name ColIdent
+		Type ColumnType
+	}
+
+	// ProcParameterMode is an enum for ProcParameter.Mode
+	ProcParameterMode int8
+
+	// RoutineCharacteristic represents a characteristic of a stored procedure or function.
+	// Comment is only set for CommentCharacteristic.
+	RoutineCharacteristic struct {
+		Type    RoutineCharacteristicType
+		Comment string
+	}
+
+	// RoutineCharacteristicType is an enum for RoutineCharacteristic.Type
+	RoutineCharacteristicType int8
+
+	// CreateTrigger represents a CREATE TRIGGER statement
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/create-trigger.html
+	CreateTrigger struct {
+		_span
+		Comments    *ParsedComments
+		Definer     *Definer
+		IfNotExists bool
+		Name        TableName
+		Timing      TriggerTimingType
+		Event       TriggerEventType
+		Table       TableName
+		Order       *TriggerOrder
+		Body        Statement
+	}
+
+	// TriggerTimingType is an enum for CreateTrigger.Timing
+	TriggerTimingType int8
+
+	// TriggerEventType is an enum for CreateTrigger.Event
+	TriggerEventType int8
+
+	// TriggerOrder represents the FOLLOWS or PRECEDES clause of a CREATE TRIGGER statement
+	TriggerOrder struct {
+		Type         TriggerOrderType
+		OtherTrigger ColIdent
+	}
+
+	// TriggerOrderType is an enum for TriggerOrder.Type
+	TriggerOrderType int8
+
+	// CreateEvent represents a CREATE EVENT statement
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+	CreateEvent struct {
+		_span
+		Comments     *ParsedComments
+		Definer      *Definer
+		IfNotExists  bool
+		Name         TableName
+		Schedule     *EventSchedule
+		OnCompletion EventOnCompletionType
+		Status       EventStatusType
+		Comment      string
+		Body         Statement
+	}
+
+	// EventSchedule represents the ON SCHEDULE clause of a CREATE EVENT statement.
+	// Either At is set for a one time event, or Every and EveryUnit for a recurring one.
+	EventSchedule struct {
+		At        Expr
+		Every     Expr
+		EveryUnit string
+		Starts    Expr
+		Ends      Expr
+	}
+
+	// EventOnCompletionType is an enum for CreateEvent.OnCompletion
+	EventOnCompletionType int8
+
+	// EventStatusType is an enum for CreateEvent.Status
+	EventStatusType int8
+
+	// AlterRoutine represents an ALTER PROCEDURE or ALTER FUNCTION statement.
+	// Type is ProcedureType or FunctionType.
+	AlterRoutine struct {
+		_span
+		Comments        *ParsedComments
+		Type            ProgramType
+		Name            TableName
+		Characteristics []*RoutineCharacteristic
+	}
+
+	// AlterEvent represents an ALTER EVENT statement. The fields of the clauses
+	// that are not specified have their zero value.
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/alter-event.html
+	AlterEvent struct {
+		_span
+		Comments     *ParsedComments
+		Definer      *Definer
+		Name         TableName
+		Schedule     *EventSchedule
+		OnCompletion EventOnCompletionType
+		RenameTo     TableName
+		Status       EventStatusType
+		Comment      string
+		Body         Statement
+	}
+
+	// DropProgram represents a DROP PROCEDURE, DROP FUNCTION, DROP TRIGGER or DROP EVENT statement
+	DropProgram struct {
+		_span
+		Comments *ParsedComments
+		Type     ProgramType
+		IfExists bool
+		Name     TableName
+	}
+
+	// ProgramType is an enum for the type of stored program of DropProgram and AlterRoutine
+	ProgramType int8
+
+	// Load represents a LOAD DATA statement
 	Load struct {
+		_span
+		Priority    LoadPriority
+		Local       bool
+		FileName    string
+		Duplicate   LoadDuplicateType
+		Table       TableName
+		Partitions  Partitions
+		Charset     string
+		Fields      *LoadFields
+		Lines       *LoadLines
+		IgnoreLines *Literal
+		Columns     Columns
+		SetExprs    UpdateExprs
+	}
+
+	// LoadPriority is an enum for Load.Priority
+	LoadPriority int8
+
+	// LoadDuplicateType is an enum for Load.Duplicate
+	LoadDuplicateType int8
+
+	// LoadFields represents the FIELDS or COLUMNS clause of a LOAD DATA statement.
+	// A nil field means the option was not specified.
+	LoadFields struct {
+		Terminated *Literal
+		Optionally bool
+		Enclosed   *Literal
+		Escaped    *Literal
+	}
+
+	// LoadLines represents the LINES clause of a LOAD DATA statement.
+	// A nil field means the option was not specified.
+	LoadLines struct {
+		Starting   *Literal
+		Terminated *Literal
 	}
 
 	// Show represents a show statement.
 	Show struct {
+		_span
 		Internal ShowInternal
 	}
 
 	// Use represents a use statement.
 	Use struct {
+		_span
 		DBName TableIdent
 	}
 
 	// Begin represents a Begin statement.
-	Begin struct{}
+	Begin struct {
+		_span
+	}
 
 	// Commit represents a Commit statement.
-	Commit struct{}
+	Commit struct {
+		_span
+	}
 
 	// Rollback represents a Rollback statement.
-	Rollback struct{}
+	Rollback struct {
+		_span
+	}
 
 	// SRollback represents a rollback to savepoint statement.
 	SRollback struct {
+		_span
 		Name ColIdent
 	}
 
 	// Savepoint represents a savepoint statement.
 	Savepoint struct {
+		_span
 		Name ColIdent
 	}
 
 	// Release represents a release savepoint statement.
 	Release struct {
+		_span
+		Name ColIdent
+	}
+
+	// XATransaction represents an XA transaction statement, e.g. XA START 'gtrid'.
+	// Xid is nil for XA RECOVER.
+	XATransaction struct {
+		_span
+		Action     XAAction
+		Xid        *Xid
+		Option     XAOption
+		ConvertXid bool
+	}
+
+	// XAAction is an enum for XATransaction.Action
+	XAAction int8
+
+	// XAOption is an enum for XATransaction.Option
+	XAOption int8
+
+	// Xid represents the identifier of an XA transaction: gtrid [, bqual [, formatID]]
+	Xid struct {
+		Gtrid    *Literal
+		Bqual    *Literal
+		FormatID *Literal
+	}
+
+	// Kill represents a KILL [CONNECTION | QUERY] processlist_id statement.
+	Kill struct {
+		_span
+		Type          KillType
+		ProcesslistID uint64
+	}
+
+	// KillType is an enum for Kill.Type
+	KillType int8
+
+	// Shutdown represents a SHUTDOWN statement.
+	Shutdown struct {
+		_span
+	}
+
+	// InstallPlugin represents an INSTALL PLUGIN plugin_name SONAME 'library' statement.
+	InstallPlugin struct {
+		_span
+		Name    ColIdent
+		Library string
+	}
+
+	// UninstallPlugin represents an UNINSTALL PLUGIN plugin_name statement.
+	UninstallPlugin struct {
+		_span
 		Name ColIdent
 	}
 
+	// InstallComponent represents an INSTALL COMPONENT 'component_name' [, 'component_name'] ... statement.
+	InstallComponent struct {
+		_span
+		Components []string
+	}
+
+	// UninstallComponent represents an UNINSTALL COMPONENT 'component_name' [, 'component_name'] ... statement.
+	UninstallComponent struct {
+		_span
+		Components []string
+	}
+
+	// ChangeReplicationSource represents a CHANGE REPLICATION SOURCE TO
+	// or CHANGE MASTER TO statement.
+	ChangeReplicationSource struct {
+		_span
+		Master  bool
+		Options ReplicationOptions
+		Channel string
+	}
+
+	// ReplicationOptions represents a list of replication options.
+	ReplicationOptions []*ReplicationOption
+
+	// ReplicationOption represents a name = value option of a replication statement.
+	// Name is lower case and Value is nil for options without a value, such as SQL_AFTER_MTS_GAPS.
+	ReplicationOption struct {
+		Name  string
+		Value Expr
+	}
+
+	// StartReplica represents a START REPLICA or START SLAVE statement.
+	// Until holds the UNTIL options and ConnectionOptions the USER, PASSWORD,
+	// DEFAULT_AUTH and PLUGIN_DIR options.
+	StartReplica struct {
+		_span
+		Slave             bool
+		Threads           ReplicaThreads
+		Until             ReplicationOptions
+		ConnectionOptions ReplicationOptions
+		Channel           string
+	}
+
+	// StopReplica represents a STOP REPLICA or STOP SLAVE statement.
+	StopReplica struct {
+		_span
+		Slave   bool
+		Threads ReplicaThreads
+		Channel string
+	}
+
+	// ReplicaThreads is a set of the replication threads of START and STOP REPLICA.
+	// An empty set means all threads.
+	ReplicaThreads int8
+
+	// ResetReplica represents a RESET REPLICA or RESET SLAVE statement.
+	ResetReplica struct {
+		_span
+		Slave   bool
+		All     bool
+		Channel string
+	}
+
+	// ResetMaster represents a RESET MASTER [TO binary_log_file_index_number] statement.
+	ResetMaster struct {
+		_span
+		To *Literal
+	}
+
+	// PurgeBinaryLogs represents a PURGE { BINARY | MASTER } LOGS statement.
+	// Exactly one of To and Before is set.
+	PurgeBinaryLogs struct {
+		_span
+		Master bool
+		To     string
+		Before Expr
+	}
+
 	// CallProc represents a CALL statement
 	CallProc struct {
+		_span
 		Name   TableName
 		Params Exprs
 	}
 
+	// DoStmt represents a DO statement
+	DoStmt struct {
+		_span
+		Exprs Exprs
+	}
+
+	// BadStmt is a placeholder for a statement with a syntax error.
+	// It is only returned by a parse that recovers from errors, see ParseScript.
+	BadStmt struct {
+		_span
+		// SQL is the text of the statement.
+		SQL string
+	}
+
 	// LockType is an enum for Lock Types
 	LockType int8
 
@@ -614,23 +1059,28 @@ type (
 
 	// LockTables represents the lock statement
 	LockTables struct {
+		_span
 		Tables TableAndLockTypes
 	}
 
 	// UnlockTables represents the unlock statement
-	UnlockTables struct{}
+	UnlockTables struct {
+		_span
+	}
 
 	// ExplainType is an enum for ExplainStmt.Type
 	ExplainType int8
 
 	// ExplainStmt represents an Explain statement
 	ExplainStmt struct {
+		_span
 		Type      ExplainType
 		Statement Statement
 	}
 
 	// ExplainTab represents the Explain table
 	ExplainTab struct {
+		_span
 		Table TableName
 		Wild  string
 	}
@@ -638,6 +1088,7 @@ type (
 	// PrepareStmt represents a Prepare Statement
 	// More info available on https://dev.mysql.com/doc/refman/8.0/en/sql-prepared-statements.html
 	PrepareStmt struct {
+		_span
 		Name      ColIdent
 		Statement Expr
 		Comments  *ParsedComments
@@ -646,6 +1097,7 @@ type (
 	// ExecuteStmt represents an Execute Statement
 	// More info available on https://dev.mysql.com/doc/refman/8.0/en/execute.html
 	ExecuteStmt struct {
+		_span
 		Name      ColIdent
 		Comments  *ParsedComments
 		Arguments Columns
@@ -654,6 +1106,7 @@ type (
 	// DeallocateStmt represents a Deallocate Statement
 	// More info available on https://dev.mysql.com/doc/refman/8.0/en/deallocate-prepare.html
 	DeallocateStmt struct {
+		_span
 		Type     DeallocateStmtType
 		Comments *ParsedComments
 		Name     ColIdent
@@ -665,74 +1118,596 @@ type (
 	// IntervalTypes is an enum to get types of intervals
 	IntervalTypes int8
 
+	// Grant represents a GRANT statement. Either Privileges and Target, or Roles are set.
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/grant.html
+	Grant struct {
+		_span
+		Privileges      Privileges
+		Target          *GrantTarget
+		Roles           Accounts
+		Proxy           *Account
+		To              Accounts
+		WithGrantOption bool
+		WithAdminOption bool
+	}
+
+	// Revoke represents a REVOKE statement. Target is nil when roles are revoked,
+	// or for REVOKE ALL PRIVILEGES, GRANT OPTION.
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/revoke.html
+	Revoke struct {
+		_span
+		IfExists   bool
+		Privileges Privileges
+		Target     *GrantTarget
+		Roles      Accounts
+		Proxy      *Account
+		From       Accounts
+	}
+
+	// PrivilegeSpec represents a single privilege of a GRANT or REVOKE statement.
+	// Name is the lower case privilege name, e.g. "select", "create temporary tables"
+	// or a dynamic privilege like "backup_admin".
+	PrivilegeSpec struct {
+		Name    string
+		Columns Columns
+	}
+
+	// Privileges represents a list of privileges
+	Privileges []*PrivilegeSpec
+
+	// GrantTarget represents the ON clause of a GRANT or REVOKE statement.
+	// Database and Name are empty for the parts given as *.
+	GrantTarget struct {
+		ObjectType GrantObjectType
+		Level      PrivilegeLevel
+		Database   TableIdent
+		Name       TableIdent
+	}
+
+	// GrantObjectType is an enum for GrantTarget.ObjectType
+	GrantObjectType int8
+
+	// PrivilegeLevel is an enum for GrantTarget.Level
+	PrivilegeLevel int8
+
+	// Account represents a user or role name with an optional host part.
+	// CurrentUser is set for CURRENT_USER, which has no user or host.
+	Account struct {
+		User        string
+		Host        string
+		CurrentUser bool
+	}
+
+	// Accounts represents a list of users or roles
+	Accounts []*Account
+
+	// AuthOption represents the IDENTIFIED clause of CREATE USER and ALTER USER.
+	// Replace, RetainCurrent and DiscardOldPassword are only used by ALTER USER.
+	AuthOption struct {
+		Plugin             string
+		AuthString         string
+		Hashed             bool // the auth string was given with AS
+		RandomPassword     bool
+		Replace            string
+		RetainCurrent      bool
+		DiscardOldPassword bool
+	}
+
+	// UserSpec represents a user with its optional authentication in CREATE USER and ALTER USER
+	UserSpec struct {
+		Account *Account
+		Auth    *AuthOption
+	}
+
+	// UserSpecs represents a list of users with their authentication
+	UserSpecs []*UserSpec
+
+	// RoleSpec represents the roles of SET ROLE, SET DEFAULT ROLE and the DEFAULT ROLE clause
+	RoleSpec struct {
+		Type  RoleSpecType
+		Roles Accounts
+	}
+
+	// RoleSpecType is an enum for RoleSpec.Type
+	RoleSpecType int8
+
+	// AccountLockType is an enum for the ACCOUNT LOCK option
+	AccountLockType int8
+
+	// TLSOption represents an option of the REQUIRE clause of CREATE USER and ALTER USER.
+	// Value is only set for CIPHER, ISSUER and SUBJECT.
+	TLSOption struct {
+		Type  TLSOptionType
+		Value string
+	}
+
+	// TLSOptions represents the REQUIRE clause. REQUIRE NONE is a single RequireNone option.
+	TLSOptions []*TLSOption
+
+	// TLSOptionType is an enum for TLSOption.Type
+	TLSOptionType int8
+
+	// ResourceOption represents an option of the WITH clause of CREATE USER and ALTER USER
+	ResourceOption struct {
+		Type  ResourceOptionType
+		Count int
+	}
+
+	// ResourceOptions represents the WITH clause of CREATE USER and ALTER USER
+	ResourceOptions []*ResourceOption
+
+	// ResourceOptionType is an enum for ResourceOption.Type
+	ResourceOptionType int8
+
+	// PasswordOption represents a password management option of CREATE USER and ALTER USER.
+	// Value is the number of days, passwords or attempts for the types that take one.
+	PasswordOption struct {
+		Type  PasswordOptionType
+		Value int
+	}
+
+	// PasswordOptions represents a list of password management options
+	PasswordOptions []*PasswordOption
+
+	// PasswordOptionType is an enum for PasswordOption.Type
+	PasswordOptionType int8
+
+	// CreateUser represents a CREATE USER statement
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/create-user.html
+	CreateUser struct {
+		_span
+		IfNotExists     bool
+		Users           UserSpecs
+		DefaultRole     *RoleSpec
+		TLSOptions      TLSOptions
+		ResourceOptions ResourceOptions
+		PasswordOptions PasswordOptions
+		AccountLock     AccountLockType
+		Comment         string
+		Attribute       string
+	}
+
+	// AlterUser represents an ALTER USER statement
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/alter-user.html
+	AlterUser struct {
+		_span
+		IfExists        bool
+		Users           UserSpecs
+		DefaultRole     *RoleSpec
+		TLSOptions      TLSOptions
+		ResourceOptions ResourceOptions
+		PasswordOptions PasswordOptions
+		AccountLock     AccountLockType
+		Comment         string
+		Attribute       string
+	}
+
+	// RenameUserPair represents the old and the new name of a user in a RENAME USER statement
+	RenameUserPair struct {
+		FromUser *Account
+		ToUser   *Account
+	}
+
+	// RenameUser represents a RENAME USER statement
+	RenameUser struct {
+		_span
+		UserPairs []*RenameUserPair
+	}
+
+	// DropUser represents a DROP USER statement
+	DropUser struct {
+		_span
+		IfExists bool
+		Users    Accounts
+	}
+
+	// CreateRole represents a CREATE ROLE statement
+	CreateRole struct {
+		_span
+		IfNotExists bool
+		Roles       Accounts
+	}
+
+	// DropRole represents a DROP ROLE statement
+	DropRole struct {
+		_span
+		IfExists bool
+		Roles    Accounts
+	}
+
+	// SetRole represents a SET ROLE statement
+	SetRole struct {
+		_span
+		Roles *RoleSpec
+	}
+
+	// SetDefaultRole represents a SET DEFAULT ROLE statement
+	SetDefaultRole struct {
+		_span
+		Roles *RoleSpec
+		To    Accounts
+	}
+
+	// SetPassword represents a SET PASSWORD statement. User is nil for the current user.
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/set-password.html
+	SetPassword struct {
+		_span
+		User           *Account
+		Password       string
+		RandomPassword bool
+		Replace        string
+		RetainCurrent  bool
+	}
+
+	// CompoundStatements represents the statements of a stored program body.
+	// Regular statements and CompoundStatements can be mixed.
+	CompoundStatements []Statement
+
+	// BeginEndBlock represents a BEGIN ... END compound statement
+	BeginEndBlock struct {
+		_span
+		Label      ColIdent
+		Statements CompoundStatements
+	}
+
+	// DeclareVar represents a DECLARE statement for local variables
+	DeclareVar struct {
+		_span
+		Names   Columns
+		Type    ColumnType
+		Default Expr
+	}
+
+	// DeclareCondition represents a DECLARE ... CONDITION statement
+	DeclareCondition struct {
+		_span
+		Name      ColIdent
+		Condition *HandlerCondition
+	}
+
+	// DeclareCursor represents a DECLARE ... CURSOR statement
+	DeclareCursor struct {
+		_span
+		Name   ColIdent
+		Select SelectStatement
+	}
+
+	// DeclareHandler represents a DECLARE ... HANDLER statement
+	DeclareHandler struct {
+		_span
+		Action     HandlerActionType
+		Conditions []*HandlerCondition
+		Statement  Statement
+	}
+
+	// HandlerActionType is an enum for DeclareHandler.Action
+	HandlerActionType int8
+
+	// HandlerCondition represents a condition value of DECLARE ... HANDLER and DECLARE ... CONDITION.
+	// Value holds the error code or the SQLSTATE value, Name the name of a declared condition.
+	HandlerCondition struct {
+		Type  HandlerConditionType
+		Value string
+		Name  ColIdent
+	}
+
+	// HandlerConditionType is an enum for HandlerCondition.Type
+	HandlerConditionType int8
+
+	// IfStmt represents an IF compound statement
+	IfStmt struct {
+		_span
+		Cond       Expr
+		Statements CompoundStatements
+		ElseIfs    []*ElseIf
+		Else       CompoundStatements
+	}
+
+	// ElseIf represents an ELSEIF branch of an IF compound statement
+	ElseIf struct {
+		Cond       Expr
+		Statements CompoundStatements
+	}
+
+	// CaseStmt represents a CASE compound statement. Expr is nil for the searched form.
+	CaseStmt struct {
+		_span
+		Expr  Expr
+		Whens []*CaseStmtWhen
+		Else  CompoundStatements
+	}
+
+	// CaseStmtWhen represents a WHEN branch of a CASE compound statement
+	CaseStmtWhen struct {
+		Cond       Expr
+		Statements CompoundStatements
+	}
+
+	// LoopStmt represents a LOOP compound statement
+	LoopStmt struct {
+		_span
+		Label      ColIdent
+		Statements CompoundStatements
+	}
+
+	// WhileStmt represents a WHILE compound statement
+	WhileStmt struct {
+		_span
+		Label      ColIdent
+		Cond       Expr
+		Statements CompoundStatements
+	}
+
+	// RepeatStmt represents a REPEAT compound statement
+	RepeatStmt struct {
+		_span
+		Label      ColIdent
+		Statements CompoundStatements
+		Until      Expr
+	}
+
+	// LeaveStmt represents a LEAVE statement
+	LeaveStmt struct {
+		_span
+		Label ColIdent
+	}
+
+	// IterateStmt represents an ITERATE statement
+	IterateStmt struct {
+		_span
+		Label ColIdent
+	}
+
+	// ReturnStmt represents a RETURN statement of a stored function
+	ReturnStmt struct {
+		_span
+		Expr Expr
+	}
+
+	// OpenCursor represents an OPEN statement
+	OpenCursor struct {
+		_span
+		Name ColIdent
+	}
+
+	// CloseCursor represents a CLOSE statement
+	CloseCursor struct {
+		_span
+		Name ColIdent
+	}
+
+	// FetchCursor represents a FETCH statement
+	FetchCursor struct {
+		_span
+		Name ColIdent
+		Into Columns
+	}
+
+	// Signal represents a SIGNAL or RESIGNAL statement. Condition is nil for a RESIGNAL
+	// without condition value.
+	// More info available on https://dev.mysql.com/doc/refman/8.0/en/signal.html
+	Signal struct {
+		_span
+		Resignal  bool
+		Condition *HandlerCondition
+		Info      []*SignalInfo
+	}
+
+	// SignalInfo represents an item of the SET clause of a SIGNAL or RESIGNAL statement.
+	// Name is the lower case condition information item name, e.g. "message_text".
+	SignalInfo struct {
+		Name  string
+		Value Expr
+	}
+
+	// AnalyzeTable represents an ANALYZE TABLE statement.
+	AnalyzeTable struct {
+		_span
+		IsLocal          bool
+		Tables           TableNames
+		HistogramAction  HistogramActionType
+		HistogramColumns Columns
+		Buckets          *Literal
+	}
+
+	// HistogramActionType is an enum for AnalyzeTable.HistogramAction
+	HistogramActionType int8
+
+	// OptimizeTable represents an OPTIMIZE TABLE statement.
+	OptimizeTable struct {
+		_span
+		IsLocal bool
+		Tables  TableNames
+	}
+
+	// RepairTable represents a REPAIR TABLE statement.
+	RepairTable struct {
+		_span
+		IsLocal bool
+		Tables  TableNames
+		Options []MaintenanceOption
+	}
+
+	// CheckTable represents a CHECK TABLE statement.
+	CheckTable struct {
+		_span
+		Tables  TableNames
+		Options []MaintenanceOption
+	}
+
+	// ChecksumTable represents a CHECKSUM TABLE statement.
+	ChecksumTable struct {
+		_span
+		Tables  TableNames
+		Options []MaintenanceOption
+	}
+
+	// MaintenanceOption is an enum for the options of REPAIR, CHECK and CHECKSUM TABLE
+	MaintenanceOption int8
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
-	OtherRead struct{}
+	OtherRead struct {
+		_span
+	}
 
 	// OtherAdmin represents a misc statement that relies on ADMIN privileges,
 	// such as REPAIR, OPTIMIZE, or TRUNCATE statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
-	OtherAdmin struct{}
+	OtherAdmin struct {
+		_span
+	}
 )
 
-func (*Union) iStatement()             {}
-func (*Select) iStatement()            {}
-func (*Stream) iStatement()            {}
-func (*VStream) iStatement()           {}
-func (*Insert) iStatement()            {}
-func (*Update) iStatement()            {}
-func (*Delete) iStatement()            {}
-func (*Set) iStatement()               {}
-func (*SetTransaction) iStatement()    {}
-func (*DropDatabase) iStatement()      {}
-func (*Flush) iStatement()             {}
-func (*Show) iStatement()              {}
-func (*Use) iStatement()               {}
-func (*Begin) iStatement()             {}
-func (*Commit) iStatement()            {}
-func (*Rollback) iStatement()          {}
-func (*SRollback) iStatement()         {}
-func (*Savepoint) iStatement()         {}
-func (*Release) iStatement()           {}
-func (*OtherRead) iStatement()         {}
-func (*OtherAdmin) iStatement()        {}
-func (*Select) iSelectStatement()      {}
-func (*Union) iSelectStatement()       {}
-func (*Load) iStatement()              {}
-func (*CreateDatabase) iStatement()    {}
-func (*AlterDatabase) iStatement()     {}
-func (*CreateTable) iStatement()       {}
-func (*CreateView) iStatement()        {}
-func (*AlterView) iStatement()         {}
-func (*LockTables) iStatement()        {}
-func (*UnlockTables) iStatement()      {}
-func (*AlterTable) iStatement()        {}
-func (*AlterVschema) iStatement()      {}
-func (*AlterMigration) iStatement()    {}
-func (*RevertMigration) iStatement()   {}
-func (*ShowMigrationLogs) iStatement() {}
-func (*ShowThrottledApps) iStatement() {}
-func (*DropTable) iStatement()         {}
-func (*DropView) iStatement()          {}
-func (*TruncateTable) iStatement()     {}
-func (*RenameTable) iStatement()       {}
-func (*CallProc) iStatement()          {}
-func (*ExplainStmt) iStatement()       {}
-func (*ExplainTab) iStatement()        {}
-func (*PrepareStmt) iStatement()       {}
-func (*ExecuteStmt) iStatement()       {}
-func (*DeallocateStmt) iStatement()    {}
-
-func (*CreateView) iDDLStatement()    {}
-func (*AlterView) iDDLStatement()     {}
-func (*CreateTable) iDDLStatement()   {}
-func (*DropTable) iDDLStatement()     {}
-func (*DropView) iDDLStatement()      {}
-func (*AlterTable) iDDLStatement()    {}
-func (*TruncateTable) iDDLStatement() {}
-func (*RenameTable) iDDLStatement()   {}
+func (*Union) iStatement()                 {}
+func (*ValuesStatement) iStatement()       {}
+func (*TableStatement) iStatement()        {}
+func (*Select) iStatement()                {}
+func (*Stream) iStatement()                {}
+func (*VStream) iStatement()               {}
+func (*Insert) iStatement()                {}
+func (*Update) iStatement()                {}
+func (*Delete) iStatement()                {}
+func (*Set) iStatement()                   {}
+func (*SetTransaction) iStatement()        {}
+func (*DropDatabase) iStatement()          {}
+func (*Flush) iStatement()                 {}
+func (*Show) iStatement()                  {}
+func (*Use) iStatement()                   {}
+func (*Begin) iStatement()                 {}
+func (*XATransaction) iStatement()         {}
+func (*Kill) iStatement()                  {}
+func (*Shutdown) iStatement()              {}
+func (*InstallPlugin) iStatement()         {}
+func (*UninstallPlugin) iStatement()       {}
+func (*InstallComponent) iStatement()      {}
+func (*UninstallComponent) iStatement()    {}
+func (*Commit) iStatement()                {}
+func (*Rollback) iStatement()              {}
+func (*SRollback) iStatement()             {}
+func (*Savepoint) iStatement()             {}
+func (*Release) iStatement()               {}
+func (*OtherRead) iStatement()             {}
+func (*AnalyzeTable) iStatement()          {}
+func (*OptimizeTable) iStatement()         {}
+func (*RepairTable) iStatement()           {}
+func (*CheckTable) iStatement()            {}
+func (*ChecksumTable) iStatement()         {}
+func (*OtherAdmin) iStatement()            {}
+func (*Select) iSelectStatement()          {}
+func (*Union) iSelectStatement()           {}
+func (*ValuesStatement) iSelectStatement() {}
+func (*TableStatement) iSelectStatement()  {}
+func (*Load) iStatement()                  {}
+func (*CreateDatabase) iStatement()        {}
+func (*AlterDatabase) iStatement()         {}
+func (*CreateTable) iStatement()           {}
+func (*CreateView) iStatement()            {}
+func (*AlterView) iStatement()             {}
+func (*LockTables) iStatement()            {}
+func (*UnlockTables) iStatement()          {}
+func (*AlterTable) iStatement()            {}
+func (*AlterVschema) iStatement()          {}
+func (*AlterMigration) iStatement()        {}
+func (*RevertMigration) iStatement()       {}
+func (*ShowMigrationLogs) iStatement()     {}
+func (*ShowThrottledApps) iStatement()     {}
+func (*DropTable) iStatement()             {}
+func (*DropView) iStatement()              {}
+func (*TruncateTable) iStatement()         {}
+func (*RenameTable) iStatement()           {}
+func (*CallProc) iStatement()              {}
+func (*DoStmt) iStatement()                {}
+func (*BadStmt) iStatement()               {}
+func (*ExplainStmt) iStatement()           {}
+func (*ExplainTab) iStatement()            {}
+func (*PrepareStmt) iStatement()           {}
+func (*ExecuteStmt) iStatement()           {}
+func (*DeallocateStmt) iStatement()        {}
+func (*Grant) iStatement()                 {}
+func (*Revoke) iStatement()                {}
+func (*CreateUser) iStatement()            {}
+func (*AlterUser) iStatement()             {}
+func (*DropUser) iStatement()              {}
+func (*RenameUser) iStatement()            {}
+func (*CreateRole) iStatement()            {}
+func (*DropRole) iStatement()              {}
+func (*SetRole) iStatement()               {}
+func (*SetDefaultRole) iStatement()        {}
+func (*SetPassword) iStatement()           {}
+func (*CreateProcedure) iStatement()       {}
+func (*CreateFunction) iStatement()        {}
+func (*CreateTrigger) iStatement()         {}
+func (*CreateEvent) iStatement()           {}
+func (*AlterRoutine) iStatement()          {}
+func (*AlterEvent) iStatement()            {}
+func (*DropProgram) iStatement()           {}
+func (*BeginEndBlock) iStatement()         {}
+func (*DeclareVar) iStatement()            {}
+func (*DeclareCondition) iStatement()      {}
+func (*DeclareCursor) iStatement()         {}
+func (*DeclareHandler) iStatement()        {}
+func (*IfStmt) iStatement()                {}
+func (*CaseStmt) iStatement()              {}
+func (*LoopStmt) iStatement()              {}
+func (*WhileStmt) iStatement()             {}
+func (*RepeatStmt) iStatement()            {}
+func (*LeaveStmt) iStatement()             {}
+func (*IterateStmt) iStatement()           {}
+func (*ReturnStmt) iStatement()            {}
+func (*OpenCursor) iStatement()            {}
+func (*CloseCursor) iStatement()           {}
+func (*FetchCursor) iStatement()           {}
+func (*Signal) iStatement()                {}
+
+func (*ChangeReplicationSource) iStatement() {}
+func (*StartReplica) iStatement()            {}
+func (*StopReplica) iStatement()             {}
+func (*ResetReplica) iStatement()            {}
+func (*ResetMaster) iStatement()             {}
+func (*PurgeBinaryLogs) iStatement()         {}
+
+func (*BeginEndBlock) iCompoundStatement()    {}
+func (*DeclareVar) iCompoundStatement()       {}
+func (*DeclareCondition) iCompoundStatement() {}
+func (*DeclareCursor) iCompoundStatement()    {}
+func (*DeclareHandler) iCompoundStatement()   {}
+func (*IfStmt) iCompoundStatement()           {}
+func (*CaseStmt) iCompoundStatement()         {}
+func (*LoopStmt) iCompoundStatement()         {}
+func (*WhileStmt) iCompoundStatement()        {}
+func (*RepeatStmt) iCompoundStatement()       {}
+func (*LeaveStmt) iCompoundStatement()        {}
+func (*IterateStmt) iCompoundStatement()      {}
+func (*ReturnStmt) iCompoundStatement()       {}
+func (*OpenCursor) iCompoundStatement()       {}
+func (*CloseCursor) iCompoundStatement()      {}
+func (*FetchCursor) iCompoundStatement()      {}
+func (*Signal) iCompoundStatement()           {}
+
+func (*AnalyzeTable) iTableMaintenanceStatement()  {}
+func (*OptimizeTable) iTableMaintenanceStatement() {}
+func (*RepairTable) iTableMaintenanceStatement()   {}
+func (*CheckTable) iTableMaintenanceStatement()    {}
+func (*ChecksumTable) iTableMaintenanceStatement() {}
+
+func (*CreateView) iDDLStatement()      {}
+func (*AlterView) iDDLStatement()       {}
+func (*CreateTable) iDDLStatement()     {}
+func (*DropTable) iDDLStatement()       {}
+func (*DropView) iDDLStatement()        {}
+func (*AlterTable) iDDLStatement()      {}
+func (*TruncateTable) iDDLStatement()   {}
+func (*RenameTable) iDDLStatement()     {}
+func (*CreateProcedure) iDDLStatement() {}
+func (*CreateFunction) iDDLStatement()  {}
+func (*CreateTrigger) iDDLStatement()   {}
+func (*CreateEvent) iDDLStatement()     {}
+func (*AlterRoutine) iDDLStatement()    {}
+func (*AlterEvent) iDDLStatement()      {}
+func (*DropProgram) iDDLStatement()     {}
 
 func (*AddConstraintDefinition) iAlterOption() {}
 func (*AddIndexDefinition) iAlterOption()      {}
@@ -754,6 +1729,7 @@ func (*OrderByOption) iAlterOption()           {}
 func (*RenameTableName) iAlterOption()         {}
 func (*RenameIndex) iAlterOption()             {}
 func (*Validation) iAlterOption()              {}
+func (*BadDefinition) iAlterOption()           {}
 func (TableOptions) iAlterOption()             {}
 
 func (*ExplainStmt) iExplain() {}
@@ -1522,17 +2498,612 @@ func (node *DropTable) SetTable(qualifier string, name string) {}
 // SetTable implements DDLStatement.
 func (node *DropView) SetTable(qualifier string, name string) {}
 
-func (*DropDatabase) iDBDDLStatement()   {}
-func (*CreateDatabase) iDBDDLStatement() {}
-func (*AlterDatabase) iDBDDLStatement()  {}
-
-// IsFullyParsed implements the DBDDLStatement interface
-func (node *DropDatabase) IsFullyParsed() bool {
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateProcedure) IsFullyParsed() bool {
 	return true
 }
 
-// SetFullyParsed implements the DBDDLStatement interface
-func (node *DropDatabase) SetFullyParsed(fullyParsed bool) {}
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateFunction) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateTrigger) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateEvent) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *AlterRoutine) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *AlterEvent) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *DropProgram) IsFullyParsed() bool {
+	return true
+}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateProcedure) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateFunction) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateTrigger) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateEvent) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *AlterRoutine) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *AlterEvent) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *DropProgram) SetFullyParsed(fullyParsed bool) {}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateProcedure) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateFunction) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateTrigger) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateEvent) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *AlterRoutine) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *AlterEvent) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *DropProgram) IsTemporary() bool {
+	return false
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateProcedure) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateFunction) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateTrigger) GetTable() TableName {
+	return node.Table
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateEvent) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterRoutine) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterEvent) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *DropProgram) GetTable() TableName {
+	return TableName{}
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateProcedure) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateFunction) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateTrigger) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateEvent) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *AlterRoutine) GetAction() DDLAction {
+	return AlterDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *AlterEvent) GetAction() DDLAction {
+	return AlterDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *DropProgram) GetAction() DDLAction {
+	return DropDDLAction
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateProcedure) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateFunction) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateTrigger) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateEvent) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *AlterRoutine) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *AlterEvent) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *DropProgram) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateProcedure) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateFunction) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateTrigger) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateEvent) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *AlterRoutine) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *AlterEvent) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *DropProgram) GetIfExists() bool {
+	return node.IfExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateProcedure) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateFunction) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateTrigger) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateEvent) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *AlterRoutine) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *AlterEvent) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *DropProgram) GetIfNotExists() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateProcedure) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateFunction) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateTrigger) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateEvent) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *AlterRoutine) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *AlterEvent) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *DropProgram) GetIsReplace() bool {
+	return false
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateProcedure) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateFunction) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateTrigger) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateEvent) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *AlterRoutine) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *AlterEvent) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *DropProgram) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateProcedure) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateFunction) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateTrigger) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateEvent) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *AlterRoutine) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *AlterEvent) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *DropProgram) GetFromTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateProcedure) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateFunction) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateTrigger) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateEvent) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *AlterRoutine) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *AlterEvent) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *DropProgram) GetToTables() TableNames {
+	return nil
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *CreateProcedure) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *CreateFunction) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *CreateTrigger) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *CreateEvent) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *AlterRoutine) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *AlterEvent) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements the DDLStatement interface
+func (node *DropProgram) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetComments implements the DDLStatement interface
+func (node *CreateProcedure) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *CreateFunction) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *CreateTrigger) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *CreateEvent) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *AlterRoutine) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *AlterEvent) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements the DDLStatement interface
+func (node *DropProgram) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *CreateProcedure) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *CreateFunction) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *CreateTrigger) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *CreateEvent) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *AlterRoutine) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *AlterEvent) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements the DDLStatement interface
+func (node *DropProgram) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *CreateProcedure) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *CreateFunction) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *CreateTrigger) AffectedTables() TableNames {
+	return TableNames{node.Table}
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *CreateEvent) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *AlterRoutine) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *AlterEvent) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements the DDLStatement interface
+func (node *DropProgram) AffectedTables() TableNames {
+	return nil
+}
+
+// SetTable implements the DDLStatement interface
+func (node *CreateProcedure) SetTable(qualifier string, name string) {}
+
+// SetTable implements the DDLStatement interface
+func (node *CreateFunction) SetTable(qualifier string, name string) {}
+
+// SetTable implements the DDLStatement interface
+func (node *CreateTrigger) SetTable(qualifier string, name string) {
+	node.Table.Qualifier = NewTableIdent(qualifier)
+	node.Table.Name = NewTableIdent(name)
+}
+
+// SetTable implements the DDLStatement interface
+func (node *CreateEvent) SetTable(qualifier string, name string) {}
+
+// SetTable implements the DDLStatement interface
+func (node *AlterRoutine) SetTable(qualifier string, name string) {}
+
+// SetTable implements the DDLStatement interface
+func (node *AlterEvent) SetTable(qualifier string, name string) {}
+
+// SetTable implements the DDLStatement interface
+func (node *DropProgram) SetTable(qualifier string, name string) {}
+
+// AffectedTables implements the TableMaintenanceStatement interface
+func (node *AnalyzeTable) AffectedTables() TableNames {
+	return node.Tables
+}
+
+// AffectedTables implements the TableMaintenanceStatement interface
+func (node *OptimizeTable) AffectedTables() TableNames {
+	return node.Tables
+}
+
+// AffectedTables implements the TableMaintenanceStatement interface
+func (node *RepairTable) AffectedTables() TableNames {
+	return node.Tables
+}
+
+// AffectedTables implements the TableMaintenanceStatement interface
+func (node *CheckTable) AffectedTables() TableNames {
+	return node.Tables
+}
+
+// AffectedTables implements the TableMaintenanceStatement interface
+func (node *ChecksumTable) AffectedTables() TableNames {
+	return node.Tables
+}
+
+func (*DropDatabase) iDBDDLStatement()   {}
+func (*CreateDatabase) iDBDDLStatement() {}
+func (*AlterDatabase) iDBDDLStatement()  {}
+
+// IsFullyParsed implements the DBDDLStatement interface
+func (node *DropDatabase) IsFullyParsed() bool {
+	return true
+}
+
+// SetFullyParsed implements the DBDDLStatement interface
+func (node *DropDatabase) SetFullyParsed(fullyParsed bool) {}
 
 // IsFullyParsed implements the DBDDLStatement interface
 func (node *CreateDatabase) IsFullyParsed() bool {
@@ -1581,29 +3152,74 @@ type (
 	ShowCommandType int8
 
 	// ShowBasic is of ShowInternal type, holds Simple SHOW queries with a filter.
+	// Limit is set for SHOW ERRORS and SHOW WARNINGS, and Channel for SHOW REPLICA STATUS.
+	// Extended is only set for SHOW EXTENDED INDEX.
 	ShowBasic struct {
-		Command ShowCommandType
-		Full    bool
-		Tbl     TableName
-		DbName  TableIdent
-		Filter  *ShowFilter
+		Command  ShowCommandType
+		Extended bool
+		Full     bool
+		Tbl      TableName
+		DbName   TableIdent
+		Filter   *ShowFilter
+		Limit    *Limit
+		Channel  string
 	}
 
-	// ShowCreate is of ShowInternal type, holds SHOW CREATE queries.
+	// ShowCreate is of ShowInternal type, holds SHOW CREATE queries and the other
+	// queries on a single named object, such as SHOW FUNCTION CODE.
+	// User is set instead of Op for SHOW CREATE USER, and IfNotExists is only set
+	// for SHOW CREATE DATABASE.
 	ShowCreate struct {
-		Command ShowCommandType
-		Op      TableName
+		Command     ShowCommandType
+		IfNotExists bool
+		Op          TableName
+		User        *Account
 	}
 
 	// ShowOther is of ShowInternal type, holds show queries that is not handled specially.
 	ShowOther struct {
 		Command string
 	}
+
+	// ShowBinlogEvents is of ShowInternal type, holds SHOW BINLOG EVENTS
+	// and SHOW RELAYLOG EVENTS queries. Channel is only set for the relay log.
+	ShowBinlogEvents struct {
+		Relaylog bool
+		LogName  string
+		Position *Literal
+		Limit    *Limit
+		Channel  string
+	}
+
+	// ShowEngine is of ShowInternal type, holds SHOW ENGINE engine_name { STATUS | MUTEX } queries.
+	ShowEngine struct {
+		Engine ColIdent
+		Mutex  bool
+	}
+
+	// ShowGrants is of ShowInternal type, holds SHOW GRANTS queries.
+	// For is nil for the grants of the current user.
+	ShowGrants struct {
+		For   *Account
+		Using Accounts
+	}
+
+	// ShowProfile is of ShowInternal type, holds SHOW PROFILE queries.
+	// Types holds the lower case information types, e.g. "cpu" or "block io".
+	ShowProfile struct {
+		Types []string
+		Query *Literal
+		Limit *Limit
+	}
 )
 
-func (*ShowBasic) isShowInternal()  {}
-func (*ShowCreate) isShowInternal() {}
-func (*ShowOther) isShowInternal()  {}
+func (*ShowBasic) isShowInternal()        {}
+func (*ShowCreate) isShowInternal()       {}
+func (*ShowOther) isShowInternal()        {}
+func (*ShowBinlogEvents) isShowInternal() {}
+func (*ShowEngine) isShowInternal()       {}
+func (*ShowGrants) isShowInternal()       {}
+func (*ShowProfile) isShowInternal()      {}
 
 // InsertRows represents the rows for an INSERT statement.
 type InsertRows interface {
@@ -1611,9 +3227,11 @@ type InsertRows interface {
 	SQLNode
 }
 
-func (*Select) iInsertRows() {}
-func (*Union) iInsertRows()  {}
-func (Values) iInsertRows()  {}
+func (*Select) iInsertRows()          {}
+func (*Union) iInsertRows()           {}
+func (*ValuesStatement) iInsertRows() {}
+func (*TableStatement) iInsertRows()  {}
+func (Values) iInsertRows()           {}
 
 // OptLike works for create table xxx like xxx
 type OptLike struct {
@@ -1721,6 +3339,8 @@ type TableSpec struct {
 	Constraints     []*ConstraintDefinition
 	Options         TableOptions
 	PartitionOption *PartitionOption
+	// BadDefinitions holds the definitions with a syntax error, see ParseScript.
+	BadDefinitions []*BadDefinition
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -1906,12 +3526,16 @@ func (c Comments) Parsed() *ParsedComments {
 	if len(c) == 0 {
 		return nil
 	}
-	return &ParsedComments{comments: c}
+	parsed := &ParsedComments{comments: c}
+	parsed.hints, parsed.hintErrors = parseOptimizerHintComment(c)
+	return parsed
 }
 
 type ParsedComments struct {
 	comments    Comments
 	_directives CommentDirectives
+	hints       OptimizerHints
+	hintErrors  []*OptimizerHintError
 }
 
 // SelectExprs represents SELECT expressions.
@@ -1965,6 +3589,7 @@ type (
 	// coupled with an optional alias or index hint.
 	// If As is empty, no alias was used.
 	AliasedTableExpr struct {
+		_span
 		Expr       SimpleTableExpr
 		Partitions Partitions
 		As         TableIdent
@@ -1974,6 +3599,7 @@ type (
 
 	// JoinTableExpr represents a TableExpr that's a JOIN operation.
 	JoinTableExpr struct {
+		_span
 		LeftExpr  TableExpr
 		Join      JoinType
 		RightExpr TableExpr
@@ -1985,6 +3611,7 @@ type (
 
 	// ParenTableExpr represents a parenthesized list of TableExpr.
 	ParenTableExpr struct {
+		_span
 		Exprs TableExprs
 	}
 )
@@ -2012,6 +3639,7 @@ type (
 
 	// Subquery represents a subquery used as an value expression.
 	Subquery struct {
+		_span
 		Select SelectStatement
 	}
 
@@ -2064,6 +3692,7 @@ type WhereType int8
 // TrimFuncExpr represents a TRIM function
 // More information available on https://dev.mysql.com/doc/refman/5.7/en/string-functions.html#function_trim
 type TrimFuncExpr struct {
+	_span
 	TrimFuncType TrimFuncType
 	Type         TrimType
 	TrimArg      Expr
@@ -2077,6 +3706,74 @@ type TrimFuncType int8
 // TrimType is an enum to get types of Trim
 type TrimType int8
 
+// Types for window functions
+type (
+	// WindowSpecification represents window_spec
+	// More information available here: https://dev.mysql.com/doc/refman/8.0/en/window-functions-usage.html
+	WindowSpecification struct {
+		Name            ColIdent
+		PartitionClause Exprs
+		OrderClause     OrderBy
+		FrameClause     *FrameClause
+	}
+
+	// WindowDefinition represents a single window_name AS (window_spec) in the WINDOW clause
+	// More information available here: https://dev.mysql.com/doc/refman/8.0/en/window-functions-named-windows.html
+	WindowDefinition struct {
+		Name       ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// WindowDefinitions represents the WINDOW clause of a SELECT
+	WindowDefinitions []*WindowDefinition
+
+	// FrameClause represents frame_clause
+	// More information available here: https://dev.mysql.com/doc/refman/8.0/en/window-functions-frames.html
+	FrameClause struct {
+		Unit  FrameUnitType
+		Start *FramePoint
+		End   *FramePoint
+	}
+
+	// FramePoint refers to frame_start/frame_end
+	// More information available here: https://dev.mysql.com/doc/refman/8.0/en/window-functions-frames.html
+	FramePoint struct {
+		Type FramePointType
+		Expr Expr
+	}
+
+	// OverClause refers to over_clause
+	// More information available here: https://dev.mysql.com/doc/refman/8.0/en/window-functions-usage.html
+	OverClause struct {
+		WindowName ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// FrameUnitType is an enum to get types of Unit used in FrameClause.
+	FrameUnitType int8
+
+	// FramePointType is an enum to get types of FramePoint.
+	FramePointType int8
+
+	// NullTreatmentClause refers to null_treatment
+	// MySQL permits only RESPECT NULLS (which is also the default); IGNORE NULLS is parsed, but produces an error.
+	NullTreatmentClause struct {
+		Type NullTreatmentType
+	}
+
+	// NullTreatmentType is an enum to get types for NullTreatmentClause
+	NullTreatmentType int8
+
+	// FromFirstLastClause refers to from_first_last
+	// MySQL permits only FROM FIRST (which is also the default); FROM LAST is parsed, but produces an error.
+	FromFirstLastClause struct {
+		Type FromFirstLastType
+	}
+
+	// FromFirstLastType is an enum to get types for FromFirstLastClause
+	FromFirstLastType int8
+)
+
 // *********** Expressions
 type (
 	// Expr represents an expression.
@@ -2092,26 +3789,31 @@ type (
 
 	// AndExpr represents an AND expression.
 	AndExpr struct {
+		_span
 		Left, Right Expr
 	}
 
 	// OrExpr represents an OR expression.
 	OrExpr struct {
+		_span
 		Left, Right Expr
 	}
 
 	// XorExpr represents an XOR expression.
 	XorExpr struct {
+		_span
 		Left, Right Expr
 	}
 
 	// NotExpr represents a NOT expression.
 	NotExpr struct {
+		_span
 		Expr Expr
 	}
 
 	// ComparisonExpr represents a two-value comparison expression.
 	ComparisonExpr struct {
+		_span
 		Operator    ComparisonExprOperator
 		Left, Right Expr
 		Escape      Expr
@@ -2122,6 +3824,7 @@ type (
 
 	// BetweenExpr represents a BETWEEN or a NOT BETWEEN expression.
 	BetweenExpr struct {
+		_span
 		IsBetween bool
 		Left      Expr
 		From, To  Expr
@@ -2132,6 +3835,7 @@ type (
 
 	// IsExpr represents an IS ... or an IS NOT ... expression.
 	IsExpr struct {
+		_span
 		Left  Expr
 		Right IsExprOperator
 	}
@@ -2141,11 +3845,13 @@ type (
 
 	// ExistsExpr represents an EXISTS expression.
 	ExistsExpr struct {
+		_span
 		Subquery *Subquery
 	}
 
 	// Literal represents a fixed value.
 	Literal struct {
+		_span
 		Type ValType
 		Val  string
 	}
@@ -2154,12 +3860,15 @@ type (
 	Argument string
 
 	// NullVal represents a NULL value.
-	NullVal struct{}
+	NullVal struct {
+		_span
+	}
 
 	// BoolVal is true or false.
 	BoolVal bool
 
-	// ColName represents a column name.
+	// ColName represents a column name. Its Span is computed from the
+	// spans of its identifiers, so that it doesn't need a field of its own.
 	ColName struct {
 		// Metadata is not populated by the parser.
 		// It's a placeholder for analyzers to store
@@ -2185,6 +3894,7 @@ type (
 
 	// BinaryExpr represents a binary value expression.
 	BinaryExpr struct {
+		_span
 		Operator    BinaryExprOperator
 		Left, Right Expr
 	}
@@ -2194,6 +3904,7 @@ type (
 
 	// UnaryExpr represents a unary value expression.
 	UnaryExpr struct {
+		_span
 		Operator UnaryExprOperator
 		Expr     Expr
 	}
@@ -2203,18 +3914,21 @@ type (
 
 	// IntroducerExpr represents a unary value expression.
 	IntroducerExpr struct {
+		_span
 		CharacterSet string
 		Expr         Expr
 	}
 
 	// IntervalExpr represents a date-time INTERVAL expression.
 	IntervalExpr struct {
+		_span
 		Expr Expr
 		Unit string
 	}
 
 	// TimestampFuncExpr represents the function and arguments for TIMESTAMP{ADD,DIFF} functions.
 	TimestampFuncExpr struct {
+		_span
 		Name  string
 		Expr1 Expr
 		Expr2 Expr
@@ -2223,32 +3937,38 @@ type (
 
 	// ExtractFuncExpr represents the function and arguments for EXTRACT(YEAR FROM '2019-07-02') type functions.
 	ExtractFuncExpr struct {
+		_span
 		IntervalTypes IntervalTypes
 		Expr          Expr
 	}
 
 	// CollateExpr represents dynamic collate operator.
 	CollateExpr struct {
+		_span
 		Expr      Expr
 		Collation string
 	}
 
 	// WeightStringFuncExpr represents the function and arguments for WEIGHT_STRING('string' AS [CHAR|BINARY](n))
 	WeightStringFuncExpr struct {
+		_span
 		Expr Expr
 		As   *ConvertType
 	}
 
 	// FuncExpr represents a function call.
 	FuncExpr struct {
+		_span
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause // set when an aggregate function is used as a window function
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
 	GroupConcatExpr struct {
+		_span
 		Distinct  bool
 		Exprs     SelectExprs
 		OrderBy   OrderBy
@@ -2256,8 +3976,15 @@ type (
 		Limit     *Limit
 	}
 
+	// GroupingExpr represents a call to GROUPING
+	GroupingExpr struct {
+		_span
+		Exprs Exprs
+	}
+
 	// ValuesFuncExpr represents a function call.
 	ValuesFuncExpr struct {
+		_span
 		Name *ColName
 	}
 
@@ -2267,6 +3994,7 @@ type (
 	// - SubstrExpr(expression FROM expression)
 	// - SubstrExpr(expression FROM expression FOR expression)
 	SubstrExpr struct {
+		_span
 		Name Expr
 		From Expr
 		To   Expr
@@ -2275,18 +4003,21 @@ type (
 	// ConvertExpr represents a call to CONVERT(expr, type)
 	// or it's equivalent CAST(expr AS type). Both are rewritten to the former.
 	ConvertExpr struct {
+		_span
 		Expr Expr
 		Type *ConvertType
 	}
 
 	// ConvertUsingExpr represents a call to CONVERT(expr USING charset).
 	ConvertUsingExpr struct {
+		_span
 		Expr Expr
 		Type string
 	}
 
 	// MatchExpr represents a call to the MATCH function
 	MatchExpr struct {
+		_span
 		Columns SelectExprs
 		Expr    Expr
 		Option  MatchExprOption
@@ -2297,6 +4028,7 @@ type (
 
 	// CaseExpr represents a CASE expression.
 	CaseExpr struct {
+		_span
 		Expr  Expr
 		Whens []*When
 		Else  Expr
@@ -2304,6 +4036,7 @@ type (
 
 	// Default represents a DEFAULT expression.
 	Default struct {
+		_span
 		ColName string
 	}
 
@@ -2316,6 +4049,7 @@ type (
 	// CurTimeFuncExpr represents the function and arguments for CURRENT DATE/TIME functions
 	// supported functions are documented in the grammar
 	CurTimeFuncExpr struct {
+		_span
 		Name ColIdent
 		Fsp  Expr // fractional seconds precision, integer from 0 to 6 or an Argument
 	}
@@ -2324,6 +4058,7 @@ type (
 	// This is a struct that the parser will never produce - it's written and read by the gen4 planner
 	// CAUTION: you should only change argName and hasValuesArg through the setter methods
 	ExtractedSubquery struct {
+		_span
 		Original     Expr // original expression that was replaced by this ExtractedSubquery
 		OpCode       int  // this should really be engine.PulloutOpCode, but we cannot depend on engine :(
 		Subquery     *Subquery
@@ -2338,18 +4073,21 @@ type (
 	// JSONPrettyExpr represents the function and argument for JSON_PRETTY()
 	// https://dev.mysql.com/doc/refman/8.0/en/json-utility-functions.html#function_json-pretty
 	JSONPrettyExpr struct {
+		_span
 		JSONVal Expr
 	}
 
 	// JSONStorageFreeExpr represents the function and argument for JSON_STORAGE_FREE()
 	// https://dev.mysql.com/doc/refman/8.0/en/json-utility-functions.html#function_json-storage-free
 	JSONStorageFreeExpr struct {
+		_span
 		JSONVal Expr
 	}
 
 	// JSONStorageSizeExpr represents the function and argument for JSON_STORAGE_SIZE()
 	// https://dev.mysql.com/doc/refman/8.0/en/json-utility-functions.html#function_json-storage-size
 	JSONStorageSizeExpr struct {
+		_span
 		JSONVal Expr
 	}
 
@@ -2359,12 +4097,14 @@ type (
 	// JSONArrayExpr represents JSON_ARRAY()
 	// More information on https://dev.mysql.com/doc/refman/8.0/en/json-creation-functions.html#function_json-array
 	JSONArrayExpr struct {
+		_span
 		Params Exprs
 	}
 
 	// JSONObjectExpr represents JSON_OBJECT()
 	// More information on https://dev.mysql.com/doc/refman/8.0/en/json-creation-functions.html#function_json-object
 	JSONObjectExpr struct {
+		_span
 		Params []*JSONObjectParam
 	}
 
@@ -2377,12 +4117,14 @@ type (
 	// JSONQuoteExpr represents JSON_QUOTE()
 	// More information https://dev.mysql.com/doc/refman/8.0/en/json-creation-functions.html#function_json-quote
 	JSONQuoteExpr struct {
+		_span
 		StringArg Expr
 	}
 
 	// JSONTableExpr describes the components of JSON_TABLE()
 	// For more information, visit https://dev.mysql.com/doc/refman/8.0/en/json-table-functions.html#function_json-table
 	JSONTableExpr struct {
+		_span
 		Expr    Expr
 		Alias   TableIdent
 		Filter  Expr
@@ -2432,6 +4174,7 @@ type (
 	// JSONContainsExpr represents the function and arguments for JSON_CONTAINS()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-contains
 	JSONContainsExpr struct {
+		_span
 		Target    Expr
 		Candidate Expr
 		PathList  []JSONPathParam
@@ -2440,6 +4183,7 @@ type (
 	// JSONContainsPathExpr represents the function and arguments for JSON_CONTAINS_PATH()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-contains-path
 	JSONContainsPathExpr struct {
+		_span
 		JSONDoc  Expr
 		OneOrAll Expr
 		PathList []JSONPathParam
@@ -2451,6 +4195,7 @@ type (
 	// JSONExtractExpr represents the function and arguments for JSON_EXTRACT()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-extract
 	JSONExtractExpr struct {
+		_span
 		JSONDoc  Expr
 		PathList []JSONPathParam
 	}
@@ -2458,6 +4203,7 @@ type (
 	// JSONKeysExpr represents the function and arguments for JSON_KEYS()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-keys
 	JSONKeysExpr struct {
+		_span
 		JSONDoc  Expr
 		PathList []JSONPathParam
 	}
@@ -2465,6 +4211,7 @@ type (
 	// JSONOverlapsExpr represents the function and arguments for JSON_OVERLAPS()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-overlaps
 	JSONOverlapsExpr struct {
+		_span
 		JSONDoc1 Expr
 		JSONDoc2 Expr
 	}
@@ -2472,6 +4219,7 @@ type (
 	// JSONSearchExpr represents the function and arguments for JSON_SEARCH()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-search
 	JSONSearchExpr struct {
+		_span
 		JSONDoc    Expr
 		OneOrAll   Expr
 		SearchStr  Expr
@@ -2482,6 +4230,7 @@ type (
 	// JSONValueExpr represents the function and arguments for JSON_VALUE()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-value
 	JSONValueExpr struct {
+		_span
 		JSONDoc         Expr
 		Path            JSONPathParam
 		ReturningType   *ConvertType
@@ -2492,6 +4241,7 @@ type (
 	// MemberOf represents the function and arguments for MEMBER OF()
 	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#operator_member-of
 	MemberOfExpr struct {
+		_span
 		Value   Expr
 		JSONArr Expr
 	}
@@ -2499,6 +4249,7 @@ type (
 	// JSONSchemaValidFuncExpr represents the structure of JSON_SCHEMA_VALID()
 	// More information available on https://dev.mysql.com/doc/refman/8.0/en/json-validation-functions.html#function_json-schema-valid
 	JSONSchemaValidFuncExpr struct {
+		_span
 		Schema   Expr
 		Document Expr
 	}
@@ -2506,6 +4257,7 @@ type (
 	// JSONSchemaValidationReportFuncExpr represents the structure of JSON_SCHEMA_VALIDATION_REPORT()
 	// More information available on https://dev.mysql.com/doc/refman/8.0/en/json-validation-functions.html#function_json-schema-validation-report
 	JSONSchemaValidationReportFuncExpr struct {
+		_span
 		Schema   Expr
 		Document Expr
 	}
@@ -2513,6 +4265,7 @@ type (
 	// JSONAttributesExpr represents the argument and function for functions returning JSON value attributes
 	// More information available on https://dev.mysql.com/doc/refman/8.0/en/json-attribute-functions.html
 	JSONAttributesExpr struct {
+		_span
 		Type    JSONAttributeType
 		JSONDoc Expr
 		Path    JSONPathParam
@@ -2523,6 +4276,7 @@ type (
 	JSONAttributeType int8
 
 	JSONValueModifierExpr struct {
+		_span
 		Type    JSONValueModifierType
 		JSONDoc Expr
 		Params  []*JSONObjectParam
@@ -2535,6 +4289,7 @@ type (
 	// JSONValueMergeExpr represents the json value modifier functions which merges documents.
 	// Functions falling under this class: JSON_MERGE, JSON_MERGE_PATCH, JSON_MERGE_PRESERVE
 	JSONValueMergeExpr struct {
+		_span
 		Type        JSONValueMergeType
 		JSONDoc     Expr
 		JSONDocList Exprs
@@ -2547,6 +4302,7 @@ type (
 	// JSONRemoveExpr represents the JSON_REMOVE()
 	// For more information, visit https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-remove
 	JSONRemoveExpr struct {
+		_span
 		JSONDoc  Expr
 		PathList Exprs
 	}
@@ -2554,8 +4310,174 @@ type (
 	// JSONRemoveExpr represents the JSON_UNQUOTE()
 	// For more information, visit https://dev.mysql.com/doc/refman/8.0/en/json-modification-functions.html#function_json-unquote
 	JSONUnquoteExpr struct {
+		_span
 		JSONValue Expr
 	}
+
+	// ArgumentLessWindowExpr stands for the following window_functions: CUME_DIST, DENSE_RANK, PERCENT_RANK, RANK, ROW_NUMBER
+	// These functions do not take any argument.
+	// For more information, visit https://dev.mysql.com/doc/refman/8.0/en/window-function-descriptions.html
+	ArgumentLessWindowExpr struct {
+		_span
+		Type       ArgumentLessWindowExprType
+		OverClause *OverClause
+	}
+
+	// ArgumentLessWindowExprType is an enum to get types of ArgumentLessWindowExpr.
+	ArgumentLessWindowExprType int8
+
+	// FirstOrLastValueExpr stands for the following window_functions: FIRST_VALUE, LAST_VALUE
+	FirstOrLastValueExpr struct {
+		_span
+		Type                FirstOrLastValueExprType
+		Expr                Expr
+		NullTreatmentClause *NullTreatmentClause
+		OverClause          *OverClause
+	}
+
+	// FirstOrLastValueExprType is an enum to get types of FirstOrLastValueExpr.
+	FirstOrLastValueExprType int8
+
+	// NtileExpr stands for the NTILE()
+	NtileExpr struct {
+		_span
+		N          Expr
+		OverClause *OverClause
+	}
+
+	// NTHValueExpr stands for the NTH_VALUE()
+	NTHValueExpr struct {
+		_span
+		Expr                Expr
+		N                   Expr
+		FromFirstLastClause *FromFirstLastClause
+		NullTreatmentClause *NullTreatmentClause
+		OverClause          *OverClause
+	}
+
+	// LagLeadExpr stand for the following: LAG, LEAD
+	LagLeadExpr struct {
+		_span
+		Type                LagLeadExprType
+		Expr                Expr
+		N                   Expr
+		Default             Expr
+		NullTreatmentClause *NullTreatmentClause
+		OverClause          *OverClause
+	}
+
+	// LagLeadExprType is an enum to get types of LagLeadExpr.
+	LagLeadExprType int8
+
+	// GeomFromTextExpr represents the functions that construct a geometry from its
+	// WKT representation, e.g. ST_GeomFromText(wkt [, srid [, options]])
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/gis-wkt-functions.html
+	GeomFromTextExpr struct {
+		_span
+		Type         GeomFromTextType
+		WktText      Expr
+		Srid         Expr
+		AxisOrderOpt Expr
+	}
+
+	// GeomFromTextType is an enum to get types of GeomFromTextExpr.
+	GeomFromTextType int8
+
+	// GeomRelationExpr represents the functions that test the spatial relation
+	// between two geometries, e.g. ST_Contains(g1, g2) or MBRWithin(g1, g2)
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions.html
+	GeomRelationExpr struct {
+		_span
+		Type  GeomRelationType
+		Geom1 Expr
+		Geom2 Expr
+	}
+
+	// GeomRelationType is an enum to get types of GeomRelationExpr.
+	GeomRelationType int8
+
+	// GeomDistanceExpr represents ST_Distance(g1, g2 [, unit])
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html#function_st-distance
+	GeomDistanceExpr struct {
+		_span
+		Geom1 Expr
+		Geom2 Expr
+		Unit  Expr
+	}
+
+	// GeomDistanceSphereExpr represents ST_Distance_Sphere(g1, g2 [, radius])
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/spatial-convenience-functions.html#function_st-distance-sphere
+	GeomDistanceSphereExpr struct {
+		_span
+		Geom1  Expr
+		Geom2  Expr
+		Radius Expr
+	}
+
+	// GeomSRIDExpr represents ST_SRID(g [, srid])
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+	GeomSRIDExpr struct {
+		_span
+		Geom Expr
+		Srid Expr
+	}
+
+	// GeomFormatExpr represents the functions that convert a geometry to WKT or
+	// WKB, e.g. ST_AsText(g [, options])
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html
+	GeomFormatExpr struct {
+		_span
+		FormatType   GeomFormatType
+		Geom         Expr
+		AxisOrderOpt Expr
+	}
+
+	// GeomFormatType is an enum to get types of GeomFormatExpr.
+	GeomFormatType int8
+
+	// RegexpLikeExpr represents REGEXP_LIKE()
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-like
+	RegexpLikeExpr struct {
+		_span
+		Expr      Expr
+		Pattern   Expr
+		MatchType Expr
+	}
+
+	// RegexpInstrExpr represents REGEXP_INSTR()
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-instr
+	RegexpInstrExpr struct {
+		_span
+		Expr         Expr
+		Pattern      Expr
+		Position     Expr
+		Occurrence   Expr
+		ReturnOption Expr
+		MatchType    Expr
+	}
+
+	// RegexpReplaceExpr represents REGEXP_REPLACE()
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-replace
+	RegexpReplaceExpr struct {
+		_span
+		Expr       Expr
+		Pattern    Expr
+		Repl       Expr
+		Position   Expr
+		Occurrence Expr
+		MatchType  Expr
+	}
+
+	// RegexpSubstrExpr represents REGEXP_SUBSTR()
+	// For more information, see https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+	RegexpSubstrExpr struct {
+		_span
+		Expr       Expr
+		Pattern    Expr
+		Position   Expr
+		Occurrence Expr
+		MatchType  Expr
+	}
 )
 
 // iExpr ensures that only expressions nodes can be assigned to a Expr
@@ -2592,6 +4514,7 @@ func (*SubstrExpr) iExpr()                         {}
 func (*ConvertUsingExpr) iExpr()                   {}
 func (*MatchExpr) iExpr()                          {}
 func (*GroupConcatExpr) iExpr()                    {}
+func (*GroupingExpr) iExpr()                       {}
 func (*Default) iExpr()                            {}
 func (*ExtractedSubquery) iExpr()                  {}
 func (*TrimFuncExpr) iExpr()                       {}
@@ -2617,6 +4540,21 @@ func (*JSONValueMergeExpr) iExpr()                 {}
 func (*JSONRemoveExpr) iExpr()                     {}
 func (*JSONUnquoteExpr) iExpr()                    {}
 func (*MemberOfExpr) iExpr()                       {}
+func (*ArgumentLessWindowExpr) iExpr()             {}
+func (*FirstOrLastValueExpr) iExpr()               {}
+func (*NtileExpr) iExpr()                          {}
+func (*NTHValueExpr) iExpr()                       {}
+func (*LagLeadExpr) iExpr()                        {}
+func (*GeomFromTextExpr) iExpr()                   {}
+func (*GeomRelationExpr) iExpr()                   {}
+func (*GeomDistanceExpr) iExpr()                   {}
+func (*GeomDistanceSphereExpr) iExpr()             {}
+func (*GeomSRIDExpr) iExpr()                       {}
+func (*GeomFormatExpr) iExpr()                     {}
+func (*RegexpLikeExpr) iExpr()                     {}
+func (*RegexpInstrExpr) iExpr()                    {}
+func (*RegexpReplaceExpr) iExpr()                  {}
+func (*RegexpSubstrExpr) iExpr()                   {}
 
 // iCallable marks all expressions that represent function calls
 func (*FuncExpr) iCallable()                           {}
@@ -2631,6 +4569,7 @@ func (*SubstrExpr) iCallable()                         {}
 func (*ConvertUsingExpr) iCallable()                   {}
 func (*MatchExpr) iCallable()                          {}
 func (*GroupConcatExpr) iCallable()                    {}
+func (*GroupingExpr) iCallable()                       {}
 func (*JSONSchemaValidFuncExpr) iCallable()            {}
 func (*JSONSchemaValidationReportFuncExpr) iCallable() {}
 func (*JSONPrettyExpr) iCallable()                     {}
@@ -2652,6 +4591,21 @@ func (*JSONValueMergeExpr) iCallable()                 {}
 func (*JSONRemoveExpr) iCallable()                     {}
 func (*JSONUnquoteExpr) iCallable()                    {}
 func (*MemberOfExpr) iCallable()                       {}
+func (*ArgumentLessWindowExpr) iCallable()             {}
+func (*FirstOrLastValueExpr) iCallable()               {}
+func (*NtileExpr) iCallable()                          {}
+func (*NTHValueExpr) iCallable()                       {}
+func (*LagLeadExpr) iCallable()                        {}
+func (*GeomFromTextExpr) iCallable()                   {}
+func (*GeomRelationExpr) iCallable()                   {}
+func (*GeomDistanceExpr) iCallable()                   {}
+func (*GeomDistanceSphereExpr) iCallable()             {}
+func (*GeomSRIDExpr) iCallable()                       {}
+func (*GeomFormatExpr) iCallable()                     {}
+func (*RegexpLikeExpr) iCallable()                     {}
+func (*RegexpInstrExpr) iCallable()                    {}
+func (*RegexpReplaceExpr) iCallable()                  {}
+func (*RegexpSubstrExpr) iCallable()                   {}
 
 // Exprs represents a list of value expressions.
 // It's not a valid expression because it's not parenthesized.
@@ -2706,9 +4660,10 @@ type SetExprs []*SetExpr
 
 // SetExpr represents a set expression.
 type SetExpr struct {
-	Scope Scope
-	Name  ColIdent
-	Expr  Expr
+	Scope     Scope
+	Qualifier TableIdent
+	Name      ColIdent
+	Expr      Expr
 }
 
 // OnDup represents an ON DUPLICATE KEY clause.
@@ -2723,12 +4678,14 @@ type ColIdent struct {
 	_            [0]struct{ _ []byte }
 	val, lowered string
 	at           AtCount
+	_span
 }
 
 // TableIdent is a case sensitive SQL identifier. It will be escaped with
 // backquotes if necessary.
 type TableIdent struct {
 	v string
+	_span
 }
 
 // AtCount return the '@' count present in ColIdent Name
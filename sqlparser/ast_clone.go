@@ -25,6 +25,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 	switch in := in.(type) {
 	case AccessMode:
 		return in
+	case *Account:
+		return CloneRefOfAccount(in)
+	case Accounts:
+		return CloneAccounts(in)
 	case *AddColumns:
 		return CloneRefOfAddColumns(in)
 	case *AddConstraintDefinition:
@@ -45,24 +49,42 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfAlterColumn(in)
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
 	case *AlterIndex:
 		return CloneRefOfAlterIndex(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterRoutine:
+		return CloneRefOfAlterRoutine(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
+	case *AlterUser:
+		return CloneRefOfAlterUser(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
 		return CloneRefOfAlterVschema(in)
+	case *AnalyzeTable:
+		return CloneRefOfAnalyzeTable(in)
 	case *AndExpr:
 		return CloneRefOfAndExpr(in)
 	case Argument:
 		return in
+	case *ArgumentLessWindowExpr:
+		return CloneRefOfArgumentLessWindowExpr(in)
+	case *AuthOption:
+		return CloneRefOfAuthOption(in)
 	case *AutoIncSpec:
 		return CloneRefOfAutoIncSpec(in)
+	case *BadDefinition:
+		return CloneRefOfBadDefinition(in)
+	case *BadStmt:
+		return CloneRefOfBadStmt(in)
 	case *Begin:
 		return CloneRefOfBegin(in)
+	case *BeginEndBlock:
+		return CloneRefOfBeginEndBlock(in)
 	case *BetweenExpr:
 		return CloneRefOfBetweenExpr(in)
 	case *BinaryExpr:
@@ -73,10 +95,22 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCallProc(in)
 	case *CaseExpr:
 		return CloneRefOfCaseExpr(in)
+	case *CaseStmt:
+		return CloneRefOfCaseStmt(in)
+	case *CaseStmtWhen:
+		return CloneRefOfCaseStmtWhen(in)
 	case *ChangeColumn:
 		return CloneRefOfChangeColumn(in)
+	case *ChangeReplicationSource:
+		return CloneRefOfChangeReplicationSource(in)
 	case *CheckConstraintDefinition:
 		return CloneRefOfCheckConstraintDefinition(in)
+	case *CheckTable:
+		return CloneRefOfCheckTable(in)
+	case *ChecksumTable:
+		return CloneRefOfChecksumTable(in)
+	case *CloseCursor:
+		return CloneRefOfCloseCursor(in)
 	case ColIdent:
 		return CloneColIdent(in)
 	case *ColName:
@@ -95,6 +129,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCommonTableExpr(in)
 	case *ComparisonExpr:
 		return CloneRefOfComparisonExpr(in)
+	case CompoundStatements:
+		return CloneCompoundStatements(in)
 	case *ConstraintDefinition:
 		return CloneRefOfConstraintDefinition(in)
 	case *ConvertExpr:
@@ -105,14 +141,34 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfConvertUsingExpr(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
+	case *CreateRole:
+		return CloneRefOfCreateRole(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateUser:
+		return CloneRefOfCreateUser(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
 	case *CurTimeFuncExpr:
 		return CloneRefOfCurTimeFuncExpr(in)
 	case *DeallocateStmt:
 		return CloneRefOfDeallocateStmt(in)
+	case *DeclareCondition:
+		return CloneRefOfDeclareCondition(in)
+	case *DeclareCursor:
+		return CloneRefOfDeclareCursor(in)
+	case *DeclareHandler:
+		return CloneRefOfDeclareHandler(in)
+	case *DeclareVar:
+		return CloneRefOfDeclareVar(in)
 	case *Default:
 		return CloneRefOfDefault(in)
 	case *Definer:
@@ -121,16 +177,28 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfDelete(in)
 	case *DerivedTable:
 		return CloneRefOfDerivedTable(in)
+	case *DoStmt:
+		return CloneRefOfDoStmt(in)
 	case *DropColumn:
 		return CloneRefOfDropColumn(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
 	case *DropKey:
 		return CloneRefOfDropKey(in)
+	case *DropProgram:
+		return CloneRefOfDropProgram(in)
+	case *DropRole:
+		return CloneRefOfDropRole(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
+	case *DropUser:
+		return CloneRefOfDropUser(in)
 	case *DropView:
 		return CloneRefOfDropView(in)
+	case *ElseIf:
+		return CloneRefOfElseIf(in)
+	case *EventSchedule:
+		return CloneRefOfEventSchedule(in)
 	case *ExecuteStmt:
 		return CloneRefOfExecuteStmt(in)
 	case *ExistsExpr:
@@ -145,18 +213,50 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfExtractFuncExpr(in)
 	case *ExtractedSubquery:
 		return CloneRefOfExtractedSubquery(in)
+	case *FetchCursor:
+		return CloneRefOfFetchCursor(in)
+	case *FirstOrLastValueExpr:
+		return CloneRefOfFirstOrLastValueExpr(in)
 	case *Flush:
 		return CloneRefOfFlush(in)
 	case *Force:
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
+	case *FromFirstLastClause:
+		return CloneRefOfFromFirstLastClause(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
+	case *GeomDistanceExpr:
+		return CloneRefOfGeomDistanceExpr(in)
+	case *GeomDistanceSphereExpr:
+		return CloneRefOfGeomDistanceSphereExpr(in)
+	case *GeomFormatExpr:
+		return CloneRefOfGeomFormatExpr(in)
+	case *GeomFromTextExpr:
+		return CloneRefOfGeomFromTextExpr(in)
+	case *GeomRelationExpr:
+		return CloneRefOfGeomRelationExpr(in)
+	case *GeomSRIDExpr:
+		return CloneRefOfGeomSRIDExpr(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
+	case *GrantTarget:
+		return CloneRefOfGrantTarget(in)
 	case GroupBy:
 		return CloneGroupBy(in)
 	case *GroupConcatExpr:
 		return CloneRefOfGroupConcatExpr(in)
+	case *GroupingExpr:
+		return CloneRefOfGroupingExpr(in)
+	case *HandlerCondition:
+		return CloneRefOfHandlerCondition(in)
+	case *IfStmt:
+		return CloneRefOfIfStmt(in)
 	case *IndexDefinition:
 		return CloneRefOfIndexDefinition(in)
 	case *IndexHint:
@@ -167,6 +267,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfIndexInfo(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *InstallComponent:
+		return CloneRefOfInstallComponent(in)
+	case *InstallPlugin:
+		return CloneRefOfInstallPlugin(in)
 	case *IntervalExpr:
 		return CloneRefOfIntervalExpr(in)
 	case *IntroducerExpr:
@@ -175,6 +279,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfIsExpr(in)
 	case IsolationLevel:
 		return in
+	case *IterateStmt:
+		return CloneRefOfIterateStmt(in)
 	case *JSONArrayExpr:
 		return CloneRefOfJSONArrayExpr(in)
 	case *JSONAttributesExpr:
@@ -229,6 +335,12 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfJtOnResponse(in)
 	case *KeyState:
 		return CloneRefOfKeyState(in)
+	case *Kill:
+		return CloneRefOfKill(in)
+	case *LagLeadExpr:
+		return CloneRefOfLagLeadExpr(in)
+	case *LeaveStmt:
+		return CloneRefOfLeaveStmt(in)
 	case *Limit:
 		return CloneRefOfLimit(in)
 	case ListArg:
@@ -237,10 +349,20 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfLiteral(in)
 	case *Load:
 		return CloneRefOfLoad(in)
+	case *LoadFields:
+		return CloneRefOfLoadFields(in)
+	case *LoadLines:
+		return CloneRefOfLoadLines(in)
+	case *LockClause:
+		return CloneRefOfLockClause(in)
+	case LockClauses:
+		return CloneLockClauses(in)
 	case *LockOption:
 		return CloneRefOfLockOption(in)
 	case *LockTables:
 		return CloneRefOfLockTables(in)
+	case *LoopStmt:
+		return CloneRefOfLoopStmt(in)
 	case MatchAction:
 		return in
 	case *MatchExpr:
@@ -249,18 +371,28 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfMemberOfExpr(in)
 	case *ModifyColumn:
 		return CloneRefOfModifyColumn(in)
+	case *NTHValueExpr:
+		return CloneRefOfNTHValueExpr(in)
 	case *Nextval:
 		return CloneRefOfNextval(in)
 	case *NotExpr:
 		return CloneRefOfNotExpr(in)
+	case *NtileExpr:
+		return CloneRefOfNtileExpr(in)
+	case *NullTreatmentClause:
+		return CloneRefOfNullTreatmentClause(in)
 	case *NullVal:
 		return CloneRefOfNullVal(in)
 	case Offset:
 		return in
 	case OnDup:
 		return CloneOnDup(in)
+	case *OpenCursor:
+		return CloneRefOfOpenCursor(in)
 	case *OptLike:
 		return CloneRefOfOptLike(in)
+	case *OptimizeTable:
+		return CloneRefOfOptimizeTable(in)
 	case *OrExpr:
 		return CloneRefOfOrExpr(in)
 	case *Order:
@@ -273,6 +405,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *OverClause:
+		return CloneRefOfOverClause(in)
 	case *ParenTableExpr:
 		return CloneRefOfParenTableExpr(in)
 	case *ParsedComments:
@@ -291,12 +425,32 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfPartitionValueRange(in)
 	case Partitions:
 		return ClonePartitions(in)
+	case *PasswordOption:
+		return CloneRefOfPasswordOption(in)
+	case PasswordOptions:
+		return ClonePasswordOptions(in)
 	case *PrepareStmt:
 		return CloneRefOfPrepareStmt(in)
+	case *PrivilegeSpec:
+		return CloneRefOfPrivilegeSpec(in)
+	case Privileges:
+		return ClonePrivileges(in)
+	case *ProcParameter:
+		return CloneRefOfProcParameter(in)
+	case *PurgeBinaryLogs:
+		return CloneRefOfPurgeBinaryLogs(in)
 	case ReferenceAction:
 		return in
 	case *ReferenceDefinition:
 		return CloneRefOfReferenceDefinition(in)
+	case *RegexpInstrExpr:
+		return CloneRefOfRegexpInstrExpr(in)
+	case *RegexpLikeExpr:
+		return CloneRefOfRegexpLikeExpr(in)
+	case *RegexpReplaceExpr:
+		return CloneRefOfRegexpReplaceExpr(in)
+	case *RegexpSubstrExpr:
+		return CloneRefOfRegexpSubstrExpr(in)
 	case *Release:
 		return CloneRefOfRelease(in)
 	case *RenameIndex:
@@ -305,12 +459,40 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfRenameTable(in)
 	case *RenameTableName:
 		return CloneRefOfRenameTableName(in)
+	case *RenameUser:
+		return CloneRefOfRenameUser(in)
+	case *RepairTable:
+		return CloneRefOfRepairTable(in)
+	case *RepeatStmt:
+		return CloneRefOfRepeatStmt(in)
+	case *ReplicationOption:
+		return CloneRefOfReplicationOption(in)
+	case ReplicationOptions:
+		return CloneReplicationOptions(in)
+	case *ResetMaster:
+		return CloneRefOfResetMaster(in)
+	case *ResetReplica:
+		return CloneRefOfResetReplica(in)
+	case *ResourceOption:
+		return CloneRefOfResourceOption(in)
+	case ResourceOptions:
+		return CloneResourceOptions(in)
+	case *ReturnStmt:
+		return CloneRefOfReturnStmt(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
+	case *RoleSpec:
+		return CloneRefOfRoleSpec(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
 	case RootNode:
 		return CloneRootNode(in)
+	case *RoutineCharacteristic:
+		return CloneRefOfRoutineCharacteristic(in)
+	case *RowAlias:
+		return CloneRefOfRowAlias(in)
 	case *SRollback:
 		return CloneRefOfSRollback(in)
 	case *Savepoint:
@@ -323,28 +505,52 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfSelectInto(in)
 	case *Set:
 		return CloneRefOfSet(in)
+	case *SetDefaultRole:
+		return CloneRefOfSetDefaultRole(in)
 	case *SetExpr:
 		return CloneRefOfSetExpr(in)
 	case SetExprs:
 		return CloneSetExprs(in)
+	case *SetPassword:
+		return CloneRefOfSetPassword(in)
+	case *SetRole:
+		return CloneRefOfSetRole(in)
 	case *SetTransaction:
 		return CloneRefOfSetTransaction(in)
 	case *Show:
 		return CloneRefOfShow(in)
 	case *ShowBasic:
 		return CloneRefOfShowBasic(in)
+	case *ShowBinlogEvents:
+		return CloneRefOfShowBinlogEvents(in)
 	case *ShowCreate:
 		return CloneRefOfShowCreate(in)
+	case *ShowEngine:
+		return CloneRefOfShowEngine(in)
 	case *ShowFilter:
 		return CloneRefOfShowFilter(in)
+	case *ShowGrants:
+		return CloneRefOfShowGrants(in)
 	case *ShowMigrationLogs:
 		return CloneRefOfShowMigrationLogs(in)
 	case *ShowOther:
 		return CloneRefOfShowOther(in)
+	case *ShowProfile:
+		return CloneRefOfShowProfile(in)
 	case *ShowThrottledApps:
 		return CloneRefOfShowThrottledApps(in)
+	case *Shutdown:
+		return CloneRefOfShutdown(in)
+	case *Signal:
+		return CloneRefOfSignal(in)
+	case *SignalInfo:
+		return CloneRefOfSignalInfo(in)
 	case *StarExpr:
 		return CloneRefOfStarExpr(in)
+	case *StartReplica:
+		return CloneRefOfStartReplica(in)
+	case *StopReplica:
+		return CloneRefOfStopReplica(in)
 	case *Stream:
 		return CloneRefOfStream(in)
 	case *SubPartition:
@@ -359,6 +565,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfSubquery(in)
 	case *SubstrExpr:
 		return CloneRefOfSubstrExpr(in)
+	case *TLSOption:
+		return CloneRefOfTLSOption(in)
+	case TLSOptions:
+		return CloneTLSOptions(in)
 	case TableExprs:
 		return CloneTableExprs(in)
 	case TableIdent:
@@ -371,16 +581,24 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneTableOptions(in)
 	case *TableSpec:
 		return CloneRefOfTableSpec(in)
+	case *TableStatement:
+		return CloneRefOfTableStatement(in)
 	case *TablespaceOperation:
 		return CloneRefOfTablespaceOperation(in)
 	case *TimestampFuncExpr:
 		return CloneRefOfTimestampFuncExpr(in)
+	case *TriggerOrder:
+		return CloneRefOfTriggerOrder(in)
 	case *TrimFuncExpr:
 		return CloneRefOfTrimFuncExpr(in)
 	case *TruncateTable:
 		return CloneRefOfTruncateTable(in)
 	case *UnaryExpr:
 		return CloneRefOfUnaryExpr(in)
+	case *UninstallComponent:
+		return CloneRefOfUninstallComponent(in)
+	case *UninstallPlugin:
+		return CloneRefOfUninstallPlugin(in)
 	case *Union:
 		return CloneRefOfUnion(in)
 	case *UnlockTables:
@@ -393,6 +611,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneUpdateExprs(in)
 	case *Use:
 		return CloneRefOfUse(in)
+	case *UserSpec:
+		return CloneRefOfUserSpec(in)
+	case UserSpecs:
+		return CloneUserSpecs(in)
 	case *VStream:
 		return CloneRefOfVStream(in)
 	case ValTuple:
@@ -403,6 +625,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneValues(in)
 	case *ValuesFuncExpr:
 		return CloneRefOfValuesFuncExpr(in)
+	case *ValuesStatement:
+		return CloneRefOfValuesStatement(in)
 	case VindexParam:
 		return CloneVindexParam(in)
 	case *VindexSpec:
@@ -413,8 +637,20 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WhileStmt:
+		return CloneRefOfWhileStmt(in)
+	case *WindowDefinition:
+		return CloneRefOfWindowDefinition(in)
+	case WindowDefinitions:
+		return CloneWindowDefinitions(in)
+	case *WindowSpecification:
+		return CloneRefOfWindowSpecification(in)
 	case *With:
 		return CloneRefOfWith(in)
+	case *XATransaction:
+		return CloneRefOfXATransaction(in)
+	case *Xid:
+		return CloneRefOfXid(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -423,6 +659,27 @@ func CloneSQLNode(in SQLNode) SQLNode {
 	}
 }
 
+// CloneRefOfAccount creates a deep clone of the input.
+func CloneRefOfAccount(n *Account) *Account {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneAccounts creates a deep clone of the input.
+func CloneAccounts(n Accounts) Accounts {
+	if n == nil {
+		return nil
+	}
+	res := make(Accounts, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfAccount(x))
+	}
+	return res
+}
+
 // CloneRefOfAddColumns creates a deep clone of the input.
 func CloneRefOfAddColumns(n *AddColumns) *AddColumns {
 	if n == nil {
@@ -521,6 +778,21 @@ func CloneRefOfAlterDatabase(n *AlterDatabase) *AlterDatabase {
 	return &out
 }
 
+// CloneRefOfAlterEvent creates a deep clone of the input.
+func CloneRefOfAlterEvent(n *AlterEvent) *AlterEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Schedule = CloneRefOfEventSchedule(n.Schedule)
+	out.RenameTo = CloneTableName(n.RenameTo)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
 // CloneRefOfAlterIndex creates a deep clone of the input.
 func CloneRefOfAlterIndex(n *AlterIndex) *AlterIndex {
 	if n == nil {
@@ -541,6 +813,18 @@ func CloneRefOfAlterMigration(n *AlterMigration) *AlterMigration {
 	return &out
 }
 
+// CloneRefOfAlterRoutine creates a deep clone of the input.
+func CloneRefOfAlterRoutine(n *AlterRoutine) *AlterRoutine {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	return &out
+}
+
 // CloneRefOfAlterTable creates a deep clone of the input.
 func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	if n == nil {
@@ -555,6 +839,20 @@ func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	return &out
 }
 
+// CloneRefOfAlterUser creates a deep clone of the input.
+func CloneRefOfAlterUser(n *AlterUser) *AlterUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Users = CloneUserSpecs(n.Users)
+	out.DefaultRole = CloneRefOfRoleSpec(n.DefaultRole)
+	out.TLSOptions = CloneTLSOptions(n.TLSOptions)
+	out.ResourceOptions = CloneResourceOptions(n.ResourceOptions)
+	out.PasswordOptions = ClonePasswordOptions(n.PasswordOptions)
+	return &out
+}
+
 // CloneRefOfAlterView creates a deep clone of the input.
 func CloneRefOfAlterView(n *AlterView) *AlterView {
 	if n == nil {
@@ -582,6 +880,18 @@ func CloneRefOfAlterVschema(n *AlterVschema) *AlterVschema {
 	return &out
 }
 
+// CloneRefOfAnalyzeTable creates a deep clone of the input.
+func CloneRefOfAnalyzeTable(n *AnalyzeTable) *AnalyzeTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	out.HistogramColumns = CloneColumns(n.HistogramColumns)
+	out.Buckets = CloneRefOfLiteral(n.Buckets)
+	return &out
+}
+
 // CloneRefOfAndExpr creates a deep clone of the input.
 func CloneRefOfAndExpr(n *AndExpr) *AndExpr {
 	if n == nil {
@@ -593,6 +903,25 @@ func CloneRefOfAndExpr(n *AndExpr) *AndExpr {
 	return &out
 }
 
+// CloneRefOfArgumentLessWindowExpr creates a deep clone of the input.
+func CloneRefOfArgumentLessWindowExpr(n *ArgumentLessWindowExpr) *ArgumentLessWindowExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.OverClause = CloneRefOfOverClause(n.OverClause)
+	return &out
+}
+
+// CloneRefOfAuthOption creates a deep clone of the input.
+func CloneRefOfAuthOption(n *AuthOption) *AuthOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfAutoIncSpec creates a deep clone of the input.
 func CloneRefOfAutoIncSpec(n *AutoIncSpec) *AutoIncSpec {
 	if n == nil {
@@ -604,6 +933,24 @@ func CloneRefOfAutoIncSpec(n *AutoIncSpec) *AutoIncSpec {
 	return &out
 }
 
+// CloneRefOfBadDefinition creates a deep clone of the input.
+func CloneRefOfBadDefinition(n *BadDefinition) *BadDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfBadStmt creates a deep clone of the input.
+func CloneRefOfBadStmt(n *BadStmt) *BadStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfBegin creates a deep clone of the input.
 func CloneRefOfBegin(n *Begin) *Begin {
 	if n == nil {
@@ -613,6 +960,17 @@ func CloneRefOfBegin(n *Begin) *Begin {
 	return &out
 }
 
+// CloneRefOfBeginEndBlock creates a deep clone of the input.
+func CloneRefOfBeginEndBlock(n *BeginEndBlock) *BeginEndBlock {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	return &out
+}
+
 // CloneRefOfBetweenExpr creates a deep clone of the input.
 func CloneRefOfBetweenExpr(n *BetweenExpr) *BetweenExpr {
 	if n == nil {
@@ -659,6 +1017,29 @@ func CloneRefOfCaseExpr(n *CaseExpr) *CaseExpr {
 	return &out
 }
 
+// CloneRefOfCaseStmt creates a deep clone of the input.
+func CloneRefOfCaseStmt(n *CaseStmt) *CaseStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Whens = CloneSliceOfRefOfCaseStmtWhen(n.Whens)
+	out.Else = CloneCompoundStatements(n.Else)
+	return &out
+}
+
+// CloneRefOfCaseStmtWhen creates a deep clone of the input.
+func CloneRefOfCaseStmtWhen(n *CaseStmtWhen) *CaseStmtWhen {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	return &out
+}
+
 // CloneRefOfChangeColumn creates a deep clone of the input.
 func CloneRefOfChangeColumn(n *ChangeColumn) *ChangeColumn {
 	if n == nil {
@@ -671,6 +1052,16 @@ func CloneRefOfChangeColumn(n *ChangeColumn) *ChangeColumn {
 	return &out
 }
 
+// CloneRefOfChangeReplicationSource creates a deep clone of the input.
+func CloneRefOfChangeReplicationSource(n *ChangeReplicationSource) *ChangeReplicationSource {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Options = CloneReplicationOptions(n.Options)
+	return &out
+}
+
 // CloneRefOfCheckConstraintDefinition creates a deep clone of the input.
 func CloneRefOfCheckConstraintDefinition(n *CheckConstraintDefinition) *CheckConstraintDefinition {
 	if n == nil {
@@ -681,6 +1072,38 @@ func CloneRefOfCheckConstraintDefinition(n *CheckConstraintDefinition) *CheckCon
 	return &out
 }
 
+// CloneRefOfCheckTable creates a deep clone of the input.
+func CloneRefOfCheckTable(n *CheckTable) *CheckTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	out.Options = CloneSliceOfMaintenanceOption(n.Options)
+	return &out
+}
+
+// CloneRefOfChecksumTable creates a deep clone of the input.
+func CloneRefOfChecksumTable(n *ChecksumTable) *ChecksumTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	out.Options = CloneSliceOfMaintenanceOption(n.Options)
+	return &out
+}
+
+// CloneRefOfCloseCursor creates a deep clone of the input.
+func CloneRefOfCloseCursor(n *CloseCursor) *CloseCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
 // CloneColIdent creates a deep clone of the input.
 func CloneColIdent(n ColIdent) ColIdent {
 	return *CloneRefOfColIdent(&n)
@@ -771,6 +1194,18 @@ func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
 	return &out
 }
 
+// CloneCompoundStatements creates a deep clone of the input.
+func CloneCompoundStatements(n CompoundStatements) CompoundStatements {
+	if n == nil {
+		return nil
+	}
+	res := make(CompoundStatements, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneStatement(x))
+	}
+	return res
+}
+
 // CloneRefOfConstraintDefinition creates a deep clone of the input.
 func CloneRefOfConstraintDefinition(n *ConstraintDefinition) *ConstraintDefinition {
 	if n == nil {
@@ -827,6 +1262,61 @@ func CloneRefOfCreateDatabase(n *CreateDatabase) *CreateDatabase {
 	return &out
 }
 
+// CloneRefOfCreateEvent creates a deep clone of the input.
+func CloneRefOfCreateEvent(n *CreateEvent) *CreateEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Schedule = CloneRefOfEventSchedule(n.Schedule)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateFunction creates a deep clone of the input.
+func CloneRefOfCreateFunction(n *CreateFunction) *CreateFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Params = CloneSliceOfRefOfProcParameter(n.Params)
+	out.Returns = CloneColumnType(n.Returns)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateProcedure creates a deep clone of the input.
+func CloneRefOfCreateProcedure(n *CreateProcedure) *CreateProcedure {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Params = CloneSliceOfRefOfProcParameter(n.Params)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateRole creates a deep clone of the input.
+func CloneRefOfCreateRole(n *CreateRole) *CreateRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneAccounts(n.Roles)
+	return &out
+}
+
 // CloneRefOfCreateTable creates a deep clone of the input.
 func CloneRefOfCreateTable(n *CreateTable) *CreateTable {
 	if n == nil {
@@ -840,6 +1330,35 @@ func CloneRefOfCreateTable(n *CreateTable) *CreateTable {
 	return &out
 }
 
+// CloneRefOfCreateTrigger creates a deep clone of the input.
+func CloneRefOfCreateTrigger(n *CreateTrigger) *CreateTrigger {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Table = CloneTableName(n.Table)
+	out.Order = CloneRefOfTriggerOrder(n.Order)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateUser creates a deep clone of the input.
+func CloneRefOfCreateUser(n *CreateUser) *CreateUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Users = CloneUserSpecs(n.Users)
+	out.DefaultRole = CloneRefOfRoleSpec(n.DefaultRole)
+	out.TLSOptions = CloneTLSOptions(n.TLSOptions)
+	out.ResourceOptions = CloneResourceOptions(n.ResourceOptions)
+	out.PasswordOptions = ClonePasswordOptions(n.PasswordOptions)
+	return &out
+}
+
 // CloneRefOfCreateView creates a deep clone of the input.
 func CloneRefOfCreateView(n *CreateView) *CreateView {
 	if n == nil {
@@ -876,6 +1395,51 @@ func CloneRefOfDeallocateStmt(n *DeallocateStmt) *DeallocateStmt {
 	return &out
 }
 
+// CloneRefOfDeclareCondition creates a deep clone of the input.
+func CloneRefOfDeclareCondition(n *DeclareCondition) *DeclareCondition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Condition = CloneRefOfHandlerCondition(n.Condition)
+	return &out
+}
+
+// CloneRefOfDeclareCursor creates a deep clone of the input.
+func CloneRefOfDeclareCursor(n *DeclareCursor) *DeclareCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
+// CloneRefOfDeclareHandler creates a deep clone of the input.
+func CloneRefOfDeclareHandler(n *DeclareHandler) *DeclareHandler {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Conditions = CloneSliceOfRefOfHandlerCondition(n.Conditions)
+	out.Statement = CloneStatement(n.Statement)
+	return &out
+}
+
+// CloneRefOfDeclareVar creates a deep clone of the input.
+func CloneRefOfDeclareVar(n *DeclareVar) *DeclareVar {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Names = CloneColumns(n.Names)
+	out.Type = CloneColumnType(n.Type)
+	out.Default = CloneExpr(n.Default)
+	return &out
+}
+
 // CloneRefOfDefault creates a deep clone of the input.
 func CloneRefOfDefault(n *Default) *Default {
 	if n == nil {
@@ -921,6 +1485,16 @@ func CloneRefOfDerivedTable(n *DerivedTable) *DerivedTable {
 	return &out
 }
 
+// CloneRefOfDoStmt creates a deep clone of the input.
+func CloneRefOfDoStmt(n *DoStmt) *DoStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneExprs(n.Exprs)
+	return &out
+}
+
 // CloneRefOfDropColumn creates a deep clone of the input.
 func CloneRefOfDropColumn(n *DropColumn) *DropColumn {
 	if n == nil {
@@ -952,53 +1526,108 @@ func CloneRefOfDropKey(n *DropKey) *DropKey {
 	return &out
 }
 
-// CloneRefOfDropTable creates a deep clone of the input.
-func CloneRefOfDropTable(n *DropTable) *DropTable {
+// CloneRefOfDropProgram creates a deep clone of the input.
+func CloneRefOfDropProgram(n *DropProgram) *DropProgram {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.FromTables = CloneTableNames(n.FromTables)
 	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
 	return &out
 }
 
-// CloneRefOfDropView creates a deep clone of the input.
-func CloneRefOfDropView(n *DropView) *DropView {
+// CloneRefOfDropRole creates a deep clone of the input.
+func CloneRefOfDropRole(n *DropRole) *DropRole {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.FromTables = CloneTableNames(n.FromTables)
-	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Roles = CloneAccounts(n.Roles)
 	return &out
 }
 
-// CloneRefOfExecuteStmt creates a deep clone of the input.
-func CloneRefOfExecuteStmt(n *ExecuteStmt) *ExecuteStmt {
+// CloneRefOfDropTable creates a deep clone of the input.
+func CloneRefOfDropTable(n *DropTable) *DropTable {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Name = CloneColIdent(n.Name)
+	out.FromTables = CloneTableNames(n.FromTables)
 	out.Comments = CloneRefOfParsedComments(n.Comments)
-	out.Arguments = CloneColumns(n.Arguments)
 	return &out
 }
 
-// CloneRefOfExistsExpr creates a deep clone of the input.
-func CloneRefOfExistsExpr(n *ExistsExpr) *ExistsExpr {
+// CloneRefOfDropUser creates a deep clone of the input.
+func CloneRefOfDropUser(n *DropUser) *DropUser {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	out.Users = CloneAccounts(n.Users)
 	return &out
 }
 
-// CloneRefOfExplainStmt creates a deep clone of the input.
-func CloneRefOfExplainStmt(n *ExplainStmt) *ExplainStmt {
-	if n == nil {
+// CloneRefOfDropView creates a deep clone of the input.
+func CloneRefOfDropView(n *DropView) *DropView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromTables = CloneTableNames(n.FromTables)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfElseIf creates a deep clone of the input.
+func CloneRefOfElseIf(n *ElseIf) *ElseIf {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	return &out
+}
+
+// CloneRefOfEventSchedule creates a deep clone of the input.
+func CloneRefOfEventSchedule(n *EventSchedule) *EventSchedule {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.At = CloneExpr(n.At)
+	out.Every = CloneExpr(n.Every)
+	out.Starts = CloneExpr(n.Starts)
+	out.Ends = CloneExpr(n.Ends)
+	return &out
+}
+
+// CloneRefOfExecuteStmt creates a deep clone of the input.
+func CloneRefOfExecuteStmt(n *ExecuteStmt) *ExecuteStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Arguments = CloneColumns(n.Arguments)
+	return &out
+}
+
+// CloneRefOfExistsExpr creates a deep clone of the input.
+func CloneRefOfExistsExpr(n *ExistsExpr) *ExistsExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
+// CloneRefOfExplainStmt creates a deep clone of the input.
+func CloneRefOfExplainStmt(n *ExplainStmt) *ExplainStmt {
+	if n == nil {
 		return nil
 	}
 	out := *n
@@ -1051,6 +1680,29 @@ func CloneRefOfExtractedSubquery(n *ExtractedSubquery) *ExtractedSubquery {
 	return &out
 }
 
+// CloneRefOfFetchCursor creates a deep clone of the input.
+func CloneRefOfFetchCursor(n *FetchCursor) *FetchCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Into = CloneColumns(n.Into)
+	return &out
+}
+
+// CloneRefOfFirstOrLastValueExpr creates a deep clone of the input.
+func CloneRefOfFirstOrLastValueExpr(n *FirstOrLastValueExpr) *FirstOrLastValueExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.NullTreatmentClause = CloneRefOfNullTreatmentClause(n.NullTreatmentClause)
+	out.OverClause = CloneRefOfOverClause(n.OverClause)
+	return &out
+}
+
 // CloneRefOfFlush creates a deep clone of the input.
 func CloneRefOfFlush(n *Flush) *Flush {
 	if n == nil {
@@ -1083,6 +1735,36 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfFromFirstLastClause creates a deep clone of the input.
+func CloneRefOfFromFirstLastClause(n *FromFirstLastClause) *FromFirstLastClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -1092,6 +1774,101 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	out.Qualifier = CloneTableIdent(n.Qualifier)
 	out.Name = CloneColIdent(n.Name)
 	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
+	return &out
+}
+
+// CloneRefOfGeomDistanceExpr creates a deep clone of the input.
+func CloneRefOfGeomDistanceExpr(n *GeomDistanceExpr) *GeomDistanceExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Geom1 = CloneExpr(n.Geom1)
+	out.Geom2 = CloneExpr(n.Geom2)
+	out.Unit = CloneExpr(n.Unit)
+	return &out
+}
+
+// CloneRefOfGeomDistanceSphereExpr creates a deep clone of the input.
+func CloneRefOfGeomDistanceSphereExpr(n *GeomDistanceSphereExpr) *GeomDistanceSphereExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Geom1 = CloneExpr(n.Geom1)
+	out.Geom2 = CloneExpr(n.Geom2)
+	out.Radius = CloneExpr(n.Radius)
+	return &out
+}
+
+// CloneRefOfGeomFormatExpr creates a deep clone of the input.
+func CloneRefOfGeomFormatExpr(n *GeomFormatExpr) *GeomFormatExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Geom = CloneExpr(n.Geom)
+	out.AxisOrderOpt = CloneExpr(n.AxisOrderOpt)
+	return &out
+}
+
+// CloneRefOfGeomFromTextExpr creates a deep clone of the input.
+func CloneRefOfGeomFromTextExpr(n *GeomFromTextExpr) *GeomFromTextExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WktText = CloneExpr(n.WktText)
+	out.Srid = CloneExpr(n.Srid)
+	out.AxisOrderOpt = CloneExpr(n.AxisOrderOpt)
+	return &out
+}
+
+// CloneRefOfGeomRelationExpr creates a deep clone of the input.
+func CloneRefOfGeomRelationExpr(n *GeomRelationExpr) *GeomRelationExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Geom1 = CloneExpr(n.Geom1)
+	out.Geom2 = CloneExpr(n.Geom2)
+	return &out
+}
+
+// CloneRefOfGeomSRIDExpr creates a deep clone of the input.
+func CloneRefOfGeomSRIDExpr(n *GeomSRIDExpr) *GeomSRIDExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Geom = CloneExpr(n.Geom)
+	out.Srid = CloneExpr(n.Srid)
+	return &out
+}
+
+// CloneRefOfGrant creates a deep clone of the input.
+func CloneRefOfGrant(n *Grant) *Grant {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = ClonePrivileges(n.Privileges)
+	out.Target = CloneRefOfGrantTarget(n.Target)
+	out.Roles = CloneAccounts(n.Roles)
+	out.Proxy = CloneRefOfAccount(n.Proxy)
+	out.To = CloneAccounts(n.To)
+	return &out
+}
+
+// CloneRefOfGrantTarget creates a deep clone of the input.
+func CloneRefOfGrantTarget(n *GrantTarget) *GrantTarget {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Database = CloneTableIdent(n.Database)
+	out.Name = CloneTableIdent(n.Name)
 	return &out
 }
 
@@ -1119,6 +1896,39 @@ func CloneRefOfGroupConcatExpr(n *GroupConcatExpr) *GroupConcatExpr {
 	return &out
 }
 
+// CloneRefOfGroupingExpr creates a deep clone of the input.
+func CloneRefOfGroupingExpr(n *GroupingExpr) *GroupingExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneExprs(n.Exprs)
+	return &out
+}
+
+// CloneRefOfHandlerCondition creates a deep clone of the input.
+func CloneRefOfHandlerCondition(n *HandlerCondition) *HandlerCondition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfIfStmt creates a deep clone of the input.
+func CloneRefOfIfStmt(n *IfStmt) *IfStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	out.ElseIfs = CloneSliceOfRefOfElseIf(n.ElseIfs)
+	out.Else = CloneCompoundStatements(n.Else)
+	return &out
+}
+
 // CloneRefOfIndexDefinition creates a deep clone of the input.
 func CloneRefOfIndexDefinition(n *IndexDefinition) *IndexDefinition {
 	if n == nil {
@@ -1175,10 +1985,31 @@ func CloneRefOfInsert(n *Insert) *Insert {
 	out.Partitions = ClonePartitions(n.Partitions)
 	out.Columns = CloneColumns(n.Columns)
 	out.Rows = CloneInsertRows(n.Rows)
+	out.RowAlias = CloneRefOfRowAlias(n.RowAlias)
 	out.OnDup = CloneOnDup(n.OnDup)
 	return &out
 }
 
+// CloneRefOfInstallComponent creates a deep clone of the input.
+func CloneRefOfInstallComponent(n *InstallComponent) *InstallComponent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Components = CloneSliceOfString(n.Components)
+	return &out
+}
+
+// CloneRefOfInstallPlugin creates a deep clone of the input.
+func CloneRefOfInstallPlugin(n *InstallPlugin) *InstallPlugin {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
 // CloneRefOfIntervalExpr creates a deep clone of the input.
 func CloneRefOfIntervalExpr(n *IntervalExpr) *IntervalExpr {
 	if n == nil {
@@ -1209,6 +2040,16 @@ func CloneRefOfIsExpr(n *IsExpr) *IsExpr {
 	return &out
 }
 
+// CloneRefOfIterateStmt creates a deep clone of the input.
+func CloneRefOfIterateStmt(n *IterateStmt) *IterateStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	return &out
+}
+
 // CloneRefOfJSONArrayExpr creates a deep clone of the input.
 func CloneRefOfJSONArrayExpr(n *JSONArrayExpr) *JSONArrayExpr {
 	if n == nil {
@@ -1502,6 +2343,39 @@ func CloneRefOfKeyState(n *KeyState) *KeyState {
 	return &out
 }
 
+// CloneRefOfKill creates a deep clone of the input.
+func CloneRefOfKill(n *Kill) *Kill {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfLagLeadExpr creates a deep clone of the input.
+func CloneRefOfLagLeadExpr(n *LagLeadExpr) *LagLeadExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.N = CloneExpr(n.N)
+	out.Default = CloneExpr(n.Default)
+	out.NullTreatmentClause = CloneRefOfNullTreatmentClause(n.NullTreatmentClause)
+	out.OverClause = CloneRefOfOverClause(n.OverClause)
+	return &out
+}
+
+// CloneRefOfLeaveStmt creates a deep clone of the input.
+func CloneRefOfLeaveStmt(n *LeaveStmt) *LeaveStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	return &out
+}
+
 // CloneRefOfLimit creates a deep clone of the input.
 func CloneRefOfLimit(n *Limit) *Limit {
 	if n == nil {
@@ -1528,9 +2402,61 @@ func CloneRefOfLoad(n *Load) *Load {
 		return nil
 	}
 	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.Fields = CloneRefOfLoadFields(n.Fields)
+	out.Lines = CloneRefOfLoadLines(n.Lines)
+	out.IgnoreLines = CloneRefOfLiteral(n.IgnoreLines)
+	out.Columns = CloneColumns(n.Columns)
+	out.SetExprs = CloneUpdateExprs(n.SetExprs)
+	return &out
+}
+
+// CloneRefOfLoadFields creates a deep clone of the input.
+func CloneRefOfLoadFields(n *LoadFields) *LoadFields {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Terminated = CloneRefOfLiteral(n.Terminated)
+	out.Enclosed = CloneRefOfLiteral(n.Enclosed)
+	out.Escaped = CloneRefOfLiteral(n.Escaped)
 	return &out
 }
 
+// CloneRefOfLoadLines creates a deep clone of the input.
+func CloneRefOfLoadLines(n *LoadLines) *LoadLines {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Starting = CloneRefOfLiteral(n.Starting)
+	out.Terminated = CloneRefOfLiteral(n.Terminated)
+	return &out
+}
+
+// CloneRefOfLockClause creates a deep clone of the input.
+func CloneRefOfLockClause(n *LockClause) *LockClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	return &out
+}
+
+// CloneLockClauses creates a deep clone of the input.
+func CloneLockClauses(n LockClauses) LockClauses {
+	if n == nil {
+		return nil
+	}
+	res := make(LockClauses, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfLockClause(x))
+	}
+	return res
+}
+
 // CloneRefOfLockOption creates a deep clone of the input.
 func CloneRefOfLockOption(n *LockOption) *LockOption {
 	if n == nil {
@@ -1550,6 +2476,17 @@ func CloneRefOfLockTables(n *LockTables) *LockTables {
 	return &out
 }
 
+// CloneRefOfLoopStmt creates a deep clone of the input.
+func CloneRefOfLoopStmt(n *LoopStmt) *LoopStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	return &out
+}
+
 // CloneRefOfMatchExpr creates a deep clone of the input.
 func CloneRefOfMatchExpr(n *MatchExpr) *MatchExpr {
 	if n == nil {
@@ -1583,8 +2520,22 @@ func CloneRefOfModifyColumn(n *ModifyColumn) *ModifyColumn {
 	return &out
 }
 
-// CloneRefOfNextval creates a deep clone of the input.
-func CloneRefOfNextval(n *Nextval) *Nextval {
+// CloneRefOfNTHValueExpr creates a deep clone of the input.
+func CloneRefOfNTHValueExpr(n *NTHValueExpr) *NTHValueExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.N = CloneExpr(n.N)
+	out.FromFirstLastClause = CloneRefOfFromFirstLastClause(n.FromFirstLastClause)
+	out.NullTreatmentClause = CloneRefOfNullTreatmentClause(n.NullTreatmentClause)
+	out.OverClause = CloneRefOfOverClause(n.OverClause)
+	return &out
+}
+
+// CloneRefOfNextval creates a deep clone of the input.
+func CloneRefOfNextval(n *Nextval) *Nextval {
 	if n == nil {
 		return nil
 	}
@@ -1603,6 +2554,26 @@ func CloneRefOfNotExpr(n *NotExpr) *NotExpr {
 	return &out
 }
 
+// CloneRefOfNtileExpr creates a deep clone of the input.
+func CloneRefOfNtileExpr(n *NtileExpr) *NtileExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.N = CloneExpr(n.N)
+	out.OverClause = CloneRefOfOverClause(n.OverClause)
+	return &out
+}
+
+// CloneRefOfNullTreatmentClause creates a deep clone of the input.
+func CloneRefOfNullTreatmentClause(n *NullTreatmentClause) *NullTreatmentClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfNullVal creates a deep clone of the input.
 func CloneRefOfNullVal(n *NullVal) *NullVal {
 	if n == nil {
@@ -1624,6 +2595,16 @@ func CloneOnDup(n OnDup) OnDup {
 	return res
 }
 
+// CloneRefOfOpenCursor creates a deep clone of the input.
+func CloneRefOfOpenCursor(n *OpenCursor) *OpenCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
 // CloneRefOfOptLike creates a deep clone of the input.
 func CloneRefOfOptLike(n *OptLike) *OptLike {
 	if n == nil {
@@ -1634,6 +2615,16 @@ func CloneRefOfOptLike(n *OptLike) *OptLike {
 	return &out
 }
 
+// CloneRefOfOptimizeTable creates a deep clone of the input.
+func CloneRefOfOptimizeTable(n *OptimizeTable) *OptimizeTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	return &out
+}
+
 // CloneRefOfOrExpr creates a deep clone of the input.
 func CloneRefOfOrExpr(n *OrExpr) *OrExpr {
 	if n == nil {
@@ -1695,6 +2686,17 @@ func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
 	return &out
 }
 
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WindowName = CloneColIdent(n.WindowName)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
 // CloneRefOfParenTableExpr creates a deep clone of the input.
 func CloneRefOfParenTableExpr(n *ParenTableExpr) *ParenTableExpr {
 	if n == nil {
@@ -1712,6 +2714,8 @@ func CloneRefOfParsedComments(n *ParsedComments) *ParsedComments {
 	}
 	out := *n
 	out.comments = CloneComments(n.comments)
+	out.hints = CloneOptimizerHints(n.hints)
+	out.hintErrors = CloneSliceOfRefOfOptimizerHintError(n.hintErrors)
 	return &out
 }
 
@@ -1800,6 +2804,27 @@ func ClonePartitions(n Partitions) Partitions {
 	return res
 }
 
+// CloneRefOfPasswordOption creates a deep clone of the input.
+func CloneRefOfPasswordOption(n *PasswordOption) *PasswordOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// ClonePasswordOptions creates a deep clone of the input.
+func ClonePasswordOptions(n PasswordOptions) PasswordOptions {
+	if n == nil {
+		return nil
+	}
+	res := make(PasswordOptions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfPasswordOption(x))
+	}
+	return res
+}
+
 // CloneRefOfPrepareStmt creates a deep clone of the input.
 func CloneRefOfPrepareStmt(n *PrepareStmt) *PrepareStmt {
 	if n == nil {
@@ -1812,6 +2837,49 @@ func CloneRefOfPrepareStmt(n *PrepareStmt) *PrepareStmt {
 	return &out
 }
 
+// CloneRefOfPrivilegeSpec creates a deep clone of the input.
+func CloneRefOfPrivilegeSpec(n *PrivilegeSpec) *PrivilegeSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
+// ClonePrivileges creates a deep clone of the input.
+func ClonePrivileges(n Privileges) Privileges {
+	if n == nil {
+		return nil
+	}
+	res := make(Privileges, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfPrivilegeSpec(x))
+	}
+	return res
+}
+
+// CloneRefOfProcParameter creates a deep clone of the input.
+func CloneRefOfProcParameter(n *ProcParameter) *ProcParameter {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Type = CloneColumnType(n.Type)
+	return &out
+}
+
+// CloneRefOfPurgeBinaryLogs creates a deep clone of the input.
+func CloneRefOfPurgeBinaryLogs(n *PurgeBinaryLogs) *PurgeBinaryLogs {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Before = CloneExpr(n.Before)
+	return &out
+}
+
 // CloneRefOfReferenceDefinition creates a deep clone of the input.
 func CloneRefOfReferenceDefinition(n *ReferenceDefinition) *ReferenceDefinition {
 	if n == nil {
@@ -1823,6 +2891,62 @@ func CloneRefOfReferenceDefinition(n *ReferenceDefinition) *ReferenceDefinition
 	return &out
 }
 
+// CloneRefOfRegexpInstrExpr creates a deep clone of the input.
+func CloneRefOfRegexpInstrExpr(n *RegexpInstrExpr) *RegexpInstrExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Pattern = CloneExpr(n.Pattern)
+	out.Position = CloneExpr(n.Position)
+	out.Occurrence = CloneExpr(n.Occurrence)
+	out.ReturnOption = CloneExpr(n.ReturnOption)
+	out.MatchType = CloneExpr(n.MatchType)
+	return &out
+}
+
+// CloneRefOfRegexpLikeExpr creates a deep clone of the input.
+func CloneRefOfRegexpLikeExpr(n *RegexpLikeExpr) *RegexpLikeExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Pattern = CloneExpr(n.Pattern)
+	out.MatchType = CloneExpr(n.MatchType)
+	return &out
+}
+
+// CloneRefOfRegexpReplaceExpr creates a deep clone of the input.
+func CloneRefOfRegexpReplaceExpr(n *RegexpReplaceExpr) *RegexpReplaceExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Pattern = CloneExpr(n.Pattern)
+	out.Repl = CloneExpr(n.Repl)
+	out.Position = CloneExpr(n.Position)
+	out.Occurrence = CloneExpr(n.Occurrence)
+	out.MatchType = CloneExpr(n.MatchType)
+	return &out
+}
+
+// CloneRefOfRegexpSubstrExpr creates a deep clone of the input.
+func CloneRefOfRegexpSubstrExpr(n *RegexpSubstrExpr) *RegexpSubstrExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Pattern = CloneExpr(n.Pattern)
+	out.Position = CloneExpr(n.Position)
+	out.Occurrence = CloneExpr(n.Occurrence)
+	out.MatchType = CloneExpr(n.MatchType)
+	return &out
+}
+
 // CloneRefOfRelease creates a deep clone of the input.
 func CloneRefOfRelease(n *Release) *Release {
 	if n == nil {
@@ -1864,6 +2988,111 @@ func CloneRefOfRenameTableName(n *RenameTableName) *RenameTableName {
 	return &out
 }
 
+// CloneRefOfRenameUser creates a deep clone of the input.
+func CloneRefOfRenameUser(n *RenameUser) *RenameUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.UserPairs = CloneSliceOfRefOfRenameUserPair(n.UserPairs)
+	return &out
+}
+
+// CloneRefOfRepairTable creates a deep clone of the input.
+func CloneRefOfRepairTable(n *RepairTable) *RepairTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	out.Options = CloneSliceOfMaintenanceOption(n.Options)
+	return &out
+}
+
+// CloneRefOfRepeatStmt creates a deep clone of the input.
+func CloneRefOfRepeatStmt(n *RepeatStmt) *RepeatStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	out.Until = CloneExpr(n.Until)
+	return &out
+}
+
+// CloneRefOfReplicationOption creates a deep clone of the input.
+func CloneRefOfReplicationOption(n *ReplicationOption) *ReplicationOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Value = CloneExpr(n.Value)
+	return &out
+}
+
+// CloneReplicationOptions creates a deep clone of the input.
+func CloneReplicationOptions(n ReplicationOptions) ReplicationOptions {
+	if n == nil {
+		return nil
+	}
+	res := make(ReplicationOptions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfReplicationOption(x))
+	}
+	return res
+}
+
+// CloneRefOfResetMaster creates a deep clone of the input.
+func CloneRefOfResetMaster(n *ResetMaster) *ResetMaster {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.To = CloneRefOfLiteral(n.To)
+	return &out
+}
+
+// CloneRefOfResetReplica creates a deep clone of the input.
+func CloneRefOfResetReplica(n *ResetReplica) *ResetReplica {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfResourceOption creates a deep clone of the input.
+func CloneRefOfResourceOption(n *ResourceOption) *ResourceOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneResourceOptions creates a deep clone of the input.
+func CloneResourceOptions(n ResourceOptions) ResourceOptions {
+	if n == nil {
+		return nil
+	}
+	res := make(ResourceOptions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfResourceOption(x))
+	}
+	return res
+}
+
+// CloneRefOfReturnStmt creates a deep clone of the input.
+func CloneRefOfReturnStmt(n *ReturnStmt) *ReturnStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfRevertMigration creates a deep clone of the input.
 func CloneRefOfRevertMigration(n *RevertMigration) *RevertMigration {
 	if n == nil {
@@ -1874,6 +3103,30 @@ func CloneRefOfRevertMigration(n *RevertMigration) *RevertMigration {
 	return &out
 }
 
+// CloneRefOfRevoke creates a deep clone of the input.
+func CloneRefOfRevoke(n *Revoke) *Revoke {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = ClonePrivileges(n.Privileges)
+	out.Target = CloneRefOfGrantTarget(n.Target)
+	out.Roles = CloneAccounts(n.Roles)
+	out.Proxy = CloneRefOfAccount(n.Proxy)
+	out.From = CloneAccounts(n.From)
+	return &out
+}
+
+// CloneRefOfRoleSpec creates a deep clone of the input.
+func CloneRefOfRoleSpec(n *RoleSpec) *RoleSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneAccounts(n.Roles)
+	return &out
+}
+
 // CloneRefOfRollback creates a deep clone of the input.
 func CloneRefOfRollback(n *Rollback) *Rollback {
 	if n == nil {
@@ -1888,6 +3141,26 @@ func CloneRootNode(n RootNode) RootNode {
 	return *CloneRefOfRootNode(&n)
 }
 
+// CloneRefOfRoutineCharacteristic creates a deep clone of the input.
+func CloneRefOfRoutineCharacteristic(n *RoutineCharacteristic) *RoutineCharacteristic {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfRowAlias creates a deep clone of the input.
+func CloneRefOfRowAlias(n *RowAlias) *RowAlias {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TableName = CloneTableIdent(n.TableName)
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
 // CloneRefOfSRollback creates a deep clone of the input.
 func CloneRefOfSRollback(n *SRollback) *SRollback {
 	if n == nil {
@@ -1922,8 +3195,10 @@ func CloneRefOfSelect(n *Select) *Select {
 	out.With = CloneRefOfWith(n.With)
 	out.GroupBy = CloneGroupBy(n.GroupBy)
 	out.Having = CloneRefOfWhere(n.Having)
+	out.Windows = CloneWindowDefinitions(n.Windows)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Lock = CloneLockClauses(n.Lock)
 	out.Into = CloneRefOfSelectInto(n.Into)
 	return &out
 }
@@ -1947,6 +3222,7 @@ func CloneRefOfSelectInto(n *SelectInto) *SelectInto {
 	}
 	out := *n
 	out.Charset = CloneColumnCharset(n.Charset)
+	out.Variables = CloneColumns(n.Variables)
 	return &out
 }
 
@@ -1961,12 +3237,24 @@ func CloneRefOfSet(n *Set) *Set {
 	return &out
 }
 
+// CloneRefOfSetDefaultRole creates a deep clone of the input.
+func CloneRefOfSetDefaultRole(n *SetDefaultRole) *SetDefaultRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneRefOfRoleSpec(n.Roles)
+	out.To = CloneAccounts(n.To)
+	return &out
+}
+
 // CloneRefOfSetExpr creates a deep clone of the input.
 func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
 	if n == nil {
 		return nil
 	}
 	out := *n
+	out.Qualifier = CloneTableIdent(n.Qualifier)
 	out.Name = CloneColIdent(n.Name)
 	out.Expr = CloneExpr(n.Expr)
 	return &out
@@ -1984,6 +3272,26 @@ func CloneSetExprs(n SetExprs) SetExprs {
 	return res
 }
 
+// CloneRefOfSetPassword creates a deep clone of the input.
+func CloneRefOfSetPassword(n *SetPassword) *SetPassword {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.User = CloneRefOfAccount(n.User)
+	return &out
+}
+
+// CloneRefOfSetRole creates a deep clone of the input.
+func CloneRefOfSetRole(n *SetRole) *SetRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneRefOfRoleSpec(n.Roles)
+	return &out
+}
+
 // CloneRefOfSetTransaction creates a deep clone of the input.
 func CloneRefOfSetTransaction(n *SetTransaction) *SetTransaction {
 	if n == nil {
@@ -2015,6 +3323,18 @@ func CloneRefOfShowBasic(n *ShowBasic) *ShowBasic {
 	out.Tbl = CloneTableName(n.Tbl)
 	out.DbName = CloneTableIdent(n.DbName)
 	out.Filter = CloneRefOfShowFilter(n.Filter)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	return &out
+}
+
+// CloneRefOfShowBinlogEvents creates a deep clone of the input.
+func CloneRefOfShowBinlogEvents(n *ShowBinlogEvents) *ShowBinlogEvents {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Position = CloneRefOfLiteral(n.Position)
+	out.Limit = CloneRefOfLimit(n.Limit)
 	return &out
 }
 
@@ -2025,6 +3345,17 @@ func CloneRefOfShowCreate(n *ShowCreate) *ShowCreate {
 	}
 	out := *n
 	out.Op = CloneTableName(n.Op)
+	out.User = CloneRefOfAccount(n.User)
+	return &out
+}
+
+// CloneRefOfShowEngine creates a deep clone of the input.
+func CloneRefOfShowEngine(n *ShowEngine) *ShowEngine {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Engine = CloneColIdent(n.Engine)
 	return &out
 }
 
@@ -2038,6 +3369,17 @@ func CloneRefOfShowFilter(n *ShowFilter) *ShowFilter {
 	return &out
 }
 
+// CloneRefOfShowGrants creates a deep clone of the input.
+func CloneRefOfShowGrants(n *ShowGrants) *ShowGrants {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.For = CloneRefOfAccount(n.For)
+	out.Using = CloneAccounts(n.Using)
+	return &out
+}
+
 // CloneRefOfShowMigrationLogs creates a deep clone of the input.
 func CloneRefOfShowMigrationLogs(n *ShowMigrationLogs) *ShowMigrationLogs {
 	if n == nil {
@@ -2057,6 +3399,18 @@ func CloneRefOfShowOther(n *ShowOther) *ShowOther {
 	return &out
 }
 
+// CloneRefOfShowProfile creates a deep clone of the input.
+func CloneRefOfShowProfile(n *ShowProfile) *ShowProfile {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Types = CloneSliceOfString(n.Types)
+	out.Query = CloneRefOfLiteral(n.Query)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	return &out
+}
+
 // CloneRefOfShowThrottledApps creates a deep clone of the input.
 func CloneRefOfShowThrottledApps(n *ShowThrottledApps) *ShowThrottledApps {
 	if n == nil {
@@ -2067,6 +3421,36 @@ func CloneRefOfShowThrottledApps(n *ShowThrottledApps) *ShowThrottledApps {
 	return &out
 }
 
+// CloneRefOfShutdown creates a deep clone of the input.
+func CloneRefOfShutdown(n *Shutdown) *Shutdown {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfSignal creates a deep clone of the input.
+func CloneRefOfSignal(n *Signal) *Signal {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Condition = CloneRefOfHandlerCondition(n.Condition)
+	out.Info = CloneSliceOfRefOfSignalInfo(n.Info)
+	return &out
+}
+
+// CloneRefOfSignalInfo creates a deep clone of the input.
+func CloneRefOfSignalInfo(n *SignalInfo) *SignalInfo {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Value = CloneExpr(n.Value)
+	return &out
+}
+
 // CloneRefOfStarExpr creates a deep clone of the input.
 func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
 	if n == nil {
@@ -2077,6 +3461,26 @@ func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
 	return &out
 }
 
+// CloneRefOfStartReplica creates a deep clone of the input.
+func CloneRefOfStartReplica(n *StartReplica) *StartReplica {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Until = CloneReplicationOptions(n.Until)
+	out.ConnectionOptions = CloneReplicationOptions(n.ConnectionOptions)
+	return &out
+}
+
+// CloneRefOfStopReplica creates a deep clone of the input.
+func CloneRefOfStopReplica(n *StopReplica) *StopReplica {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfStream creates a deep clone of the input.
 func CloneRefOfStream(n *Stream) *Stream {
 	if n == nil {
@@ -2160,6 +3564,27 @@ func CloneRefOfSubstrExpr(n *SubstrExpr) *SubstrExpr {
 	return &out
 }
 
+// CloneRefOfTLSOption creates a deep clone of the input.
+func CloneRefOfTLSOption(n *TLSOption) *TLSOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneTLSOptions creates a deep clone of the input.
+func CloneTLSOptions(n TLSOptions) TLSOptions {
+	if n == nil {
+		return nil
+	}
+	res := make(TLSOptions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfTLSOption(x))
+	}
+	return res
+}
+
 // CloneTableExprs creates a deep clone of the input.
 func CloneTableExprs(n TableExprs) TableExprs {
 	if n == nil {
@@ -2217,6 +3642,23 @@ func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
 	out.Constraints = CloneSliceOfRefOfConstraintDefinition(n.Constraints)
 	out.Options = CloneTableOptions(n.Options)
 	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
+	out.BadDefinitions = CloneSliceOfRefOfBadDefinition(n.BadDefinitions)
+	return &out
+}
+
+// CloneRefOfTableStatement creates a deep clone of the input.
+func CloneRefOfTableStatement(n *TableStatement) *TableStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Table = CloneTableName(n.Table)
+	out.With = CloneRefOfWith(n.With)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Lock = CloneLockClauses(n.Lock)
+	out.Into = CloneRefOfSelectInto(n.Into)
 	return &out
 }
 
@@ -2240,6 +3682,16 @@ func CloneRefOfTimestampFuncExpr(n *TimestampFuncExpr) *TimestampFuncExpr {
 	return &out
 }
 
+// CloneRefOfTriggerOrder creates a deep clone of the input.
+func CloneRefOfTriggerOrder(n *TriggerOrder) *TriggerOrder {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.OtherTrigger = CloneColIdent(n.OtherTrigger)
+	return &out
+}
+
 // CloneRefOfTrimFuncExpr creates a deep clone of the input.
 func CloneRefOfTrimFuncExpr(n *TrimFuncExpr) *TrimFuncExpr {
 	if n == nil {
@@ -2271,6 +3723,26 @@ func CloneRefOfUnaryExpr(n *UnaryExpr) *UnaryExpr {
 	return &out
 }
 
+// CloneRefOfUninstallComponent creates a deep clone of the input.
+func CloneRefOfUninstallComponent(n *UninstallComponent) *UninstallComponent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Components = CloneSliceOfString(n.Components)
+	return &out
+}
+
+// CloneRefOfUninstallPlugin creates a deep clone of the input.
+func CloneRefOfUninstallPlugin(n *UninstallPlugin) *UninstallPlugin {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
 // CloneRefOfUnion creates a deep clone of the input.
 func CloneRefOfUnion(n *Union) *Union {
 	if n == nil {
@@ -2282,6 +3754,7 @@ func CloneRefOfUnion(n *Union) *Union {
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.With = CloneRefOfWith(n.With)
 	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Lock = CloneLockClauses(n.Lock)
 	out.Into = CloneRefOfSelectInto(n.Into)
 	return &out
 }
@@ -2344,6 +3817,29 @@ func CloneRefOfUse(n *Use) *Use {
 	return &out
 }
 
+// CloneRefOfUserSpec creates a deep clone of the input.
+func CloneRefOfUserSpec(n *UserSpec) *UserSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Account = CloneRefOfAccount(n.Account)
+	out.Auth = CloneRefOfAuthOption(n.Auth)
+	return &out
+}
+
+// CloneUserSpecs creates a deep clone of the input.
+func CloneUserSpecs(n UserSpecs) UserSpecs {
+	if n == nil {
+		return nil
+	}
+	res := make(UserSpecs, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfUserSpec(x))
+	}
+	return res
+}
+
 // CloneRefOfVStream creates a deep clone of the input.
 func CloneRefOfVStream(n *VStream) *VStream {
 	if n == nil {
@@ -2391,72 +3887,158 @@ func CloneValues(n Values) Values {
 	return res
 }
 
-// CloneRefOfValuesFuncExpr creates a deep clone of the input.
-func CloneRefOfValuesFuncExpr(n *ValuesFuncExpr) *ValuesFuncExpr {
+// CloneRefOfValuesFuncExpr creates a deep clone of the input.
+func CloneRefOfValuesFuncExpr(n *ValuesFuncExpr) *ValuesFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneRefOfColName(n.Name)
+	return &out
+}
+
+// CloneRefOfValuesStatement creates a deep clone of the input.
+func CloneRefOfValuesStatement(n *ValuesStatement) *ValuesStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Rows = CloneValues(n.Rows)
+	out.With = CloneRefOfWith(n.With)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Lock = CloneLockClauses(n.Lock)
+	out.Into = CloneRefOfSelectInto(n.Into)
+	return &out
+}
+
+// CloneVindexParam creates a deep clone of the input.
+func CloneVindexParam(n VindexParam) VindexParam {
+	return *CloneRefOfVindexParam(&n)
+}
+
+// CloneRefOfVindexSpec creates a deep clone of the input.
+func CloneRefOfVindexSpec(n *VindexSpec) *VindexSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Type = CloneColIdent(n.Type)
+	out.Params = CloneSliceOfVindexParam(n.Params)
+	return &out
+}
+
+// CloneRefOfWeightStringFuncExpr creates a deep clone of the input.
+func CloneRefOfWeightStringFuncExpr(n *WeightStringFuncExpr) *WeightStringFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.As = CloneRefOfConvertType(n.As)
+	return &out
+}
+
+// CloneRefOfWhen creates a deep clone of the input.
+func CloneRefOfWhen(n *When) *When {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Val = CloneExpr(n.Val)
+	return &out
+}
+
+// CloneRefOfWhere creates a deep clone of the input.
+func CloneRefOfWhere(n *Where) *Where {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Name = CloneRefOfColName(n.Name)
+	out.Expr = CloneExpr(n.Expr)
 	return &out
 }
 
-// CloneVindexParam creates a deep clone of the input.
-func CloneVindexParam(n VindexParam) VindexParam {
-	return *CloneRefOfVindexParam(&n)
+// CloneRefOfWhileStmt creates a deep clone of the input.
+func CloneRefOfWhileStmt(n *WhileStmt) *WhileStmt {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneColIdent(n.Label)
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneCompoundStatements(n.Statements)
+	return &out
 }
 
-// CloneRefOfVindexSpec creates a deep clone of the input.
-func CloneRefOfVindexSpec(n *VindexSpec) *VindexSpec {
+// CloneRefOfWindowDefinition creates a deep clone of the input.
+func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
 	if n == nil {
 		return nil
 	}
 	out := *n
 	out.Name = CloneColIdent(n.Name)
-	out.Type = CloneColIdent(n.Type)
-	out.Params = CloneSliceOfVindexParam(n.Params)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
 	return &out
 }
 
-// CloneRefOfWeightStringFuncExpr creates a deep clone of the input.
-func CloneRefOfWeightStringFuncExpr(n *WeightStringFuncExpr) *WeightStringFuncExpr {
+// CloneWindowDefinitions creates a deep clone of the input.
+func CloneWindowDefinitions(n WindowDefinitions) WindowDefinitions {
+	if n == nil {
+		return nil
+	}
+	res := make(WindowDefinitions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfWindowDefinition(x))
+	}
+	return res
+}
+
+// CloneRefOfWindowSpecification creates a deep clone of the input.
+func CloneRefOfWindowSpecification(n *WindowSpecification) *WindowSpecification {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Expr = CloneExpr(n.Expr)
-	out.As = CloneRefOfConvertType(n.As)
+	out.Name = CloneColIdent(n.Name)
+	out.PartitionClause = CloneExprs(n.PartitionClause)
+	out.OrderClause = CloneOrderBy(n.OrderClause)
+	out.FrameClause = CloneRefOfFrameClause(n.FrameClause)
 	return &out
 }
 
-// CloneRefOfWhen creates a deep clone of the input.
-func CloneRefOfWhen(n *When) *When {
+// CloneRefOfWith creates a deep clone of the input.
+func CloneRefOfWith(n *With) *With {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Cond = CloneExpr(n.Cond)
-	out.Val = CloneExpr(n.Val)
+	out.ctes = CloneSliceOfRefOfCommonTableExpr(n.ctes)
 	return &out
 }
 
-// CloneRefOfWhere creates a deep clone of the input.
-func CloneRefOfWhere(n *Where) *Where {
+// CloneRefOfXATransaction creates a deep clone of the input.
+func CloneRefOfXATransaction(n *XATransaction) *XATransaction {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.Expr = CloneExpr(n.Expr)
+	out.Xid = CloneRefOfXid(n.Xid)
 	return &out
 }
 
-// CloneRefOfWith creates a deep clone of the input.
-func CloneRefOfWith(n *With) *With {
+// CloneRefOfXid creates a deep clone of the input.
+func CloneRefOfXid(n *Xid) *Xid {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.ctes = CloneSliceOfRefOfCommonTableExpr(n.ctes)
+	out.Gtrid = CloneRefOfLiteral(n.Gtrid)
+	out.Bqual = CloneRefOfLiteral(n.Bqual)
+	out.FormatID = CloneRefOfLiteral(n.FormatID)
 	return &out
 }
 
@@ -2493,6 +4075,8 @@ func CloneAlterOption(in AlterOption) AlterOption {
 		return CloneRefOfAlterColumn(in)
 	case *AlterIndex:
 		return CloneRefOfAlterIndex(in)
+	case *BadDefinition:
+		return CloneRefOfBadDefinition(in)
 	case *ChangeColumn:
 		return CloneRefOfChangeColumn(in)
 	case *DropColumn:
@@ -2531,6 +4115,8 @@ func CloneCallable(in Callable) Callable {
 		return nil
 	}
 	switch in := in.(type) {
+	case *ArgumentLessWindowExpr:
+		return CloneRefOfArgumentLessWindowExpr(in)
 	case *ConvertExpr:
 		return CloneRefOfConvertExpr(in)
 	case *ConvertUsingExpr:
@@ -2539,10 +4125,26 @@ func CloneCallable(in Callable) Callable {
 		return CloneRefOfCurTimeFuncExpr(in)
 	case *ExtractFuncExpr:
 		return CloneRefOfExtractFuncExpr(in)
+	case *FirstOrLastValueExpr:
+		return CloneRefOfFirstOrLastValueExpr(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
+	case *GeomDistanceExpr:
+		return CloneRefOfGeomDistanceExpr(in)
+	case *GeomDistanceSphereExpr:
+		return CloneRefOfGeomDistanceSphereExpr(in)
+	case *GeomFormatExpr:
+		return CloneRefOfGeomFormatExpr(in)
+	case *GeomFromTextExpr:
+		return CloneRefOfGeomFromTextExpr(in)
+	case *GeomRelationExpr:
+		return CloneRefOfGeomRelationExpr(in)
+	case *GeomSRIDExpr:
+		return CloneRefOfGeomSRIDExpr(in)
 	case *GroupConcatExpr:
 		return CloneRefOfGroupConcatExpr(in)
+	case *GroupingExpr:
+		return CloneRefOfGroupingExpr(in)
 	case *JSONArrayExpr:
 		return CloneRefOfJSONArrayExpr(in)
 	case *JSONAttributesExpr:
@@ -2583,10 +4185,24 @@ func CloneCallable(in Callable) Callable {
 		return CloneRefOfJSONValueMergeExpr(in)
 	case *JSONValueModifierExpr:
 		return CloneRefOfJSONValueModifierExpr(in)
+	case *LagLeadExpr:
+		return CloneRefOfLagLeadExpr(in)
 	case *MatchExpr:
 		return CloneRefOfMatchExpr(in)
 	case *MemberOfExpr:
 		return CloneRefOfMemberOfExpr(in)
+	case *NTHValueExpr:
+		return CloneRefOfNTHValueExpr(in)
+	case *NtileExpr:
+		return CloneRefOfNtileExpr(in)
+	case *RegexpInstrExpr:
+		return CloneRefOfRegexpInstrExpr(in)
+	case *RegexpLikeExpr:
+		return CloneRefOfRegexpLikeExpr(in)
+	case *RegexpReplaceExpr:
+		return CloneRefOfRegexpReplaceExpr(in)
+	case *RegexpSubstrExpr:
+		return CloneRefOfRegexpSubstrExpr(in)
 	case *SubstrExpr:
 		return CloneRefOfSubstrExpr(in)
 	case *TimestampFuncExpr:
@@ -2637,6 +4253,52 @@ func CloneColTuple(in ColTuple) ColTuple {
 	}
 }
 
+// CloneCompoundStatement creates a deep clone of the input.
+func CloneCompoundStatement(in CompoundStatement) CompoundStatement {
+	if in == nil {
+		return nil
+	}
+	switch in := in.(type) {
+	case *BeginEndBlock:
+		return CloneRefOfBeginEndBlock(in)
+	case *CaseStmt:
+		return CloneRefOfCaseStmt(in)
+	case *CloseCursor:
+		return CloneRefOfCloseCursor(in)
+	case *DeclareCondition:
+		return CloneRefOfDeclareCondition(in)
+	case *DeclareCursor:
+		return CloneRefOfDeclareCursor(in)
+	case *DeclareHandler:
+		return CloneRefOfDeclareHandler(in)
+	case *DeclareVar:
+		return CloneRefOfDeclareVar(in)
+	case *FetchCursor:
+		return CloneRefOfFetchCursor(in)
+	case *IfStmt:
+		return CloneRefOfIfStmt(in)
+	case *IterateStmt:
+		return CloneRefOfIterateStmt(in)
+	case *LeaveStmt:
+		return CloneRefOfLeaveStmt(in)
+	case *LoopStmt:
+		return CloneRefOfLoopStmt(in)
+	case *OpenCursor:
+		return CloneRefOfOpenCursor(in)
+	case *RepeatStmt:
+		return CloneRefOfRepeatStmt(in)
+	case *ReturnStmt:
+		return CloneRefOfReturnStmt(in)
+	case *Signal:
+		return CloneRefOfSignal(in)
+	case *WhileStmt:
+		return CloneRefOfWhileStmt(in)
+	default:
+		// this should never happen
+		return nil
+	}
+}
+
 // CloneConstraintInfo creates a deep clone of the input.
 func CloneConstraintInfo(in ConstraintInfo) ConstraintInfo {
 	if in == nil {
@@ -2677,14 +4339,28 @@ func CloneDDLStatement(in DDLStatement) DDLStatement {
 		return nil
 	}
 	switch in := in.(type) {
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
+	case *AlterRoutine:
+		return CloneRefOfAlterRoutine(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
+	case *DropProgram:
+		return CloneRefOfDropProgram(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
 	case *DropView:
@@ -2725,6 +4401,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfAndExpr(in)
 	case Argument:
 		return in
+	case *ArgumentLessWindowExpr:
+		return CloneRefOfArgumentLessWindowExpr(in)
 	case *BetweenExpr:
 		return CloneRefOfBetweenExpr(in)
 	case *BinaryExpr:
@@ -2753,10 +4431,26 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfExtractFuncExpr(in)
 	case *ExtractedSubquery:
 		return CloneRefOfExtractedSubquery(in)
+	case *FirstOrLastValueExpr:
+		return CloneRefOfFirstOrLastValueExpr(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
+	case *GeomDistanceExpr:
+		return CloneRefOfGeomDistanceExpr(in)
+	case *GeomDistanceSphereExpr:
+		return CloneRefOfGeomDistanceSphereExpr(in)
+	case *GeomFormatExpr:
+		return CloneRefOfGeomFormatExpr(in)
+	case *GeomFromTextExpr:
+		return CloneRefOfGeomFromTextExpr(in)
+	case *GeomRelationExpr:
+		return CloneRefOfGeomRelationExpr(in)
+	case *GeomSRIDExpr:
+		return CloneRefOfGeomSRIDExpr(in)
 	case *GroupConcatExpr:
 		return CloneRefOfGroupConcatExpr(in)
+	case *GroupingExpr:
+		return CloneRefOfGroupingExpr(in)
 	case *IntervalExpr:
 		return CloneRefOfIntervalExpr(in)
 	case *IntroducerExpr:
@@ -2803,6 +4497,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfJSONValueMergeExpr(in)
 	case *JSONValueModifierExpr:
 		return CloneRefOfJSONValueModifierExpr(in)
+	case *LagLeadExpr:
+		return CloneRefOfLagLeadExpr(in)
 	case ListArg:
 		return in
 	case *Literal:
@@ -2811,14 +4507,26 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfMatchExpr(in)
 	case *MemberOfExpr:
 		return CloneRefOfMemberOfExpr(in)
+	case *NTHValueExpr:
+		return CloneRefOfNTHValueExpr(in)
 	case *NotExpr:
 		return CloneRefOfNotExpr(in)
+	case *NtileExpr:
+		return CloneRefOfNtileExpr(in)
 	case *NullVal:
 		return CloneRefOfNullVal(in)
 	case Offset:
 		return in
 	case *OrExpr:
 		return CloneRefOfOrExpr(in)
+	case *RegexpInstrExpr:
+		return CloneRefOfRegexpInstrExpr(in)
+	case *RegexpLikeExpr:
+		return CloneRefOfRegexpLikeExpr(in)
+	case *RegexpReplaceExpr:
+		return CloneRefOfRegexpReplaceExpr(in)
+	case *RegexpSubstrExpr:
+		return CloneRefOfRegexpSubstrExpr(in)
 	case *Subquery:
 		return CloneRefOfSubquery(in)
 	case *SubstrExpr:
@@ -2851,10 +4559,14 @@ func CloneInsertRows(in InsertRows) InsertRows {
 	switch in := in.(type) {
 	case *Select:
 		return CloneRefOfSelect(in)
+	case *TableStatement:
+		return CloneRefOfTableStatement(in)
 	case *Union:
 		return CloneRefOfUnion(in)
 	case Values:
 		return CloneValues(in)
+	case *ValuesStatement:
+		return CloneRefOfValuesStatement(in)
 	default:
 		// this should never happen
 		return nil
@@ -2871,6 +4583,8 @@ func CloneJSONPathParam(in JSONPathParam) JSONPathParam {
 		return CloneRefOfAndExpr(in)
 	case Argument:
 		return in
+	case *ArgumentLessWindowExpr:
+		return CloneRefOfArgumentLessWindowExpr(in)
 	case *BetweenExpr:
 		return CloneRefOfBetweenExpr(in)
 	case *BinaryExpr:
@@ -2899,10 +4613,26 @@ func CloneJSONPathParam(in JSONPathParam) JSONPathParam {
 		return CloneRefOfExtractFuncExpr(in)
 	case *ExtractedSubquery:
 		return CloneRefOfExtractedSubquery(in)
+	case *FirstOrLastValueExpr:
+		return CloneRefOfFirstOrLastValueExpr(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
+	case *GeomDistanceExpr:
+		return CloneRefOfGeomDistanceExpr(in)
+	case *GeomDistanceSphereExpr:
+		return CloneRefOfGeomDistanceSphereExpr(in)
+	case *GeomFormatExpr:
+		return CloneRefOfGeomFormatExpr(in)
+	case *GeomFromTextExpr:
+		return CloneRefOfGeomFromTextExpr(in)
+	case *GeomRelationExpr:
+		return CloneRefOfGeomRelationExpr(in)
+	case *GeomSRIDExpr:
+		return CloneRefOfGeomSRIDExpr(in)
 	case *GroupConcatExpr:
 		return CloneRefOfGroupConcatExpr(in)
+	case *GroupingExpr:
+		return CloneRefOfGroupingExpr(in)
 	case *IntervalExpr:
 		return CloneRefOfIntervalExpr(in)
 	case *IntroducerExpr:
@@ -2949,6 +4679,8 @@ func CloneJSONPathParam(in JSONPathParam) JSONPathParam {
 		return CloneRefOfJSONValueMergeExpr(in)
 	case *JSONValueModifierExpr:
 		return CloneRefOfJSONValueModifierExpr(in)
+	case *LagLeadExpr:
+		return CloneRefOfLagLeadExpr(in)
 	case ListArg:
 		return in
 	case *Literal:
@@ -2957,14 +4689,26 @@ func CloneJSONPathParam(in JSONPathParam) JSONPathParam {
 		return CloneRefOfMatchExpr(in)
 	case *MemberOfExpr:
 		return CloneRefOfMemberOfExpr(in)
+	case *NTHValueExpr:
+		return CloneRefOfNTHValueExpr(in)
 	case *NotExpr:
 		return CloneRefOfNotExpr(in)
+	case *NtileExpr:
+		return CloneRefOfNtileExpr(in)
 	case *NullVal:
 		return CloneRefOfNullVal(in)
 	case Offset:
 		return in
 	case *OrExpr:
 		return CloneRefOfOrExpr(in)
+	case *RegexpInstrExpr:
+		return CloneRefOfRegexpInstrExpr(in)
+	case *RegexpLikeExpr:
+		return CloneRefOfRegexpLikeExpr(in)
+	case *RegexpReplaceExpr:
+		return CloneRefOfRegexpReplaceExpr(in)
+	case *RegexpSubstrExpr:
+		return CloneRefOfRegexpSubstrExpr(in)
 	case *Subquery:
 		return CloneRefOfSubquery(in)
 	case *SubstrExpr:
@@ -3015,8 +4759,12 @@ func CloneSelectStatement(in SelectStatement) SelectStatement {
 	switch in := in.(type) {
 	case *Select:
 		return CloneRefOfSelect(in)
+	case *TableStatement:
+		return CloneRefOfTableStatement(in)
 	case *Union:
 		return CloneRefOfUnion(in)
+	case *ValuesStatement:
+		return CloneRefOfValuesStatement(in)
 	default:
 		// this should never happen
 		return nil
@@ -3031,10 +4779,18 @@ func CloneShowInternal(in ShowInternal) ShowInternal {
 	switch in := in.(type) {
 	case *ShowBasic:
 		return CloneRefOfShowBasic(in)
+	case *ShowBinlogEvents:
+		return CloneRefOfShowBinlogEvents(in)
 	case *ShowCreate:
 		return CloneRefOfShowCreate(in)
+	case *ShowEngine:
+		return CloneRefOfShowEngine(in)
+	case *ShowGrants:
+		return CloneRefOfShowGrants(in)
 	case *ShowOther:
 		return CloneRefOfShowOther(in)
+	case *ShowProfile:
+		return CloneRefOfShowProfile(in)
 	default:
 		// this should never happen
 		return nil
@@ -3065,34 +4821,84 @@ func CloneStatement(in Statement) Statement {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterRoutine:
+		return CloneRefOfAlterRoutine(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
+	case *AlterUser:
+		return CloneRefOfAlterUser(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
 		return CloneRefOfAlterVschema(in)
+	case *AnalyzeTable:
+		return CloneRefOfAnalyzeTable(in)
+	case *BadStmt:
+		return CloneRefOfBadStmt(in)
 	case *Begin:
 		return CloneRefOfBegin(in)
+	case *BeginEndBlock:
+		return CloneRefOfBeginEndBlock(in)
 	case *CallProc:
 		return CloneRefOfCallProc(in)
+	case *CaseStmt:
+		return CloneRefOfCaseStmt(in)
+	case *ChangeReplicationSource:
+		return CloneRefOfChangeReplicationSource(in)
+	case *CheckTable:
+		return CloneRefOfCheckTable(in)
+	case *ChecksumTable:
+		return CloneRefOfChecksumTable(in)
+	case *CloseCursor:
+		return CloneRefOfCloseCursor(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
+	case *CreateRole:
+		return CloneRefOfCreateRole(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateUser:
+		return CloneRefOfCreateUser(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
 	case *DeallocateStmt:
 		return CloneRefOfDeallocateStmt(in)
+	case *DeclareCondition:
+		return CloneRefOfDeclareCondition(in)
+	case *DeclareCursor:
+		return CloneRefOfDeclareCursor(in)
+	case *DeclareHandler:
+		return CloneRefOfDeclareHandler(in)
+	case *DeclareVar:
+		return CloneRefOfDeclareVar(in)
 	case *Delete:
 		return CloneRefOfDelete(in)
+	case *DoStmt:
+		return CloneRefOfDoStmt(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
+	case *DropProgram:
+		return CloneRefOfDropProgram(in)
+	case *DropRole:
+		return CloneRefOfDropRole(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
+	case *DropUser:
+		return CloneRefOfDropUser(in)
 	case *DropView:
 		return CloneRefOfDropView(in)
 	case *ExecuteStmt:
@@ -3101,26 +4907,64 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfExplainStmt(in)
 	case *ExplainTab:
 		return CloneRefOfExplainTab(in)
+	case *FetchCursor:
+		return CloneRefOfFetchCursor(in)
 	case *Flush:
 		return CloneRefOfFlush(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
+	case *IfStmt:
+		return CloneRefOfIfStmt(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *InstallComponent:
+		return CloneRefOfInstallComponent(in)
+	case *InstallPlugin:
+		return CloneRefOfInstallPlugin(in)
+	case *IterateStmt:
+		return CloneRefOfIterateStmt(in)
+	case *Kill:
+		return CloneRefOfKill(in)
+	case *LeaveStmt:
+		return CloneRefOfLeaveStmt(in)
 	case *Load:
 		return CloneRefOfLoad(in)
 	case *LockTables:
 		return CloneRefOfLockTables(in)
+	case *LoopStmt:
+		return CloneRefOfLoopStmt(in)
+	case *OpenCursor:
+		return CloneRefOfOpenCursor(in)
+	case *OptimizeTable:
+		return CloneRefOfOptimizeTable(in)
 	case *OtherAdmin:
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
 	case *PrepareStmt:
 		return CloneRefOfPrepareStmt(in)
+	case *PurgeBinaryLogs:
+		return CloneRefOfPurgeBinaryLogs(in)
 	case *Release:
 		return CloneRefOfRelease(in)
 	case *RenameTable:
 		return CloneRefOfRenameTable(in)
+	case *RenameUser:
+		return CloneRefOfRenameUser(in)
+	case *RepairTable:
+		return CloneRefOfRepairTable(in)
+	case *RepeatStmt:
+		return CloneRefOfRepeatStmt(in)
+	case *ResetMaster:
+		return CloneRefOfResetMaster(in)
+	case *ResetReplica:
+		return CloneRefOfResetReplica(in)
+	case *ReturnStmt:
+		return CloneRefOfReturnStmt(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
 	case *SRollback:
@@ -3131,6 +4975,12 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfSelect(in)
 	case *Set:
 		return CloneRefOfSet(in)
+	case *SetDefaultRole:
+		return CloneRefOfSetDefaultRole(in)
+	case *SetPassword:
+		return CloneRefOfSetPassword(in)
+	case *SetRole:
+		return CloneRefOfSetRole(in)
 	case *SetTransaction:
 		return CloneRefOfSetTransaction(in)
 	case *Show:
@@ -3139,10 +4989,24 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfShowMigrationLogs(in)
 	case *ShowThrottledApps:
 		return CloneRefOfShowThrottledApps(in)
+	case *Shutdown:
+		return CloneRefOfShutdown(in)
+	case *Signal:
+		return CloneRefOfSignal(in)
+	case *StartReplica:
+		return CloneRefOfStartReplica(in)
+	case *StopReplica:
+		return CloneRefOfStopReplica(in)
 	case *Stream:
 		return CloneRefOfStream(in)
+	case *TableStatement:
+		return CloneRefOfTableStatement(in)
 	case *TruncateTable:
 		return CloneRefOfTruncateTable(in)
+	case *UninstallComponent:
+		return CloneRefOfUninstallComponent(in)
+	case *UninstallPlugin:
+		return CloneRefOfUninstallPlugin(in)
 	case *Union:
 		return CloneRefOfUnion(in)
 	case *UnlockTables:
@@ -3153,6 +5017,12 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfUse(in)
 	case *VStream:
 		return CloneRefOfVStream(in)
+	case *ValuesStatement:
+		return CloneRefOfValuesStatement(in)
+	case *WhileStmt:
+		return CloneRefOfWhileStmt(in)
+	case *XATransaction:
+		return CloneRefOfXATransaction(in)
 	default:
 		// this should never happen
 		return nil
@@ -3179,6 +5049,28 @@ func CloneTableExpr(in TableExpr) TableExpr {
 	}
 }
 
+// CloneTableMaintenanceStatement creates a deep clone of the input.
+func CloneTableMaintenanceStatement(in TableMaintenanceStatement) TableMaintenanceStatement {
+	if in == nil {
+		return nil
+	}
+	switch in := in.(type) {
+	case *AnalyzeTable:
+		return CloneRefOfAnalyzeTable(in)
+	case *CheckTable:
+		return CloneRefOfCheckTable(in)
+	case *ChecksumTable:
+		return CloneRefOfChecksumTable(in)
+	case *OptimizeTable:
+		return CloneRefOfOptimizeTable(in)
+	case *RepairTable:
+		return CloneRefOfRepairTable(in)
+	default:
+		// this should never happen
+		return nil
+	}
+}
+
 // CloneSliceOfRefOfColumnDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinition {
 	if n == nil {
@@ -3212,6 +5104,18 @@ func CloneSliceOfDatabaseOption(n []DatabaseOption) []DatabaseOption {
 	return res
 }
 
+// CloneSliceOfRefOfRoutineCharacteristic creates a deep clone of the input.
+func CloneSliceOfRefOfRoutineCharacteristic(n []*RoutineCharacteristic) []*RoutineCharacteristic {
+	if n == nil {
+		return nil
+	}
+	res := make([]*RoutineCharacteristic, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfRoutineCharacteristic(x))
+	}
+	return res
+}
+
 // CloneSliceOfAlterOption creates a deep clone of the input.
 func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 	if n == nil {
@@ -3248,6 +5152,28 @@ func CloneSliceOfRefOfWhen(n []*When) []*When {
 	return res
 }
 
+// CloneSliceOfRefOfCaseStmtWhen creates a deep clone of the input.
+func CloneSliceOfRefOfCaseStmtWhen(n []*CaseStmtWhen) []*CaseStmtWhen {
+	if n == nil {
+		return nil
+	}
+	res := make([]*CaseStmtWhen, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfCaseStmtWhen(x))
+	}
+	return res
+}
+
+// CloneSliceOfMaintenanceOption creates a deep clone of the input.
+func CloneSliceOfMaintenanceOption(n []MaintenanceOption) []MaintenanceOption {
+	if n == nil {
+		return nil
+	}
+	res := make([]MaintenanceOption, len(n))
+	copy(res, n)
+	return res
+}
+
 // CloneRefOfColIdent creates a deep clone of the input.
 func CloneRefOfColIdent(n *ColIdent) *ColIdent {
 	if n == nil {
@@ -3291,11 +5217,47 @@ func CloneSliceOfString(n []string) []string {
 	if n == nil {
 		return nil
 	}
-	res := make([]string, 0, len(n))
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
 
+// CloneSliceOfRefOfProcParameter creates a deep clone of the input.
+func CloneSliceOfRefOfProcParameter(n []*ProcParameter) []*ProcParameter {
+	if n == nil {
+		return nil
+	}
+	res := make([]*ProcParameter, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfProcParameter(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfHandlerCondition creates a deep clone of the input.
+func CloneSliceOfRefOfHandlerCondition(n []*HandlerCondition) []*HandlerCondition {
+	if n == nil {
+		return nil
+	}
+	res := make([]*HandlerCondition, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfHandlerCondition(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfElseIf creates a deep clone of the input.
+func CloneSliceOfRefOfElseIf(n []*ElseIf) []*ElseIf {
+	if n == nil {
+		return nil
+	}
+	res := make([]*ElseIf, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfElseIf(x))
+	}
+	return res
+}
+
 // CloneSliceOfRefOfIndexColumn creates a deep clone of the input.
 func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
 	if n == nil {
@@ -3426,6 +5388,30 @@ func CloneComments(n Comments) Comments {
 	return res
 }
 
+// CloneOptimizerHints creates a deep clone of the input.
+func CloneOptimizerHints(n OptimizerHints) OptimizerHints {
+	if n == nil {
+		return nil
+	}
+	res := make(OptimizerHints, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneOptimizerHint(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfOptimizerHintError creates a deep clone of the input.
+func CloneSliceOfRefOfOptimizerHintError(n []*OptimizerHintError) []*OptimizerHintError {
+	if n == nil {
+		return nil
+	}
+	res := make([]*OptimizerHintError, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfOptimizerHintError(x))
+	}
+	return res
+}
+
 // CloneRefOfInt creates a deep clone of the input.
 func CloneRefOfInt(n *int) *int {
 	if n == nil {
@@ -3459,6 +5445,18 @@ func CloneSliceOfRefOfRenameTablePair(n []*RenameTablePair) []*RenameTablePair {
 	return res
 }
 
+// CloneSliceOfRefOfRenameUserPair creates a deep clone of the input.
+func CloneSliceOfRefOfRenameUserPair(n []*RenameUserPair) []*RenameUserPair {
+	if n == nil {
+		return nil
+	}
+	res := make([]*RenameUserPair, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfRenameUserPair(x))
+	}
+	return res
+}
+
 // CloneRefOfRootNode creates a deep clone of the input.
 func CloneRefOfRootNode(n *RootNode) *RootNode {
 	if n == nil {
@@ -3493,6 +5491,18 @@ func CloneSliceOfCharacteristic(n []Characteristic) []Characteristic {
 	return res
 }
 
+// CloneSliceOfRefOfSignalInfo creates a deep clone of the input.
+func CloneSliceOfRefOfSignalInfo(n []*SignalInfo) []*SignalInfo {
+	if n == nil {
+		return nil
+	}
+	res := make([]*SignalInfo, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfSignalInfo(x))
+	}
+	return res
+}
+
 // CloneRefOfTableIdent creates a deep clone of the input.
 func CloneRefOfTableIdent(n *TableIdent) *TableIdent {
 	if n == nil {
@@ -3548,6 +5558,18 @@ func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*Constra
 	return res
 }
 
+// CloneSliceOfRefOfBadDefinition creates a deep clone of the input.
+func CloneSliceOfRefOfBadDefinition(n []*BadDefinition) []*BadDefinition {
+	if n == nil {
+		return nil
+	}
+	res := make([]*BadDefinition, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfBadDefinition(x))
+	}
+	return res
+}
+
 // CloneRefOfVindexParam creates a deep clone of the input.
 func CloneRefOfVindexParam(n *VindexParam) *VindexParam {
 	if n == nil {
@@ -3628,6 +5650,45 @@ func CloneRefOfTableAndLockType(n *TableAndLockType) *TableAndLockType {
 	return &out
 }
 
+// CloneOptimizerHint creates a deep clone of the input.
+func CloneOptimizerHint(in OptimizerHint) OptimizerHint {
+	if in == nil {
+		return nil
+	}
+	switch in := in.(type) {
+	case *IndexLevelHint:
+		return CloneRefOfIndexLevelHint(in)
+	case *JoinOrderHint:
+		return CloneRefOfJoinOrderHint(in)
+	case *MaxExecutionTimeHint:
+		return CloneRefOfMaxExecutionTimeHint(in)
+	case *QBNameHint:
+		return CloneRefOfQBNameHint(in)
+	case *ResourceGroupHint:
+		return CloneRefOfResourceGroupHint(in)
+	case *SetVarHint:
+		return CloneRefOfSetVarHint(in)
+	case *SubqueryHint:
+		return CloneRefOfSubqueryHint(in)
+	case *TableLevelHint:
+		return CloneRefOfTableLevelHint(in)
+	case *UnknownHint:
+		return CloneRefOfUnknownHint(in)
+	default:
+		// this should never happen
+		return nil
+	}
+}
+
+// CloneRefOfOptimizerHintError creates a deep clone of the input.
+func CloneRefOfOptimizerHintError(n *OptimizerHintError) *OptimizerHintError {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfRenameTablePair creates a deep clone of the input.
 func CloneRefOfRenameTablePair(n *RenameTablePair) *RenameTablePair {
 	if n == nil {
@@ -3639,6 +5700,17 @@ func CloneRefOfRenameTablePair(n *RenameTablePair) *RenameTablePair {
 	return &out
 }
 
+// CloneRefOfRenameUserPair creates a deep clone of the input.
+func CloneRefOfRenameUserPair(n *RenameUserPair) *RenameUserPair {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromUser = CloneRefOfAccount(n.FromUser)
+	out.ToUser = CloneRefOfAccount(n.ToUser)
+	return &out
+}
+
 // CloneRefOfDatabaseOption creates a deep clone of the input.
 func CloneRefOfDatabaseOption(n *DatabaseOption) *DatabaseOption {
 	if n == nil {
@@ -3647,3 +5719,115 @@ func CloneRefOfDatabaseOption(n *DatabaseOption) *DatabaseOption {
 	out := *n
 	return &out
 }
+
+// CloneRefOfIndexLevelHint creates a deep clone of the input.
+func CloneRefOfIndexLevelHint(n *IndexLevelHint) *IndexLevelHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Table = CloneHintTable(n.Table)
+	out.Indexes = CloneSliceOfString(n.Indexes)
+	return &out
+}
+
+// CloneRefOfJoinOrderHint creates a deep clone of the input.
+func CloneRefOfJoinOrderHint(n *JoinOrderHint) *JoinOrderHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneSliceOfHintTable(n.Tables)
+	return &out
+}
+
+// CloneRefOfMaxExecutionTimeHint creates a deep clone of the input.
+func CloneRefOfMaxExecutionTimeHint(n *MaxExecutionTimeHint) *MaxExecutionTimeHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfQBNameHint creates a deep clone of the input.
+func CloneRefOfQBNameHint(n *QBNameHint) *QBNameHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfResourceGroupHint creates a deep clone of the input.
+func CloneRefOfResourceGroupHint(n *ResourceGroupHint) *ResourceGroupHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfSetVarHint creates a deep clone of the input.
+func CloneRefOfSetVarHint(n *SetVarHint) *SetVarHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfSubqueryHint creates a deep clone of the input.
+func CloneRefOfSubqueryHint(n *SubqueryHint) *SubqueryHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Strategies = CloneSliceOfString(n.Strategies)
+	return &out
+}
+
+// CloneRefOfTableLevelHint creates a deep clone of the input.
+func CloneRefOfTableLevelHint(n *TableLevelHint) *TableLevelHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneSliceOfHintTable(n.Tables)
+	return &out
+}
+
+// CloneRefOfUnknownHint creates a deep clone of the input.
+func CloneRefOfUnknownHint(n *UnknownHint) *UnknownHint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneHintTable creates a deep clone of the input.
+func CloneHintTable(n HintTable) HintTable {
+	return *CloneRefOfHintTable(&n)
+}
+
+// CloneSliceOfHintTable creates a deep clone of the input.
+func CloneSliceOfHintTable(n []HintTable) []HintTable {
+	if n == nil {
+		return nil
+	}
+	res := make([]HintTable, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneHintTable(x))
+	}
+	return res
+}
+
+// CloneRefOfHintTable creates a deep clone of the input.
+func CloneRefOfHintTable(n *HintTable) *HintTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
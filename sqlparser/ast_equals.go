@@ -32,6 +32,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return a == b
+	case *Account:
+		b, ok := inB.(*Account)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAccount(a, b)
+	case Accounts:
+		b, ok := inB.(Accounts)
+		if !ok {
+			return false
+		}
+		return EqualsAccounts(a, b)
 	case *AddColumns:
 		b, ok := inB.(*AddColumns)
 		if !ok {
@@ -92,6 +104,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterDatabase(a, b)
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterEvent(a, b)
 	case *AlterIndex:
 		b, ok := inB.(*AlterIndex)
 		if !ok {
@@ -104,12 +122,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterMigration(a, b)
+	case *AlterRoutine:
+		b, ok := inB.(*AlterRoutine)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterRoutine(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAlterTable(a, b)
+	case *AlterUser:
+		b, ok := inB.(*AlterUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterUser(a, b)
 	case *AlterView:
 		b, ok := inB.(*AlterView)
 		if !ok {
@@ -122,6 +152,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterVschema(a, b)
+	case *AnalyzeTable:
+		b, ok := inB.(*AnalyzeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAnalyzeTable(a, b)
 	case *AndExpr:
 		b, ok := inB.(*AndExpr)
 		if !ok {
@@ -134,18 +170,48 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return a == b
+	case *ArgumentLessWindowExpr:
+		b, ok := inB.(*ArgumentLessWindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfArgumentLessWindowExpr(a, b)
+	case *AuthOption:
+		b, ok := inB.(*AuthOption)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAuthOption(a, b)
 	case *AutoIncSpec:
 		b, ok := inB.(*AutoIncSpec)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAutoIncSpec(a, b)
+	case *BadDefinition:
+		b, ok := inB.(*BadDefinition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBadDefinition(a, b)
+	case *BadStmt:
+		b, ok := inB.(*BadStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBadStmt(a, b)
 	case *Begin:
 		b, ok := inB.(*Begin)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfBegin(a, b)
+	case *BeginEndBlock:
+		b, ok := inB.(*BeginEndBlock)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBeginEndBlock(a, b)
 	case *BetweenExpr:
 		b, ok := inB.(*BetweenExpr)
 		if !ok {
@@ -176,18 +242,54 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCaseExpr(a, b)
+	case *CaseStmt:
+		b, ok := inB.(*CaseStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCaseStmt(a, b)
+	case *CaseStmtWhen:
+		b, ok := inB.(*CaseStmtWhen)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCaseStmtWhen(a, b)
 	case *ChangeColumn:
 		b, ok := inB.(*ChangeColumn)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfChangeColumn(a, b)
+	case *ChangeReplicationSource:
+		b, ok := inB.(*ChangeReplicationSource)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfChangeReplicationSource(a, b)
 	case *CheckConstraintDefinition:
 		b, ok := inB.(*CheckConstraintDefinition)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCheckConstraintDefinition(a, b)
+	case *CheckTable:
+		b, ok := inB.(*CheckTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCheckTable(a, b)
+	case *ChecksumTable:
+		b, ok := inB.(*ChecksumTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfChecksumTable(a, b)
+	case *CloseCursor:
+		b, ok := inB.(*CloseCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCloseCursor(a, b)
 	case ColIdent:
 		b, ok := inB.(ColIdent)
 		if !ok {
@@ -242,6 +344,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfComparisonExpr(a, b)
+	case CompoundStatements:
+		b, ok := inB.(CompoundStatements)
+		if !ok {
+			return false
+		}
+		return EqualsCompoundStatements(a, b)
 	case *ConstraintDefinition:
 		b, ok := inB.(*ConstraintDefinition)
 		if !ok {
@@ -272,12 +380,48 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCreateDatabase(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateProcedure(a, b)
+	case *CreateRole:
+		b, ok := inB.(*CreateRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateRole(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateTrigger(a, b)
+	case *CreateUser:
+		b, ok := inB.(*CreateUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateUser(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
@@ -296,6 +440,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfDeallocateStmt(a, b)
+	case *DeclareCondition:
+		b, ok := inB.(*DeclareCondition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCondition(a, b)
+	case *DeclareCursor:
+		b, ok := inB.(*DeclareCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCursor(a, b)
+	case *DeclareHandler:
+		b, ok := inB.(*DeclareHandler)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareHandler(a, b)
+	case *DeclareVar:
+		b, ok := inB.(*DeclareVar)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareVar(a, b)
 	case *Default:
 		b, ok := inB.(*Default)
 		if !ok {
@@ -320,6 +488,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfDerivedTable(a, b)
+	case *DoStmt:
+		b, ok := inB.(*DoStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDoStmt(a, b)
 	case *DropColumn:
 		b, ok := inB.(*DropColumn)
 		if !ok {
@@ -338,18 +512,48 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfDropKey(a, b)
+	case *DropProgram:
+		b, ok := inB.(*DropProgram)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropProgram(a, b)
+	case *DropRole:
+		b, ok := inB.(*DropRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropRole(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfDropTable(a, b)
+	case *DropUser:
+		b, ok := inB.(*DropUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropUser(a, b)
 	case *DropView:
 		b, ok := inB.(*DropView)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfDropView(a, b)
+	case *ElseIf:
+		b, ok := inB.(*ElseIf)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfElseIf(a, b)
+	case *EventSchedule:
+		b, ok := inB.(*EventSchedule)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfEventSchedule(a, b)
 	case *ExecuteStmt:
 		b, ok := inB.(*ExecuteStmt)
 		if !ok {
@@ -392,6 +596,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfExtractedSubquery(a, b)
+	case *FetchCursor:
+		b, ok := inB.(*FetchCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFetchCursor(a, b)
+	case *FirstOrLastValueExpr:
+		b, ok := inB.(*FirstOrLastValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFirstOrLastValueExpr(a, b)
 	case *Flush:
 		b, ok := inB.(*Flush)
 		if !ok {
@@ -410,12 +626,78 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
+	case *FromFirstLastClause:
+		b, ok := inB.(*FromFirstLastClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFromFirstLastClause(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfFuncExpr(a, b)
+	case *GeomDistanceExpr:
+		b, ok := inB.(*GeomDistanceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceExpr(a, b)
+	case *GeomDistanceSphereExpr:
+		b, ok := inB.(*GeomDistanceSphereExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceSphereExpr(a, b)
+	case *GeomFormatExpr:
+		b, ok := inB.(*GeomFormatExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFormatExpr(a, b)
+	case *GeomFromTextExpr:
+		b, ok := inB.(*GeomFromTextExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFromTextExpr(a, b)
+	case *GeomRelationExpr:
+		b, ok := inB.(*GeomRelationExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomRelationExpr(a, b)
+	case *GeomSRIDExpr:
+		b, ok := inB.(*GeomSRIDExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomSRIDExpr(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrant(a, b)
+	case *GrantTarget:
+		b, ok := inB.(*GrantTarget)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrantTarget(a, b)
 	case GroupBy:
 		b, ok := inB.(GroupBy)
 		if !ok {
@@ -428,6 +710,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfGroupConcatExpr(a, b)
+	case *GroupingExpr:
+		b, ok := inB.(*GroupingExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGroupingExpr(a, b)
+	case *HandlerCondition:
+		b, ok := inB.(*HandlerCondition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfHandlerCondition(a, b)
+	case *IfStmt:
+		b, ok := inB.(*IfStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIfStmt(a, b)
 	case *IndexDefinition:
 		b, ok := inB.(*IndexDefinition)
 		if !ok {
@@ -458,6 +758,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfInsert(a, b)
+	case *InstallComponent:
+		b, ok := inB.(*InstallComponent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfInstallComponent(a, b)
+	case *InstallPlugin:
+		b, ok := inB.(*InstallPlugin)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfInstallPlugin(a, b)
 	case *IntervalExpr:
 		b, ok := inB.(*IntervalExpr)
 		if !ok {
@@ -482,6 +794,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return a == b
+	case *IterateStmt:
+		b, ok := inB.(*IterateStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIterateStmt(a, b)
 	case *JSONArrayExpr:
 		b, ok := inB.(*JSONArrayExpr)
 		if !ok {
@@ -644,6 +962,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfKeyState(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
+	case *LagLeadExpr:
+		b, ok := inB.(*LagLeadExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLagLeadExpr(a, b)
+	case *LeaveStmt:
+		b, ok := inB.(*LeaveStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLeaveStmt(a, b)
 	case *Limit:
 		b, ok := inB.(*Limit)
 		if !ok {
@@ -668,6 +1004,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfLoad(a, b)
+	case *LoadFields:
+		b, ok := inB.(*LoadFields)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLoadFields(a, b)
+	case *LoadLines:
+		b, ok := inB.(*LoadLines)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLoadLines(a, b)
+	case *LockClause:
+		b, ok := inB.(*LockClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLockClause(a, b)
+	case LockClauses:
+		b, ok := inB.(LockClauses)
+		if !ok {
+			return false
+		}
+		return EqualsLockClauses(a, b)
 	case *LockOption:
 		b, ok := inB.(*LockOption)
 		if !ok {
@@ -680,6 +1040,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfLockTables(a, b)
+	case *LoopStmt:
+		b, ok := inB.(*LoopStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLoopStmt(a, b)
 	case MatchAction:
 		b, ok := inB.(MatchAction)
 		if !ok {
@@ -704,6 +1070,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfModifyColumn(a, b)
+	case *NTHValueExpr:
+		b, ok := inB.(*NTHValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNTHValueExpr(a, b)
 	case *Nextval:
 		b, ok := inB.(*Nextval)
 		if !ok {
@@ -716,6 +1088,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfNotExpr(a, b)
+	case *NtileExpr:
+		b, ok := inB.(*NtileExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNtileExpr(a, b)
+	case *NullTreatmentClause:
+		b, ok := inB.(*NullTreatmentClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNullTreatmentClause(a, b)
 	case *NullVal:
 		b, ok := inB.(*NullVal)
 		if !ok {
@@ -734,12 +1118,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsOnDup(a, b)
+	case *OpenCursor:
+		b, ok := inB.(*OpenCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOpenCursor(a, b)
 	case *OptLike:
 		b, ok := inB.(*OptLike)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfOptLike(a, b)
+	case *OptimizeTable:
+		b, ok := inB.(*OptimizeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOptimizeTable(a, b)
 	case *OrExpr:
 		b, ok := inB.(*OrExpr)
 		if !ok {
@@ -776,6 +1172,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *OverClause:
+		b, ok := inB.(*OverClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOverClause(a, b)
 	case *ParenTableExpr:
 		b, ok := inB.(*ParenTableExpr)
 		if !ok {
@@ -830,12 +1232,48 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsPartitions(a, b)
+	case *PasswordOption:
+		b, ok := inB.(*PasswordOption)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPasswordOption(a, b)
+	case PasswordOptions:
+		b, ok := inB.(PasswordOptions)
+		if !ok {
+			return false
+		}
+		return EqualsPasswordOptions(a, b)
 	case *PrepareStmt:
 		b, ok := inB.(*PrepareStmt)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfPrepareStmt(a, b)
+	case *PrivilegeSpec:
+		b, ok := inB.(*PrivilegeSpec)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPrivilegeSpec(a, b)
+	case Privileges:
+		b, ok := inB.(Privileges)
+		if !ok {
+			return false
+		}
+		return EqualsPrivileges(a, b)
+	case *ProcParameter:
+		b, ok := inB.(*ProcParameter)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfProcParameter(a, b)
+	case *PurgeBinaryLogs:
+		b, ok := inB.(*PurgeBinaryLogs)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPurgeBinaryLogs(a, b)
 	case ReferenceAction:
 		b, ok := inB.(ReferenceAction)
 		if !ok {
@@ -848,6 +1286,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfReferenceDefinition(a, b)
+	case *RegexpInstrExpr:
+		b, ok := inB.(*RegexpInstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpInstrExpr(a, b)
+	case *RegexpLikeExpr:
+		b, ok := inB.(*RegexpLikeExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpLikeExpr(a, b)
+	case *RegexpReplaceExpr:
+		b, ok := inB.(*RegexpReplaceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpReplaceExpr(a, b)
+	case *RegexpSubstrExpr:
+		b, ok := inB.(*RegexpSubstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpSubstrExpr(a, b)
 	case *Release:
 		b, ok := inB.(*Release)
 		if !ok {
@@ -872,60 +1334,150 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfRenameTableName(a, b)
-	case *RevertMigration:
-		b, ok := inB.(*RevertMigration)
+	case *RenameUser:
+		b, ok := inB.(*RenameUser)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfRevertMigration(a, b)
-	case *Rollback:
-		b, ok := inB.(*Rollback)
+		return EqualsRefOfRenameUser(a, b)
+	case *RepairTable:
+		b, ok := inB.(*RepairTable)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfRollback(a, b)
-	case RootNode:
-		b, ok := inB.(RootNode)
+		return EqualsRefOfRepairTable(a, b)
+	case *RepeatStmt:
+		b, ok := inB.(*RepeatStmt)
 		if !ok {
 			return false
 		}
-		return EqualsRootNode(a, b)
-	case *SRollback:
-		b, ok := inB.(*SRollback)
+		return EqualsRefOfRepeatStmt(a, b)
+	case *ReplicationOption:
+		b, ok := inB.(*ReplicationOption)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfSRollback(a, b)
-	case *Savepoint:
-		b, ok := inB.(*Savepoint)
+		return EqualsRefOfReplicationOption(a, b)
+	case ReplicationOptions:
+		b, ok := inB.(ReplicationOptions)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfSavepoint(a, b)
-	case *Select:
-		b, ok := inB.(*Select)
+		return EqualsReplicationOptions(a, b)
+	case *ResetMaster:
+		b, ok := inB.(*ResetMaster)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfSelect(a, b)
-	case SelectExprs:
-		b, ok := inB.(SelectExprs)
+		return EqualsRefOfResetMaster(a, b)
+	case *ResetReplica:
+		b, ok := inB.(*ResetReplica)
 		if !ok {
 			return false
 		}
-		return EqualsSelectExprs(a, b)
-	case *SelectInto:
-		b, ok := inB.(*SelectInto)
+		return EqualsRefOfResetReplica(a, b)
+	case *ResourceOption:
+		b, ok := inB.(*ResourceOption)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfSelectInto(a, b)
-	case *Set:
+		return EqualsRefOfResourceOption(a, b)
+	case ResourceOptions:
+		b, ok := inB.(ResourceOptions)
+		if !ok {
+			return false
+		}
+		return EqualsResourceOptions(a, b)
+	case *ReturnStmt:
+		b, ok := inB.(*ReturnStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfReturnStmt(a, b)
+	case *RevertMigration:
+		b, ok := inB.(*RevertMigration)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRevoke(a, b)
+	case *RoleSpec:
+		b, ok := inB.(*RoleSpec)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRoleSpec(a, b)
+	case *Rollback:
+		b, ok := inB.(*Rollback)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRollback(a, b)
+	case RootNode:
+		b, ok := inB.(RootNode)
+		if !ok {
+			return false
+		}
+		return EqualsRootNode(a, b)
+	case *RoutineCharacteristic:
+		b, ok := inB.(*RoutineCharacteristic)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRoutineCharacteristic(a, b)
+	case *RowAlias:
+		b, ok := inB.(*RowAlias)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRowAlias(a, b)
+	case *SRollback:
+		b, ok := inB.(*SRollback)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSRollback(a, b)
+	case *Savepoint:
+		b, ok := inB.(*Savepoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSavepoint(a, b)
+	case *Select:
+		b, ok := inB.(*Select)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSelect(a, b)
+	case SelectExprs:
+		b, ok := inB.(SelectExprs)
+		if !ok {
+			return false
+		}
+		return EqualsSelectExprs(a, b)
+	case *SelectInto:
+		b, ok := inB.(*SelectInto)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSelectInto(a, b)
+	case *Set:
 		b, ok := inB.(*Set)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfSet(a, b)
+	case *SetDefaultRole:
+		b, ok := inB.(*SetDefaultRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetDefaultRole(a, b)
 	case *SetExpr:
 		b, ok := inB.(*SetExpr)
 		if !ok {
@@ -938,6 +1490,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsSetExprs(a, b)
+	case *SetPassword:
+		b, ok := inB.(*SetPassword)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetPassword(a, b)
+	case *SetRole:
+		b, ok := inB.(*SetRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetRole(a, b)
 	case *SetTransaction:
 		b, ok := inB.(*SetTransaction)
 		if !ok {
@@ -956,18 +1520,36 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfShowBasic(a, b)
+	case *ShowBinlogEvents:
+		b, ok := inB.(*ShowBinlogEvents)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowBinlogEvents(a, b)
 	case *ShowCreate:
 		b, ok := inB.(*ShowCreate)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfShowCreate(a, b)
+	case *ShowEngine:
+		b, ok := inB.(*ShowEngine)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowEngine(a, b)
 	case *ShowFilter:
 		b, ok := inB.(*ShowFilter)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfShowFilter(a, b)
+	case *ShowGrants:
+		b, ok := inB.(*ShowGrants)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowGrants(a, b)
 	case *ShowMigrationLogs:
 		b, ok := inB.(*ShowMigrationLogs)
 		if !ok {
@@ -980,18 +1562,54 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfShowOther(a, b)
+	case *ShowProfile:
+		b, ok := inB.(*ShowProfile)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowProfile(a, b)
 	case *ShowThrottledApps:
 		b, ok := inB.(*ShowThrottledApps)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfShowThrottledApps(a, b)
+	case *Shutdown:
+		b, ok := inB.(*Shutdown)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShutdown(a, b)
+	case *Signal:
+		b, ok := inB.(*Signal)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSignal(a, b)
+	case *SignalInfo:
+		b, ok := inB.(*SignalInfo)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSignalInfo(a, b)
 	case *StarExpr:
 		b, ok := inB.(*StarExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfStarExpr(a, b)
+	case *StartReplica:
+		b, ok := inB.(*StartReplica)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfStartReplica(a, b)
+	case *StopReplica:
+		b, ok := inB.(*StopReplica)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfStopReplica(a, b)
 	case *Stream:
 		b, ok := inB.(*Stream)
 		if !ok {
@@ -1034,6 +1652,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfSubstrExpr(a, b)
+	case *TLSOption:
+		b, ok := inB.(*TLSOption)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTLSOption(a, b)
+	case TLSOptions:
+		b, ok := inB.(TLSOptions)
+		if !ok {
+			return false
+		}
+		return EqualsTLSOptions(a, b)
 	case TableExprs:
 		b, ok := inB.(TableExprs)
 		if !ok {
@@ -1070,6 +1700,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfTableSpec(a, b)
+	case *TableStatement:
+		b, ok := inB.(*TableStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTableStatement(a, b)
 	case *TablespaceOperation:
 		b, ok := inB.(*TablespaceOperation)
 		if !ok {
@@ -1082,6 +1718,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfTimestampFuncExpr(a, b)
+	case *TriggerOrder:
+		b, ok := inB.(*TriggerOrder)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTriggerOrder(a, b)
 	case *TrimFuncExpr:
 		b, ok := inB.(*TrimFuncExpr)
 		if !ok {
@@ -1100,6 +1742,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfUnaryExpr(a, b)
+	case *UninstallComponent:
+		b, ok := inB.(*UninstallComponent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUninstallComponent(a, b)
+	case *UninstallPlugin:
+		b, ok := inB.(*UninstallPlugin)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUninstallPlugin(a, b)
 	case *Union:
 		b, ok := inB.(*Union)
 		if !ok {
@@ -1136,6 +1790,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfUse(a, b)
+	case *UserSpec:
+		b, ok := inB.(*UserSpec)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUserSpec(a, b)
+	case UserSpecs:
+		b, ok := inB.(UserSpecs)
+		if !ok {
+			return false
+		}
+		return EqualsUserSpecs(a, b)
 	case *VStream:
 		b, ok := inB.(*VStream)
 		if !ok {
@@ -1166,6 +1832,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfValuesFuncExpr(a, b)
+	case *ValuesStatement:
+		b, ok := inB.(*ValuesStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfValuesStatement(a, b)
 	case VindexParam:
 		b, ok := inB.(VindexParam)
 		if !ok {
@@ -1196,12 +1868,48 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WhileStmt:
+		b, ok := inB.(*WhileStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWhileStmt(a, b)
+	case *WindowDefinition:
+		b, ok := inB.(*WindowDefinition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowDefinition(a, b)
+	case WindowDefinitions:
+		b, ok := inB.(WindowDefinitions)
+		if !ok {
+			return false
+		}
+		return EqualsWindowDefinitions(a, b)
+	case *WindowSpecification:
+		b, ok := inB.(*WindowSpecification)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowSpecification(a, b)
 	case *With:
 		b, ok := inB.(*With)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfWith(a, b)
+	case *XATransaction:
+		b, ok := inB.(*XATransaction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfXATransaction(a, b)
+	case *Xid:
+		b, ok := inB.(*Xid)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfXid(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -1214,6 +1922,32 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 	}
 }
 
+// EqualsRefOfAccount does deep equals between the two objects.
+func EqualsRefOfAccount(a, b *Account) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.User == b.User &&
+		a.Host == b.Host &&
+		a.CurrentUser == b.CurrentUser
+}
+
+// EqualsAccounts does deep equals between the two objects.
+func EqualsAccounts(a, b Accounts) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfAccount(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfAddColumns does deep equals between the two objects.
 func EqualsRefOfAddColumns(a, b *AddColumns) bool {
 	if a == b {
@@ -1328,6 +2062,25 @@ func EqualsRefOfAlterDatabase(a, b *AlterDatabase) bool {
 		EqualsSliceOfDatabaseOption(a.AlterOptions, b.AlterOptions)
 }
 
+// EqualsRefOfAlterEvent does deep equals between the two objects.
+func EqualsRefOfAlterEvent(a, b *AlterEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Comment == b.Comment &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfDefiner(a.Definer, b.Definer) &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsRefOfEventSchedule(a.Schedule, b.Schedule) &&
+		a.OnCompletion == b.OnCompletion &&
+		EqualsTableName(a.RenameTo, b.RenameTo) &&
+		a.Status == b.Status &&
+		EqualsStatement(a.Body, b.Body)
+}
+
 // EqualsRefOfAlterIndex does deep equals between the two objects.
 func EqualsRefOfAlterIndex(a, b *AlterIndex) bool {
 	if a == b {
@@ -1354,6 +2107,20 @@ func EqualsRefOfAlterMigration(a, b *AlterMigration) bool {
 		EqualsRefOfLiteral(a.Ratio, b.Ratio)
 }
 
+// EqualsRefOfAlterRoutine does deep equals between the two objects.
+func EqualsRefOfAlterRoutine(a, b *AlterRoutine) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		a.Type == b.Type &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics)
+}
+
 // EqualsRefOfAlterTable does deep equals between the two objects.
 func EqualsRefOfAlterTable(a, b *AlterTable) bool {
 	if a == b {
@@ -1370,6 +2137,25 @@ func EqualsRefOfAlterTable(a, b *AlterTable) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfAlterUser does deep equals between the two objects.
+func EqualsRefOfAlterUser(a, b *AlterUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		a.Comment == b.Comment &&
+		a.Attribute == b.Attribute &&
+		EqualsUserSpecs(a.Users, b.Users) &&
+		EqualsRefOfRoleSpec(a.DefaultRole, b.DefaultRole) &&
+		EqualsTLSOptions(a.TLSOptions, b.TLSOptions) &&
+		EqualsResourceOptions(a.ResourceOptions, b.ResourceOptions) &&
+		EqualsPasswordOptions(a.PasswordOptions, b.PasswordOptions) &&
+		a.AccountLock == b.AccountLock
+}
+
 // EqualsRefOfAlterView does deep equals between the two objects.
 func EqualsRefOfAlterView(a, b *AlterView) bool {
 	if a == b {
@@ -1403,6 +2189,21 @@ func EqualsRefOfAlterVschema(a, b *AlterVschema) bool {
 		EqualsRefOfAutoIncSpec(a.AutoIncSpec, b.AutoIncSpec)
 }
 
+// EqualsRefOfAnalyzeTable does deep equals between the two objects.
+func EqualsRefOfAnalyzeTable(a, b *AnalyzeTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsLocal == b.IsLocal &&
+		EqualsTableNames(a.Tables, b.Tables) &&
+		a.HistogramAction == b.HistogramAction &&
+		EqualsColumns(a.HistogramColumns, b.HistogramColumns) &&
+		EqualsRefOfLiteral(a.Buckets, b.Buckets)
+}
+
 // EqualsRefOfAndExpr does deep equals between the two objects.
 func EqualsRefOfAndExpr(a, b *AndExpr) bool {
 	if a == b {
@@ -1415,6 +2216,35 @@ func EqualsRefOfAndExpr(a, b *AndExpr) bool {
 		EqualsExpr(a.Right, b.Right)
 }
 
+// EqualsRefOfArgumentLessWindowExpr does deep equals between the two objects.
+func EqualsRefOfArgumentLessWindowExpr(a, b *ArgumentLessWindowExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsRefOfOverClause(a.OverClause, b.OverClause)
+}
+
+// EqualsRefOfAuthOption does deep equals between the two objects.
+func EqualsRefOfAuthOption(a, b *AuthOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Plugin == b.Plugin &&
+		a.AuthString == b.AuthString &&
+		a.Hashed == b.Hashed &&
+		a.RandomPassword == b.RandomPassword &&
+		a.Replace == b.Replace &&
+		a.RetainCurrent == b.RetainCurrent &&
+		a.DiscardOldPassword == b.DiscardOldPassword
+}
+
 // EqualsRefOfAutoIncSpec does deep equals between the two objects.
 func EqualsRefOfAutoIncSpec(a, b *AutoIncSpec) bool {
 	if a == b {
@@ -1427,6 +2257,29 @@ func EqualsRefOfAutoIncSpec(a, b *AutoIncSpec) bool {
 		EqualsTableName(a.Sequence, b.Sequence)
 }
 
+// EqualsRefOfBadDefinition does deep equals between the two objects.
+func EqualsRefOfBadDefinition(a, b *BadDefinition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.SQL == b.SQL &&
+		a.Index == b.Index
+}
+
+// EqualsRefOfBadStmt does deep equals between the two objects.
+func EqualsRefOfBadStmt(a, b *BadStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.SQL == b.SQL
+}
+
 // EqualsRefOfBegin does deep equals between the two objects.
 func EqualsRefOfBegin(a, b *Begin) bool {
 	if a == b {
@@ -1438,6 +2291,18 @@ func EqualsRefOfBegin(a, b *Begin) bool {
 	return true
 }
 
+// EqualsRefOfBeginEndBlock does deep equals between the two objects.
+func EqualsRefOfBeginEndBlock(a, b *BeginEndBlock) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label) &&
+		EqualsCompoundStatements(a.Statements, b.Statements)
+}
+
 // EqualsRefOfBetweenExpr does deep equals between the two objects.
 func EqualsRefOfBetweenExpr(a, b *BetweenExpr) bool {
 	if a == b {
@@ -1490,30 +2355,103 @@ func EqualsRefOfCaseExpr(a, b *CaseExpr) bool {
 		EqualsExpr(a.Else, b.Else)
 }
 
-// EqualsRefOfChangeColumn does deep equals between the two objects.
-func EqualsRefOfChangeColumn(a, b *ChangeColumn) bool {
+// EqualsRefOfCaseStmt does deep equals between the two objects.
+func EqualsRefOfCaseStmt(a, b *CaseStmt) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.First == b.First &&
-		EqualsRefOfColName(a.OldColumn, b.OldColumn) &&
-		EqualsRefOfColumnDefinition(a.NewColDefinition, b.NewColDefinition) &&
-		EqualsRefOfColName(a.After, b.After)
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsSliceOfRefOfCaseStmtWhen(a.Whens, b.Whens) &&
+		EqualsCompoundStatements(a.Else, b.Else)
 }
 
-// EqualsRefOfCheckConstraintDefinition does deep equals between the two objects.
-func EqualsRefOfCheckConstraintDefinition(a, b *CheckConstraintDefinition) bool {
+// EqualsRefOfCaseStmtWhen does deep equals between the two objects.
+func EqualsRefOfCaseStmtWhen(a, b *CaseStmtWhen) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Enforced == b.Enforced &&
-		EqualsExpr(a.Expr, b.Expr)
+	return EqualsExpr(a.Cond, b.Cond) &&
+		EqualsCompoundStatements(a.Statements, b.Statements)
+}
+
+// EqualsRefOfChangeColumn does deep equals between the two objects.
+func EqualsRefOfChangeColumn(a, b *ChangeColumn) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.First == b.First &&
+		EqualsRefOfColName(a.OldColumn, b.OldColumn) &&
+		EqualsRefOfColumnDefinition(a.NewColDefinition, b.NewColDefinition) &&
+		EqualsRefOfColName(a.After, b.After)
+}
+
+// EqualsRefOfChangeReplicationSource does deep equals between the two objects.
+func EqualsRefOfChangeReplicationSource(a, b *ChangeReplicationSource) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Master == b.Master &&
+		a.Channel == b.Channel &&
+		EqualsReplicationOptions(a.Options, b.Options)
+}
+
+// EqualsRefOfCheckConstraintDefinition does deep equals between the two objects.
+func EqualsRefOfCheckConstraintDefinition(a, b *CheckConstraintDefinition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Enforced == b.Enforced &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
+// EqualsRefOfCheckTable does deep equals between the two objects.
+func EqualsRefOfCheckTable(a, b *CheckTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableNames(a.Tables, b.Tables) &&
+		EqualsSliceOfMaintenanceOption(a.Options, b.Options)
+}
+
+// EqualsRefOfChecksumTable does deep equals between the two objects.
+func EqualsRefOfChecksumTable(a, b *ChecksumTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableNames(a.Tables, b.Tables) &&
+		EqualsSliceOfMaintenanceOption(a.Options, b.Options)
+}
+
+// EqualsRefOfCloseCursor does deep equals between the two objects.
+func EqualsRefOfCloseCursor(a, b *CloseCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name)
 }
 
 // EqualsColIdent does deep equals between the two objects.
@@ -1628,6 +2566,19 @@ func EqualsRefOfComparisonExpr(a, b *ComparisonExpr) bool {
 		EqualsExpr(a.Escape, b.Escape)
 }
 
+// EqualsCompoundStatements does deep equals between the two objects.
+func EqualsCompoundStatements(a, b CompoundStatements) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsStatement(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfConstraintDefinition does deep equals between the two objects.
 func EqualsRefOfConstraintDefinition(a, b *ConstraintDefinition) bool {
 	if a == b {
@@ -1693,6 +2644,72 @@ func EqualsRefOfCreateDatabase(a, b *CreateDatabase) bool {
 		EqualsSliceOfDatabaseOption(a.CreateOptions, b.CreateOptions)
 }
 
+// EqualsRefOfCreateEvent does deep equals between the two objects.
+func EqualsRefOfCreateEvent(a, b *CreateEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		a.Comment == b.Comment &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfDefiner(a.Definer, b.Definer) &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsRefOfEventSchedule(a.Schedule, b.Schedule) &&
+		a.OnCompletion == b.OnCompletion &&
+		a.Status == b.Status &&
+		EqualsStatement(a.Body, b.Body)
+}
+
+// EqualsRefOfCreateFunction does deep equals between the two objects.
+func EqualsRefOfCreateFunction(a, b *CreateFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfDefiner(a.Definer, b.Definer) &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfProcParameter(a.Params, b.Params) &&
+		EqualsColumnType(a.Returns, b.Returns) &&
+		EqualsSliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics) &&
+		EqualsStatement(a.Body, b.Body)
+}
+
+// EqualsRefOfCreateProcedure does deep equals between the two objects.
+func EqualsRefOfCreateProcedure(a, b *CreateProcedure) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfDefiner(a.Definer, b.Definer) &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfProcParameter(a.Params, b.Params) &&
+		EqualsSliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics) &&
+		EqualsStatement(a.Body, b.Body)
+}
+
+// EqualsRefOfCreateRole does deep equals between the two objects.
+func EqualsRefOfCreateRole(a, b *CreateRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		EqualsAccounts(a.Roles, b.Roles)
+}
+
 // EqualsRefOfCreateTable does deep equals between the two objects.
 func EqualsRefOfCreateTable(a, b *CreateTable) bool {
 	if a == b {
@@ -1710,6 +2727,44 @@ func EqualsRefOfCreateTable(a, b *CreateTable) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfCreateTrigger does deep equals between the two objects.
+func EqualsRefOfCreateTrigger(a, b *CreateTrigger) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfDefiner(a.Definer, b.Definer) &&
+		EqualsTableName(a.Name, b.Name) &&
+		a.Timing == b.Timing &&
+		a.Event == b.Event &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsRefOfTriggerOrder(a.Order, b.Order) &&
+		EqualsStatement(a.Body, b.Body)
+}
+
+// EqualsRefOfCreateUser does deep equals between the two objects.
+func EqualsRefOfCreateUser(a, b *CreateUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		a.Comment == b.Comment &&
+		a.Attribute == b.Attribute &&
+		EqualsUserSpecs(a.Users, b.Users) &&
+		EqualsRefOfRoleSpec(a.DefaultRole, b.DefaultRole) &&
+		EqualsTLSOptions(a.TLSOptions, b.TLSOptions) &&
+		EqualsResourceOptions(a.ResourceOptions, b.ResourceOptions) &&
+		EqualsPasswordOptions(a.PasswordOptions, b.PasswordOptions) &&
+		a.AccountLock == b.AccountLock
+}
+
 // EqualsRefOfCreateView does deep equals between the two objects.
 func EqualsRefOfCreateView(a, b *CreateView) bool {
 	if a == b {
@@ -1754,6 +2809,56 @@ func EqualsRefOfDeallocateStmt(a, b *DeallocateStmt) bool {
 		EqualsColIdent(a.Name, b.Name)
 }
 
+// EqualsRefOfDeclareCondition does deep equals between the two objects.
+func EqualsRefOfDeclareCondition(a, b *DeclareCondition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfHandlerCondition(a.Condition, b.Condition)
+}
+
+// EqualsRefOfDeclareCursor does deep equals between the two objects.
+func EqualsRefOfDeclareCursor(a, b *DeclareCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsSelectStatement(a.Select, b.Select)
+}
+
+// EqualsRefOfDeclareHandler does deep equals between the two objects.
+func EqualsRefOfDeclareHandler(a, b *DeclareHandler) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Action == b.Action &&
+		EqualsSliceOfRefOfHandlerCondition(a.Conditions, b.Conditions) &&
+		EqualsStatement(a.Statement, b.Statement)
+}
+
+// EqualsRefOfDeclareVar does deep equals between the two objects.
+func EqualsRefOfDeclareVar(a, b *DeclareVar) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColumns(a.Names, b.Names) &&
+		EqualsColumnType(a.Type, b.Type) &&
+		EqualsExpr(a.Default, b.Default)
+}
+
 // EqualsRefOfDefault does deep equals between the two objects.
 func EqualsRefOfDefault(a, b *Default) bool {
 	if a == b {
@@ -1808,6 +2913,17 @@ func EqualsRefOfDerivedTable(a, b *DerivedTable) bool {
 		EqualsSelectStatement(a.Select, b.Select)
 }
 
+// EqualsRefOfDoStmt does deep equals between the two objects.
+func EqualsRefOfDoStmt(a, b *DoStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExprs(a.Exprs, b.Exprs)
+}
+
 // EqualsRefOfDropColumn does deep equals between the two objects.
 func EqualsRefOfDropColumn(a, b *DropColumn) bool {
 	if a == b {
@@ -1844,6 +2960,32 @@ func EqualsRefOfDropKey(a, b *DropKey) bool {
 		EqualsColIdent(a.Name, b.Name)
 }
 
+// EqualsRefOfDropProgram does deep equals between the two objects.
+func EqualsRefOfDropProgram(a, b *DropProgram) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		a.Type == b.Type &&
+		EqualsTableName(a.Name, b.Name)
+}
+
+// EqualsRefOfDropRole does deep equals between the two objects.
+func EqualsRefOfDropRole(a, b *DropRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		EqualsAccounts(a.Roles, b.Roles)
+}
+
 // EqualsRefOfDropTable does deep equals between the two objects.
 func EqualsRefOfDropTable(a, b *DropTable) bool {
 	if a == b {
@@ -1858,6 +3000,18 @@ func EqualsRefOfDropTable(a, b *DropTable) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfDropUser does deep equals between the two objects.
+func EqualsRefOfDropUser(a, b *DropUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		EqualsAccounts(a.Users, b.Users)
+}
+
 // EqualsRefOfDropView does deep equals between the two objects.
 func EqualsRefOfDropView(a, b *DropView) bool {
 	if a == b {
@@ -1871,6 +3025,33 @@ func EqualsRefOfDropView(a, b *DropView) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfElseIf does deep equals between the two objects.
+func EqualsRefOfElseIf(a, b *ElseIf) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Cond, b.Cond) &&
+		EqualsCompoundStatements(a.Statements, b.Statements)
+}
+
+// EqualsRefOfEventSchedule does deep equals between the two objects.
+func EqualsRefOfEventSchedule(a, b *EventSchedule) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.EveryUnit == b.EveryUnit &&
+		EqualsExpr(a.At, b.At) &&
+		EqualsExpr(a.Every, b.Every) &&
+		EqualsExpr(a.Starts, b.Starts) &&
+		EqualsExpr(a.Ends, b.Ends)
+}
+
 // EqualsRefOfExecuteStmt does deep equals between the two objects.
 func EqualsRefOfExecuteStmt(a, b *ExecuteStmt) bool {
 	if a == b {
@@ -1962,6 +3143,32 @@ func EqualsRefOfExtractedSubquery(a, b *ExtractedSubquery) bool {
 		EqualsExpr(a.alternative, b.alternative)
 }
 
+// EqualsRefOfFetchCursor does deep equals between the two objects.
+func EqualsRefOfFetchCursor(a, b *FetchCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsColumns(a.Into, b.Into)
+}
+
+// EqualsRefOfFirstOrLastValueExpr does deep equals between the two objects.
+func EqualsRefOfFirstOrLastValueExpr(a, b *FirstOrLastValueExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr) &&
+		EqualsRefOfNullTreatmentClause(a.NullTreatmentClause, b.NullTreatmentClause) &&
+		EqualsRefOfOverClause(a.OverClause, b.OverClause)
+}
+
 // EqualsRefOfFlush does deep equals between the two objects.
 func EqualsRefOfFlush(a, b *Flush) bool {
 	if a == b {
@@ -2001,24 +3208,170 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
-// EqualsRefOfFuncExpr does deep equals between the two objects.
-func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Distinct == b.Distinct &&
-		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
-		EqualsColIdent(a.Name, b.Name) &&
-		EqualsSelectExprs(a.Exprs, b.Exprs)
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
 }
 
-// EqualsGroupBy does deep equals between the two objects.
-func EqualsGroupBy(a, b GroupBy) bool {
-	if len(a) != len(b) {
-		return false
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
+// EqualsRefOfFromFirstLastClause does deep equals between the two objects.
+func EqualsRefOfFromFirstLastClause(a, b *FromFirstLastClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type
+}
+
+// EqualsRefOfFuncExpr does deep equals between the two objects.
+func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Distinct == b.Distinct &&
+		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsSelectExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
+}
+
+// EqualsRefOfGeomDistanceExpr does deep equals between the two objects.
+func EqualsRefOfGeomDistanceExpr(a, b *GeomDistanceExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Geom1, b.Geom1) &&
+		EqualsExpr(a.Geom2, b.Geom2) &&
+		EqualsExpr(a.Unit, b.Unit)
+}
+
+// EqualsRefOfGeomDistanceSphereExpr does deep equals between the two objects.
+func EqualsRefOfGeomDistanceSphereExpr(a, b *GeomDistanceSphereExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Geom1, b.Geom1) &&
+		EqualsExpr(a.Geom2, b.Geom2) &&
+		EqualsExpr(a.Radius, b.Radius)
+}
+
+// EqualsRefOfGeomFormatExpr does deep equals between the two objects.
+func EqualsRefOfGeomFormatExpr(a, b *GeomFormatExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.FormatType == b.FormatType &&
+		EqualsExpr(a.Geom, b.Geom) &&
+		EqualsExpr(a.AxisOrderOpt, b.AxisOrderOpt)
+}
+
+// EqualsRefOfGeomFromTextExpr does deep equals between the two objects.
+func EqualsRefOfGeomFromTextExpr(a, b *GeomFromTextExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.WktText, b.WktText) &&
+		EqualsExpr(a.Srid, b.Srid) &&
+		EqualsExpr(a.AxisOrderOpt, b.AxisOrderOpt)
+}
+
+// EqualsRefOfGeomRelationExpr does deep equals between the two objects.
+func EqualsRefOfGeomRelationExpr(a, b *GeomRelationExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Geom1, b.Geom1) &&
+		EqualsExpr(a.Geom2, b.Geom2)
+}
+
+// EqualsRefOfGeomSRIDExpr does deep equals between the two objects.
+func EqualsRefOfGeomSRIDExpr(a, b *GeomSRIDExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Geom, b.Geom) &&
+		EqualsExpr(a.Srid, b.Srid)
+}
+
+// EqualsRefOfGrant does deep equals between the two objects.
+func EqualsRefOfGrant(a, b *Grant) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.WithGrantOption == b.WithGrantOption &&
+		a.WithAdminOption == b.WithAdminOption &&
+		EqualsPrivileges(a.Privileges, b.Privileges) &&
+		EqualsRefOfGrantTarget(a.Target, b.Target) &&
+		EqualsAccounts(a.Roles, b.Roles) &&
+		EqualsRefOfAccount(a.Proxy, b.Proxy) &&
+		EqualsAccounts(a.To, b.To)
+}
+
+// EqualsRefOfGrantTarget does deep equals between the two objects.
+func EqualsRefOfGrantTarget(a, b *GrantTarget) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ObjectType == b.ObjectType &&
+		a.Level == b.Level &&
+		EqualsTableIdent(a.Database, b.Database) &&
+		EqualsTableIdent(a.Name, b.Name)
+}
+
+// EqualsGroupBy does deep equals between the two objects.
+func EqualsGroupBy(a, b GroupBy) bool {
+	if len(a) != len(b) {
+		return false
 	}
 	for i := 0; i < len(a); i++ {
 		if !EqualsExpr(a[i], b[i]) {
@@ -2043,6 +3396,44 @@ func EqualsRefOfGroupConcatExpr(a, b *GroupConcatExpr) bool {
 		EqualsRefOfLimit(a.Limit, b.Limit)
 }
 
+// EqualsRefOfGroupingExpr does deep equals between the two objects.
+func EqualsRefOfGroupingExpr(a, b *GroupingExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExprs(a.Exprs, b.Exprs)
+}
+
+// EqualsRefOfHandlerCondition does deep equals between the two objects.
+func EqualsRefOfHandlerCondition(a, b *HandlerCondition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Value == b.Value &&
+		a.Type == b.Type &&
+		EqualsColIdent(a.Name, b.Name)
+}
+
+// EqualsRefOfIfStmt does deep equals between the two objects.
+func EqualsRefOfIfStmt(a, b *IfStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Cond, b.Cond) &&
+		EqualsCompoundStatements(a.Statements, b.Statements) &&
+		EqualsSliceOfRefOfElseIf(a.ElseIfs, b.ElseIfs) &&
+		EqualsCompoundStatements(a.Else, b.Else)
+}
+
 // EqualsRefOfIndexDefinition does deep equals between the two objects.
 func EqualsRefOfIndexDefinition(a, b *IndexDefinition) bool {
 	if a == b {
@@ -2114,9 +3505,33 @@ func EqualsRefOfInsert(a, b *Insert) bool {
 		EqualsPartitions(a.Partitions, b.Partitions) &&
 		EqualsColumns(a.Columns, b.Columns) &&
 		EqualsInsertRows(a.Rows, b.Rows) &&
+		EqualsRefOfRowAlias(a.RowAlias, b.RowAlias) &&
 		EqualsOnDup(a.OnDup, b.OnDup)
 }
 
+// EqualsRefOfInstallComponent does deep equals between the two objects.
+func EqualsRefOfInstallComponent(a, b *InstallComponent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSliceOfString(a.Components, b.Components)
+}
+
+// EqualsRefOfInstallPlugin does deep equals between the two objects.
+func EqualsRefOfInstallPlugin(a, b *InstallPlugin) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Library == b.Library &&
+		EqualsColIdent(a.Name, b.Name)
+}
+
 // EqualsRefOfIntervalExpr does deep equals between the two objects.
 func EqualsRefOfIntervalExpr(a, b *IntervalExpr) bool {
 	if a == b {
@@ -2153,6 +3568,17 @@ func EqualsRefOfIsExpr(a, b *IsExpr) bool {
 		a.Right == b.Right
 }
 
+// EqualsRefOfIterateStmt does deep equals between the two objects.
+func EqualsRefOfIterateStmt(a, b *IterateStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label)
+}
+
 // EqualsRefOfJSONArrayExpr does deep equals between the two objects.
 func EqualsRefOfJSONArrayExpr(a, b *JSONArrayExpr) bool {
 	if a == b {
@@ -2479,6 +3905,45 @@ func EqualsRefOfKeyState(a, b *KeyState) bool {
 	return a.Enable == b.Enable
 }
 
+// EqualsRefOfKill does deep equals between the two objects.
+func EqualsRefOfKill(a, b *Kill) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ProcesslistID == b.ProcesslistID &&
+		a.Type == b.Type
+}
+
+// EqualsRefOfLagLeadExpr does deep equals between the two objects.
+func EqualsRefOfLagLeadExpr(a, b *LagLeadExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.N, b.N) &&
+		EqualsExpr(a.Default, b.Default) &&
+		EqualsRefOfNullTreatmentClause(a.NullTreatmentClause, b.NullTreatmentClause) &&
+		EqualsRefOfOverClause(a.OverClause, b.OverClause)
+}
+
+// EqualsRefOfLeaveStmt does deep equals between the two objects.
+func EqualsRefOfLeaveStmt(a, b *LeaveStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label)
+}
+
 // EqualsRefOfLimit does deep equals between the two objects.
 func EqualsRefOfLimit(a, b *Limit) bool {
 	if a == b {
@@ -2511,6 +3976,69 @@ func EqualsRefOfLoad(a, b *Load) bool {
 	if a == nil || b == nil {
 		return false
 	}
+	return a.Local == b.Local &&
+		a.FileName == b.FileName &&
+		a.Charset == b.Charset &&
+		a.Priority == b.Priority &&
+		a.Duplicate == b.Duplicate &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsPartitions(a.Partitions, b.Partitions) &&
+		EqualsRefOfLoadFields(a.Fields, b.Fields) &&
+		EqualsRefOfLoadLines(a.Lines, b.Lines) &&
+		EqualsRefOfLiteral(a.IgnoreLines, b.IgnoreLines) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsUpdateExprs(a.SetExprs, b.SetExprs)
+}
+
+// EqualsRefOfLoadFields does deep equals between the two objects.
+func EqualsRefOfLoadFields(a, b *LoadFields) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Optionally == b.Optionally &&
+		EqualsRefOfLiteral(a.Terminated, b.Terminated) &&
+		EqualsRefOfLiteral(a.Enclosed, b.Enclosed) &&
+		EqualsRefOfLiteral(a.Escaped, b.Escaped)
+}
+
+// EqualsRefOfLoadLines does deep equals between the two objects.
+func EqualsRefOfLoadLines(a, b *LoadLines) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfLiteral(a.Starting, b.Starting) &&
+		EqualsRefOfLiteral(a.Terminated, b.Terminated)
+}
+
+// EqualsRefOfLockClause does deep equals between the two objects.
+func EqualsRefOfLockClause(a, b *LockClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Lock == b.Lock &&
+		EqualsTableNames(a.Tables, b.Tables) &&
+		a.Wait == b.Wait
+}
+
+// EqualsLockClauses does deep equals between the two objects.
+func EqualsLockClauses(a, b LockClauses) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfLockClause(a[i], b[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -2536,6 +4064,18 @@ func EqualsRefOfLockTables(a, b *LockTables) bool {
 	return EqualsTableAndLockTypes(a.Tables, b.Tables)
 }
 
+// EqualsRefOfLoopStmt does deep equals between the two objects.
+func EqualsRefOfLoopStmt(a, b *LoopStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label) &&
+		EqualsCompoundStatements(a.Statements, b.Statements)
+}
+
 // EqualsRefOfMatchExpr does deep equals between the two objects.
 func EqualsRefOfMatchExpr(a, b *MatchExpr) bool {
 	if a == b {
@@ -2574,6 +4114,21 @@ func EqualsRefOfModifyColumn(a, b *ModifyColumn) bool {
 		EqualsRefOfColName(a.After, b.After)
 }
 
+// EqualsRefOfNTHValueExpr does deep equals between the two objects.
+func EqualsRefOfNTHValueExpr(a, b *NTHValueExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.N, b.N) &&
+		EqualsRefOfFromFirstLastClause(a.FromFirstLastClause, b.FromFirstLastClause) &&
+		EqualsRefOfNullTreatmentClause(a.NullTreatmentClause, b.NullTreatmentClause) &&
+		EqualsRefOfOverClause(a.OverClause, b.OverClause)
+}
+
 // EqualsRefOfNextval does deep equals between the two objects.
 func EqualsRefOfNextval(a, b *Nextval) bool {
 	if a == b {
@@ -2596,6 +4151,29 @@ func EqualsRefOfNotExpr(a, b *NotExpr) bool {
 	return EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfNtileExpr does deep equals between the two objects.
+func EqualsRefOfNtileExpr(a, b *NtileExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.N, b.N) &&
+		EqualsRefOfOverClause(a.OverClause, b.OverClause)
+}
+
+// EqualsRefOfNullTreatmentClause does deep equals between the two objects.
+func EqualsRefOfNullTreatmentClause(a, b *NullTreatmentClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type
+}
+
 // EqualsRefOfNullVal does deep equals between the two objects.
 func EqualsRefOfNullVal(a, b *NullVal) bool {
 	if a == b {
@@ -2620,6 +4198,17 @@ func EqualsOnDup(a, b OnDup) bool {
 	return true
 }
 
+// EqualsRefOfOpenCursor does deep equals between the two objects.
+func EqualsRefOfOpenCursor(a, b *OpenCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name)
+}
+
 // EqualsRefOfOptLike does deep equals between the two objects.
 func EqualsRefOfOptLike(a, b *OptLike) bool {
 	if a == b {
@@ -2631,6 +4220,18 @@ func EqualsRefOfOptLike(a, b *OptLike) bool {
 	return EqualsTableName(a.LikeTable, b.LikeTable)
 }
 
+// EqualsRefOfOptimizeTable does deep equals between the two objects.
+func EqualsRefOfOptimizeTable(a, b *OptimizeTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsLocal == b.IsLocal &&
+		EqualsTableNames(a.Tables, b.Tables)
+}
+
 // EqualsRefOfOrExpr does deep equals between the two objects.
 func EqualsRefOfOrExpr(a, b *OrExpr) bool {
 	if a == b {
@@ -2701,6 +4302,18 @@ func EqualsRefOfOtherRead(a, b *OtherRead) bool {
 	return true
 }
 
+// EqualsRefOfOverClause does deep equals between the two objects.
+func EqualsRefOfOverClause(a, b *OverClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.WindowName, b.WindowName) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
 // EqualsRefOfParenTableExpr does deep equals between the two objects.
 func EqualsRefOfParenTableExpr(a, b *ParenTableExpr) bool {
 	if a == b {
@@ -2720,7 +4333,9 @@ func EqualsRefOfParsedComments(a, b *ParsedComments) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return EqualsComments(a.comments, b.comments)
+	return EqualsComments(a.comments, b.comments) &&
+		EqualsOptimizerHints(a.hints, b.hints) &&
+		EqualsSliceOfRefOfOptimizerHintError(a.hintErrors, b.hintErrors)
 }
 
 // EqualsRefOfPartitionDefinition does deep equals between the two objects.
@@ -2827,6 +4442,31 @@ func EqualsPartitions(a, b Partitions) bool {
 	return true
 }
 
+// EqualsRefOfPasswordOption does deep equals between the two objects.
+func EqualsRefOfPasswordOption(a, b *PasswordOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Value == b.Value &&
+		a.Type == b.Type
+}
+
+// EqualsPasswordOptions does deep equals between the two objects.
+func EqualsPasswordOptions(a, b PasswordOptions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfPasswordOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfPrepareStmt does deep equals between the two objects.
 func EqualsRefOfPrepareStmt(a, b *PrepareStmt) bool {
 	if a == b {
@@ -2840,6 +4480,57 @@ func EqualsRefOfPrepareStmt(a, b *PrepareStmt) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfPrivilegeSpec does deep equals between the two objects.
+func EqualsRefOfPrivilegeSpec(a, b *PrivilegeSpec) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		EqualsColumns(a.Columns, b.Columns)
+}
+
+// EqualsPrivileges does deep equals between the two objects.
+func EqualsPrivileges(a, b Privileges) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfPrivilegeSpec(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfProcParameter does deep equals between the two objects.
+func EqualsRefOfProcParameter(a, b *ProcParameter) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Mode == b.Mode &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsColumnType(a.Type, b.Type)
+}
+
+// EqualsRefOfPurgeBinaryLogs does deep equals between the two objects.
+func EqualsRefOfPurgeBinaryLogs(a, b *PurgeBinaryLogs) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Master == b.Master &&
+		a.To == b.To &&
+		EqualsExpr(a.Before, b.Before)
+}
+
 // EqualsRefOfReferenceDefinition does deep equals between the two objects.
 func EqualsRefOfReferenceDefinition(a, b *ReferenceDefinition) bool {
 	if a == b {
@@ -2855,6 +4546,66 @@ func EqualsRefOfReferenceDefinition(a, b *ReferenceDefinition) bool {
 		a.OnUpdate == b.OnUpdate
 }
 
+// EqualsRefOfRegexpInstrExpr does deep equals between the two objects.
+func EqualsRefOfRegexpInstrExpr(a, b *RegexpInstrExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.Pattern, b.Pattern) &&
+		EqualsExpr(a.Position, b.Position) &&
+		EqualsExpr(a.Occurrence, b.Occurrence) &&
+		EqualsExpr(a.ReturnOption, b.ReturnOption) &&
+		EqualsExpr(a.MatchType, b.MatchType)
+}
+
+// EqualsRefOfRegexpLikeExpr does deep equals between the two objects.
+func EqualsRefOfRegexpLikeExpr(a, b *RegexpLikeExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.Pattern, b.Pattern) &&
+		EqualsExpr(a.MatchType, b.MatchType)
+}
+
+// EqualsRefOfRegexpReplaceExpr does deep equals between the two objects.
+func EqualsRefOfRegexpReplaceExpr(a, b *RegexpReplaceExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.Pattern, b.Pattern) &&
+		EqualsExpr(a.Repl, b.Repl) &&
+		EqualsExpr(a.Position, b.Position) &&
+		EqualsExpr(a.Occurrence, b.Occurrence) &&
+		EqualsExpr(a.MatchType, b.MatchType)
+}
+
+// EqualsRefOfRegexpSubstrExpr does deep equals between the two objects.
+func EqualsRefOfRegexpSubstrExpr(a, b *RegexpSubstrExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsExpr(a.Pattern, b.Pattern) &&
+		EqualsExpr(a.Position, b.Position) &&
+		EqualsExpr(a.Occurrence, b.Occurrence) &&
+		EqualsExpr(a.MatchType, b.MatchType)
+}
+
 // EqualsRefOfRelease does deep equals between the two objects.
 func EqualsRefOfRelease(a, b *Release) bool {
 	if a == b {
@@ -2900,6 +4651,128 @@ func EqualsRefOfRenameTableName(a, b *RenameTableName) bool {
 	return EqualsTableName(a.Table, b.Table)
 }
 
+// EqualsRefOfRenameUser does deep equals between the two objects.
+func EqualsRefOfRenameUser(a, b *RenameUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSliceOfRefOfRenameUserPair(a.UserPairs, b.UserPairs)
+}
+
+// EqualsRefOfRepairTable does deep equals between the two objects.
+func EqualsRefOfRepairTable(a, b *RepairTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsLocal == b.IsLocal &&
+		EqualsTableNames(a.Tables, b.Tables) &&
+		EqualsSliceOfMaintenanceOption(a.Options, b.Options)
+}
+
+// EqualsRefOfRepeatStmt does deep equals between the two objects.
+func EqualsRefOfRepeatStmt(a, b *RepeatStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label) &&
+		EqualsCompoundStatements(a.Statements, b.Statements) &&
+		EqualsExpr(a.Until, b.Until)
+}
+
+// EqualsRefOfReplicationOption does deep equals between the two objects.
+func EqualsRefOfReplicationOption(a, b *ReplicationOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		EqualsExpr(a.Value, b.Value)
+}
+
+// EqualsReplicationOptions does deep equals between the two objects.
+func EqualsReplicationOptions(a, b ReplicationOptions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfReplicationOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfResetMaster does deep equals between the two objects.
+func EqualsRefOfResetMaster(a, b *ResetMaster) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfLiteral(a.To, b.To)
+}
+
+// EqualsRefOfResetReplica does deep equals between the two objects.
+func EqualsRefOfResetReplica(a, b *ResetReplica) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Slave == b.Slave &&
+		a.All == b.All &&
+		a.Channel == b.Channel
+}
+
+// EqualsRefOfResourceOption does deep equals between the two objects.
+func EqualsRefOfResourceOption(a, b *ResourceOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Count == b.Count &&
+		a.Type == b.Type
+}
+
+// EqualsResourceOptions does deep equals between the two objects.
+func EqualsResourceOptions(a, b ResourceOptions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfResourceOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfReturnStmt does deep equals between the two objects.
+func EqualsRefOfReturnStmt(a, b *ReturnStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfRevertMigration does deep equals between the two objects.
 func EqualsRefOfRevertMigration(a, b *RevertMigration) bool {
 	if a == b {
@@ -2912,6 +4785,34 @@ func EqualsRefOfRevertMigration(a, b *RevertMigration) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfRevoke does deep equals between the two objects.
+func EqualsRefOfRevoke(a, b *Revoke) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		EqualsPrivileges(a.Privileges, b.Privileges) &&
+		EqualsRefOfGrantTarget(a.Target, b.Target) &&
+		EqualsAccounts(a.Roles, b.Roles) &&
+		EqualsRefOfAccount(a.Proxy, b.Proxy) &&
+		EqualsAccounts(a.From, b.From)
+}
+
+// EqualsRefOfRoleSpec does deep equals between the two objects.
+func EqualsRefOfRoleSpec(a, b *RoleSpec) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsAccounts(a.Roles, b.Roles)
+}
+
 // EqualsRefOfRollback does deep equals between the two objects.
 func EqualsRefOfRollback(a, b *Rollback) bool {
 	if a == b {
@@ -2928,6 +4829,30 @@ func EqualsRootNode(a, b RootNode) bool {
 	return EqualsSQLNode(a.SQLNode, b.SQLNode)
 }
 
+// EqualsRefOfRoutineCharacteristic does deep equals between the two objects.
+func EqualsRefOfRoutineCharacteristic(a, b *RoutineCharacteristic) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Comment == b.Comment &&
+		a.Type == b.Type
+}
+
+// EqualsRefOfRowAlias does deep equals between the two objects.
+func EqualsRefOfRowAlias(a, b *RowAlias) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableIdent(a.TableName, b.TableName) &&
+		EqualsColumns(a.Columns, b.Columns)
+}
+
 // EqualsRefOfSRollback does deep equals between the two objects.
 func EqualsRefOfSRollback(a, b *SRollback) bool {
 	if a == b {
@@ -2961,6 +4886,7 @@ func EqualsRefOfSelect(a, b *Select) bool {
 	return a.Distinct == b.Distinct &&
 		a.StraightJoinHint == b.StraightJoinHint &&
 		a.SQLCalcFoundRows == b.SQLCalcFoundRows &&
+		a.WithRollup == b.WithRollup &&
 		EqualsRefOfBool(a.Cache, b.Cache) &&
 		EqualsSliceOfTableExpr(a.From, b.From) &&
 		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
@@ -2969,9 +4895,10 @@ func EqualsRefOfSelect(a, b *Select) bool {
 		EqualsRefOfWith(a.With, b.With) &&
 		EqualsGroupBy(a.GroupBy, b.GroupBy) &&
 		EqualsRefOfWhere(a.Having, b.Having) &&
+		EqualsWindowDefinitions(a.Windows, b.Windows) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
-		a.Lock == b.Lock &&
+		EqualsLockClauses(a.Lock, b.Lock) &&
 		EqualsRefOfSelectInto(a.Into, b.Into)
 }
 
@@ -3002,7 +4929,8 @@ func EqualsRefOfSelectInto(a, b *SelectInto) bool {
 		a.Manifest == b.Manifest &&
 		a.Overwrite == b.Overwrite &&
 		a.Type == b.Type &&
-		EqualsColumnCharset(a.Charset, b.Charset)
+		EqualsColumnCharset(a.Charset, b.Charset) &&
+		EqualsColumns(a.Variables, b.Variables)
 }
 
 // EqualsRefOfSet does deep equals between the two objects.
@@ -3017,6 +4945,18 @@ func EqualsRefOfSet(a, b *Set) bool {
 		EqualsSetExprs(a.Exprs, b.Exprs)
 }
 
+// EqualsRefOfSetDefaultRole does deep equals between the two objects.
+func EqualsRefOfSetDefaultRole(a, b *SetDefaultRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfRoleSpec(a.Roles, b.Roles) &&
+		EqualsAccounts(a.To, b.To)
+}
+
 // EqualsRefOfSetExpr does deep equals between the two objects.
 func EqualsRefOfSetExpr(a, b *SetExpr) bool {
 	if a == b {
@@ -3026,6 +4966,7 @@ func EqualsRefOfSetExpr(a, b *SetExpr) bool {
 		return false
 	}
 	return a.Scope == b.Scope &&
+		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
 		EqualsColIdent(a.Name, b.Name) &&
 		EqualsExpr(a.Expr, b.Expr)
 }
@@ -3043,6 +4984,32 @@ func EqualsSetExprs(a, b SetExprs) bool {
 	return true
 }
 
+// EqualsRefOfSetPassword does deep equals between the two objects.
+func EqualsRefOfSetPassword(a, b *SetPassword) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Password == b.Password &&
+		a.RandomPassword == b.RandomPassword &&
+		a.Replace == b.Replace &&
+		a.RetainCurrent == b.RetainCurrent &&
+		EqualsRefOfAccount(a.User, b.User)
+}
+
+// EqualsRefOfSetRole does deep equals between the two objects.
+func EqualsRefOfSetRole(a, b *SetRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfRoleSpec(a.Roles, b.Roles)
+}
+
 // EqualsRefOfSetTransaction does deep equals between the two objects.
 func EqualsRefOfSetTransaction(a, b *SetTransaction) bool {
 	if a == b {
@@ -3076,11 +5043,29 @@ func EqualsRefOfShowBasic(a, b *ShowBasic) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Full == b.Full &&
+	return a.Extended == b.Extended &&
+		a.Full == b.Full &&
+		a.Channel == b.Channel &&
 		a.Command == b.Command &&
 		EqualsTableName(a.Tbl, b.Tbl) &&
 		EqualsTableIdent(a.DbName, b.DbName) &&
-		EqualsRefOfShowFilter(a.Filter, b.Filter)
+		EqualsRefOfShowFilter(a.Filter, b.Filter) &&
+		EqualsRefOfLimit(a.Limit, b.Limit)
+}
+
+// EqualsRefOfShowBinlogEvents does deep equals between the two objects.
+func EqualsRefOfShowBinlogEvents(a, b *ShowBinlogEvents) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Relaylog == b.Relaylog &&
+		a.LogName == b.LogName &&
+		a.Channel == b.Channel &&
+		EqualsRefOfLiteral(a.Position, b.Position) &&
+		EqualsRefOfLimit(a.Limit, b.Limit)
 }
 
 // EqualsRefOfShowCreate does deep equals between the two objects.
@@ -3091,8 +5076,22 @@ func EqualsRefOfShowCreate(a, b *ShowCreate) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Command == b.Command &&
-		EqualsTableName(a.Op, b.Op)
+	return a.IfNotExists == b.IfNotExists &&
+		a.Command == b.Command &&
+		EqualsTableName(a.Op, b.Op) &&
+		EqualsRefOfAccount(a.User, b.User)
+}
+
+// EqualsRefOfShowEngine does deep equals between the two objects.
+func EqualsRefOfShowEngine(a, b *ShowEngine) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Mutex == b.Mutex &&
+		EqualsColIdent(a.Engine, b.Engine)
 }
 
 // EqualsRefOfShowFilter does deep equals between the two objects.
@@ -3107,6 +5106,18 @@ func EqualsRefOfShowFilter(a, b *ShowFilter) bool {
 		EqualsExpr(a.Filter, b.Filter)
 }
 
+// EqualsRefOfShowGrants does deep equals between the two objects.
+func EqualsRefOfShowGrants(a, b *ShowGrants) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfAccount(a.For, b.For) &&
+		EqualsAccounts(a.Using, b.Using)
+}
+
 // EqualsRefOfShowMigrationLogs does deep equals between the two objects.
 func EqualsRefOfShowMigrationLogs(a, b *ShowMigrationLogs) bool {
 	if a == b {
@@ -3130,6 +5141,19 @@ func EqualsRefOfShowOther(a, b *ShowOther) bool {
 	return a.Command == b.Command
 }
 
+// EqualsRefOfShowProfile does deep equals between the two objects.
+func EqualsRefOfShowProfile(a, b *ShowProfile) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSliceOfString(a.Types, b.Types) &&
+		EqualsRefOfLiteral(a.Query, b.Query) &&
+		EqualsRefOfLimit(a.Limit, b.Limit)
+}
+
 // EqualsRefOfShowThrottledApps does deep equals between the two objects.
 func EqualsRefOfShowThrottledApps(a, b *ShowThrottledApps) bool {
 	if a == b {
@@ -3141,6 +5165,42 @@ func EqualsRefOfShowThrottledApps(a, b *ShowThrottledApps) bool {
 	return EqualsComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfShutdown does deep equals between the two objects.
+func EqualsRefOfShutdown(a, b *Shutdown) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return true
+}
+
+// EqualsRefOfSignal does deep equals between the two objects.
+func EqualsRefOfSignal(a, b *Signal) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Resignal == b.Resignal &&
+		EqualsRefOfHandlerCondition(a.Condition, b.Condition) &&
+		EqualsSliceOfRefOfSignalInfo(a.Info, b.Info)
+}
+
+// EqualsRefOfSignalInfo does deep equals between the two objects.
+func EqualsRefOfSignalInfo(a, b *SignalInfo) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		EqualsExpr(a.Value, b.Value)
+}
+
 // EqualsRefOfStarExpr does deep equals between the two objects.
 func EqualsRefOfStarExpr(a, b *StarExpr) bool {
 	if a == b {
@@ -3152,6 +5212,34 @@ func EqualsRefOfStarExpr(a, b *StarExpr) bool {
 	return EqualsTableName(a.TableName, b.TableName)
 }
 
+// EqualsRefOfStartReplica does deep equals between the two objects.
+func EqualsRefOfStartReplica(a, b *StartReplica) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Slave == b.Slave &&
+		a.Channel == b.Channel &&
+		a.Threads == b.Threads &&
+		EqualsReplicationOptions(a.Until, b.Until) &&
+		EqualsReplicationOptions(a.ConnectionOptions, b.ConnectionOptions)
+}
+
+// EqualsRefOfStopReplica does deep equals between the two objects.
+func EqualsRefOfStopReplica(a, b *StopReplica) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Slave == b.Slave &&
+		a.Channel == b.Channel &&
+		a.Threads == b.Threads
+}
+
 // EqualsRefOfStream does deep equals between the two objects.
 func EqualsRefOfStream(a, b *Stream) bool {
 	if a == b {
@@ -3247,6 +5335,31 @@ func EqualsRefOfSubstrExpr(a, b *SubstrExpr) bool {
 		EqualsExpr(a.To, b.To)
 }
 
+// EqualsRefOfTLSOption does deep equals between the two objects.
+func EqualsRefOfTLSOption(a, b *TLSOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Value == b.Value &&
+		a.Type == b.Type
+}
+
+// EqualsTLSOptions does deep equals between the two objects.
+func EqualsTLSOptions(a, b TLSOptions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfTLSOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsTableExprs does deep equals between the two objects.
 func EqualsTableExprs(a, b TableExprs) bool {
 	if len(a) != len(b) {
@@ -3309,7 +5422,25 @@ func EqualsRefOfTableSpec(a, b *TableSpec) bool {
 		EqualsSliceOfRefOfIndexDefinition(a.Indexes, b.Indexes) &&
 		EqualsSliceOfRefOfConstraintDefinition(a.Constraints, b.Constraints) &&
 		EqualsTableOptions(a.Options, b.Options) &&
-		EqualsRefOfPartitionOption(a.PartitionOption, b.PartitionOption)
+		EqualsRefOfPartitionOption(a.PartitionOption, b.PartitionOption) &&
+		EqualsSliceOfRefOfBadDefinition(a.BadDefinitions, b.BadDefinitions)
+}
+
+// EqualsRefOfTableStatement does deep equals between the two objects.
+func EqualsRefOfTableStatement(a, b *TableStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsRefOfWith(a.With, b.With) &&
+		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
+		EqualsRefOfLimit(a.Limit, b.Limit) &&
+		EqualsLockClauses(a.Lock, b.Lock) &&
+		EqualsRefOfSelectInto(a.Into, b.Into)
 }
 
 // EqualsRefOfTablespaceOperation does deep equals between the two objects.
@@ -3337,6 +5468,18 @@ func EqualsRefOfTimestampFuncExpr(a, b *TimestampFuncExpr) bool {
 		EqualsExpr(a.Expr2, b.Expr2)
 }
 
+// EqualsRefOfTriggerOrder does deep equals between the two objects.
+func EqualsRefOfTriggerOrder(a, b *TriggerOrder) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsColIdent(a.OtherTrigger, b.OtherTrigger)
+}
+
 // EqualsRefOfTrimFuncExpr does deep equals between the two objects.
 func EqualsRefOfTrimFuncExpr(a, b *TrimFuncExpr) bool {
 	if a == b {
@@ -3374,6 +5517,28 @@ func EqualsRefOfUnaryExpr(a, b *UnaryExpr) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfUninstallComponent does deep equals between the two objects.
+func EqualsRefOfUninstallComponent(a, b *UninstallComponent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSliceOfString(a.Components, b.Components)
+}
+
+// EqualsRefOfUninstallPlugin does deep equals between the two objects.
+func EqualsRefOfUninstallPlugin(a, b *UninstallPlugin) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name)
+}
+
 // EqualsRefOfUnion does deep equals between the two objects.
 func EqualsRefOfUnion(a, b *Union) bool {
 	if a == b {
@@ -3385,10 +5550,11 @@ func EqualsRefOfUnion(a, b *Union) bool {
 	return a.Distinct == b.Distinct &&
 		EqualsSelectStatement(a.Left, b.Left) &&
 		EqualsSelectStatement(a.Right, b.Right) &&
+		a.Operator == b.Operator &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfWith(a.With, b.With) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
-		a.Lock == b.Lock &&
+		EqualsLockClauses(a.Lock, b.Lock) &&
 		EqualsRefOfSelectInto(a.Into, b.Into)
 }
 
@@ -3457,6 +5623,31 @@ func EqualsRefOfUse(a, b *Use) bool {
 	return EqualsTableIdent(a.DBName, b.DBName)
 }
 
+// EqualsRefOfUserSpec does deep equals between the two objects.
+func EqualsRefOfUserSpec(a, b *UserSpec) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfAccount(a.Account, b.Account) &&
+		EqualsRefOfAuthOption(a.Auth, b.Auth)
+}
+
+// EqualsUserSpecs does deep equals between the two objects.
+func EqualsUserSpecs(a, b UserSpecs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfUserSpec(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfVStream does deep equals between the two objects.
 func EqualsRefOfVStream(a, b *VStream) bool {
 	if a == b {
@@ -3520,6 +5711,23 @@ func EqualsRefOfValuesFuncExpr(a, b *ValuesFuncExpr) bool {
 	return EqualsRefOfColName(a.Name, b.Name)
 }
 
+// EqualsRefOfValuesStatement does deep equals between the two objects.
+func EqualsRefOfValuesStatement(a, b *ValuesStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsValues(a.Rows, b.Rows) &&
+		EqualsRefOfWith(a.With, b.With) &&
+		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
+		EqualsRefOfLimit(a.Limit, b.Limit) &&
+		EqualsLockClauses(a.Lock, b.Lock) &&
+		EqualsRefOfSelectInto(a.Into, b.Into)
+}
+
 // EqualsVindexParam does deep equals between the two objects.
 func EqualsVindexParam(a, b VindexParam) bool {
 	return a.Val == b.Val &&
@@ -3559,32 +5767,111 @@ func EqualsRefOfWhen(a, b *When) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return EqualsExpr(a.Cond, b.Cond) &&
-		EqualsExpr(a.Val, b.Val)
+	return EqualsExpr(a.Cond, b.Cond) &&
+		EqualsExpr(a.Val, b.Val)
+}
+
+// EqualsRefOfWhere does deep equals between the two objects.
+func EqualsRefOfWhere(a, b *Where) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
+// EqualsRefOfWhileStmt does deep equals between the two objects.
+func EqualsRefOfWhileStmt(a, b *WhileStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Label, b.Label) &&
+		EqualsExpr(a.Cond, b.Cond) &&
+		EqualsCompoundStatements(a.Statements, b.Statements)
+}
+
+// EqualsRefOfWindowDefinition does deep equals between the two objects.
+func EqualsRefOfWindowDefinition(a, b *WindowDefinition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
+// EqualsWindowDefinitions does deep equals between the two objects.
+func EqualsWindowDefinitions(a, b WindowDefinitions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfWindowDefinition(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfWindowSpecification does deep equals between the two objects.
+func EqualsRefOfWindowSpecification(a, b *WindowSpecification) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsExprs(a.PartitionClause, b.PartitionClause) &&
+		EqualsOrderBy(a.OrderClause, b.OrderClause) &&
+		EqualsRefOfFrameClause(a.FrameClause, b.FrameClause)
+}
+
+// EqualsRefOfWith does deep equals between the two objects.
+func EqualsRefOfWith(a, b *With) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Recursive == b.Recursive &&
+		EqualsSliceOfRefOfCommonTableExpr(a.ctes, b.ctes)
 }
 
-// EqualsRefOfWhere does deep equals between the two objects.
-func EqualsRefOfWhere(a, b *Where) bool {
+// EqualsRefOfXATransaction does deep equals between the two objects.
+func EqualsRefOfXATransaction(a, b *XATransaction) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Type == b.Type &&
-		EqualsExpr(a.Expr, b.Expr)
+	return a.ConvertXid == b.ConvertXid &&
+		a.Action == b.Action &&
+		EqualsRefOfXid(a.Xid, b.Xid) &&
+		a.Option == b.Option
 }
 
-// EqualsRefOfWith does deep equals between the two objects.
-func EqualsRefOfWith(a, b *With) bool {
+// EqualsRefOfXid does deep equals between the two objects.
+func EqualsRefOfXid(a, b *Xid) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Recursive == b.Recursive &&
-		EqualsSliceOfRefOfCommonTableExpr(a.ctes, b.ctes)
+	return EqualsRefOfLiteral(a.Gtrid, b.Gtrid) &&
+		EqualsRefOfLiteral(a.Bqual, b.Bqual) &&
+		EqualsRefOfLiteral(a.FormatID, b.FormatID)
 }
 
 // EqualsRefOfXorExpr does deep equals between the two objects.
@@ -3656,6 +5943,12 @@ func EqualsAlterOption(inA, inB AlterOption) bool {
 			return false
 		}
 		return EqualsRefOfAlterIndex(a, b)
+	case *BadDefinition:
+		b, ok := inB.(*BadDefinition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBadDefinition(a, b)
 	case *ChangeColumn:
 		b, ok := inB.(*ChangeColumn)
 		if !ok {
@@ -3749,6 +6042,12 @@ func EqualsCallable(inA, inB Callable) bool {
 		return false
 	}
 	switch a := inA.(type) {
+	case *ArgumentLessWindowExpr:
+		b, ok := inB.(*ArgumentLessWindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfArgumentLessWindowExpr(a, b)
 	case *ConvertExpr:
 		b, ok := inB.(*ConvertExpr)
 		if !ok {
@@ -3773,18 +6072,66 @@ func EqualsCallable(inA, inB Callable) bool {
 			return false
 		}
 		return EqualsRefOfExtractFuncExpr(a, b)
+	case *FirstOrLastValueExpr:
+		b, ok := inB.(*FirstOrLastValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFirstOrLastValueExpr(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfFuncExpr(a, b)
+	case *GeomDistanceExpr:
+		b, ok := inB.(*GeomDistanceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceExpr(a, b)
+	case *GeomDistanceSphereExpr:
+		b, ok := inB.(*GeomDistanceSphereExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceSphereExpr(a, b)
+	case *GeomFormatExpr:
+		b, ok := inB.(*GeomFormatExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFormatExpr(a, b)
+	case *GeomFromTextExpr:
+		b, ok := inB.(*GeomFromTextExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFromTextExpr(a, b)
+	case *GeomRelationExpr:
+		b, ok := inB.(*GeomRelationExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomRelationExpr(a, b)
+	case *GeomSRIDExpr:
+		b, ok := inB.(*GeomSRIDExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomSRIDExpr(a, b)
 	case *GroupConcatExpr:
 		b, ok := inB.(*GroupConcatExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfGroupConcatExpr(a, b)
+	case *GroupingExpr:
+		b, ok := inB.(*GroupingExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGroupingExpr(a, b)
 	case *JSONArrayExpr:
 		b, ok := inB.(*JSONArrayExpr)
 		if !ok {
@@ -3905,6 +6252,12 @@ func EqualsCallable(inA, inB Callable) bool {
 			return false
 		}
 		return EqualsRefOfJSONValueModifierExpr(a, b)
+	case *LagLeadExpr:
+		b, ok := inB.(*LagLeadExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLagLeadExpr(a, b)
 	case *MatchExpr:
 		b, ok := inB.(*MatchExpr)
 		if !ok {
@@ -3917,6 +6270,42 @@ func EqualsCallable(inA, inB Callable) bool {
 			return false
 		}
 		return EqualsRefOfMemberOfExpr(a, b)
+	case *NTHValueExpr:
+		b, ok := inB.(*NTHValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNTHValueExpr(a, b)
+	case *NtileExpr:
+		b, ok := inB.(*NtileExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNtileExpr(a, b)
+	case *RegexpInstrExpr:
+		b, ok := inB.(*RegexpInstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpInstrExpr(a, b)
+	case *RegexpLikeExpr:
+		b, ok := inB.(*RegexpLikeExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpLikeExpr(a, b)
+	case *RegexpReplaceExpr:
+		b, ok := inB.(*RegexpReplaceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpReplaceExpr(a, b)
+	case *RegexpSubstrExpr:
+		b, ok := inB.(*RegexpSubstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpSubstrExpr(a, b)
 	case *SubstrExpr:
 		b, ok := inB.(*SubstrExpr)
 		if !ok {
@@ -4013,6 +6402,123 @@ func EqualsColTuple(inA, inB ColTuple) bool {
 	}
 }
 
+// EqualsCompoundStatement does deep equals between the two objects.
+func EqualsCompoundStatement(inA, inB CompoundStatement) bool {
+	if inA == nil && inB == nil {
+		return true
+	}
+	if inA == nil || inB == nil {
+		return false
+	}
+	switch a := inA.(type) {
+	case *BeginEndBlock:
+		b, ok := inB.(*BeginEndBlock)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBeginEndBlock(a, b)
+	case *CaseStmt:
+		b, ok := inB.(*CaseStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCaseStmt(a, b)
+	case *CloseCursor:
+		b, ok := inB.(*CloseCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCloseCursor(a, b)
+	case *DeclareCondition:
+		b, ok := inB.(*DeclareCondition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCondition(a, b)
+	case *DeclareCursor:
+		b, ok := inB.(*DeclareCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCursor(a, b)
+	case *DeclareHandler:
+		b, ok := inB.(*DeclareHandler)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareHandler(a, b)
+	case *DeclareVar:
+		b, ok := inB.(*DeclareVar)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareVar(a, b)
+	case *FetchCursor:
+		b, ok := inB.(*FetchCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFetchCursor(a, b)
+	case *IfStmt:
+		b, ok := inB.(*IfStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIfStmt(a, b)
+	case *IterateStmt:
+		b, ok := inB.(*IterateStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIterateStmt(a, b)
+	case *LeaveStmt:
+		b, ok := inB.(*LeaveStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLeaveStmt(a, b)
+	case *LoopStmt:
+		b, ok := inB.(*LoopStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLoopStmt(a, b)
+	case *OpenCursor:
+		b, ok := inB.(*OpenCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOpenCursor(a, b)
+	case *RepeatStmt:
+		b, ok := inB.(*RepeatStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRepeatStmt(a, b)
+	case *ReturnStmt:
+		b, ok := inB.(*ReturnStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfReturnStmt(a, b)
+	case *Signal:
+		b, ok := inB.(*Signal)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSignal(a, b)
+	case *WhileStmt:
+		b, ok := inB.(*WhileStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWhileStmt(a, b)
+	default:
+		// this should never happen
+		return false
+	}
+}
+
 // EqualsConstraintInfo does deep equals between the two objects.
 func EqualsConstraintInfo(inA, inB ConstraintInfo) bool {
 	if inA == nil && inB == nil {
@@ -4082,6 +6588,18 @@ func EqualsDDLStatement(inA, inB DDLStatement) bool {
 		return false
 	}
 	switch a := inA.(type) {
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterEvent(a, b)
+	case *AlterRoutine:
+		b, ok := inB.(*AlterRoutine)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterRoutine(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
@@ -4094,18 +6612,48 @@ func EqualsDDLStatement(inA, inB DDLStatement) bool {
 			return false
 		}
 		return EqualsRefOfAlterView(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateProcedure(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateTrigger(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateView(a, b)
+	case *DropProgram:
+		b, ok := inB.(*DropProgram)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropProgram(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
@@ -4184,6 +6732,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return a == b
+	case *ArgumentLessWindowExpr:
+		b, ok := inB.(*ArgumentLessWindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfArgumentLessWindowExpr(a, b)
 	case *BetweenExpr:
 		b, ok := inB.(*BetweenExpr)
 		if !ok {
@@ -4268,18 +6822,66 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfExtractedSubquery(a, b)
+	case *FirstOrLastValueExpr:
+		b, ok := inB.(*FirstOrLastValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFirstOrLastValueExpr(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfFuncExpr(a, b)
+	case *GeomDistanceExpr:
+		b, ok := inB.(*GeomDistanceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceExpr(a, b)
+	case *GeomDistanceSphereExpr:
+		b, ok := inB.(*GeomDistanceSphereExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceSphereExpr(a, b)
+	case *GeomFormatExpr:
+		b, ok := inB.(*GeomFormatExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFormatExpr(a, b)
+	case *GeomFromTextExpr:
+		b, ok := inB.(*GeomFromTextExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFromTextExpr(a, b)
+	case *GeomRelationExpr:
+		b, ok := inB.(*GeomRelationExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomRelationExpr(a, b)
+	case *GeomSRIDExpr:
+		b, ok := inB.(*GeomSRIDExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomSRIDExpr(a, b)
 	case *GroupConcatExpr:
 		b, ok := inB.(*GroupConcatExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfGroupConcatExpr(a, b)
+	case *GroupingExpr:
+		b, ok := inB.(*GroupingExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGroupingExpr(a, b)
 	case *IntervalExpr:
 		b, ok := inB.(*IntervalExpr)
 		if !ok {
@@ -4418,6 +7020,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfJSONValueModifierExpr(a, b)
+	case *LagLeadExpr:
+		b, ok := inB.(*LagLeadExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLagLeadExpr(a, b)
 	case ListArg:
 		b, ok := inB.(ListArg)
 		if !ok {
@@ -4442,12 +7050,24 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfMemberOfExpr(a, b)
+	case *NTHValueExpr:
+		b, ok := inB.(*NTHValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNTHValueExpr(a, b)
 	case *NotExpr:
 		b, ok := inB.(*NotExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfNotExpr(a, b)
+	case *NtileExpr:
+		b, ok := inB.(*NtileExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNtileExpr(a, b)
 	case *NullVal:
 		b, ok := inB.(*NullVal)
 		if !ok {
@@ -4466,6 +7086,30 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfOrExpr(a, b)
+	case *RegexpInstrExpr:
+		b, ok := inB.(*RegexpInstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpInstrExpr(a, b)
+	case *RegexpLikeExpr:
+		b, ok := inB.(*RegexpLikeExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpLikeExpr(a, b)
+	case *RegexpReplaceExpr:
+		b, ok := inB.(*RegexpReplaceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpReplaceExpr(a, b)
+	case *RegexpSubstrExpr:
+		b, ok := inB.(*RegexpSubstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpSubstrExpr(a, b)
 	case *Subquery:
 		b, ok := inB.(*Subquery)
 		if !ok {
@@ -4541,6 +7185,12 @@ func EqualsInsertRows(inA, inB InsertRows) bool {
 			return false
 		}
 		return EqualsRefOfSelect(a, b)
+	case *TableStatement:
+		b, ok := inB.(*TableStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTableStatement(a, b)
 	case *Union:
 		b, ok := inB.(*Union)
 		if !ok {
@@ -4553,6 +7203,12 @@ func EqualsInsertRows(inA, inB InsertRows) bool {
 			return false
 		}
 		return EqualsValues(a, b)
+	case *ValuesStatement:
+		b, ok := inB.(*ValuesStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfValuesStatement(a, b)
 	default:
 		// this should never happen
 		return false
@@ -4580,6 +7236,12 @@ func EqualsJSONPathParam(inA, inB JSONPathParam) bool {
 			return false
 		}
 		return a == b
+	case *ArgumentLessWindowExpr:
+		b, ok := inB.(*ArgumentLessWindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfArgumentLessWindowExpr(a, b)
 	case *BetweenExpr:
 		b, ok := inB.(*BetweenExpr)
 		if !ok {
@@ -4664,18 +7326,66 @@ func EqualsJSONPathParam(inA, inB JSONPathParam) bool {
 			return false
 		}
 		return EqualsRefOfExtractedSubquery(a, b)
+	case *FirstOrLastValueExpr:
+		b, ok := inB.(*FirstOrLastValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFirstOrLastValueExpr(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfFuncExpr(a, b)
+	case *GeomDistanceExpr:
+		b, ok := inB.(*GeomDistanceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceExpr(a, b)
+	case *GeomDistanceSphereExpr:
+		b, ok := inB.(*GeomDistanceSphereExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomDistanceSphereExpr(a, b)
+	case *GeomFormatExpr:
+		b, ok := inB.(*GeomFormatExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFormatExpr(a, b)
+	case *GeomFromTextExpr:
+		b, ok := inB.(*GeomFromTextExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomFromTextExpr(a, b)
+	case *GeomRelationExpr:
+		b, ok := inB.(*GeomRelationExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomRelationExpr(a, b)
+	case *GeomSRIDExpr:
+		b, ok := inB.(*GeomSRIDExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGeomSRIDExpr(a, b)
 	case *GroupConcatExpr:
 		b, ok := inB.(*GroupConcatExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfGroupConcatExpr(a, b)
+	case *GroupingExpr:
+		b, ok := inB.(*GroupingExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGroupingExpr(a, b)
 	case *IntervalExpr:
 		b, ok := inB.(*IntervalExpr)
 		if !ok {
@@ -4814,6 +7524,12 @@ func EqualsJSONPathParam(inA, inB JSONPathParam) bool {
 			return false
 		}
 		return EqualsRefOfJSONValueModifierExpr(a, b)
+	case *LagLeadExpr:
+		b, ok := inB.(*LagLeadExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLagLeadExpr(a, b)
 	case ListArg:
 		b, ok := inB.(ListArg)
 		if !ok {
@@ -4838,12 +7554,24 @@ func EqualsJSONPathParam(inA, inB JSONPathParam) bool {
 			return false
 		}
 		return EqualsRefOfMemberOfExpr(a, b)
+	case *NTHValueExpr:
+		b, ok := inB.(*NTHValueExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNTHValueExpr(a, b)
 	case *NotExpr:
 		b, ok := inB.(*NotExpr)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfNotExpr(a, b)
+	case *NtileExpr:
+		b, ok := inB.(*NtileExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNtileExpr(a, b)
 	case *NullVal:
 		b, ok := inB.(*NullVal)
 		if !ok {
@@ -4855,13 +7583,37 @@ func EqualsJSONPathParam(inA, inB JSONPathParam) bool {
 		if !ok {
 			return false
 		}
-		return a == b
-	case *OrExpr:
-		b, ok := inB.(*OrExpr)
+		return a == b
+	case *OrExpr:
+		b, ok := inB.(*OrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOrExpr(a, b)
+	case *RegexpInstrExpr:
+		b, ok := inB.(*RegexpInstrExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpInstrExpr(a, b)
+	case *RegexpLikeExpr:
+		b, ok := inB.(*RegexpLikeExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpLikeExpr(a, b)
+	case *RegexpReplaceExpr:
+		b, ok := inB.(*RegexpReplaceExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRegexpReplaceExpr(a, b)
+	case *RegexpSubstrExpr:
+		b, ok := inB.(*RegexpSubstrExpr)
 		if !ok {
 			return false
 		}
-		return EqualsRefOfOrExpr(a, b)
+		return EqualsRefOfRegexpSubstrExpr(a, b)
 	case *Subquery:
 		b, ok := inB.(*Subquery)
 		if !ok {
@@ -4970,12 +7722,24 @@ func EqualsSelectStatement(inA, inB SelectStatement) bool {
 			return false
 		}
 		return EqualsRefOfSelect(a, b)
+	case *TableStatement:
+		b, ok := inB.(*TableStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTableStatement(a, b)
 	case *Union:
 		b, ok := inB.(*Union)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfUnion(a, b)
+	case *ValuesStatement:
+		b, ok := inB.(*ValuesStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfValuesStatement(a, b)
 	default:
 		// this should never happen
 		return false
@@ -4997,18 +7761,42 @@ func EqualsShowInternal(inA, inB ShowInternal) bool {
 			return false
 		}
 		return EqualsRefOfShowBasic(a, b)
+	case *ShowBinlogEvents:
+		b, ok := inB.(*ShowBinlogEvents)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowBinlogEvents(a, b)
 	case *ShowCreate:
 		b, ok := inB.(*ShowCreate)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfShowCreate(a, b)
+	case *ShowEngine:
+		b, ok := inB.(*ShowEngine)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowEngine(a, b)
+	case *ShowGrants:
+		b, ok := inB.(*ShowGrants)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowGrants(a, b)
 	case *ShowOther:
 		b, ok := inB.(*ShowOther)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfShowOther(a, b)
+	case *ShowProfile:
+		b, ok := inB.(*ShowProfile)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShowProfile(a, b)
 	default:
 		// this should never happen
 		return false
@@ -5057,18 +7845,36 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfAlterDatabase(a, b)
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterEvent(a, b)
 	case *AlterMigration:
 		b, ok := inB.(*AlterMigration)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAlterMigration(a, b)
+	case *AlterRoutine:
+		b, ok := inB.(*AlterRoutine)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterRoutine(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAlterTable(a, b)
+	case *AlterUser:
+		b, ok := inB.(*AlterUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterUser(a, b)
 	case *AlterView:
 		b, ok := inB.(*AlterView)
 		if !ok {
@@ -5081,18 +7887,66 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfAlterVschema(a, b)
+	case *AnalyzeTable:
+		b, ok := inB.(*AnalyzeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAnalyzeTable(a, b)
+	case *BadStmt:
+		b, ok := inB.(*BadStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBadStmt(a, b)
 	case *Begin:
 		b, ok := inB.(*Begin)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfBegin(a, b)
+	case *BeginEndBlock:
+		b, ok := inB.(*BeginEndBlock)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfBeginEndBlock(a, b)
 	case *CallProc:
 		b, ok := inB.(*CallProc)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCallProc(a, b)
+	case *CaseStmt:
+		b, ok := inB.(*CaseStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCaseStmt(a, b)
+	case *ChangeReplicationSource:
+		b, ok := inB.(*ChangeReplicationSource)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfChangeReplicationSource(a, b)
+	case *CheckTable:
+		b, ok := inB.(*CheckTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCheckTable(a, b)
+	case *ChecksumTable:
+		b, ok := inB.(*ChecksumTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfChecksumTable(a, b)
+	case *CloseCursor:
+		b, ok := inB.(*CloseCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCloseCursor(a, b)
 	case *Commit:
 		b, ok := inB.(*Commit)
 		if !ok {
@@ -5105,12 +7959,48 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfCreateDatabase(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateProcedure(a, b)
+	case *CreateRole:
+		b, ok := inB.(*CreateRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateRole(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateTrigger(a, b)
+	case *CreateUser:
+		b, ok := inB.(*CreateUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateUser(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
@@ -5123,24 +8013,72 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfDeallocateStmt(a, b)
+	case *DeclareCondition:
+		b, ok := inB.(*DeclareCondition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCondition(a, b)
+	case *DeclareCursor:
+		b, ok := inB.(*DeclareCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareCursor(a, b)
+	case *DeclareHandler:
+		b, ok := inB.(*DeclareHandler)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareHandler(a, b)
+	case *DeclareVar:
+		b, ok := inB.(*DeclareVar)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDeclareVar(a, b)
 	case *Delete:
 		b, ok := inB.(*Delete)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfDelete(a, b)
+	case *DoStmt:
+		b, ok := inB.(*DoStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDoStmt(a, b)
 	case *DropDatabase:
 		b, ok := inB.(*DropDatabase)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfDropDatabase(a, b)
+	case *DropProgram:
+		b, ok := inB.(*DropProgram)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropProgram(a, b)
+	case *DropRole:
+		b, ok := inB.(*DropRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropRole(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfDropTable(a, b)
+	case *DropUser:
+		b, ok := inB.(*DropUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropUser(a, b)
 	case *DropView:
 		b, ok := inB.(*DropView)
 		if !ok {
@@ -5165,18 +8103,66 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfExplainTab(a, b)
+	case *FetchCursor:
+		b, ok := inB.(*FetchCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFetchCursor(a, b)
 	case *Flush:
 		b, ok := inB.(*Flush)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfFlush(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrant(a, b)
+	case *IfStmt:
+		b, ok := inB.(*IfStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIfStmt(a, b)
 	case *Insert:
 		b, ok := inB.(*Insert)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfInsert(a, b)
+	case *InstallComponent:
+		b, ok := inB.(*InstallComponent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfInstallComponent(a, b)
+	case *InstallPlugin:
+		b, ok := inB.(*InstallPlugin)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfInstallPlugin(a, b)
+	case *IterateStmt:
+		b, ok := inB.(*IterateStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIterateStmt(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
+	case *LeaveStmt:
+		b, ok := inB.(*LeaveStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLeaveStmt(a, b)
 	case *Load:
 		b, ok := inB.(*Load)
 		if !ok {
@@ -5189,6 +8175,24 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfLockTables(a, b)
+	case *LoopStmt:
+		b, ok := inB.(*LoopStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfLoopStmt(a, b)
+	case *OpenCursor:
+		b, ok := inB.(*OpenCursor)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOpenCursor(a, b)
+	case *OptimizeTable:
+		b, ok := inB.(*OptimizeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOptimizeTable(a, b)
 	case *OtherAdmin:
 		b, ok := inB.(*OtherAdmin)
 		if !ok {
@@ -5207,6 +8211,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfPrepareStmt(a, b)
+	case *PurgeBinaryLogs:
+		b, ok := inB.(*PurgeBinaryLogs)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPurgeBinaryLogs(a, b)
 	case *Release:
 		b, ok := inB.(*Release)
 		if !ok {
@@ -5219,12 +8229,54 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfRenameTable(a, b)
+	case *RenameUser:
+		b, ok := inB.(*RenameUser)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRenameUser(a, b)
+	case *RepairTable:
+		b, ok := inB.(*RepairTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRepairTable(a, b)
+	case *RepeatStmt:
+		b, ok := inB.(*RepeatStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRepeatStmt(a, b)
+	case *ResetMaster:
+		b, ok := inB.(*ResetMaster)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfResetMaster(a, b)
+	case *ResetReplica:
+		b, ok := inB.(*ResetReplica)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfResetReplica(a, b)
+	case *ReturnStmt:
+		b, ok := inB.(*ReturnStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfReturnStmt(a, b)
 	case *RevertMigration:
 		b, ok := inB.(*RevertMigration)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRevoke(a, b)
 	case *Rollback:
 		b, ok := inB.(*Rollback)
 		if !ok {
@@ -5255,6 +8307,24 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfSet(a, b)
+	case *SetDefaultRole:
+		b, ok := inB.(*SetDefaultRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetDefaultRole(a, b)
+	case *SetPassword:
+		b, ok := inB.(*SetPassword)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetPassword(a, b)
+	case *SetRole:
+		b, ok := inB.(*SetRole)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetRole(a, b)
 	case *SetTransaction:
 		b, ok := inB.(*SetTransaction)
 		if !ok {
@@ -5279,18 +8349,60 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfShowThrottledApps(a, b)
+	case *Shutdown:
+		b, ok := inB.(*Shutdown)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfShutdown(a, b)
+	case *Signal:
+		b, ok := inB.(*Signal)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSignal(a, b)
+	case *StartReplica:
+		b, ok := inB.(*StartReplica)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfStartReplica(a, b)
+	case *StopReplica:
+		b, ok := inB.(*StopReplica)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfStopReplica(a, b)
 	case *Stream:
 		b, ok := inB.(*Stream)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfStream(a, b)
+	case *TableStatement:
+		b, ok := inB.(*TableStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTableStatement(a, b)
 	case *TruncateTable:
 		b, ok := inB.(*TruncateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfTruncateTable(a, b)
+	case *UninstallComponent:
+		b, ok := inB.(*UninstallComponent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUninstallComponent(a, b)
+	case *UninstallPlugin:
+		b, ok := inB.(*UninstallPlugin)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUninstallPlugin(a, b)
 	case *Union:
 		b, ok := inB.(*Union)
 		if !ok {
@@ -5321,6 +8433,24 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfVStream(a, b)
+	case *ValuesStatement:
+		b, ok := inB.(*ValuesStatement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfValuesStatement(a, b)
+	case *WhileStmt:
+		b, ok := inB.(*WhileStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWhileStmt(a, b)
+	case *XATransaction:
+		b, ok := inB.(*XATransaction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfXATransaction(a, b)
 	default:
 		// this should never happen
 		return false
@@ -5366,6 +8496,51 @@ func EqualsTableExpr(inA, inB TableExpr) bool {
 	}
 }
 
+// EqualsTableMaintenanceStatement does deep equals between the two objects.
+func EqualsTableMaintenanceStatement(inA, inB TableMaintenanceStatement) bool {
+	if inA == nil && inB == nil {
+		return true
+	}
+	if inA == nil || inB == nil {
+		return false
+	}
+	switch a := inA.(type) {
+	case *AnalyzeTable:
+		b, ok := inB.(*AnalyzeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAnalyzeTable(a, b)
+	case *CheckTable:
+		b, ok := inB.(*CheckTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCheckTable(a, b)
+	case *ChecksumTable:
+		b, ok := inB.(*ChecksumTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfChecksumTable(a, b)
+	case *OptimizeTable:
+		b, ok := inB.(*OptimizeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOptimizeTable(a, b)
+	case *RepairTable:
+		b, ok := inB.(*RepairTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRepairTable(a, b)
+	default:
+		// this should never happen
+		return false
+	}
+}
+
 // EqualsSliceOfRefOfColumnDefinition does deep equals between the two objects.
 func EqualsSliceOfRefOfColumnDefinition(a, b []*ColumnDefinition) bool {
 	if len(a) != len(b) {
@@ -5390,52 +8565,91 @@ func EqualsRefOfBool(a, b *bool) bool {
 	return *a == *b
 }
 
-// EqualsSliceOfDatabaseOption does deep equals between the two objects.
-func EqualsSliceOfDatabaseOption(a, b []DatabaseOption) bool {
+// EqualsSliceOfDatabaseOption does deep equals between the two objects.
+func EqualsSliceOfDatabaseOption(a, b []DatabaseOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsDatabaseOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfRoutineCharacteristic does deep equals between the two objects.
+func EqualsSliceOfRefOfRoutineCharacteristic(a, b []*RoutineCharacteristic) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfRoutineCharacteristic(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfAlterOption does deep equals between the two objects.
+func EqualsSliceOfAlterOption(a, b []AlterOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsAlterOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfColIdent does deep equals between the two objects.
+func EqualsSliceOfColIdent(a, b []ColIdent) bool {
 	if len(a) != len(b) {
 		return false
 	}
 	for i := 0; i < len(a); i++ {
-		if !EqualsDatabaseOption(a[i], b[i]) {
+		if !EqualsColIdent(a[i], b[i]) {
 			return false
 		}
 	}
 	return true
 }
 
-// EqualsSliceOfAlterOption does deep equals between the two objects.
-func EqualsSliceOfAlterOption(a, b []AlterOption) bool {
+// EqualsSliceOfRefOfWhen does deep equals between the two objects.
+func EqualsSliceOfRefOfWhen(a, b []*When) bool {
 	if len(a) != len(b) {
 		return false
 	}
 	for i := 0; i < len(a); i++ {
-		if !EqualsAlterOption(a[i], b[i]) {
+		if !EqualsRefOfWhen(a[i], b[i]) {
 			return false
 		}
 	}
 	return true
 }
 
-// EqualsSliceOfColIdent does deep equals between the two objects.
-func EqualsSliceOfColIdent(a, b []ColIdent) bool {
+// EqualsSliceOfRefOfCaseStmtWhen does deep equals between the two objects.
+func EqualsSliceOfRefOfCaseStmtWhen(a, b []*CaseStmtWhen) bool {
 	if len(a) != len(b) {
 		return false
 	}
 	for i := 0; i < len(a); i++ {
-		if !EqualsColIdent(a[i], b[i]) {
+		if !EqualsRefOfCaseStmtWhen(a[i], b[i]) {
 			return false
 		}
 	}
 	return true
 }
 
-// EqualsSliceOfRefOfWhen does deep equals between the two objects.
-func EqualsSliceOfRefOfWhen(a, b []*When) bool {
+// EqualsSliceOfMaintenanceOption does deep equals between the two objects.
+func EqualsSliceOfMaintenanceOption(a, b []MaintenanceOption) bool {
 	if len(a) != len(b) {
 		return false
 	}
 	for i := 0; i < len(a); i++ {
-		if !EqualsRefOfWhen(a[i], b[i]) {
+		if a[i] != b[i] {
 			return false
 		}
 	}
@@ -5511,6 +8725,45 @@ func EqualsSliceOfString(a, b []string) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfProcParameter does deep equals between the two objects.
+func EqualsSliceOfRefOfProcParameter(a, b []*ProcParameter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfProcParameter(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfHandlerCondition does deep equals between the two objects.
+func EqualsSliceOfRefOfHandlerCondition(a, b []*HandlerCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfHandlerCondition(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfElseIf does deep equals between the two objects.
+func EqualsSliceOfRefOfElseIf(a, b []*ElseIf) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfElseIf(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsSliceOfRefOfIndexColumn does deep equals between the two objects.
 func EqualsSliceOfRefOfIndexColumn(a, b []*IndexColumn) bool {
 	if len(a) != len(b) {
@@ -5653,6 +8906,32 @@ func EqualsComments(a, b Comments) bool {
 	return true
 }
 
+// EqualsOptimizerHints does deep equals between the two objects.
+func EqualsOptimizerHints(a, b OptimizerHints) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsOptimizerHint(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfOptimizerHintError does deep equals between the two objects.
+func EqualsSliceOfRefOfOptimizerHintError(a, b []*OptimizerHintError) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfOptimizerHintError(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfInt does deep equals between the two objects.
 func EqualsRefOfInt(a, b *int) bool {
 	if a == b {
@@ -5690,6 +8969,19 @@ func EqualsSliceOfRefOfRenameTablePair(a, b []*RenameTablePair) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfRenameUserPair does deep equals between the two objects.
+func EqualsSliceOfRefOfRenameUserPair(a, b []*RenameUserPair) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfRenameUserPair(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfRootNode does deep equals between the two objects.
 func EqualsRefOfRootNode(a, b *RootNode) bool {
 	if a == b {
@@ -5727,6 +9019,19 @@ func EqualsSliceOfCharacteristic(a, b []Characteristic) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfSignalInfo does deep equals between the two objects.
+func EqualsSliceOfRefOfSignalInfo(a, b []*SignalInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfSignalInfo(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfTableIdent does deep equals between the two objects.
 func EqualsRefOfTableIdent(a, b *TableIdent) bool {
 	if a == b {
@@ -5791,6 +9096,19 @@ func EqualsSliceOfRefOfConstraintDefinition(a, b []*ConstraintDefinition) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfBadDefinition does deep equals between the two objects.
+func EqualsSliceOfRefOfBadDefinition(a, b []*BadDefinition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfBadDefinition(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfVindexParam does deep equals between the two objects.
 func EqualsRefOfVindexParam(a, b *VindexParam) bool {
 	if a == b {
@@ -5887,6 +9205,87 @@ func EqualsRefOfTableAndLockType(a, b *TableAndLockType) bool {
 		a.Lock == b.Lock
 }
 
+// EqualsOptimizerHint does deep equals between the two objects.
+func EqualsOptimizerHint(inA, inB OptimizerHint) bool {
+	if inA == nil && inB == nil {
+		return true
+	}
+	if inA == nil || inB == nil {
+		return false
+	}
+	switch a := inA.(type) {
+	case *IndexLevelHint:
+		b, ok := inB.(*IndexLevelHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIndexLevelHint(a, b)
+	case *JoinOrderHint:
+		b, ok := inB.(*JoinOrderHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfJoinOrderHint(a, b)
+	case *MaxExecutionTimeHint:
+		b, ok := inB.(*MaxExecutionTimeHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfMaxExecutionTimeHint(a, b)
+	case *QBNameHint:
+		b, ok := inB.(*QBNameHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfQBNameHint(a, b)
+	case *ResourceGroupHint:
+		b, ok := inB.(*ResourceGroupHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfResourceGroupHint(a, b)
+	case *SetVarHint:
+		b, ok := inB.(*SetVarHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSetVarHint(a, b)
+	case *SubqueryHint:
+		b, ok := inB.(*SubqueryHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfSubqueryHint(a, b)
+	case *TableLevelHint:
+		b, ok := inB.(*TableLevelHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTableLevelHint(a, b)
+	case *UnknownHint:
+		b, ok := inB.(*UnknownHint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfUnknownHint(a, b)
+	default:
+		// this should never happen
+		return false
+	}
+}
+
+// EqualsRefOfOptimizerHintError does deep equals between the two objects.
+func EqualsRefOfOptimizerHintError(a, b *OptimizerHintError) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Message == b.Message &&
+		a.Offset == b.Offset
+}
+
 // EqualsRefOfRenameTablePair does deep equals between the two objects.
 func EqualsRefOfRenameTablePair(a, b *RenameTablePair) bool {
 	if a == b {
@@ -5899,6 +9298,18 @@ func EqualsRefOfRenameTablePair(a, b *RenameTablePair) bool {
 		EqualsTableName(a.ToTable, b.ToTable)
 }
 
+// EqualsRefOfRenameUserPair does deep equals between the two objects.
+func EqualsRefOfRenameUserPair(a, b *RenameUserPair) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfAccount(a.FromUser, b.FromUser) &&
+		EqualsRefOfAccount(a.ToUser, b.ToUser)
+}
+
 // EqualsRefOfDatabaseOption does deep equals between the two objects.
 func EqualsRefOfDatabaseOption(a, b *DatabaseOption) bool {
 	if a == b {
@@ -5911,3 +9322,144 @@ func EqualsRefOfDatabaseOption(a, b *DatabaseOption) bool {
 		a.Value == b.Value &&
 		a.Type == b.Type
 }
+
+// EqualsRefOfIndexLevelHint does deep equals between the two objects.
+func EqualsRefOfIndexLevelHint(a, b *IndexLevelHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock &&
+		EqualsHintTable(a.Table, b.Table) &&
+		EqualsSliceOfString(a.Indexes, b.Indexes)
+}
+
+// EqualsRefOfJoinOrderHint does deep equals between the two objects.
+func EqualsRefOfJoinOrderHint(a, b *JoinOrderHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock &&
+		EqualsSliceOfHintTable(a.Tables, b.Tables)
+}
+
+// EqualsRefOfMaxExecutionTimeHint does deep equals between the two objects.
+func EqualsRefOfMaxExecutionTimeHint(a, b *MaxExecutionTimeHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Milliseconds == b.Milliseconds
+}
+
+// EqualsRefOfQBNameHint does deep equals between the two objects.
+func EqualsRefOfQBNameHint(a, b *QBNameHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name
+}
+
+// EqualsRefOfResourceGroupHint does deep equals between the two objects.
+func EqualsRefOfResourceGroupHint(a, b *ResourceGroupHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name
+}
+
+// EqualsRefOfSetVarHint does deep equals between the two objects.
+func EqualsRefOfSetVarHint(a, b *SetVarHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.Value == b.Value
+}
+
+// EqualsRefOfSubqueryHint does deep equals between the two objects.
+func EqualsRefOfSubqueryHint(a, b *SubqueryHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock &&
+		EqualsSliceOfString(a.Strategies, b.Strategies)
+}
+
+// EqualsRefOfTableLevelHint does deep equals between the two objects.
+func EqualsRefOfTableLevelHint(a, b *TableLevelHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock &&
+		EqualsSliceOfHintTable(a.Tables, b.Tables)
+}
+
+// EqualsRefOfUnknownHint does deep equals between the two objects.
+func EqualsRefOfUnknownHint(a, b *UnknownHint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.Args == b.Args
+}
+
+// EqualsHintTable does deep equals between the two objects.
+func EqualsHintTable(a, b HintTable) bool {
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock
+}
+
+// EqualsSliceOfHintTable does deep equals between the two objects.
+func EqualsSliceOfHintTable(a, b []HintTable) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsHintTable(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfHintTable does deep equals between the two objects.
+func EqualsRefOfHintTable(a, b *HintTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.QueryBlock == b.QueryBlock
+}
@@ -54,35 +54,79 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		prefix = ", "
 	}
 
-	buf.astPrintf(node, "%v%v%v%v%v%s%v",
-		node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
-		node.Limit, node.Lock.ToString(), node.Into)
+	buf.astPrintf(node, "%v%v", node.Where, node.GroupBy)
+	if node.WithRollup {
+		buf.literal(WithRollupStr)
+	}
+	buf.astPrintf(node, "%v%v%v%v%v%v",
+		node.Having, node.Windows, node.OrderBy,
+		node.Limit, node.Lock, node.Into)
 }
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	if requiresParen(node.Left) {
+	if node.With != nil {
+		buf.astPrintf(node, "%v", node.With)
+	}
+	if requiresParen(node.Left) || setOpRequiresParen(node.Operator, node.Left, false) {
 		buf.astPrintf(node, "(%v)", node.Left)
 	} else {
 		buf.astPrintf(node, "%v", node.Left)
 	}
 
 	buf.WriteByte(' ')
-	if node.Distinct {
-		buf.literal(UnionStr)
-	} else {
-		buf.literal(UnionAllStr)
+	buf.literal(node.Operator.ToString())
+	if !node.Distinct {
+		buf.literal(" all")
 	}
 	buf.WriteByte(' ')
 
-	if requiresParen(node.Right) {
+	if requiresParen(node.Right) || setOpRequiresParen(node.Operator, node.Right, true) {
 		buf.astPrintf(node, "(%v)", node.Right)
 	} else {
 		buf.astPrintf(node, "%v", node.Right)
 	}
 
-	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
+	buf.astPrintf(node, "%v%v%v%v", node.OrderBy, node.Limit, node.Lock, node.Into)
+}
+
+// Format formats the node.
+func (node *ValuesStatement) Format(buf *TrackedBuffer) {
+	if node.With != nil {
+		buf.astPrintf(node, "%v", node.With)
+	}
+	buf.astPrintf(node, "values %v", node.Comments)
+	prefix := ""
+	for _, row := range node.Rows {
+		buf.astPrintf(node, "%srow%v", prefix, row)
+		prefix = ", "
+	}
+	buf.astPrintf(node, "%v%v%v%v", node.OrderBy, node.Limit, node.Lock, node.Into)
+}
+
+// Format formats the node.
+func (node *TableStatement) Format(buf *TrackedBuffer) {
+	if node.With != nil {
+		buf.astPrintf(node, "%v", node.With)
+	}
+	buf.astPrintf(node, "table %v%v", node.Comments, node.Table)
+	buf.astPrintf(node, "%v%v%v%v", node.OrderBy, node.Limit, node.Lock, node.Into)
+}
+
+// Format formats the node.
+func (node LockClauses) Format(buf *TrackedBuffer) {
+	for _, n := range node {
+		buf.astPrintf(node, "%v", n)
+	}
+}
+
+// Format formats the node.
+func (node *LockClause) Format(buf *TrackedBuffer) {
+	buf.literal(node.Lock.ToString())
+	if len(node.Tables) > 0 {
+		buf.astPrintf(node, " of %v", node.Tables)
+	}
+	buf.literal(node.Wait.ToString())
 }
 
 // Format formats the node.
@@ -101,24 +145,32 @@ func (node *Stream) Format(buf *TrackedBuffer) {
 func (node *Insert) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case InsertAct:
-		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v",
+		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v%v",
 			InsertStr,
 			node.Comments, node.Ignore.ToString(),
-			node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup)
+			node.Table, node.Partitions, node.Columns, node.Rows, node.RowAlias, node.OnDup)
 	case ReplaceAct:
-		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v",
+		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v%v",
 			ReplaceStr,
 			node.Comments, node.Ignore.ToString(),
-			node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup)
+			node.Table, node.Partitions, node.Columns, node.Rows, node.RowAlias, node.OnDup)
 	default:
-		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v",
+		buf.astPrintf(node, "%s %v%sinto %v%v%v %v%v%v",
 			"Unkown Insert Action",
 			node.Comments, node.Ignore.ToString(),
-			node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup)
+			node.Table, node.Partitions, node.Columns, node.Rows, node.RowAlias, node.OnDup)
 	}
 
 }
 
+// Format formats the node.
+func (node *RowAlias) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.astPrintf(node, " as %v%v", node.TableName, node.Columns)
+}
+
 // Format formats the node.
 func (node *With) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "with ")
@@ -610,18 +662,28 @@ func (node *SubPartition) Format(buf *TrackedBuffer) {
 // Format formats the node.
 func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	buf.astPrintf(ts, "(\n")
-	for i, col := range ts.Columns {
-		if i == 0 {
-			buf.astPrintf(ts, "\t%v", col)
-		} else {
-			buf.astPrintf(ts, ",\n\t%v", col)
+	// The definitions with a syntax error go where they were in the SQL,
+	// between the columns, indexes and constraints.
+	bad := ts.BadDefinitions
+	cols, idxs, consts := ts.Columns, ts.Indexes, ts.Constraints
+	for i := 0; i < ts.definitionCount(); i++ {
+		if i > 0 {
+			buf.WriteString(",\n")
+		}
+		switch {
+		case len(bad) > 0 && (bad[0].Index <= i || len(cols)+len(idxs)+len(consts) == 0):
+			buf.astPrintf(ts, "\t%v", bad[0])
+			bad = bad[1:]
+		case len(cols) > 0:
+			buf.astPrintf(ts, "\t%v", cols[0])
+			cols = cols[1:]
+		case len(idxs) > 0:
+			buf.astPrintf(ts, "\t%v", idxs[0])
+			idxs = idxs[1:]
+		default:
+			buf.astPrintf(ts, "\t%v", consts[0])
+			consts = consts[1:]
 		}
-	}
-	for _, idx := range ts.Indexes {
-		buf.astPrintf(ts, ",\n\t%v", idx)
-	}
-	for _, c := range ts.Constraints {
-		buf.astPrintf(ts, ",\n\t%v", c)
 	}
 
 	buf.astPrintf(ts, "\n)")
@@ -654,7 +716,12 @@ func (col *ColumnDefinition) Format(buf *TrackedBuffer) {
 
 // Format returns a canonical string representation of the type and all relevant options
 func (ct *ColumnType) Format(buf *TrackedBuffer) {
-	buf.astPrintf(ct, "%#s", ct.Type)
+	if buf.mode&SQLModeRealAsFloat != 0 && strings.EqualFold(ct.Type, "real") {
+		// REAL means FLOAT in this mode
+		buf.literal("double")
+	} else {
+		buf.astPrintf(ct, "%#s", ct.Type)
+	}
 
 	if ct.Length != nil && ct.Scale != nil {
 		buf.astPrintf(ct, "(%v,%v)", ct.Length, ct.Scale)
@@ -669,7 +736,7 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 			if i > 0 {
 				buf.WriteString(", ")
 			}
-			buf.astPrintf(ct, "%#s", enum)
+			buf.astPrintf(ct, "%#s", buf.requoteSQLString(enum))
 		}
 		buf.WriteString(")")
 	}
@@ -922,8 +989,7 @@ func (node *ShowFilter) Format(buf *TrackedBuffer) {
 		return
 	}
 	if node.Like != "" {
-		buf.astPrintf(node, " like ")
-		sqltypes.BufEncodeStringSQL(buf.Builder, node.Like)
+		buf.astPrintf(node, " like %#s", buf.encodeSQLString(node.Like))
 	} else {
 		buf.astPrintf(node, " where %v", node.Filter)
 	}
@@ -968,6 +1034,170 @@ func (node *Release) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "release savepoint %v", node.Name)
 }
 
+// Format formats the node.
+func (node *XATransaction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "xa %s", node.Action.ToString())
+	if node.Xid != nil {
+		buf.astPrintf(node, " %v", node.Xid)
+	}
+	buf.literal(node.Option.ToString())
+	if node.ConvertXid {
+		buf.literal(" convert xid")
+	}
+}
+
+// Format formats the node.
+func (node *Xid) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v", node.Gtrid)
+	if node.Bqual != nil {
+		buf.astPrintf(node, ", %v", node.Bqual)
+	}
+	if node.FormatID != nil {
+		buf.astPrintf(node, ", %v", node.FormatID)
+	}
+}
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "kill %s %d", node.Type.ToString(), node.ProcesslistID)
+}
+
+// Format formats the node.
+func (node *Shutdown) Format(buf *TrackedBuffer) {
+	buf.literal("shutdown")
+}
+
+// Format formats the node.
+func (node *InstallPlugin) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "install plugin %v soname %#s", node.Name, buf.encodeSQLString(node.Library))
+}
+
+// Format formats the node.
+func (node *UninstallPlugin) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "uninstall plugin %v", node.Name)
+}
+
+// Format formats the node.
+func (node *InstallComponent) Format(buf *TrackedBuffer) {
+	buf.literal("install component ")
+	for i, component := range node.Components {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.literal(buf.encodeSQLString(component))
+	}
+}
+
+// Format formats the node.
+func (node *UninstallComponent) Format(buf *TrackedBuffer) {
+	buf.literal("uninstall component ")
+	for i, component := range node.Components {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.literal(buf.encodeSQLString(component))
+	}
+}
+
+// Format formats the node.
+func (node *ChangeReplicationSource) Format(buf *TrackedBuffer) {
+	if node.Master {
+		buf.literal("change master to ")
+	} else {
+		buf.literal("change replication source to ")
+	}
+	buf.astPrintf(node, "%v", node.Options)
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
+}
+
+// Format formats the node.
+func (node ReplicationOptions) Format(buf *TrackedBuffer) {
+	prefix := ""
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *ReplicationOption) Format(buf *TrackedBuffer) {
+	buf.literal(node.Name)
+	if node.Value != nil {
+		buf.astPrintf(node, " = %v", node.Value)
+	}
+}
+
+// Format formats the node.
+func (node *StartReplica) Format(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.literal("start slave")
+	} else {
+		buf.literal("start replica")
+	}
+	buf.literal(node.Threads.ToString())
+	if len(node.Until) > 0 {
+		buf.astPrintf(node, " until %v", node.Until)
+	}
+	for _, option := range node.ConnectionOptions {
+		buf.astPrintf(node, " %v", option)
+	}
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
+}
+
+// Format formats the node.
+func (node *StopReplica) Format(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.literal("stop slave")
+	} else {
+		buf.literal("stop replica")
+	}
+	buf.literal(node.Threads.ToString())
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
+}
+
+// Format formats the node.
+func (node *ResetReplica) Format(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.literal("reset slave")
+	} else {
+		buf.literal("reset replica")
+	}
+	if node.All {
+		buf.literal(" all")
+	}
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
+}
+
+// Format formats the node.
+func (node *ResetMaster) Format(buf *TrackedBuffer) {
+	buf.literal("reset master")
+	if node.To != nil {
+		buf.astPrintf(node, " to %v", node.To)
+	}
+}
+
+// Format formats the node.
+func (node *PurgeBinaryLogs) Format(buf *TrackedBuffer) {
+	if node.Master {
+		buf.literal("purge master logs")
+	} else {
+		buf.literal("purge binary logs")
+	}
+	if node.Before != nil {
+		buf.astPrintf(node, " before %v", node.Before)
+	} else {
+		buf.astPrintf(node, " to %#s", buf.encodeSQLString(node.To))
+	}
+}
+
 // Format formats the node.
 func (node *ExplainStmt) Format(buf *TrackedBuffer) {
 	format := ""
@@ -1020,6 +1250,21 @@ func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
 }
 
+// Format formats the node.
+func (node *DoStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "do %v", node.Exprs)
+}
+
+// Format formats the node.
+func (node *BadStmt) Format(buf *TrackedBuffer) {
+	buf.literal(node.SQL)
+}
+
+// Format formats the node.
+func (node *BadDefinition) Format(buf *TrackedBuffer) {
+	buf.literal(node.SQL)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.literal("otherread")
@@ -1030,6 +1275,64 @@ func (node *OtherAdmin) Format(buf *TrackedBuffer) {
 	buf.literal("otheradmin")
 }
 
+// Format formats the node.
+func (node *AnalyzeTable) Format(buf *TrackedBuffer) {
+	buf.literal("analyze ")
+	if node.IsLocal {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "table %v", node.Tables)
+	if node.HistogramAction != NoHistogram {
+		buf.astPrintf(node, " %s on ", node.HistogramAction.ToString())
+		for i, col := range node.HistogramColumns {
+			if i > 0 {
+				buf.literal(", ")
+			}
+			buf.astPrintf(node, "%v", col)
+		}
+	}
+	if node.Buckets != nil {
+		buf.astPrintf(node, " with %v buckets", node.Buckets)
+	}
+}
+
+// Format formats the node.
+func (node *OptimizeTable) Format(buf *TrackedBuffer) {
+	buf.literal("optimize ")
+	if node.IsLocal {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "table %v", node.Tables)
+}
+
+// Format formats the node.
+func (node *RepairTable) Format(buf *TrackedBuffer) {
+	buf.literal("repair ")
+	if node.IsLocal {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "table %v", node.Tables)
+	for _, opt := range node.Options {
+		buf.astPrintf(node, " %s", opt.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *CheckTable) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "check table %v", node.Tables)
+	for _, opt := range node.Options {
+		buf.astPrintf(node, " %s", opt.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *ChecksumTable) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "checksum table %v", node.Tables)
+	for _, opt := range node.Options {
+		buf.astPrintf(node, " %s", opt.ToString())
+	}
+}
+
 // Format formats the node.
 func (node *ParsedComments) Format(buf *TrackedBuffer) {
 	if node == nil {
@@ -1256,7 +1559,11 @@ func (node *ExistsExpr) Format(buf *TrackedBuffer) {
 func (node *Literal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		if buf.mode&SQLModeNoBackslashEscapes != 0 {
+			buf.WriteString(encodeSQLStringNoBackslashEscapes(node.Val))
+		} else {
+			sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		}
 	case IntVal, FloatVal, DecimalVal, HexNum:
 		buf.astPrintf(node, "%s", node.Val)
 	case HexVal:
@@ -1412,6 +1719,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
 }
 
 // Format formats the node
@@ -1423,6 +1733,11 @@ func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node
+func (node *GroupingExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "grouping(%v)", node.Exprs)
+}
+
 // Format formats the node.
 func (node *ValuesFuncExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "values(%v)", node.Name)
@@ -1467,7 +1782,12 @@ func (node *ConvertUsingExpr) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *ConvertType) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%s", node.Type)
+	if buf.mode&SQLModeRealAsFloat != 0 && strings.EqualFold(node.Type, "real") {
+		// REAL means FLOAT in this mode
+		buf.literal("double")
+	} else {
+		buf.astPrintf(node, "%s", node.Type)
+	}
 	if node.Length != nil {
 		buf.astPrintf(node, "(%v", node.Length)
 		if node.Scale != nil {
@@ -1602,6 +1922,9 @@ func (node *SetExpr) Format(buf *TrackedBuffer) {
 		buf.literal(node.Scope.ToString())
 		buf.WriteByte(' ')
 	}
+	if !node.Qualifier.IsEmpty() {
+		buf.astPrintf(node, "%v.", node.Qualifier)
+	}
 	// We don't have to backtick set variable names.
 	switch {
 	case node.Name.EqualString("charset") || node.Name.EqualString("names"):
@@ -1666,12 +1989,72 @@ func (node AccessMode) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Load) Format(buf *TrackedBuffer) {
-	buf.literal("AST node missing for Load type")
+	if node.Table.IsEmpty() {
+		// LOAD DATA FROM S3 is accepted but not parsed.
+		buf.literal("AST node missing for Load type")
+		return
+	}
+	buf.astPrintf(node, "load data %s", node.Priority.ToString())
+	if node.Local {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "infile %s%s into table %v%v",
+		buf.encodeSQLString(node.FileName), node.Duplicate.ToString(), node.Table, node.Partitions)
+	if node.Charset != "" {
+		buf.astPrintf(node, " character set %s", node.Charset)
+	}
+	buf.astPrintf(node, "%v%v", node.Fields, node.Lines)
+	if node.IgnoreLines != nil {
+		buf.astPrintf(node, " ignore %v lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.astPrintf(node, " set %v", node.SetExprs)
+	}
+}
+
+// Format formats the node.
+func (node *LoadFields) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" fields")
+	if node.Terminated != nil {
+		buf.astPrintf(node, " terminated by %v", node.Terminated)
+	}
+	if node.Enclosed != nil {
+		if node.Optionally {
+			buf.literal(" optionally")
+		}
+		buf.astPrintf(node, " enclosed by %v", node.Enclosed)
+	}
+	if node.Escaped != nil {
+		buf.astPrintf(node, " escaped by %v", node.Escaped)
+	}
+}
+
+// Format formats the node.
+func (node *LoadLines) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" lines")
+	if node.Starting != nil {
+		buf.astPrintf(node, " starting by %v", node.Starting)
+	}
+	if node.Terminated != nil {
+		buf.astPrintf(node, " terminated by %v", node.Terminated)
+	}
 }
 
 // Format formats the node.
 func (node *ShowBasic) Format(buf *TrackedBuffer) {
 	buf.literal("show")
+	if node.Extended {
+		buf.literal(" extended")
+	}
 	if node.Full {
 		buf.literal(" full")
 	}
@@ -1682,12 +2065,23 @@ func (node *ShowBasic) Format(buf *TrackedBuffer) {
 	if !node.DbName.IsEmpty() {
 		buf.astPrintf(node, " from %v", node.DbName)
 	}
-	buf.astPrintf(node, "%v", node.Filter)
+	buf.astPrintf(node, "%v%v", node.Filter, node.Limit)
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
 }
 
 // Format formats the node.
 func (node *ShowCreate) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "show%s %v", node.Command.ToString(), node.Op)
+	if node.User != nil {
+		buf.astPrintf(node, "show%s %v", node.Command.ToString(), node.User)
+		return
+	}
+	buf.astPrintf(node, "show%s ", node.Command.ToString())
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v", node.Op)
 }
 
 // Format formats the node.
@@ -1695,11 +2089,78 @@ func (node *ShowOther) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "show %s", node.Command)
 }
 
+// Format formats the node.
+func (node *ShowBinlogEvents) Format(buf *TrackedBuffer) {
+	if node.Relaylog {
+		buf.literal("show relaylog events")
+	} else {
+		buf.literal("show binlog events")
+	}
+	if node.LogName != "" {
+		buf.astPrintf(node, " in %#s", buf.encodeSQLString(node.LogName))
+	}
+	if node.Position != nil {
+		buf.astPrintf(node, " from %v", node.Position)
+	}
+	buf.astPrintf(node, "%v", node.Limit)
+	if node.Channel != "" {
+		buf.astPrintf(node, " for channel %#s", buf.encodeSQLString(node.Channel))
+	}
+}
+
+// Format formats the node.
+func (node *ShowEngine) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "show engine %v", node.Engine)
+	if node.Mutex {
+		buf.literal(" mutex")
+	} else {
+		buf.literal(" status")
+	}
+}
+
+// Format formats the node.
+func (node *ShowGrants) Format(buf *TrackedBuffer) {
+	buf.literal("show grants")
+	if node.For != nil {
+		buf.astPrintf(node, " for %v", node.For)
+	}
+	if len(node.Using) > 0 {
+		buf.astPrintf(node, " using %v", node.Using)
+	}
+}
+
+// Format formats the node.
+func (node *ShowProfile) Format(buf *TrackedBuffer) {
+	buf.literal("show profile")
+	for i, ty := range node.Types {
+		if i == 0 {
+			buf.literal(" ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.literal(ty)
+	}
+	if node.Query != nil {
+		buf.astPrintf(node, " for query %v", node.Query)
+	}
+	buf.astPrintf(node, "%v", node.Limit)
+}
+
 // Format formats the node.
 func (node *SelectInto) Format(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	if node.Type == IntoVariables {
+		buf.literal(node.Type.ToString())
+		for i, variable := range node.Variables {
+			if i > 0 {
+				buf.literal(", ")
+			}
+			buf.astPrintf(node, "%v", variable)
+		}
+		return
+	}
 	buf.astPrintf(node, "%s%s", node.Type.ToString(), node.FileName)
 	if node.Charset.Name != "" {
 		buf.astPrintf(node, " character set %s", node.Charset.Name)
@@ -1823,9 +2284,9 @@ func (node *AlterView) Format(buf *TrackedBuffer) {
 }
 
 func (definer *Definer) Format(buf *TrackedBuffer) {
-	buf.astPrintf(definer, "%#s", definer.Name)
+	buf.astPrintf(definer, "%#s", buf.requoteSQLString(definer.Name))
 	if definer.Address != "" {
-		buf.astPrintf(definer, "@%#s", definer.Address)
+		buf.astPrintf(definer, "@%#s", buf.requoteSQLString(definer.Address))
 	}
 }
 
@@ -2342,3 +2803,943 @@ func (node *JSONUnquoteExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "json_unquote(%v", node.JSONValue)
 	buf.WriteString(")")
 }
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	var prefix string
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionClause)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		var sep string
+		for _, n := range node.OrderClause {
+			buf.astPrintf(node, "%s%v", sep, n)
+			sep = ", "
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End != nil {
+		buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+	} else {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+	}
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	buf.astPrintf(node, "%s", node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	buf.WriteString("over")
+	if !node.WindowName.IsEmpty() {
+		buf.astPrintf(node, " %v", node.WindowName)
+	}
+	if node.WindowSpec != nil {
+		buf.astPrintf(node, " (%v)", node.WindowSpec)
+	}
+}
+
+// Format formats the node.
+func (node *NullTreatmentClause) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *FromFirstLastClause) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *ArgumentLessWindowExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s() %v", node.Type.ToString(), node.OverClause)
+}
+
+// Format formats the node.
+func (node *FirstOrLastValueExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v)", node.Type.ToString(), node.Expr)
+	if node.NullTreatmentClause != nil {
+		buf.astPrintf(node, " %v", node.NullTreatmentClause)
+	}
+	buf.astPrintf(node, " %v", node.OverClause)
+}
+
+// Format formats the node.
+func (node *NtileExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "ntile(%v) %v", node.N, node.OverClause)
+}
+
+// Format formats the node.
+func (node *NTHValueExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "nth_value(%v, %v)", node.Expr, node.N)
+	if node.FromFirstLastClause != nil {
+		buf.astPrintf(node, " %v", node.FromFirstLastClause)
+	}
+	if node.NullTreatmentClause != nil {
+		buf.astPrintf(node, " %v", node.NullTreatmentClause)
+	}
+	buf.astPrintf(node, " %v", node.OverClause)
+}
+
+// Format formats the node.
+func (node *LagLeadExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v", node.Type.ToString(), node.Expr)
+	if node.N != nil {
+		buf.astPrintf(node, ", %v", node.N)
+	}
+	if node.Default != nil {
+		buf.astPrintf(node, ", %v", node.Default)
+	}
+	buf.WriteString(")")
+	if node.NullTreatmentClause != nil {
+		buf.astPrintf(node, " %v", node.NullTreatmentClause)
+	}
+	buf.astPrintf(node, " %v", node.OverClause)
+}
+
+// Format formats the node
+func (node *GeomFromTextExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v", node.Type.ToString(), node.WktText)
+	if node.Srid != nil {
+		buf.astPrintf(node, ", %v", node.Srid)
+	}
+	if node.AxisOrderOpt != nil {
+		buf.astPrintf(node, ", %v", node.AxisOrderOpt)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *GeomRelationExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v, %v)", node.Type.ToString(), node.Geom1, node.Geom2)
+}
+
+// Format formats the node
+func (node *GeomDistanceExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "st_distance(%v, %v", node.Geom1, node.Geom2)
+	if node.Unit != nil {
+		buf.astPrintf(node, ", %v", node.Unit)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *GeomDistanceSphereExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "st_distance_sphere(%v, %v", node.Geom1, node.Geom2)
+	if node.Radius != nil {
+		buf.astPrintf(node, ", %v", node.Radius)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *GeomSRIDExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "st_srid(%v", node.Geom)
+	if node.Srid != nil {
+		buf.astPrintf(node, ", %v", node.Srid)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *GeomFormatExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v", node.FormatType.ToString(), node.Geom)
+	if node.AxisOrderOpt != nil {
+		buf.astPrintf(node, ", %v", node.AxisOrderOpt)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *RegexpLikeExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "regexp_like(%v, %v", node.Expr, node.Pattern)
+	if node.MatchType != nil {
+		buf.astPrintf(node, ", %v", node.MatchType)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *RegexpInstrExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "regexp_instr(%v, %v", node.Expr, node.Pattern)
+	if node.Position != nil {
+		buf.astPrintf(node, ", %v", node.Position)
+	}
+	if node.Occurrence != nil {
+		buf.astPrintf(node, ", %v", node.Occurrence)
+	}
+	if node.ReturnOption != nil {
+		buf.astPrintf(node, ", %v", node.ReturnOption)
+	}
+	if node.MatchType != nil {
+		buf.astPrintf(node, ", %v", node.MatchType)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *RegexpReplaceExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "regexp_replace(%v, %v, %v", node.Expr, node.Pattern, node.Repl)
+	if node.Position != nil {
+		buf.astPrintf(node, ", %v", node.Position)
+	}
+	if node.Occurrence != nil {
+		buf.astPrintf(node, ", %v", node.Occurrence)
+	}
+	if node.MatchType != nil {
+		buf.astPrintf(node, ", %v", node.MatchType)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node
+func (node *RegexpSubstrExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "regexp_substr(%v, %v", node.Expr, node.Pattern)
+	if node.Position != nil {
+		buf.astPrintf(node, ", %v", node.Position)
+	}
+	if node.Occurrence != nil {
+		buf.astPrintf(node, ", %v", node.Occurrence)
+	}
+	if node.MatchType != nil {
+		buf.astPrintf(node, ", %v", node.MatchType)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node.
+func (node *Grant) Format(buf *TrackedBuffer) {
+	switch {
+	case node.Target != nil:
+		buf.astPrintf(node, "grant %v %v to %v", node.Privileges, node.Target, node.To)
+	case node.Proxy != nil:
+		buf.astPrintf(node, "grant proxy on %v to %v", node.Proxy, node.To)
+	default:
+		buf.astPrintf(node, "grant %v to %v", node.Roles, node.To)
+	}
+	if node.WithGrantOption {
+		buf.literal(" with grant option")
+	}
+	if node.WithAdminOption {
+		buf.literal(" with admin option")
+	}
+}
+
+// Format formats the node.
+func (node *Revoke) Format(buf *TrackedBuffer) {
+	buf.literal("revoke ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	switch {
+	case node.Proxy != nil:
+		buf.astPrintf(node, "proxy on %v", node.Proxy)
+	case node.Roles != nil:
+		buf.astPrintf(node, "%v", node.Roles)
+	default:
+		buf.astPrintf(node, "%v", node.Privileges)
+	}
+	if node.Target != nil {
+		buf.astPrintf(node, " %v", node.Target)
+	}
+	buf.astPrintf(node, " from %v", node.From)
+}
+
+// Format formats the node.
+func (node *PrivilegeSpec) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Name)
+	if len(node.Columns) > 0 {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+}
+
+// Format formats the node.
+func (node Privileges) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *GrantTarget) Format(buf *TrackedBuffer) {
+	buf.literal("on ")
+	if node.ObjectType != NoObjectType {
+		buf.astPrintf(node, "%s ", node.ObjectType.ToString())
+	}
+	switch node.Level {
+	case GlobalPrivilegeLevel:
+		buf.literal("*.*")
+	case DatabasePrivilegeLevel:
+		if !node.Database.IsEmpty() {
+			buf.astPrintf(node, "%v.", node.Database)
+		}
+		buf.WriteByte('*')
+	default:
+		if !node.Database.IsEmpty() {
+			buf.astPrintf(node, "%v.", node.Database)
+		}
+		buf.astPrintf(node, "%v", node.Name)
+	}
+}
+
+// Format formats the node.
+func (node *Account) Format(buf *TrackedBuffer) {
+	if node.CurrentUser {
+		buf.literal("current_user()")
+		return
+	}
+	buf.astPrintf(node, "%#s", buf.encodeSQLString(node.User))
+	if node.Host != "" {
+		buf.astPrintf(node, "@%#s", buf.encodeSQLString(node.Host))
+	}
+}
+
+// Format formats the node.
+func (node Accounts) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *AuthOption) Format(buf *TrackedBuffer) {
+	if node.DiscardOldPassword {
+		buf.literal("discard old password")
+		return
+	}
+	buf.literal("identified")
+	if node.Plugin != "" {
+		buf.literal(" with ")
+		formatID(buf, node.Plugin, NoAt)
+	}
+	switch {
+	case node.RandomPassword:
+		buf.literal(" by random password")
+	case node.Hashed:
+		buf.astPrintf(node, " as %#s", buf.encodeSQLString(node.AuthString))
+	case node.Plugin == "" || node.AuthString != "":
+		buf.astPrintf(node, " by %#s", buf.encodeSQLString(node.AuthString))
+	}
+	if node.Replace != "" {
+		buf.astPrintf(node, " replace %#s", buf.encodeSQLString(node.Replace))
+	}
+	if node.RetainCurrent {
+		buf.literal(" retain current password")
+	}
+}
+
+// Format formats the node.
+func (node *UserSpec) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v", node.Account)
+	if node.Auth != nil {
+		buf.astPrintf(node, " %v", node.Auth)
+	}
+}
+
+// Format formats the node.
+func (node UserSpecs) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *RoleSpec) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ListRoleSpec:
+		buf.astPrintf(node, "%v", node.Roles)
+	case AllExceptRoleSpec:
+		buf.astPrintf(node, "%s %v", node.Type.ToString(), node.Roles)
+	default:
+		buf.astPrintf(node, "%s", node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *TLSOption) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Type.ToString())
+	if node.Value != "" {
+		buf.astPrintf(node, " %#s", buf.encodeSQLString(node.Value))
+	}
+}
+
+// Format formats the node.
+func (node TLSOptions) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = " "
+	}
+}
+
+// Format formats the node.
+func (node *ResourceOption) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s %d", node.Type.ToString(), node.Count)
+}
+
+// Format formats the node.
+func (node ResourceOptions) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = " "
+	}
+}
+
+// Format formats the node.
+func (node *PasswordOption) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case PasswordExpireInterval, PasswordReuseInterval:
+		buf.astPrintf(node, "%s %d day", node.Type.ToString(), node.Value)
+	case PasswordHistory, FailedLoginAttempts, PasswordLockTime:
+		buf.astPrintf(node, "%s %d", node.Type.ToString(), node.Value)
+	default:
+		buf.astPrintf(node, "%s", node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node PasswordOptions) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = " "
+	}
+}
+
+// Format formats the node.
+func (node *CreateUser) Format(buf *TrackedBuffer) {
+	buf.literal("create user ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v", node.Users)
+	if node.DefaultRole != nil {
+		buf.astPrintf(node, " default role %v", node.DefaultRole)
+	}
+	if len(node.TLSOptions) > 0 {
+		buf.astPrintf(node, " require %v", node.TLSOptions)
+	}
+	if len(node.ResourceOptions) > 0 {
+		buf.astPrintf(node, " with %v", node.ResourceOptions)
+	}
+	if len(node.PasswordOptions) > 0 {
+		buf.astPrintf(node, " %v", node.PasswordOptions)
+	}
+	if node.AccountLock != NoAccountLock {
+		buf.astPrintf(node, " %s", node.AccountLock.ToString())
+	}
+	if node.Comment != "" {
+		buf.astPrintf(node, " comment %#s", buf.encodeSQLString(node.Comment))
+	}
+	if node.Attribute != "" {
+		buf.astPrintf(node, " attribute %#s", buf.encodeSQLString(node.Attribute))
+	}
+}
+
+// Format formats the node.
+func (node *AlterUser) Format(buf *TrackedBuffer) {
+	buf.literal("alter user ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	buf.astPrintf(node, "%v", node.Users)
+	if node.DefaultRole != nil {
+		buf.astPrintf(node, " default role %v", node.DefaultRole)
+	}
+	if len(node.TLSOptions) > 0 {
+		buf.astPrintf(node, " require %v", node.TLSOptions)
+	}
+	if len(node.ResourceOptions) > 0 {
+		buf.astPrintf(node, " with %v", node.ResourceOptions)
+	}
+	if len(node.PasswordOptions) > 0 {
+		buf.astPrintf(node, " %v", node.PasswordOptions)
+	}
+	if node.AccountLock != NoAccountLock {
+		buf.astPrintf(node, " %s", node.AccountLock.ToString())
+	}
+	if node.Comment != "" {
+		buf.astPrintf(node, " comment %#s", buf.encodeSQLString(node.Comment))
+	}
+	if node.Attribute != "" {
+		buf.astPrintf(node, " attribute %#s", buf.encodeSQLString(node.Attribute))
+	}
+}
+
+// Format formats the node.
+func (node *DropUser) Format(buf *TrackedBuffer) {
+	buf.literal("drop user ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	buf.astPrintf(node, "%v", node.Users)
+}
+
+// Format formats the node.
+func (node *RenameUser) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "rename user")
+	prefix := " "
+	for _, pair := range node.UserPairs {
+		buf.astPrintf(node, "%s%v to %v", prefix, pair.FromUser, pair.ToUser)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *CreateRole) Format(buf *TrackedBuffer) {
+	buf.literal("create role ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v", node.Roles)
+}
+
+// Format formats the node.
+func (node *DropRole) Format(buf *TrackedBuffer) {
+	buf.literal("drop role ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	buf.astPrintf(node, "%v", node.Roles)
+}
+
+// Format formats the node.
+func (node *SetRole) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "set role %v", node.Roles)
+}
+
+// Format formats the node.
+func (node *SetDefaultRole) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "set default role %v to %v", node.Roles, node.To)
+}
+
+// Format formats the node.
+func (node *SetPassword) Format(buf *TrackedBuffer) {
+	buf.literal("set password")
+	if node.User != nil {
+		buf.astPrintf(node, " for %v", node.User)
+	}
+	if node.RandomPassword {
+		buf.literal(" to random")
+	} else {
+		buf.astPrintf(node, " = %#s", buf.encodeSQLString(node.Password))
+	}
+	if node.Replace != "" {
+		buf.astPrintf(node, " replace %#s", buf.encodeSQLString(node.Replace))
+	}
+	if node.RetainCurrent {
+		buf.literal(" retain current password")
+	}
+}
+
+// Format formats the node.
+func (node *CreateProcedure) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("procedure ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v(", node.Name)
+	for i, param := range node.Params {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", param)
+	}
+	buf.WriteByte(')')
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+	buf.literal(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// Format formats the node.
+func (node *CreateFunction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("function ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v(", node.Name)
+	for i, param := range node.Params {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", param)
+	}
+	buf.astPrintf(node, ") returns %v", &node.Returns)
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+	buf.literal(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// Format formats the node.
+func (node *ProcParameter) Format(buf *TrackedBuffer) {
+	if node.Mode != NoParameterMode {
+		buf.astPrintf(node, "%s ", node.Mode.ToString())
+	}
+	buf.astPrintf(node, "%v %v", node.Name, &node.Type)
+}
+
+// Format formats the node.
+func (node *RoutineCharacteristic) Format(buf *TrackedBuffer) {
+	buf.literal(node.Type.ToString())
+	if node.Type == CommentCharacteristic {
+		buf.astPrintf(node, " %#s", buf.encodeSQLString(node.Comment))
+	}
+}
+
+// Format formats the node.
+func (node *CreateTrigger) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("trigger ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v %s %s on %v for each row", node.Name, node.Timing.ToString(), node.Event.ToString(), node.Table)
+	if node.Order != nil {
+		buf.astPrintf(node, " %v", node.Order)
+	}
+	buf.literal(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// Format formats the node.
+func (node *TriggerOrder) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s %v", node.Type.ToString(), node.OtherTrigger)
+}
+
+// Format formats the node.
+func (node *CreateEvent) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("event ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v on schedule %v", node.Name, node.Schedule)
+	if node.OnCompletion != NoOnCompletion {
+		buf.astPrintf(node, " %s", node.OnCompletion.ToString())
+	}
+	if node.Status != NoEventStatus {
+		buf.astPrintf(node, " %s", node.Status.ToString())
+	}
+	if node.Comment != "" {
+		buf.astPrintf(node, " comment %#s", buf.encodeSQLString(node.Comment))
+	}
+	buf.literal(" do ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// Format formats the node.
+func (node *AlterRoutine) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %v%s %v", node.Comments, node.Type.ToString(), node.Name)
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+}
+
+// Format formats the node.
+func (node *AlterEvent) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.astPrintf(node, "event %v", node.Name)
+	if node.Schedule != nil {
+		buf.astPrintf(node, " on schedule %v", node.Schedule)
+	}
+	if node.OnCompletion != NoOnCompletion {
+		buf.astPrintf(node, " %s", node.OnCompletion.ToString())
+	}
+	if !node.RenameTo.IsEmpty() {
+		buf.astPrintf(node, " rename to %v", node.RenameTo)
+	}
+	if node.Status != NoEventStatus {
+		buf.astPrintf(node, " %s", node.Status.ToString())
+	}
+	if node.Comment != "" {
+		buf.astPrintf(node, " comment %#s", buf.encodeSQLString(node.Comment))
+	}
+	if node.Body != nil {
+		buf.literal(" do ")
+		formatRoutineStatement(buf, node.Body)
+	}
+}
+
+// Format formats the node.
+func (node *DropProgram) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "drop %v%s ", node.Comments, node.Type.ToString())
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	buf.astPrintf(node, "%v", node.Name)
+}
+
+// Format formats the node.
+func (node *EventSchedule) Format(buf *TrackedBuffer) {
+	if node.At != nil {
+		buf.astPrintf(node, "at %v", node.At)
+		return
+	}
+	buf.astPrintf(node, "every %v %#s", node.Every, node.EveryUnit)
+	if node.Starts != nil {
+		buf.astPrintf(node, " starts %v", node.Starts)
+	}
+	if node.Ends != nil {
+		buf.astPrintf(node, " ends %v", node.Ends)
+	}
+}
+
+// Format formats the node.
+func (node CompoundStatements) Format(buf *TrackedBuffer) {
+	for _, stmt := range node {
+		formatRoutineStatement(buf, stmt)
+		buf.literal("; ")
+	}
+}
+
+// Format formats the node.
+func (node *BeginEndBlock) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "begin %vend", node.Statements)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *DeclareVar) Format(buf *TrackedBuffer) {
+	buf.literal("declare ")
+	for i, name := range node.Names {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", name)
+	}
+	buf.astPrintf(node, " %v", &node.Type)
+	if node.Default != nil {
+		buf.astPrintf(node, " default %v", node.Default)
+	}
+}
+
+// Format formats the node.
+func (node *DeclareCondition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %v condition for %v", node.Name, node.Condition)
+}
+
+// Format formats the node.
+func (node *DeclareCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %v cursor for %v", node.Name, node.Select)
+}
+
+// Format formats the node.
+func (node *DeclareHandler) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %s handler for ", node.Action.ToString())
+	for i, condition := range node.Conditions {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", condition)
+	}
+	buf.astPrintf(node, " %v", node.Statement)
+}
+
+// Format formats the node.
+func (node *HandlerCondition) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ErrorCodeCondition:
+		buf.astPrintf(node, "%#s", node.Value)
+	case SQLStateCondition:
+		buf.astPrintf(node, "sqlstate %#s", buf.encodeSQLString(node.Value))
+	case NamedCondition:
+		buf.astPrintf(node, "%v", node.Name)
+	default:
+		buf.literal(node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *IfStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "if %v then %v", node.Cond, node.Statements)
+	for _, elseIf := range node.ElseIfs {
+		buf.astPrintf(node, "%v", elseIf)
+	}
+	if node.Else != nil {
+		buf.astPrintf(node, "else %v", node.Else)
+	}
+	buf.literal("end if")
+}
+
+// Format formats the node.
+func (node *ElseIf) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "elseif %v then %v", node.Cond, node.Statements)
+}
+
+// Format formats the node.
+func (node *CaseStmt) Format(buf *TrackedBuffer) {
+	buf.literal("case ")
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	for _, when := range node.Whens {
+		buf.astPrintf(node, "%v", when)
+	}
+	if node.Else != nil {
+		buf.astPrintf(node, "else %v", node.Else)
+	}
+	buf.literal("end case")
+}
+
+// Format formats the node.
+func (node *CaseStmtWhen) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "when %v then %v", node.Cond, node.Statements)
+}
+
+// Format formats the node.
+func (node *LoopStmt) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "loop %vend loop", node.Statements)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *WhileStmt) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "while %v do %vend while", node.Cond, node.Statements)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *RepeatStmt) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "repeat %vuntil %v end repeat", node.Statements, node.Until)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *LeaveStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "leave %v", node.Label)
+}
+
+// Format formats the node.
+func (node *IterateStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "iterate %v", node.Label)
+}
+
+// Format formats the node.
+func (node *ReturnStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "return %v", node.Expr)
+}
+
+// Format formats the node.
+func (node *OpenCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "open %v", node.Name)
+}
+
+// Format formats the node.
+func (node *CloseCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "close %v", node.Name)
+}
+
+// Format formats the node.
+func (node *FetchCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "fetch %v into ", node.Name)
+	for i, col := range node.Into {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", col)
+	}
+}
+
+// Format formats the node.
+func (node *Signal) Format(buf *TrackedBuffer) {
+	if node.Resignal {
+		buf.literal("resignal")
+	} else {
+		buf.literal("signal")
+	}
+	if node.Condition != nil {
+		buf.astPrintf(node, " %v", node.Condition)
+	}
+	for i, info := range node.Info {
+		if i == 0 {
+			buf.literal(" set ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", info)
+	}
+}
+
+// Format formats the node.
+func (node *SignalInfo) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%#s = %v", node.Name, node.Value)
+}
@@ -60,22 +60,29 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 	}
 
 	node.Where.formatFast(buf)
-
 	node.GroupBy.formatFast(buf)
+	if node.WithRollup {
+		buf.WriteString(WithRollupStr)
+	}
 
 	node.Having.formatFast(buf)
-
+	node.Windows.formatFast(buf)
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
-	buf.WriteString(node.Lock.ToString())
+
+	node.Lock.formatFast(buf)
+
 	node.Into.formatFast(buf)
 
 }
 
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
-	if requiresParen(node.Left) {
+	if node.With != nil {
+		node.With.formatFast(buf)
+	}
+	if requiresParen(node.Left) || setOpRequiresParen(node.Operator, node.Left, false) {
 		buf.WriteByte('(')
 		node.Left.formatFast(buf)
 		buf.WriteByte(')')
@@ -84,14 +91,13 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 	}
 
 	buf.WriteByte(' ')
-	if node.Distinct {
-		buf.WriteString(UnionStr)
-	} else {
-		buf.WriteString(UnionAllStr)
+	buf.WriteString(node.Operator.ToString())
+	if !node.Distinct {
+		buf.WriteString(" all")
 	}
 	buf.WriteByte(' ')
 
-	if requiresParen(node.Right) {
+	if requiresParen(node.Right) || setOpRequiresParen(node.Operator, node.Right, true) {
 		buf.WriteByte('(')
 		node.Right.formatFast(buf)
 		buf.WriteByte(')')
@@ -101,7 +107,59 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 
 	node.OrderBy.formatFast(buf)
 	node.Limit.formatFast(buf)
+	node.Lock.formatFast(buf)
+	node.Into.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *ValuesStatement) formatFast(buf *TrackedBuffer) {
+	if node.With != nil {
+		node.With.formatFast(buf)
+	}
+	buf.WriteString("values ")
+	node.Comments.formatFast(buf)
+	prefix := ""
+	for _, row := range node.Rows {
+		buf.WriteString(prefix)
+		buf.WriteString("row")
+		row.formatFast(buf)
+		prefix = ", "
+	}
+	node.OrderBy.formatFast(buf)
+	node.Limit.formatFast(buf)
+	node.Lock.formatFast(buf)
+	node.Into.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *TableStatement) formatFast(buf *TrackedBuffer) {
+	if node.With != nil {
+		node.With.formatFast(buf)
+	}
+	buf.WriteString("table ")
+	node.Comments.formatFast(buf)
+	node.Table.formatFast(buf)
+	node.OrderBy.formatFast(buf)
+	node.Limit.formatFast(buf)
+	node.Lock.formatFast(buf)
+	node.Into.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node LockClauses) formatFast(buf *TrackedBuffer) {
+	for _, n := range node {
+		n.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LockClause) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Lock.ToString())
+	if len(node.Tables) > 0 {
+		buf.WriteString(" of ")
+		node.Tables.formatFast(buf)
+	}
+	buf.WriteString(node.Wait.ToString())
 }
 
 // formatFast formats the node.
@@ -144,6 +202,8 @@ func (node *Insert) formatFast(buf *TrackedBuffer) {
 
 		node.Rows.formatFast(buf)
 
+		node.RowAlias.formatFast(buf)
+
 		node.OnDup.formatFast(buf)
 
 	case ReplaceAct:
@@ -163,6 +223,8 @@ func (node *Insert) formatFast(buf *TrackedBuffer) {
 
 		node.Rows.formatFast(buf)
 
+		node.RowAlias.formatFast(buf)
+
 		node.OnDup.formatFast(buf)
 
 	default:
@@ -182,12 +244,24 @@ func (node *Insert) formatFast(buf *TrackedBuffer) {
 
 		node.Rows.formatFast(buf)
 
+		node.RowAlias.formatFast(buf)
+
 		node.OnDup.formatFast(buf)
 
 	}
 
 }
 
+// formatFast formats the node.
+func (node *RowAlias) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" as ")
+	node.TableName.formatFast(buf)
+	node.Columns.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *With) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("with ")
@@ -814,23 +888,33 @@ func (node *SubPartition) formatFast(buf *TrackedBuffer) {
 // formatFast formats the node.
 func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("(\n")
-	for i, col := range ts.Columns {
-		if i == 0 {
+	// The definitions with a syntax error go where they were in the SQL,
+	// between the columns, indexes and constraints.
+	bad := ts.BadDefinitions
+	cols, idxs, consts := ts.Columns, ts.Indexes, ts.Constraints
+	for i := 0; i < ts.definitionCount(); i++ {
+		if i > 0 {
+			buf.WriteString(",\n")
+		}
+		switch {
+		case len(bad) > 0 && (bad[0].Index <= i || len(cols)+len(idxs)+len(consts) == 0):
 			buf.WriteByte('\t')
-			col.formatFast(buf)
-		} else {
-			buf.WriteString(",\n\t")
-			col.formatFast(buf)
+			bad[0].formatFast(buf)
+			bad = bad[1:]
+		case len(cols) > 0:
+			buf.WriteByte('\t')
+			cols[0].formatFast(buf)
+			cols = cols[1:]
+		case len(idxs) > 0:
+			buf.WriteByte('\t')
+			idxs[0].formatFast(buf)
+			idxs = idxs[1:]
+		default:
+			buf.WriteByte('\t')
+			consts[0].formatFast(buf)
+			consts = consts[1:]
 		}
 	}
-	for _, idx := range ts.Indexes {
-		buf.WriteString(",\n\t")
-		idx.formatFast(buf)
-	}
-	for _, c := range ts.Constraints {
-		buf.WriteString(",\n\t")
-		c.formatFast(buf)
-	}
 
 	buf.WriteString("\n)")
 	for i, opt := range ts.Options {
@@ -870,7 +954,12 @@ func (col *ColumnDefinition) formatFast(buf *TrackedBuffer) {
 
 // formatFast returns a canonical string representation of the type and all relevant options
 func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
-	buf.WriteString(ct.Type)
+	if buf.mode&SQLModeRealAsFloat != 0 && strings.EqualFold(ct.Type, "real") {
+		// REAL means FLOAT in this mode
+		buf.WriteString("double")
+	} else {
+		buf.WriteString(ct.Type)
+	}
 
 	if ct.Length != nil && ct.Scale != nil {
 		buf.WriteByte('(')
@@ -891,7 +980,7 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 			if i > 0 {
 				buf.WriteString(", ")
 			}
-			buf.WriteString(enum)
+			buf.WriteString(buf.requoteSQLString(enum))
 		}
 		buf.WriteString(")")
 	}
@@ -1242,7 +1331,7 @@ func (node *ShowFilter) formatFast(buf *TrackedBuffer) {
 	}
 	if node.Like != "" {
 		buf.WriteString(" like ")
-		sqltypes.BufEncodeStringSQL(buf.Builder, node.Like)
+		buf.WriteString(buf.encodeSQLString(node.Like))
 	} else {
 		buf.WriteString(" where ")
 		node.Filter.formatFast(buf)
@@ -1292,6 +1381,192 @@ func (node *Release) formatFast(buf *TrackedBuffer) {
 	node.Name.formatFast(buf)
 }
 
+// formatFast formats the node.
+func (node *XATransaction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("xa ")
+	buf.WriteString(node.Action.ToString())
+	if node.Xid != nil {
+		buf.WriteByte(' ')
+		node.Xid.formatFast(buf)
+	}
+	buf.WriteString(node.Option.ToString())
+	if node.ConvertXid {
+		buf.WriteString(" convert xid")
+	}
+}
+
+// formatFast formats the node.
+func (node *Xid) formatFast(buf *TrackedBuffer) {
+	node.Gtrid.formatFast(buf)
+	if node.Bqual != nil {
+		buf.WriteString(", ")
+		node.Bqual.formatFast(buf)
+	}
+	if node.FormatID != nil {
+		buf.WriteString(", ")
+		node.FormatID.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Kill) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("kill ")
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(fmt.Sprintf("%d", node.ProcesslistID))
+}
+
+// formatFast formats the node.
+func (node *Shutdown) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("shutdown")
+}
+
+// formatFast formats the node.
+func (node *InstallPlugin) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("install plugin ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" soname ")
+	buf.WriteString(buf.encodeSQLString(node.Library))
+}
+
+// formatFast formats the node.
+func (node *UninstallPlugin) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("uninstall plugin ")
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *InstallComponent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("install component ")
+	for i, component := range node.Components {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(buf.encodeSQLString(component))
+	}
+}
+
+// formatFast formats the node.
+func (node *UninstallComponent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("uninstall component ")
+	for i, component := range node.Components {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(buf.encodeSQLString(component))
+	}
+}
+
+// formatFast formats the node.
+func (node *ChangeReplicationSource) formatFast(buf *TrackedBuffer) {
+	if node.Master {
+		buf.WriteString("change master to ")
+	} else {
+		buf.WriteString("change replication source to ")
+	}
+	node.Options.formatFast(buf)
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
+}
+
+// formatFast formats the node.
+func (node ReplicationOptions) formatFast(buf *TrackedBuffer) {
+	prefix := ""
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *ReplicationOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	if node.Value != nil {
+		buf.WriteString(" = ")
+		node.Value.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *StartReplica) formatFast(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.WriteString("start slave")
+	} else {
+		buf.WriteString("start replica")
+	}
+	buf.WriteString(node.Threads.ToString())
+	if len(node.Until) > 0 {
+		buf.WriteString(" until ")
+		node.Until.formatFast(buf)
+	}
+	for _, option := range node.ConnectionOptions {
+		buf.WriteByte(' ')
+		option.formatFast(buf)
+	}
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
+}
+
+// formatFast formats the node.
+func (node *StopReplica) formatFast(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.WriteString("stop slave")
+	} else {
+		buf.WriteString("stop replica")
+	}
+	buf.WriteString(node.Threads.ToString())
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
+}
+
+// formatFast formats the node.
+func (node *ResetReplica) formatFast(buf *TrackedBuffer) {
+	if node.Slave {
+		buf.WriteString("reset slave")
+	} else {
+		buf.WriteString("reset replica")
+	}
+	if node.All {
+		buf.WriteString(" all")
+	}
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
+}
+
+// formatFast formats the node.
+func (node *ResetMaster) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("reset master")
+	if node.To != nil {
+		buf.WriteString(" to ")
+		node.To.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *PurgeBinaryLogs) formatFast(buf *TrackedBuffer) {
+	if node.Master {
+		buf.WriteString("purge master logs")
+	} else {
+		buf.WriteString("purge binary logs")
+	}
+	if node.Before != nil {
+		buf.WriteString(" before ")
+		node.Before.formatFast(buf)
+	} else {
+		buf.WriteString(" to ")
+		buf.WriteString(buf.encodeSQLString(node.To))
+	}
+}
+
 // formatFast formats the node.
 func (node *ExplainStmt) formatFast(buf *TrackedBuffer) {
 	format := ""
@@ -1362,6 +1637,22 @@ func (node *CallProc) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(')')
 }
 
+// formatFast formats the node.
+func (node *DoStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("do ")
+	node.Exprs.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *BadStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.SQL)
+}
+
+// formatFast formats the node.
+func (node *BadDefinition) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.SQL)
+}
+
 // formatFast formats the node.
 func (node *OtherRead) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -1372,6 +1663,76 @@ func (node *OtherAdmin) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("otheradmin")
 }
 
+// formatFast formats the node.
+func (node *AnalyzeTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("analyze ")
+	if node.IsLocal {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("table ")
+	node.Tables.formatFast(buf)
+	if node.HistogramAction != NoHistogram {
+		buf.WriteByte(' ')
+		buf.WriteString(node.HistogramAction.ToString())
+		buf.WriteString(" on ")
+		for i, col := range node.HistogramColumns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			col.formatFast(buf)
+		}
+	}
+	if node.Buckets != nil {
+		buf.WriteString(" with ")
+		node.Buckets.formatFast(buf)
+		buf.WriteString(" buckets")
+	}
+}
+
+// formatFast formats the node.
+func (node *OptimizeTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("optimize ")
+	if node.IsLocal {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("table ")
+	node.Tables.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RepairTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("repair ")
+	if node.IsLocal {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("table ")
+	node.Tables.formatFast(buf)
+	for _, opt := range node.Options {
+		buf.WriteByte(' ')
+		buf.WriteString(opt.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node *CheckTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("check table ")
+	node.Tables.formatFast(buf)
+	for _, opt := range node.Options {
+		buf.WriteByte(' ')
+		buf.WriteString(opt.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node *ChecksumTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("checksum table ")
+	node.Tables.formatFast(buf)
+	for _, opt := range node.Options {
+		buf.WriteByte(' ')
+		buf.WriteString(opt.ToString())
+	}
+}
+
 // formatFast formats the node.
 func (node *ParsedComments) formatFast(buf *TrackedBuffer) {
 	if node == nil {
@@ -1652,7 +2013,11 @@ func (node *ExistsExpr) formatFast(buf *TrackedBuffer) {
 func (node *Literal) formatFast(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		if buf.mode&SQLModeNoBackslashEscapes != 0 {
+			buf.WriteString(encodeSQLStringNoBackslashEscapes(node.Val))
+		} else {
+			sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		}
 	case IntVal, FloatVal, DecimalVal, HexNum:
 		buf.WriteString(node.Val)
 	case HexVal:
@@ -1861,6 +2226,10 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	if node.Over != nil {
+		buf.WriteByte(' ')
+		node.Over.formatFast(buf)
+	}
 }
 
 // formatFast formats the node
@@ -1883,6 +2252,13 @@ func (node *GroupConcatExpr) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node
+func (node *GroupingExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("grouping(")
+	node.Exprs.formatFast(buf)
+	buf.WriteByte(')')
+}
+
 // formatFast formats the node.
 func (node *ValuesFuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("values(")
@@ -1953,7 +2329,12 @@ func (node *ConvertUsingExpr) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *ConvertType) formatFast(buf *TrackedBuffer) {
-	buf.WriteString(node.Type)
+	if buf.mode&SQLModeRealAsFloat != 0 && strings.EqualFold(node.Type, "real") {
+		// REAL means FLOAT in this mode
+		buf.WriteString("double")
+	} else {
+		buf.WriteString(node.Type)
+	}
 	if node.Length != nil {
 		buf.WriteByte('(')
 		node.Length.formatFast(buf)
@@ -2114,6 +2495,10 @@ func (node *SetExpr) formatFast(buf *TrackedBuffer) {
 		buf.WriteString(node.Scope.ToString())
 		buf.WriteByte(' ')
 	}
+	if !node.Qualifier.IsEmpty() {
+		node.Qualifier.formatFast(buf)
+		buf.WriteByte('.')
+	}
 	// We don't have to backtick set variable names.
 	switch {
 	case node.Name.EqualString("charset") || node.Name.EqualString("names"):
@@ -2185,18 +2570,95 @@ func (node AccessMode) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Load) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
-}
-
-// formatFast formats the node.
-func (node *ShowBasic) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("show")
-	if node.Full {
-		buf.WriteString(" full")
+	if node.Table.IsEmpty() {
+		// LOAD DATA FROM S3 is accepted but not parsed.
+		buf.WriteString("AST node missing for Load type")
+		return
 	}
-	buf.WriteString(node.Command.ToString())
-	if !node.Tbl.IsEmpty() {
-		buf.WriteString(" from ")
+	buf.WriteString("load data ")
+	buf.WriteString(node.Priority.ToString())
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("infile ")
+	buf.WriteString(buf.encodeSQLString(node.FileName))
+	buf.WriteString(node.Duplicate.ToString())
+	buf.WriteString(" into table ")
+	node.Table.formatFast(buf)
+	node.Partitions.formatFast(buf)
+
+	if node.Charset != "" {
+		buf.WriteString(" character set ")
+		buf.WriteString(node.Charset)
+	}
+	node.Fields.formatFast(buf)
+	node.Lines.formatFast(buf)
+	if node.IgnoreLines != nil {
+		buf.WriteString(" ignore ")
+		node.IgnoreLines.formatFast(buf)
+		buf.WriteString(" lines")
+	}
+	if node.Columns != nil {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.WriteString(" set ")
+		node.SetExprs.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LoadFields) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" fields")
+	if node.Terminated != nil {
+		buf.WriteString(" terminated by ")
+		node.Terminated.formatFast(buf)
+	}
+	if node.Enclosed != nil {
+		if node.Optionally {
+			buf.WriteString(" optionally")
+		}
+		buf.WriteString(" enclosed by ")
+		node.Enclosed.formatFast(buf)
+	}
+	if node.Escaped != nil {
+		buf.WriteString(" escaped by ")
+		node.Escaped.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LoadLines) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" lines")
+	if node.Starting != nil {
+		buf.WriteString(" starting by ")
+		node.Starting.formatFast(buf)
+	}
+	if node.Terminated != nil {
+		buf.WriteString(" terminated by ")
+		node.Terminated.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *ShowBasic) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("show")
+	if node.Extended {
+		buf.WriteString(" extended")
+	}
+	if node.Full {
+		buf.WriteString(" full")
+	}
+	buf.WriteString(node.Command.ToString())
+	if !node.Tbl.IsEmpty() {
+		buf.WriteString(" from ")
 		node.Tbl.formatFast(buf)
 	}
 	if !node.DbName.IsEmpty() {
@@ -2204,13 +2666,28 @@ func (node *ShowBasic) formatFast(buf *TrackedBuffer) {
 		node.DbName.formatFast(buf)
 	}
 	node.Filter.formatFast(buf)
+	node.Limit.formatFast(buf)
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
 }
 
 // formatFast formats the node.
 func (node *ShowCreate) formatFast(buf *TrackedBuffer) {
+	if node.User != nil {
+		buf.WriteString("show")
+		buf.WriteString(node.Command.ToString())
+		buf.WriteByte(' ')
+		node.User.formatFast(buf)
+		return
+	}
 	buf.WriteString("show")
 	buf.WriteString(node.Command.ToString())
 	buf.WriteByte(' ')
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
 	node.Op.formatFast(buf)
 }
 
@@ -2220,11 +2697,85 @@ func (node *ShowOther) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Command)
 }
 
+// formatFast formats the node.
+func (node *ShowBinlogEvents) formatFast(buf *TrackedBuffer) {
+	if node.Relaylog {
+		buf.WriteString("show relaylog events")
+	} else {
+		buf.WriteString("show binlog events")
+	}
+	if node.LogName != "" {
+		buf.WriteString(" in ")
+		buf.WriteString(buf.encodeSQLString(node.LogName))
+	}
+	if node.Position != nil {
+		buf.WriteString(" from ")
+		node.Position.formatFast(buf)
+	}
+	node.Limit.formatFast(buf)
+	if node.Channel != "" {
+		buf.WriteString(" for channel ")
+		buf.WriteString(buf.encodeSQLString(node.Channel))
+	}
+}
+
+// formatFast formats the node.
+func (node *ShowEngine) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("show engine ")
+	node.Engine.formatFast(buf)
+	if node.Mutex {
+		buf.WriteString(" mutex")
+	} else {
+		buf.WriteString(" status")
+	}
+}
+
+// formatFast formats the node.
+func (node *ShowGrants) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("show grants")
+	if node.For != nil {
+		buf.WriteString(" for ")
+		node.For.formatFast(buf)
+	}
+	if len(node.Using) > 0 {
+		buf.WriteString(" using ")
+		node.Using.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *ShowProfile) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("show profile")
+	for i, ty := range node.Types {
+		if i == 0 {
+			buf.WriteString(" ")
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(ty)
+	}
+	if node.Query != nil {
+		buf.WriteString(" for query ")
+		node.Query.formatFast(buf)
+	}
+	node.Limit.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *SelectInto) formatFast(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	if node.Type == IntoVariables {
+		buf.WriteString(node.Type.ToString())
+		for i, variable := range node.Variables {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			variable.formatFast(buf)
+		}
+		return
+	}
 	buf.WriteString(node.Type.ToString())
 	buf.WriteString(node.FileName)
 	if node.Charset.Name != "" {
@@ -2388,10 +2939,10 @@ func (node *AlterView) formatFast(buf *TrackedBuffer) {
 }
 
 func (definer *Definer) formatFast(buf *TrackedBuffer) {
-	buf.WriteString(definer.Name)
+	buf.WriteString(buf.requoteSQLString(definer.Name))
 	if definer.Address != "" {
 		buf.WriteByte('@')
-		buf.WriteString(definer.Address)
+		buf.WriteString(buf.requoteSQLString(definer.Address))
 	}
 }
 
@@ -3056,3 +3607,1208 @@ func (node *JSONUnquoteExpr) formatFast(buf *TrackedBuffer) {
 	buf.printExpr(node, node.JSONValue, true)
 	buf.WriteString(")")
 }
+
+// formatFast formats the node.
+func (node WindowDefinitions) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *WindowDefinition) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		var sep string
+		for _, n := range node.OrderClause {
+			buf.WriteString(sep)
+			n.formatFast(buf)
+			sep = ", "
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(prefix)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End != nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteString(" between ")
+		node.Start.formatFast(buf)
+		buf.WriteString(" and ")
+		node.End.formatFast(buf)
+	} else {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("over")
+	if !node.WindowName.IsEmpty() {
+		buf.WriteByte(' ')
+		node.WindowName.formatFast(buf)
+	}
+	if node.WindowSpec != nil {
+		buf.WriteString(" (")
+		node.WindowSpec.formatFast(buf)
+		buf.WriteByte(')')
+	}
+}
+
+// formatFast formats the node.
+func (node *NullTreatmentClause) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+}
+
+// formatFast formats the node.
+func (node *FromFirstLastClause) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+}
+
+// formatFast formats the node.
+func (node *ArgumentLessWindowExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteString("() ")
+	node.OverClause.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FirstOrLastValueExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte('(')
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteByte(')')
+	if node.NullTreatmentClause != nil {
+		buf.WriteByte(' ')
+		node.NullTreatmentClause.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.OverClause.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *NtileExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("ntile(")
+	buf.printExpr(node, node.N, true)
+	buf.WriteString(") ")
+	node.OverClause.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *NTHValueExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("nth_value(")
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.N, true)
+	buf.WriteByte(')')
+	if node.FromFirstLastClause != nil {
+		buf.WriteByte(' ')
+		node.FromFirstLastClause.formatFast(buf)
+	}
+	if node.NullTreatmentClause != nil {
+		buf.WriteByte(' ')
+		node.NullTreatmentClause.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.OverClause.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *LagLeadExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte('(')
+	buf.printExpr(node, node.Expr, true)
+	if node.N != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.N, true)
+	}
+	if node.Default != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Default, true)
+	}
+	buf.WriteString(")")
+	if node.NullTreatmentClause != nil {
+		buf.WriteByte(' ')
+		node.NullTreatmentClause.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.OverClause.formatFast(buf)
+}
+
+// formatFast formats the node
+func (node *GeomFromTextExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte('(')
+	buf.printExpr(node, node.WktText, true)
+	if node.Srid != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Srid, true)
+	}
+	if node.AxisOrderOpt != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.AxisOrderOpt, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *GeomRelationExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte('(')
+	buf.printExpr(node, node.Geom1, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Geom2, true)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *GeomDistanceExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("st_distance(")
+	buf.printExpr(node, node.Geom1, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Geom2, true)
+	if node.Unit != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Unit, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *GeomDistanceSphereExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("st_distance_sphere(")
+	buf.printExpr(node, node.Geom1, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Geom2, true)
+	if node.Radius != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Radius, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *GeomSRIDExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("st_srid(")
+	buf.printExpr(node, node.Geom, true)
+	if node.Srid != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Srid, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *GeomFormatExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.FormatType.ToString())
+	buf.WriteByte('(')
+	buf.printExpr(node, node.Geom, true)
+	if node.AxisOrderOpt != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.AxisOrderOpt, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *RegexpLikeExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("regexp_like(")
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Pattern, true)
+	if node.MatchType != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.MatchType, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *RegexpInstrExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("regexp_instr(")
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Pattern, true)
+	if node.Position != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Position, true)
+	}
+	if node.Occurrence != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Occurrence, true)
+	}
+	if node.ReturnOption != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.ReturnOption, true)
+	}
+	if node.MatchType != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.MatchType, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *RegexpReplaceExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("regexp_replace(")
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Pattern, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Repl, true)
+	if node.Position != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Position, true)
+	}
+	if node.Occurrence != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Occurrence, true)
+	}
+	if node.MatchType != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.MatchType, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *RegexpSubstrExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("regexp_substr(")
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString(", ")
+	buf.printExpr(node, node.Pattern, true)
+	if node.Position != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Position, true)
+	}
+	if node.Occurrence != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.Occurrence, true)
+	}
+	if node.MatchType != nil {
+		buf.WriteString(", ")
+		buf.printExpr(node, node.MatchType, true)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *Grant) formatFast(buf *TrackedBuffer) {
+	switch {
+	case node.Target != nil:
+		buf.WriteString("grant ")
+		node.Privileges.formatFast(buf)
+		buf.WriteByte(' ')
+		node.Target.formatFast(buf)
+		buf.WriteString(" to ")
+		node.To.formatFast(buf)
+	case node.Proxy != nil:
+		buf.WriteString("grant proxy on ")
+		node.Proxy.formatFast(buf)
+		buf.WriteString(" to ")
+		node.To.formatFast(buf)
+	default:
+		buf.WriteString("grant ")
+		node.Roles.formatFast(buf)
+		buf.WriteString(" to ")
+		node.To.formatFast(buf)
+	}
+	if node.WithGrantOption {
+		buf.WriteString(" with grant option")
+	}
+	if node.WithAdminOption {
+		buf.WriteString(" with admin option")
+	}
+}
+
+// formatFast formats the node.
+func (node *Revoke) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("revoke ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	switch {
+	case node.Proxy != nil:
+		buf.WriteString("proxy on ")
+		node.Proxy.formatFast(buf)
+	case node.Roles != nil:
+		node.Roles.formatFast(buf)
+	default:
+		node.Privileges.formatFast(buf)
+	}
+	if node.Target != nil {
+		buf.WriteByte(' ')
+		node.Target.formatFast(buf)
+	}
+	buf.WriteString(" from ")
+	node.From.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *PrivilegeSpec) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	if len(node.Columns) > 0 {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node Privileges) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *GrantTarget) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("on ")
+	if node.ObjectType != NoObjectType {
+		buf.WriteString(node.ObjectType.ToString())
+		buf.WriteByte(' ')
+	}
+	switch node.Level {
+	case GlobalPrivilegeLevel:
+		buf.WriteString("*.*")
+	case DatabasePrivilegeLevel:
+		if !node.Database.IsEmpty() {
+			node.Database.formatFast(buf)
+			buf.WriteByte('.')
+		}
+		buf.WriteByte('*')
+	default:
+		if !node.Database.IsEmpty() {
+			node.Database.formatFast(buf)
+			buf.WriteByte('.')
+		}
+		node.Name.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Account) formatFast(buf *TrackedBuffer) {
+	if node.CurrentUser {
+		buf.WriteString("current_user()")
+		return
+	}
+	buf.WriteString(buf.encodeSQLString(node.User))
+	if node.Host != "" {
+		buf.WriteByte('@')
+		buf.WriteString(buf.encodeSQLString(node.Host))
+	}
+}
+
+// formatFast formats the node.
+func (node Accounts) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *AuthOption) formatFast(buf *TrackedBuffer) {
+	if node.DiscardOldPassword {
+		buf.WriteString("discard old password")
+		return
+	}
+	buf.WriteString("identified")
+	if node.Plugin != "" {
+		buf.WriteString(" with ")
+		formatID(buf, node.Plugin, NoAt)
+	}
+	switch {
+	case node.RandomPassword:
+		buf.WriteString(" by random password")
+	case node.Hashed:
+		buf.WriteString(" as ")
+		buf.WriteString(buf.encodeSQLString(node.AuthString))
+	case node.Plugin == "" || node.AuthString != "":
+		buf.WriteString(" by ")
+		buf.WriteString(buf.encodeSQLString(node.AuthString))
+	}
+	if node.Replace != "" {
+		buf.WriteString(" replace ")
+		buf.WriteString(buf.encodeSQLString(node.Replace))
+	}
+	if node.RetainCurrent {
+		buf.WriteString(" retain current password")
+	}
+}
+
+// formatFast formats the node.
+func (node *UserSpec) formatFast(buf *TrackedBuffer) {
+	node.Account.formatFast(buf)
+	if node.Auth != nil {
+		buf.WriteByte(' ')
+		node.Auth.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node UserSpecs) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *RoleSpec) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ListRoleSpec:
+		node.Roles.formatFast(buf)
+	case AllExceptRoleSpec:
+		buf.WriteString(node.Type.ToString())
+		buf.WriteByte(' ')
+		node.Roles.formatFast(buf)
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node *TLSOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	if node.Value != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(buf.encodeSQLString(node.Value))
+	}
+}
+
+// formatFast formats the node.
+func (node TLSOptions) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = " "
+	}
+}
+
+// formatFast formats the node.
+func (node *ResourceOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(fmt.Sprintf("%d", node.Count))
+}
+
+// formatFast formats the node.
+func (node ResourceOptions) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = " "
+	}
+}
+
+// formatFast formats the node.
+func (node *PasswordOption) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case PasswordExpireInterval, PasswordReuseInterval:
+		buf.WriteString(node.Type.ToString())
+		buf.WriteByte(' ')
+		buf.WriteString(fmt.Sprintf("%d", node.Value))
+		buf.WriteString(" day")
+	case PasswordHistory, FailedLoginAttempts, PasswordLockTime:
+		buf.WriteString(node.Type.ToString())
+		buf.WriteByte(' ')
+		buf.WriteString(fmt.Sprintf("%d", node.Value))
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node PasswordOptions) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = " "
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create user ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Users.formatFast(buf)
+	if node.DefaultRole != nil {
+		buf.WriteString(" default role ")
+		node.DefaultRole.formatFast(buf)
+	}
+	if len(node.TLSOptions) > 0 {
+		buf.WriteString(" require ")
+		node.TLSOptions.formatFast(buf)
+	}
+	if len(node.ResourceOptions) > 0 {
+		buf.WriteString(" with ")
+		node.ResourceOptions.formatFast(buf)
+	}
+	if len(node.PasswordOptions) > 0 {
+		buf.WriteByte(' ')
+		node.PasswordOptions.formatFast(buf)
+	}
+	if node.AccountLock != NoAccountLock {
+		buf.WriteByte(' ')
+		buf.WriteString(node.AccountLock.ToString())
+	}
+	if node.Comment != "" {
+		buf.WriteString(" comment ")
+		buf.WriteString(buf.encodeSQLString(node.Comment))
+	}
+	if node.Attribute != "" {
+		buf.WriteString(" attribute ")
+		buf.WriteString(buf.encodeSQLString(node.Attribute))
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter user ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	node.Users.formatFast(buf)
+	if node.DefaultRole != nil {
+		buf.WriteString(" default role ")
+		node.DefaultRole.formatFast(buf)
+	}
+	if len(node.TLSOptions) > 0 {
+		buf.WriteString(" require ")
+		node.TLSOptions.formatFast(buf)
+	}
+	if len(node.ResourceOptions) > 0 {
+		buf.WriteString(" with ")
+		node.ResourceOptions.formatFast(buf)
+	}
+	if len(node.PasswordOptions) > 0 {
+		buf.WriteByte(' ')
+		node.PasswordOptions.formatFast(buf)
+	}
+	if node.AccountLock != NoAccountLock {
+		buf.WriteByte(' ')
+		buf.WriteString(node.AccountLock.ToString())
+	}
+	if node.Comment != "" {
+		buf.WriteString(" comment ")
+		buf.WriteString(buf.encodeSQLString(node.Comment))
+	}
+	if node.Attribute != "" {
+		buf.WriteString(" attribute ")
+		buf.WriteString(buf.encodeSQLString(node.Attribute))
+	}
+}
+
+// formatFast formats the node.
+func (node *DropUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop user ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	node.Users.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RenameUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("rename user")
+	prefix := " "
+	for _, pair := range node.UserPairs {
+		buf.WriteString(prefix)
+		pair.FromUser.formatFast(buf)
+		buf.WriteString(" to ")
+		pair.ToUser.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create role ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Roles.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DropRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop role ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	node.Roles.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *SetRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set role ")
+	node.Roles.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *SetDefaultRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set default role ")
+	node.Roles.formatFast(buf)
+	buf.WriteString(" to ")
+	node.To.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *SetPassword) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set password")
+	if node.User != nil {
+		buf.WriteString(" for ")
+		node.User.formatFast(buf)
+	}
+	if node.RandomPassword {
+		buf.WriteString(" to random")
+	} else {
+		buf.WriteString(" = ")
+		buf.WriteString(buf.encodeSQLString(node.Password))
+	}
+	if node.Replace != "" {
+		buf.WriteString(" replace ")
+		buf.WriteString(buf.encodeSQLString(node.Replace))
+	}
+	if node.RetainCurrent {
+		buf.WriteString(" retain current password")
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateProcedure) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("procedure ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte('(')
+	for i, param := range node.Params {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		param.formatFast(buf)
+	}
+	buf.WriteByte(')')
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+	buf.WriteString(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// formatFast formats the node.
+func (node *CreateFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("function ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte('(')
+	for i, param := range node.Params {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		param.formatFast(buf)
+	}
+	buf.WriteString(") returns ")
+	(&node.Returns).formatFast(buf)
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+	buf.WriteString(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// formatFast formats the node.
+func (node *ProcParameter) formatFast(buf *TrackedBuffer) {
+	if node.Mode != NoParameterMode {
+		buf.WriteString(node.Mode.ToString())
+		buf.WriteByte(' ')
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	(&node.Type).formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RoutineCharacteristic) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	if node.Type == CommentCharacteristic {
+		buf.WriteByte(' ')
+		buf.WriteString(buf.encodeSQLString(node.Comment))
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateTrigger) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("trigger ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Timing.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(node.Event.ToString())
+	buf.WriteString(" on ")
+	node.Table.formatFast(buf)
+	buf.WriteString(" for each row")
+	if node.Order != nil {
+		buf.WriteByte(' ')
+		node.Order.formatFast(buf)
+	}
+	buf.WriteString(" ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// formatFast formats the node.
+func (node *TriggerOrder) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	node.OtherTrigger.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CreateEvent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("event ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteString(" on schedule ")
+	node.Schedule.formatFast(buf)
+	if node.OnCompletion != NoOnCompletion {
+		buf.WriteByte(' ')
+		buf.WriteString(node.OnCompletion.ToString())
+	}
+	if node.Status != NoEventStatus {
+		buf.WriteByte(' ')
+		buf.WriteString(node.Status.ToString())
+	}
+	if node.Comment != "" {
+		buf.WriteString(" comment ")
+		buf.WriteString(buf.encodeSQLString(node.Comment))
+	}
+	buf.WriteString(" do ")
+	formatRoutineStatement(buf, node.Body)
+}
+
+// formatFast formats the node.
+func (node *AlterRoutine) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterEvent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("event ")
+	node.Name.formatFast(buf)
+	if node.Schedule != nil {
+		buf.WriteString(" on schedule ")
+		node.Schedule.formatFast(buf)
+	}
+	if node.OnCompletion != NoOnCompletion {
+		buf.WriteByte(' ')
+		buf.WriteString(node.OnCompletion.ToString())
+	}
+	if !node.RenameTo.IsEmpty() {
+		buf.WriteString(" rename to ")
+		node.RenameTo.formatFast(buf)
+	}
+	if node.Status != NoEventStatus {
+		buf.WriteByte(' ')
+		buf.WriteString(node.Status.ToString())
+	}
+	if node.Comment != "" {
+		buf.WriteString(" comment ")
+		buf.WriteString(buf.encodeSQLString(node.Comment))
+	}
+	if node.Body != nil {
+		buf.WriteString(" do ")
+		formatRoutineStatement(buf, node.Body)
+	}
+}
+
+// formatFast formats the node.
+func (node *DropProgram) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *EventSchedule) formatFast(buf *TrackedBuffer) {
+	if node.At != nil {
+		buf.WriteString("at ")
+		node.At.formatFast(buf)
+		return
+	}
+	buf.WriteString("every ")
+	node.Every.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(node.EveryUnit)
+	if node.Starts != nil {
+		buf.WriteString(" starts ")
+		node.Starts.formatFast(buf)
+	}
+	if node.Ends != nil {
+		buf.WriteString(" ends ")
+		node.Ends.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node CompoundStatements) formatFast(buf *TrackedBuffer) {
+	for _, stmt := range node {
+		formatRoutineStatement(buf, stmt)
+		buf.WriteString("; ")
+	}
+}
+
+// formatFast formats the node.
+func (node *BeginEndBlock) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("begin ")
+	node.Statements.formatFast(buf)
+	buf.WriteString("end")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DeclareVar) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	for i, name := range node.Names {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		name.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	(&node.Type).formatFast(buf)
+	if node.Default != nil {
+		buf.WriteString(" default ")
+		node.Default.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DeclareCondition) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" condition for ")
+	node.Condition.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DeclareCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" cursor for ")
+	node.Select.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DeclareHandler) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	buf.WriteString(node.Action.ToString())
+	buf.WriteString(" handler for ")
+	for i, condition := range node.Conditions {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		condition.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Statement.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *HandlerCondition) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ErrorCodeCondition:
+		buf.WriteString(node.Value)
+	case SQLStateCondition:
+		buf.WriteString("sqlstate ")
+		buf.WriteString(buf.encodeSQLString(node.Value))
+	case NamedCondition:
+		node.Name.formatFast(buf)
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node *IfStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("if ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+	for _, elseIf := range node.ElseIfs {
+		elseIf.formatFast(buf)
+	}
+	if node.Else != nil {
+		buf.WriteString("else ")
+		node.Else.formatFast(buf)
+	}
+	buf.WriteString("end if")
+}
+
+// formatFast formats the node.
+func (node *ElseIf) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("elseif ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CaseStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("case ")
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	for _, when := range node.Whens {
+		when.formatFast(buf)
+	}
+	if node.Else != nil {
+		buf.WriteString("else ")
+		node.Else.formatFast(buf)
+	}
+	buf.WriteString("end case")
+}
+
+// formatFast formats the node.
+func (node *CaseStmtWhen) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("when ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *LoopStmt) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("loop ")
+	node.Statements.formatFast(buf)
+	buf.WriteString("end loop")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *WhileStmt) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("while ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" do ")
+	node.Statements.formatFast(buf)
+	buf.WriteString("end while")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *RepeatStmt) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("repeat ")
+	node.Statements.formatFast(buf)
+	buf.WriteString("until ")
+	node.Until.formatFast(buf)
+	buf.WriteString(" end repeat")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LeaveStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("leave ")
+	node.Label.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *IterateStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("iterate ")
+	node.Label.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *ReturnStmt) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("return ")
+	node.Expr.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OpenCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("open ")
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CloseCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("close ")
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FetchCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("fetch ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" into ")
+	for i, col := range node.Into {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		col.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Signal) formatFast(buf *TrackedBuffer) {
+	if node.Resignal {
+		buf.WriteString("resignal")
+	} else {
+		buf.WriteString("signal")
+	}
+	if node.Condition != nil {
+		buf.WriteByte(' ')
+		node.Condition.formatFast(buf)
+	}
+	for i, info := range node.Info {
+		if i == 0 {
+			buf.WriteString(" set ")
+		} else {
+			buf.WriteString(", ")
+		}
+		info.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *SignalInfo) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	buf.WriteString(" = ")
+	node.Value.formatFast(buf)
+}
@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/wind-c/cosqlparser/coerrors"
 	"github.com/wind-c/cosqlparser/sqltypes"
@@ -176,6 +177,12 @@ func (ts *TableSpec) AddConstraint(cd *ConstraintDefinition) {
 	ts.Constraints = append(ts.Constraints, cd)
 }
 
+// definitionCount returns the number of the definitions of the table,
+// including the ones with a syntax error.
+func (ts *TableSpec) definitionCount() int {
+	return len(ts.Columns) + len(ts.Indexes) + len(ts.Constraints) + len(ts.BadDefinitions)
+}
+
 // DescribeType returns the abbreviated type information as required for
 // describe table
 func (ct *ColumnType) DescribeType() string {
@@ -286,7 +293,7 @@ func (ct *ColumnType) SQLType() sqltypes.Type {
 		return sqltypes.Geometry
 	case keywordStrings[POLYGON]:
 		return sqltypes.Geometry
-	case keywordStrings[GEOMETRYCOLLECTION]:
+	case keywordStrings[GEOMETRYCOLLECTION], keywordStrings[GEOMCOLLECTION]:
 		return sqltypes.Geometry
 	case keywordStrings[MULTIPOINT]:
 		return sqltypes.Geometry
@@ -302,6 +309,7 @@ func (ct *ColumnType) SQLType() sqltypes.Type {
 // If the list is empty, one will be created containing the query hint.
 // If the list already contains a query hint, the given string will be merged with the existing one.
 // This is done because only one query hint is allowed per query.
+// Use OptimizerHints and SetOptimizerHints to inspect or modify individual hints.
 func (node *ParsedComments) AddQueryHint(queryHint string) (Comments, error) {
 	if queryHint == "" {
 		if node == nil {
@@ -571,6 +579,24 @@ func (node *ColName) Equal(c *ColName) bool {
 	return node.Name.Equal(c.Name) && node.Qualifier == c.Qualifier
 }
 
+// Span returns the part of the parsed SQL that the column name was parsed
+// from, from its first qualifier to its name, or nil if the parser did not
+// record it.
+func (node *ColName) Span() *Span {
+	end := node.Name.Span()
+	if end == nil {
+		return nil
+	}
+	start := node.Qualifier.Qualifier.Span()
+	if start == nil {
+		start = node.Qualifier.Name.Span()
+	}
+	if start == nil {
+		return end
+	}
+	return &Span{Start: start.Start, End: end.End}
+}
+
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
 	"avg":          true,
@@ -593,7 +619,8 @@ var Aggregates = map[string]bool{
 
 // IsAggregate returns true if the function is an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	// an aggregate function with an OVER clause is evaluated as a window function
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
 }
 
 // NewColIdent makes a new ColIdent.
@@ -774,8 +801,14 @@ func (node *TableIdent) UnmarshalJSON(b []byte) error {
 func containEscapableChars(s string, at AtCount) bool {
 	isDbSystemVariable := at != NoAt
 
-	for i := range s {
-		c := uint16(s[i])
+	for i, r := range s {
+		if r >= utf8.RuneSelf {
+			if !isIdentifierRune(r) {
+				return true
+			}
+			continue
+		}
+		c := uint16(r)
 		letter := isLetter(c)
 		systemVarChar := isDbSystemVariable && isCarat(c)
 		if !(letter || systemVarChar) {
@@ -790,6 +823,9 @@ func containEscapableChars(s string, at AtCount) bool {
 
 func formatID(buf *TrackedBuffer, original string, at AtCount) {
 	_, isKeyword := keywordLookupTable.LookupString(original)
+	if buf.mode&SQLModeIgnoreSpace != 0 && isIgnoreSpaceFunction(original) {
+		isKeyword = true
+	}
 	if buf.escape || isKeyword || containEscapableChars(original, at) {
 		writeEscapedString(buf, original)
 	} else {
@@ -843,7 +879,7 @@ func (node *Select) SetLimit(limit *Limit) {
 }
 
 // SetLock sets the lock clause
-func (node *Select) SetLock(lock Lock) {
+func (node *Select) SetLock(lock LockClauses) {
 	node.Lock = lock
 }
 
@@ -934,6 +970,54 @@ func (node *Update) AddWhere(expr Expr) {
 	}
 }
 
+// RewriteValuesFuncs rewrites the VALUES(col) references in the ON DUPLICATE KEY
+// UPDATE clause to the row alias form that replaces them as of MySQL 8.0.20,
+// e.g. VALUES(a) becomes new.a. If the insert has no row alias, one named alias
+// is added. If the row alias has column aliases, a column is mapped to its alias
+// by its position in the insert column list. The insert is left unchanged on error.
+func (node *Insert) RewriteValuesFuncs(alias string) error {
+	if len(node.OnDup) == 0 {
+		return nil
+	}
+	if _, ok := node.Rows.(Values); !ok {
+		return coerrors.New(coerrors.Code_INVALID_ARGUMENT, "row alias is only supported with INSERT ... VALUES")
+	}
+	rowAlias := node.RowAlias
+	if rowAlias == nil {
+		rowAlias = &RowAlias{TableName: NewTableIdent(alias)}
+	}
+	qualifier := TableName{Name: rowAlias.TableName}
+
+	var err error
+	onDup := CloneOnDup(node.OnDup)
+	_ = Rewrite(onDup, func(cursor *Cursor) bool {
+		if err != nil {
+			return false
+		}
+		values, ok := cursor.Node().(*ValuesFuncExpr)
+		if !ok {
+			return true
+		}
+		col := values.Name.Name
+		if len(rowAlias.Columns) > 0 {
+			idx := node.Columns.FindColumn(col)
+			if idx < 0 || idx >= len(rowAlias.Columns) {
+				err = coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "column %s has no alias in the row alias", col.String())
+				return false
+			}
+			col = rowAlias.Columns[idx]
+		}
+		cursor.Replace(&ColName{Name: col, Qualifier: qualifier})
+		return true
+	}, nil)
+	if err != nil {
+		return err
+	}
+	node.RowAlias = rowAlias
+	node.OnDup = onDup
+	return nil
+}
+
 // AddOrder adds an order by element
 func (node *Union) AddOrder(order *Order) {
 	node.OrderBy = append(node.OrderBy, order)
@@ -955,7 +1039,7 @@ func (node *Union) SetLimit(limit *Limit) {
 }
 
 // SetLock sets the lock clause
-func (node *Union) SetLock(lock Lock) {
+func (node *Union) SetLock(lock LockClauses) {
 	node.Lock = lock
 }
 
@@ -989,18 +1073,159 @@ func (node *Union) GetParsedComments() *ParsedComments {
 	return node.Left.GetParsedComments()
 }
 
+// AddOrder adds an order by element
+func (node *ValuesStatement) AddOrder(order *Order) {
+	node.OrderBy = append(node.OrderBy, order)
+}
+
+// SetOrderBy sets the order by clause
+func (node *ValuesStatement) SetOrderBy(orderBy OrderBy) {
+	node.OrderBy = orderBy
+}
+
+// GetOrderBy gets the order by clause
+func (node *ValuesStatement) GetOrderBy() OrderBy {
+	return node.OrderBy
+}
+
+// SetLimit sets the limit clause
+func (node *ValuesStatement) SetLimit(limit *Limit) {
+	node.Limit = limit
+}
+
+// SetLock sets the lock clause
+func (node *ValuesStatement) SetLock(lock LockClauses) {
+	node.Lock = lock
+}
+
+// SetInto sets the into clause
+func (node *ValuesStatement) SetInto(into *SelectInto) {
+	node.Into = into
+}
+
+// SetWith sets the with clause to a values statement
+func (node *ValuesStatement) SetWith(with *With) {
+	node.With = with
+}
+
+// MakeDistinct implements the SelectStatement interface.
+// A table value constructor has no DISTINCT option, so this is a no-op.
+func (node *ValuesStatement) MakeDistinct() {
+}
+
+// GetColumnCount returns the number of values in the first row.
+func (node *ValuesStatement) GetColumnCount() int {
+	if len(node.Rows) == 0 {
+		return 0
+	}
+	return len(node.Rows[0])
+}
+
+// SetComments implements the SelectStatement interface
+func (node *ValuesStatement) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// GetComments implements the SelectStatement interface
+func (node *ValuesStatement) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// AddOrder adds an order by element
+func (node *TableStatement) AddOrder(order *Order) {
+	node.OrderBy = append(node.OrderBy, order)
+}
+
+// SetOrderBy sets the order by clause
+func (node *TableStatement) SetOrderBy(orderBy OrderBy) {
+	node.OrderBy = orderBy
+}
+
+// GetOrderBy gets the order by clause
+func (node *TableStatement) GetOrderBy() OrderBy {
+	return node.OrderBy
+}
+
+// SetLimit sets the limit clause
+func (node *TableStatement) SetLimit(limit *Limit) {
+	node.Limit = limit
+}
+
+// SetLock sets the lock clause
+func (node *TableStatement) SetLock(lock LockClauses) {
+	node.Lock = lock
+}
+
+// SetInto sets the into clause
+func (node *TableStatement) SetInto(into *SelectInto) {
+	node.Into = into
+}
+
+// SetWith sets the with clause to a table statement
+func (node *TableStatement) SetWith(with *With) {
+	node.With = with
+}
+
+// MakeDistinct implements the SelectStatement interface.
+// A TABLE statement has no DISTINCT option, so this is a no-op.
+func (node *TableStatement) MakeDistinct() {
+}
+
+// GetColumnCount implements the SelectStatement interface.
+// Like SELECT * FROM t, a TABLE statement has a single star expression,
+// so it returns 1.
+func (node *TableStatement) GetColumnCount() int {
+	return 1
+}
+
+// SetComments implements the SelectStatement interface
+func (node *TableStatement) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// GetComments implements the SelectStatement interface
+func (node *TableStatement) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
 func requiresParen(stmt SelectStatement) bool {
 	switch node := stmt.(type) {
 	case *Union:
-		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil
+		return len(node.OrderBy) != 0 || len(node.Lock) != 0 || node.Into != nil || node.Limit != nil
 	case *Select:
-		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil
+		return len(node.OrderBy) != 0 || len(node.Lock) != 0 || node.Into != nil || node.Limit != nil
+	case *ValuesStatement:
+		return len(node.OrderBy) != 0 || len(node.Lock) != 0 || node.Into != nil || node.Limit != nil
+	case *TableStatement:
+		return len(node.OrderBy) != 0 || len(node.Lock) != 0 || node.Into != nil || node.Limit != nil
 	}
 
 	return false
 }
 
-func setLockInSelect(stmt SelectStatement, lock Lock) {
+// setOpRequiresParen returns true if an operand of a set operation needs
+// parentheses to keep its meaning. INTERSECT binds tighter than UNION and
+// EXCEPT, and set operations of the same precedence are left-associative.
+func setOpRequiresParen(op SetOperator, operand SelectStatement, right bool) bool {
+	node, ok := operand.(*Union)
+	if !ok {
+		return false
+	}
+	if right {
+		return node.Operator.precedence() <= op.precedence()
+	}
+	return node.Operator.precedence() < op.precedence()
+}
+
+// precedence returns the binding strength of the set operator.
+func (op SetOperator) precedence() int {
+	if op == IntersectOp {
+		return 2
+	}
+	return 1
+}
+
+func setLockInSelect(stmt SelectStatement, lock LockClauses) {
 	stmt.SetLock(lock)
 }
 
@@ -1075,11 +1300,27 @@ func (lock Lock) ToString() string {
 		return ForUpdateStr
 	case ShareModeLock:
 		return ShareModeStr
+	case ForShareLock:
+		return ForShareStr
 	default:
 		return "Unknown lock"
 	}
 }
 
+// ToString returns the string associated with the LockWaitType
+func (wait LockWaitType) ToString() string {
+	switch wait {
+	case DefaultWait:
+		return ""
+	case NoWait:
+		return NoWaitStr
+	case SkipLocked:
+		return SkipLockedStr
+	default:
+		return "Unknown LockWaitType"
+	}
+}
+
 // ToString returns the string associated with WhereType
 func (whereType WhereType) ToString() string {
 	switch whereType {
@@ -1366,6 +1607,84 @@ func (ty JSONAttributeType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty GeomFromTextType) ToString() string {
+	switch ty {
+	case GeometryFromText:
+		return GeometryFromTextStr
+	case GeometryCollectionFromText:
+		return GeometryCollectionFromTextStr
+	case PointFromText:
+		return PointFromTextStr
+	case LineStringFromText:
+		return LineStringFromTextStr
+	case PolygonFromText:
+		return PolygonFromTextStr
+	case MultiPointFromText:
+		return MultiPointFromTextStr
+	case MultiLineStringFromText:
+		return MultiLineStringFromTextStr
+	case MultiPolygonFromText:
+		return MultiPolygonFromTextStr
+	default:
+		return "Unknown GeomFromTextType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty GeomRelationType) ToString() string {
+	switch ty {
+	case ContainsRelation:
+		return ContainsRelationStr
+	case CrossesRelation:
+		return CrossesRelationStr
+	case DisjointRelation:
+		return DisjointRelationStr
+	case EqualsRelation:
+		return EqualsRelationStr
+	case IntersectsRelation:
+		return IntersectsRelationStr
+	case OverlapsRelation:
+		return OverlapsRelationStr
+	case TouchesRelation:
+		return TouchesRelationStr
+	case WithinRelation:
+		return WithinRelationStr
+	case MBRContainsRelation:
+		return MBRContainsRelationStr
+	case MBRCoveredByRelation:
+		return MBRCoveredByRelationStr
+	case MBRCoversRelation:
+		return MBRCoversRelationStr
+	case MBRDisjointRelation:
+		return MBRDisjointRelationStr
+	case MBREqualsRelation:
+		return MBREqualsRelationStr
+	case MBRIntersectsRelation:
+		return MBRIntersectsRelationStr
+	case MBROverlapsRelation:
+		return MBROverlapsRelationStr
+	case MBRTouchesRelation:
+		return MBRTouchesRelationStr
+	case MBRWithinRelation:
+		return MBRWithinRelationStr
+	default:
+		return "Unknown GeomRelationType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty GeomFormatType) ToString() string {
+	switch ty {
+	case AsTextFormat:
+		return AsTextFormatStr
+	case AsBinaryFormat:
+		return AsBinaryFormatStr
+	default:
+		return "Unknown GeomFormatType"
+	}
+}
+
 // ToString returns the type as a string
 func (ty JSONValueModifierType) ToString() string {
 	switch ty {
@@ -1398,6 +1717,572 @@ func (ty JSONValueMergeType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty FrameUnitType) ToString() string {
+	switch ty {
+	case FrameRowsType:
+		return FrameRowsStr
+	case FrameRangeType:
+		return FrameRangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty ArgumentLessWindowExprType) ToString() string {
+	switch ty {
+	case CumeDistExprType:
+		return CumeDistExprStr
+	case DenseRankExprType:
+		return DenseRankExprStr
+	case PercentRankExprType:
+		return PercentRankExprStr
+	case RankExprType:
+		return RankExprStr
+	case RowNumberExprType:
+		return RowNumberExprStr
+	default:
+		return "Unknown ArgumentLessWindowExprType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty NullTreatmentType) ToString() string {
+	switch ty {
+	case RespectNullsType:
+		return RespectNullsStr
+	case IgnoreNullsType:
+		return IgnoreNullsStr
+	default:
+		return "Unknown NullTreatmentType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FromFirstLastType) ToString() string {
+	switch ty {
+	case FromFirstType:
+		return FromFirstStr
+	case FromLastType:
+		return FromLastStr
+	default:
+		return "Unknown FromFirstLastType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FirstOrLastValueExprType) ToString() string {
+	switch ty {
+	case FirstValueExprType:
+		return FirstValueExprStr
+	case LastValueExprType:
+		return LastValueExprStr
+	default:
+		return "Unknown FirstOrLastValueExprType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty LagLeadExprType) ToString() string {
+	switch ty {
+	case LagExprType:
+		return LagExprStr
+	case LeadExprType:
+		return LeadExprStr
+	default:
+		return "Unknown LagLeadExprType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty GrantObjectType) ToString() string {
+	switch ty {
+	case NoObjectType:
+		return ""
+	case TableObjectType:
+		return TableObjectStr
+	case FunctionObjectType:
+		return FunctionObjectStr
+	case ProcedureObjectType:
+		return ProcedureObjectStr
+	default:
+		return "Unknown GrantObjectType"
+	}
+}
+
+// ToString returns the level as a string
+func (ty PrivilegeLevel) ToString() string {
+	switch ty {
+	case GlobalPrivilegeLevel:
+		return GlobalPrivilegeLevelStr
+	case DatabasePrivilegeLevel:
+		return DatabasePrivilegeLevelStr
+	case TablePrivilegeLevel:
+		return TablePrivilegeLevelStr
+	case ColumnPrivilegeLevel:
+		return ColumnPrivilegeLevelStr
+	case RoutinePrivilegeLevel:
+		return RoutinePrivilegeLevelStr
+	default:
+		return "Unknown PrivilegeLevel"
+	}
+}
+
+// ToString returns the type as a string
+func (ty RoleSpecType) ToString() string {
+	switch ty {
+	case ListRoleSpec:
+		return ""
+	case DefaultRoleSpec:
+		return DefaultRoleSpecStr
+	case NoneRoleSpec:
+		return NoneRoleSpecStr
+	case AllRoleSpec:
+		return AllRoleSpecStr
+	case AllExceptRoleSpec:
+		return AllExceptRoleSpecStr
+	default:
+		return "Unknown RoleSpecType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty AccountLockType) ToString() string {
+	switch ty {
+	case NoAccountLock:
+		return ""
+	case LockAccount:
+		return LockAccountStr
+	case UnlockAccount:
+		return UnlockAccountStr
+	default:
+		return "Unknown AccountLockType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty TLSOptionType) ToString() string {
+	switch ty {
+	case RequireNone:
+		return RequireNoneStr
+	case RequireSSL:
+		return RequireSSLStr
+	case RequireX509:
+		return RequireX509Str
+	case RequireCipher:
+		return RequireCipherStr
+	case RequireIssuer:
+		return RequireIssuerStr
+	case RequireSubject:
+		return RequireSubjectStr
+	default:
+		return "Unknown TLSOptionType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty ResourceOptionType) ToString() string {
+	switch ty {
+	case MaxQueriesPerHour:
+		return MaxQueriesPerHourStr
+	case MaxUpdatesPerHour:
+		return MaxUpdatesPerHourStr
+	case MaxConnectionsPerHour:
+		return MaxConnectionsPerHourStr
+	case MaxUserConnections:
+		return MaxUserConnectionsStr
+	default:
+		return "Unknown ResourceOptionType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty PasswordOptionType) ToString() string {
+	switch ty {
+	case PasswordExpire:
+		return PasswordExpireStr
+	case PasswordExpireDefault:
+		return PasswordExpireDefaultStr
+	case PasswordExpireNever:
+		return PasswordExpireNeverStr
+	case PasswordExpireInterval:
+		return PasswordExpireIntervalStr
+	case PasswordHistory:
+		return PasswordHistoryStr
+	case PasswordHistoryDefault:
+		return PasswordHistoryDefaultStr
+	case PasswordReuseInterval:
+		return PasswordReuseIntervalStr
+	case PasswordReuseIntervalDefault:
+		return PasswordReuseIntervalDefaultStr
+	case PasswordRequireCurrent:
+		return PasswordRequireCurrentStr
+	case PasswordRequireCurrentDefault:
+		return PasswordRequireCurrentDefaultStr
+	case PasswordRequireCurrentOptional:
+		return PasswordRequireCurrentOptionalStr
+	case FailedLoginAttempts:
+		return FailedLoginAttemptsStr
+	case PasswordLockTime:
+		return PasswordLockTimeStr
+	case PasswordLockTimeUnbounded:
+		return PasswordLockTimeUnboundedStr
+	default:
+		return "Unknown PasswordOptionType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty ProcParameterMode) ToString() string {
+	switch ty {
+	case NoParameterMode:
+		return ""
+	case InParameterMode:
+		return InParameterModeStr
+	case OutParameterMode:
+		return OutParameterModeStr
+	case InOutParameterMode:
+		return InOutParameterModeStr
+	default:
+		return "Unknown ProcParameterMode"
+	}
+}
+
+// ToString returns the type as a string
+func (ty RoutineCharacteristicType) ToString() string {
+	switch ty {
+	case CommentCharacteristic:
+		return CommentCharacteristicStr
+	case LanguageSQLCharacteristic:
+		return LanguageSQLCharacteristicStr
+	case DeterministicCharacteristic:
+		return DeterministicCharacteristicStr
+	case NotDeterministicCharacteristic:
+		return NotDeterministicCharacteristicStr
+	case ContainsSQLCharacteristic:
+		return ContainsSQLCharacteristicStr
+	case NoSQLCharacteristic:
+		return NoSQLCharacteristicStr
+	case ReadsSQLDataCharacteristic:
+		return ReadsSQLDataCharacteristicStr
+	case ModifiesSQLDataCharacteristic:
+		return ModifiesSQLDataCharacteristicStr
+	case SQLSecurityDefinerCharacteristic:
+		return SQLSecurityDefinerCharacteristicStr
+	case SQLSecurityInvokerCharacteristic:
+		return SQLSecurityInvokerCharacteristicStr
+	default:
+		return "Unknown RoutineCharacteristicType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty TriggerTimingType) ToString() string {
+	switch ty {
+	case BeforeTrigger:
+		return BeforeTriggerStr
+	case AfterTrigger:
+		return AfterTriggerStr
+	default:
+		return "Unknown TriggerTimingType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty TriggerEventType) ToString() string {
+	switch ty {
+	case InsertTrigger:
+		return InsertTriggerStr
+	case UpdateTrigger:
+		return UpdateTriggerStr
+	case DeleteTrigger:
+		return DeleteTriggerStr
+	default:
+		return "Unknown TriggerEventType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty TriggerOrderType) ToString() string {
+	switch ty {
+	case FollowsTrigger:
+		return FollowsTriggerStr
+	case PrecedesTrigger:
+		return PrecedesTriggerStr
+	default:
+		return "Unknown TriggerOrderType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty EventOnCompletionType) ToString() string {
+	switch ty {
+	case NoOnCompletion:
+		return ""
+	case OnCompletionPreserve:
+		return OnCompletionPreserveStr
+	case OnCompletionNotPreserve:
+		return OnCompletionNotPreserveStr
+	default:
+		return "Unknown EventOnCompletionType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty EventStatusType) ToString() string {
+	switch ty {
+	case NoEventStatus:
+		return ""
+	case EnableEvent:
+		return EnableEventStr
+	case DisableEvent:
+		return DisableEventStr
+	case DisableOnReplicaEvent:
+		return DisableOnReplicaEventStr
+	default:
+		return "Unknown EventStatusType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty ProgramType) ToString() string {
+	switch ty {
+	case ProcedureType:
+		return ProcedureTypeStr
+	case FunctionType:
+		return FunctionTypeStr
+	case TriggerType:
+		return TriggerTypeStr
+	case EventType:
+		return EventTypeStr
+	default:
+		return "Unknown ProgramType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty HandlerActionType) ToString() string {
+	switch ty {
+	case ContinueHandler:
+		return ContinueHandlerStr
+	case ExitHandler:
+		return ExitHandlerStr
+	case UndoHandler:
+		return UndoHandlerStr
+	default:
+		return "Unknown HandlerActionType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty HandlerConditionType) ToString() string {
+	switch ty {
+	case ErrorCodeCondition:
+		return ""
+	case SQLStateCondition:
+		return SQLStateConditionStr
+	case NamedCondition:
+		return ""
+	case SQLWarningCondition:
+		return SQLWarningConditionStr
+	case NotFoundCondition:
+		return NotFoundConditionStr
+	case SQLExceptionCondition:
+		return SQLExceptionConditionStr
+	default:
+		return "Unknown HandlerConditionType"
+	}
+}
+
+// ToString returns the operator as a string
+func (op SetOperator) ToString() string {
+	switch op {
+	case UnionOp:
+		return UnionStr
+	case IntersectOp:
+		return IntersectStr
+	case ExceptOp:
+		return ExceptStr
+	default:
+		return "Unknown SetOperator"
+	}
+}
+
+// ToString returns the priority as a string
+func (ty LoadPriority) ToString() string {
+	switch ty {
+	case DefaultLoadPriority:
+		return ""
+	case LowPriorityLoad:
+		return LowPriorityLoadStr
+	case ConcurrentLoad:
+		return ConcurrentLoadStr
+	default:
+		return "Unknown LoadPriority"
+	}
+}
+
+// ToString returns the type as a string
+func (ty LoadDuplicateType) ToString() string {
+	switch ty {
+	case NoLoadDuplicate:
+		return ""
+	case ReplaceLoadDuplicate:
+		return ReplaceLoadDuplicateStr
+	case IgnoreLoadDuplicate:
+		return IgnoreLoadDuplicateStr
+	default:
+		return "Unknown LoadDuplicateType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty HistogramActionType) ToString() string {
+	switch ty {
+	case NoHistogram:
+		return ""
+	case UpdateHistogram:
+		return UpdateHistogramStr
+	case DropHistogram:
+		return DropHistogramStr
+	default:
+		return "Unknown HistogramActionType"
+	}
+}
+
+// ToString returns the option as a string
+func (ty MaintenanceOption) ToString() string {
+	switch ty {
+	case QuickOption:
+		return QuickOptionStr
+	case FastOption:
+		return FastOptionStr
+	case MediumOption:
+		return MediumOptionStr
+	case ExtendedOption:
+		return ExtendedOptionStr
+	case ChangedOption:
+		return ChangedOptionStr
+	case UseFrmOption:
+		return UseFrmOptionStr
+	case ForUpgradeOption:
+		return ForUpgradeOptionStr
+	default:
+		return "Unknown MaintenanceOption"
+	}
+}
+
+// ToString returns the string associated with the XAAction
+func (action XAAction) ToString() string {
+	switch action {
+	case XAStart:
+		return XAStartStr
+	case XAEnd:
+		return XAEndStr
+	case XAPrepare:
+		return XAPrepareStr
+	case XACommit:
+		return XACommitStr
+	case XARollback:
+		return XARollbackStr
+	case XARecover:
+		return XARecoverStr
+	default:
+		return "Unknown XAAction"
+	}
+}
+
+// ToString returns the string associated with the XAOption
+func (option XAOption) ToString() string {
+	switch option {
+	case NoXAOption:
+		return ""
+	case JoinXAOption:
+		return JoinXAOptionStr
+	case ResumeXAOption:
+		return ResumeXAOptionStr
+	case SuspendXAOption:
+		return SuspendXAOptionStr
+	case SuspendForMigrateXAOption:
+		return SuspendForMigrateXAOptionStr
+	case OnePhaseXAOption:
+		return OnePhaseXAOptionStr
+	default:
+		return "Unknown XAOption"
+	}
+}
+
+// ToString returns the thread types of START and STOP REPLICA, with a leading space.
+func (threads ReplicaThreads) ToString() string {
+	switch threads {
+	case 0:
+		return ""
+	case IOThread:
+		return " " + IOThreadStr
+	case SQLThread:
+		return " " + SQLThreadStr
+	default:
+		return " " + IOThreadStr + ", " + SQLThreadStr
+	}
+}
+
+// ToString returns the string associated with the KillType
+func (ty KillType) ToString() string {
+	switch ty {
+	case ConnectionKill:
+		return ConnectionKillStr
+	case QueryKill:
+		return QueryKillStr
+	default:
+		return "Unknown KillType"
+	}
+}
+
+// merge sets the options specified in other. Options that are
+// given more than once keep the last value, as in MySQL.
+func (node *LoadFields) merge(other *LoadFields) {
+	if other.Terminated != nil {
+		node.Terminated = other.Terminated
+	}
+	if other.Enclosed != nil {
+		node.Enclosed = other.Enclosed
+		node.Optionally = other.Optionally
+	}
+	if other.Escaped != nil {
+		node.Escaped = other.Escaped
+	}
+}
+
+// merge sets the options specified in other. Options that are
+// given more than once keep the last value, as in MySQL.
+func (node *LoadLines) merge(other *LoadLines) {
+	if other.Starting != nil {
+		node.Starting = other.Starting
+	}
+	if other.Terminated != nil {
+		node.Terminated = other.Terminated
+	}
+}
+
 // ToString returns the type as a string
 func (ty ExplainType) ToString() string {
 	switch ty {
@@ -1475,6 +2360,8 @@ func (sel SelectIntoType) ToString() string {
 		return IntoOutfileS3Str
 	case IntoDumpfile:
 		return IntoDumpfileStr
+	case IntoVariables:
+		return IntoVariablesStr
 	default:
 		return "Unknown Select Into Type"
 	}
@@ -1591,6 +2478,28 @@ func (ty ShowCommandType) ToString() string {
 		return WarningsStr
 	case Keyspace:
 		return KeyspaceStr
+	case BinaryLogs:
+		return BinaryLogsStr
+	case CountErrors:
+		return CountErrorsStr
+	case CountWarnings:
+		return CountWarningsStr
+	case CreateUsr:
+		return CreateUsrStr
+	case Errors:
+		return ErrorsStr
+	case Events:
+		return EventsStr
+	case MasterStatus:
+		return MasterStatusStr
+	case Processlist:
+		return ProcesslistStr
+	case Profiles:
+		return ProfilesStr
+	case Replicas:
+		return ReplicasStr
+	case ReplicaStatus:
+		return ReplicaStatusStr
 	default:
 		return "" +
 			"Unknown ShowCommandType"
@@ -1697,15 +2606,6 @@ func formatIdentifier(id string) string {
 	return buf.String()
 }
 
-func formatAddress(address string) string {
-	if len(address) > 0 && address[0] == '\'' {
-		return address
-	}
-	buf := NewTrackedBuffer(nil)
-	formatID(buf, address, NoAt)
-	return buf.String()
-}
-
 // ContainsAggregation returns true if the expression contains aggregation
 func ContainsAggregation(e SQLNode) bool {
 	hasAggregates := false
@@ -1724,7 +2624,7 @@ func IsAggregation(node SQLNode) bool {
 	switch node := node.(type) {
 	case *FuncExpr:
 		return node.IsAggregate()
-	case *GroupConcatExpr:
+	case *GroupConcatExpr, *GroupingExpr:
 		return true
 	}
 	return false
@@ -1740,6 +2640,8 @@ func GetFirstSelect(selStmt SelectStatement) *Select {
 		return node
 	case *Union:
 		return GetFirstSelect(node.Left)
+	case *ValuesStatement, *TableStatement:
+		return nil
 	}
 	panic("[BUG]: unknown type for SelectStatement")
 }
@@ -1751,6 +2653,8 @@ func GetAllSelects(selStmt SelectStatement) []*Select {
 		return []*Select{node}
 	case *Union:
 		return append(GetAllSelects(node.Left), GetAllSelects(node.Right)...)
+	case *ValuesStatement, *TableStatement:
+		return nil
 	}
 	panic("[BUG]: unknown type for SelectStatement")
 }
@@ -1802,7 +2706,7 @@ func (es *ExtractedSubquery) updateAlternative() {
 			// :__sq_has_values = 0 or other_side not in ::__sq
 			cmp.Right = NewListArg(es.argName)
 			hasValue := &ComparisonExpr{Left: NewArgument(es.hasValuesArg), Right: NewIntLiteral("0"), Operator: EqualOp}
-			expr = &OrExpr{hasValue, cmp}
+			expr = &OrExpr{Left: hasValue, Right: cmp}
 		}
 		es.alternative = expr
 	}
@@ -1856,6 +2760,106 @@ func defaultRequiresParens(ct *ColumnType) bool {
 	return true
 }
 
+// changeReplicationOptions are the options of CHANGE REPLICATION SOURCE TO.
+// All of them take a value.
+var changeReplicationOptions = map[string]bool{
+	"source_bind":                            true,
+	"source_host":                            true,
+	"source_user":                            true,
+	"source_password":                        true,
+	"source_port":                            true,
+	"privilege_checks_user":                  true,
+	"require_row_format":                     true,
+	"require_table_primary_key_check":        true,
+	"assign_gtids_to_anonymous_transactions": true,
+	"source_log_file":                        true,
+	"source_log_pos":                         true,
+	"source_auto_position":                   true,
+	"relay_log_file":                         true,
+	"relay_log_pos":                          true,
+	"source_heartbeat_period":                true,
+	"source_connect_retry":                   true,
+	"source_retry_count":                     true,
+	"source_connection_auto_failover":        true,
+	"source_delay":                           true,
+	"source_compression_algorithms":          true,
+	"source_zstd_compression_level":          true,
+	"source_ssl":                             true,
+	"source_ssl_ca":                          true,
+	"source_ssl_capath":                      true,
+	"source_ssl_cert":                        true,
+	"source_ssl_crl":                         true,
+	"source_ssl_crlpath":                     true,
+	"source_ssl_key":                         true,
+	"source_ssl_cipher":                      true,
+	"source_ssl_verify_server_cert":          true,
+	"source_tls_version":                     true,
+	"source_tls_ciphersuites":                true,
+	"source_public_key_path":                 true,
+	"get_source_public_key":                  true,
+	"network_namespace":                      true,
+	"ignore_server_ids":                      true,
+	"gtid_only":                              true,
+}
+
+// untilReplicationOptions are the options of START REPLICA ... UNTIL,
+// mapped to whether they take a value.
+var untilReplicationOptions = map[string]bool{
+	"sql_before_gtids":   true,
+	"sql_after_gtids":    true,
+	"sql_after_mts_gaps": false,
+	"source_log_file":    true,
+	"source_log_pos":     true,
+	"relay_log_file":     true,
+	"relay_log_pos":      true,
+}
+
+// replicaConnectionOptions are the connection options of START REPLICA.
+var replicaConnectionOptions = map[string]bool{
+	"user":         true,
+	"password":     true,
+	"default_auth": true,
+	"plugin_dir":   true,
+}
+
+// sourceOptionName returns the SOURCE_ name of a replication option,
+// e.g. source_host for master_host.
+func sourceOptionName(name string) string {
+	return strings.Replace(strings.ToLower(name), "master_", "source_", 1)
+}
+
+// checkReplicationOptions checks that the options are known and take a value
+// exactly when they are expected to. The MASTER_ options are checked as their
+// SOURCE_ aliases.
+func checkReplicationOptions(options ReplicationOptions, known map[string]bool) error {
+	for _, option := range options {
+		hasValue, ok := known[sourceOptionName(option.Name)]
+		if !ok {
+			return coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "unknown replication option '%s'", option.Name)
+		}
+		if hasValue && option.Value == nil {
+			return coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "replication option '%s' requires a value", option.Name)
+		}
+		if !hasValue && option.Value != nil {
+			return coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "replication option '%s' does not take a value", option.Name)
+		}
+	}
+	return nil
+}
+
+// Get returns the option with the given name, or nil if there is none.
+// The MASTER_ and SOURCE_ names of an option are interchangeable,
+// e.g. Get("source_host") finds MASTER_HOST = 'h'.
+func (options ReplicationOptions) Get(name string) *ReplicationOption {
+	name = sourceOptionName(name)
+	for _, option := range options {
+		if sourceOptionName(option.Name) == name {
+			return option
+		}
+	}
+	return nil
+}
+
 // RemoveKeyspaceFromColName removes the Qualifier.Qualifier on all ColNames in the expression tree
 func RemoveKeyspaceFromColName(expr Expr) Expr {
 	return RemoveKeyspace(expr).(Expr) // This hard cast is safe because we do not change the type the input
@@ -1878,3 +2882,39 @@ func convertStringToInt(integer string) int {
 	val, _ := strconv.Atoi(integer)
 	return val
 }
+
+func convertStringToUint64(integer string) (uint64, error) {
+	return strconv.ParseUint(integer, 10, 64)
+}
+
+// profileTypes are the information types accepted by SHOW PROFILE.
+var profileTypes = map[string]bool{
+	"all":              true,
+	"block io":         true,
+	"context switches": true,
+	"cpu":              true,
+	"ipc":              true,
+	"memory":           true,
+	"page faults":      true,
+	"source":           true,
+	"swaps":            true,
+}
+
+// checkProfileType returns the given SHOW PROFILE type if it is known, or an error otherwise.
+func checkProfileType(ty string) (string, error) {
+	if !profileTypes[ty] {
+		return "", coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "unknown profile type '%s'", ty)
+	}
+	return ty, nil
+}
+
+// formatRoutineStatement formats a statement of the body of a stored program,
+// in which BEGIN starts a BEGIN ... END block. So a Begin is formatted as
+// START TRANSACTION.
+func formatRoutineStatement(buf *TrackedBuffer, stmt Statement) {
+	if _, ok := stmt.(*Begin); ok {
+		buf.literal("start transaction")
+		return
+	}
+	buf.formatter(stmt)
+}
@@ -81,3 +81,65 @@ func TestAddQueryHint(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsAggregation(t *testing.T) {
+	tcs := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "a + 1", want: false},
+		{expr: "count(*)", want: true},
+		{expr: "group_concat(a)", want: true},
+		{expr: "grouping(a, b)", want: true},
+		{expr: "if(grouping(a) = 1, 'all', a)", want: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.expr, func(t *testing.T) {
+			stmt, err := Parse("select " + tc.expr + " from t")
+			require.NoError(t, err)
+			expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			assert.Equal(t, tc.want, ContainsAggregation(expr))
+		})
+	}
+}
+
+func TestRewriteValuesFuncs(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "insert into t(a, b) values (1, 2) on duplicate key update a = values(a) + values(b)",
+		out: "insert into t(a, b) values (1, 2) as new on duplicate key update a = new.a + new.b",
+	}, {
+		in:  "insert into t(a, b) values (1, 2) as r on duplicate key update b = values(b)",
+		out: "insert into t(a, b) values (1, 2) as r on duplicate key update b = r.b",
+	}, {
+		in:  "insert into t(a, b) values (1, 2) as r(x, y) on duplicate key update a = values(b)",
+		out: "insert into t(a, b) values (1, 2) as r(x, y) on duplicate key update a = r.y",
+	}, {
+		in:  "insert into t(a, b) values (1, 2)",
+		out: "insert into t(a, b) values (1, 2)",
+	}, {
+		in:  "insert into t values (1, 2) as r(x, y) on duplicate key update a = values(a)",
+		err: "column a has no alias in the row alias",
+	}, {
+		in:  "insert into t select * from u on duplicate key update a = values(a)",
+		err: "row alias is only supported with INSERT ... VALUES",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			stmt, err := Parse(tcase.in)
+			require.NoError(t, err)
+			ins := stmt.(*Insert)
+			err = ins.RewriteValuesFuncs("new")
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				assert.Equal(t, tcase.in, String(ins))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, String(ins))
+		})
+	}
+}
@@ -24,6 +24,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 	switch node := node.(type) {
 	case AccessMode:
 		return a.rewriteAccessMode(parent, node, replacer)
+	case *Account:
+		return a.rewriteRefOfAccount(parent, node, replacer)
+	case Accounts:
+		return a.rewriteAccounts(parent, node, replacer)
 	case *AddColumns:
 		return a.rewriteRefOfAddColumns(parent, node, replacer)
 	case *AddConstraintDefinition:
@@ -44,24 +48,42 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
 	case *AlterIndex:
 		return a.rewriteRefOfAlterIndex(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterRoutine:
+		return a.rewriteRefOfAlterRoutine(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
+	case *AlterUser:
+		return a.rewriteRefOfAlterUser(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
 	case *AlterVschema:
 		return a.rewriteRefOfAlterVschema(parent, node, replacer)
+	case *AnalyzeTable:
+		return a.rewriteRefOfAnalyzeTable(parent, node, replacer)
 	case *AndExpr:
 		return a.rewriteRefOfAndExpr(parent, node, replacer)
 	case Argument:
 		return a.rewriteArgument(parent, node, replacer)
+	case *ArgumentLessWindowExpr:
+		return a.rewriteRefOfArgumentLessWindowExpr(parent, node, replacer)
+	case *AuthOption:
+		return a.rewriteRefOfAuthOption(parent, node, replacer)
 	case *AutoIncSpec:
 		return a.rewriteRefOfAutoIncSpec(parent, node, replacer)
+	case *BadDefinition:
+		return a.rewriteRefOfBadDefinition(parent, node, replacer)
+	case *BadStmt:
+		return a.rewriteRefOfBadStmt(parent, node, replacer)
 	case *Begin:
 		return a.rewriteRefOfBegin(parent, node, replacer)
+	case *BeginEndBlock:
+		return a.rewriteRefOfBeginEndBlock(parent, node, replacer)
 	case *BetweenExpr:
 		return a.rewriteRefOfBetweenExpr(parent, node, replacer)
 	case *BinaryExpr:
@@ -72,10 +94,22 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCallProc(parent, node, replacer)
 	case *CaseExpr:
 		return a.rewriteRefOfCaseExpr(parent, node, replacer)
+	case *CaseStmt:
+		return a.rewriteRefOfCaseStmt(parent, node, replacer)
+	case *CaseStmtWhen:
+		return a.rewriteRefOfCaseStmtWhen(parent, node, replacer)
 	case *ChangeColumn:
 		return a.rewriteRefOfChangeColumn(parent, node, replacer)
+	case *ChangeReplicationSource:
+		return a.rewriteRefOfChangeReplicationSource(parent, node, replacer)
 	case *CheckConstraintDefinition:
 		return a.rewriteRefOfCheckConstraintDefinition(parent, node, replacer)
+	case *CheckTable:
+		return a.rewriteRefOfCheckTable(parent, node, replacer)
+	case *ChecksumTable:
+		return a.rewriteRefOfChecksumTable(parent, node, replacer)
+	case *CloseCursor:
+		return a.rewriteRefOfCloseCursor(parent, node, replacer)
 	case ColIdent:
 		return a.rewriteColIdent(parent, node, replacer)
 	case *ColName:
@@ -94,6 +128,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCommonTableExpr(parent, node, replacer)
 	case *ComparisonExpr:
 		return a.rewriteRefOfComparisonExpr(parent, node, replacer)
+	case CompoundStatements:
+		return a.rewriteCompoundStatements(parent, node, replacer)
 	case *ConstraintDefinition:
 		return a.rewriteRefOfConstraintDefinition(parent, node, replacer)
 	case *ConvertExpr:
@@ -104,14 +140,34 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfConvertUsingExpr(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
+	case *CreateRole:
+		return a.rewriteRefOfCreateRole(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateUser:
+		return a.rewriteRefOfCreateUser(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
 	case *CurTimeFuncExpr:
 		return a.rewriteRefOfCurTimeFuncExpr(parent, node, replacer)
 	case *DeallocateStmt:
 		return a.rewriteRefOfDeallocateStmt(parent, node, replacer)
+	case *DeclareCondition:
+		return a.rewriteRefOfDeclareCondition(parent, node, replacer)
+	case *DeclareCursor:
+		return a.rewriteRefOfDeclareCursor(parent, node, replacer)
+	case *DeclareHandler:
+		return a.rewriteRefOfDeclareHandler(parent, node, replacer)
+	case *DeclareVar:
+		return a.rewriteRefOfDeclareVar(parent, node, replacer)
 	case *Default:
 		return a.rewriteRefOfDefault(parent, node, replacer)
 	case *Definer:
@@ -120,16 +176,28 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfDelete(parent, node, replacer)
 	case *DerivedTable:
 		return a.rewriteRefOfDerivedTable(parent, node, replacer)
+	case *DoStmt:
+		return a.rewriteRefOfDoStmt(parent, node, replacer)
 	case *DropColumn:
 		return a.rewriteRefOfDropColumn(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
 	case *DropKey:
 		return a.rewriteRefOfDropKey(parent, node, replacer)
+	case *DropProgram:
+		return a.rewriteRefOfDropProgram(parent, node, replacer)
+	case *DropRole:
+		return a.rewriteRefOfDropRole(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
+	case *DropUser:
+		return a.rewriteRefOfDropUser(parent, node, replacer)
 	case *DropView:
 		return a.rewriteRefOfDropView(parent, node, replacer)
+	case *ElseIf:
+		return a.rewriteRefOfElseIf(parent, node, replacer)
+	case *EventSchedule:
+		return a.rewriteRefOfEventSchedule(parent, node, replacer)
 	case *ExecuteStmt:
 		return a.rewriteRefOfExecuteStmt(parent, node, replacer)
 	case *ExistsExpr:
@@ -144,18 +212,50 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfExtractFuncExpr(parent, node, replacer)
 	case *ExtractedSubquery:
 		return a.rewriteRefOfExtractedSubquery(parent, node, replacer)
+	case *FetchCursor:
+		return a.rewriteRefOfFetchCursor(parent, node, replacer)
+	case *FirstOrLastValueExpr:
+		return a.rewriteRefOfFirstOrLastValueExpr(parent, node, replacer)
 	case *Flush:
 		return a.rewriteRefOfFlush(parent, node, replacer)
 	case *Force:
 		return a.rewriteRefOfForce(parent, node, replacer)
 	case *ForeignKeyDefinition:
 		return a.rewriteRefOfForeignKeyDefinition(parent, node, replacer)
+	case *FrameClause:
+		return a.rewriteRefOfFrameClause(parent, node, replacer)
+	case *FramePoint:
+		return a.rewriteRefOfFramePoint(parent, node, replacer)
+	case *FromFirstLastClause:
+		return a.rewriteRefOfFromFirstLastClause(parent, node, replacer)
 	case *FuncExpr:
 		return a.rewriteRefOfFuncExpr(parent, node, replacer)
+	case *GeomDistanceExpr:
+		return a.rewriteRefOfGeomDistanceExpr(parent, node, replacer)
+	case *GeomDistanceSphereExpr:
+		return a.rewriteRefOfGeomDistanceSphereExpr(parent, node, replacer)
+	case *GeomFormatExpr:
+		return a.rewriteRefOfGeomFormatExpr(parent, node, replacer)
+	case *GeomFromTextExpr:
+		return a.rewriteRefOfGeomFromTextExpr(parent, node, replacer)
+	case *GeomRelationExpr:
+		return a.rewriteRefOfGeomRelationExpr(parent, node, replacer)
+	case *GeomSRIDExpr:
+		return a.rewriteRefOfGeomSRIDExpr(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
+	case *GrantTarget:
+		return a.rewriteRefOfGrantTarget(parent, node, replacer)
 	case GroupBy:
 		return a.rewriteGroupBy(parent, node, replacer)
 	case *GroupConcatExpr:
 		return a.rewriteRefOfGroupConcatExpr(parent, node, replacer)
+	case *GroupingExpr:
+		return a.rewriteRefOfGroupingExpr(parent, node, replacer)
+	case *HandlerCondition:
+		return a.rewriteRefOfHandlerCondition(parent, node, replacer)
+	case *IfStmt:
+		return a.rewriteRefOfIfStmt(parent, node, replacer)
 	case *IndexDefinition:
 		return a.rewriteRefOfIndexDefinition(parent, node, replacer)
 	case *IndexHint:
@@ -166,6 +266,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfIndexInfo(parent, node, replacer)
 	case *Insert:
 		return a.rewriteRefOfInsert(parent, node, replacer)
+	case *InstallComponent:
+		return a.rewriteRefOfInstallComponent(parent, node, replacer)
+	case *InstallPlugin:
+		return a.rewriteRefOfInstallPlugin(parent, node, replacer)
 	case *IntervalExpr:
 		return a.rewriteRefOfIntervalExpr(parent, node, replacer)
 	case *IntroducerExpr:
@@ -174,6 +278,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfIsExpr(parent, node, replacer)
 	case IsolationLevel:
 		return a.rewriteIsolationLevel(parent, node, replacer)
+	case *IterateStmt:
+		return a.rewriteRefOfIterateStmt(parent, node, replacer)
 	case *JSONArrayExpr:
 		return a.rewriteRefOfJSONArrayExpr(parent, node, replacer)
 	case *JSONAttributesExpr:
@@ -228,6 +334,12 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfJtOnResponse(parent, node, replacer)
 	case *KeyState:
 		return a.rewriteRefOfKeyState(parent, node, replacer)
+	case *Kill:
+		return a.rewriteRefOfKill(parent, node, replacer)
+	case *LagLeadExpr:
+		return a.rewriteRefOfLagLeadExpr(parent, node, replacer)
+	case *LeaveStmt:
+		return a.rewriteRefOfLeaveStmt(parent, node, replacer)
 	case *Limit:
 		return a.rewriteRefOfLimit(parent, node, replacer)
 	case ListArg:
@@ -236,10 +348,20 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfLiteral(parent, node, replacer)
 	case *Load:
 		return a.rewriteRefOfLoad(parent, node, replacer)
+	case *LoadFields:
+		return a.rewriteRefOfLoadFields(parent, node, replacer)
+	case *LoadLines:
+		return a.rewriteRefOfLoadLines(parent, node, replacer)
+	case *LockClause:
+		return a.rewriteRefOfLockClause(parent, node, replacer)
+	case LockClauses:
+		return a.rewriteLockClauses(parent, node, replacer)
 	case *LockOption:
 		return a.rewriteRefOfLockOption(parent, node, replacer)
 	case *LockTables:
 		return a.rewriteRefOfLockTables(parent, node, replacer)
+	case *LoopStmt:
+		return a.rewriteRefOfLoopStmt(parent, node, replacer)
 	case MatchAction:
 		return a.rewriteMatchAction(parent, node, replacer)
 	case *MatchExpr:
@@ -248,18 +370,28 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfMemberOfExpr(parent, node, replacer)
 	case *ModifyColumn:
 		return a.rewriteRefOfModifyColumn(parent, node, replacer)
+	case *NTHValueExpr:
+		return a.rewriteRefOfNTHValueExpr(parent, node, replacer)
 	case *Nextval:
 		return a.rewriteRefOfNextval(parent, node, replacer)
 	case *NotExpr:
 		return a.rewriteRefOfNotExpr(parent, node, replacer)
+	case *NtileExpr:
+		return a.rewriteRefOfNtileExpr(parent, node, replacer)
+	case *NullTreatmentClause:
+		return a.rewriteRefOfNullTreatmentClause(parent, node, replacer)
 	case *NullVal:
 		return a.rewriteRefOfNullVal(parent, node, replacer)
 	case Offset:
 		return a.rewriteOffset(parent, node, replacer)
 	case OnDup:
 		return a.rewriteOnDup(parent, node, replacer)
+	case *OpenCursor:
+		return a.rewriteRefOfOpenCursor(parent, node, replacer)
 	case *OptLike:
 		return a.rewriteRefOfOptLike(parent, node, replacer)
+	case *OptimizeTable:
+		return a.rewriteRefOfOptimizeTable(parent, node, replacer)
 	case *OrExpr:
 		return a.rewriteRefOfOrExpr(parent, node, replacer)
 	case *Order:
@@ -272,6 +404,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfOtherAdmin(parent, node, replacer)
 	case *OtherRead:
 		return a.rewriteRefOfOtherRead(parent, node, replacer)
+	case *OverClause:
+		return a.rewriteRefOfOverClause(parent, node, replacer)
 	case *ParenTableExpr:
 		return a.rewriteRefOfParenTableExpr(parent, node, replacer)
 	case *ParsedComments:
@@ -290,12 +424,32 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfPartitionValueRange(parent, node, replacer)
 	case Partitions:
 		return a.rewritePartitions(parent, node, replacer)
+	case *PasswordOption:
+		return a.rewriteRefOfPasswordOption(parent, node, replacer)
+	case PasswordOptions:
+		return a.rewritePasswordOptions(parent, node, replacer)
 	case *PrepareStmt:
 		return a.rewriteRefOfPrepareStmt(parent, node, replacer)
+	case *PrivilegeSpec:
+		return a.rewriteRefOfPrivilegeSpec(parent, node, replacer)
+	case Privileges:
+		return a.rewritePrivileges(parent, node, replacer)
+	case *ProcParameter:
+		return a.rewriteRefOfProcParameter(parent, node, replacer)
+	case *PurgeBinaryLogs:
+		return a.rewriteRefOfPurgeBinaryLogs(parent, node, replacer)
 	case ReferenceAction:
 		return a.rewriteReferenceAction(parent, node, replacer)
 	case *ReferenceDefinition:
 		return a.rewriteRefOfReferenceDefinition(parent, node, replacer)
+	case *RegexpInstrExpr:
+		return a.rewriteRefOfRegexpInstrExpr(parent, node, replacer)
+	case *RegexpLikeExpr:
+		return a.rewriteRefOfRegexpLikeExpr(parent, node, replacer)
+	case *RegexpReplaceExpr:
+		return a.rewriteRefOfRegexpReplaceExpr(parent, node, replacer)
+	case *RegexpSubstrExpr:
+		return a.rewriteRefOfRegexpSubstrExpr(parent, node, replacer)
 	case *Release:
 		return a.rewriteRefOfRelease(parent, node, replacer)
 	case *RenameIndex:
@@ -304,12 +458,40 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfRenameTable(parent, node, replacer)
 	case *RenameTableName:
 		return a.rewriteRefOfRenameTableName(parent, node, replacer)
+	case *RenameUser:
+		return a.rewriteRefOfRenameUser(parent, node, replacer)
+	case *RepairTable:
+		return a.rewriteRefOfRepairTable(parent, node, replacer)
+	case *RepeatStmt:
+		return a.rewriteRefOfRepeatStmt(parent, node, replacer)
+	case *ReplicationOption:
+		return a.rewriteRefOfReplicationOption(parent, node, replacer)
+	case ReplicationOptions:
+		return a.rewriteReplicationOptions(parent, node, replacer)
+	case *ResetMaster:
+		return a.rewriteRefOfResetMaster(parent, node, replacer)
+	case *ResetReplica:
+		return a.rewriteRefOfResetReplica(parent, node, replacer)
+	case *ResourceOption:
+		return a.rewriteRefOfResourceOption(parent, node, replacer)
+	case ResourceOptions:
+		return a.rewriteResourceOptions(parent, node, replacer)
+	case *ReturnStmt:
+		return a.rewriteRefOfReturnStmt(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
+	case *RoleSpec:
+		return a.rewriteRefOfRoleSpec(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
 	case RootNode:
 		return a.rewriteRootNode(parent, node, replacer)
+	case *RoutineCharacteristic:
+		return a.rewriteRefOfRoutineCharacteristic(parent, node, replacer)
+	case *RowAlias:
+		return a.rewriteRefOfRowAlias(parent, node, replacer)
 	case *SRollback:
 		return a.rewriteRefOfSRollback(parent, node, replacer)
 	case *Savepoint:
@@ -322,28 +504,52 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfSelectInto(parent, node, replacer)
 	case *Set:
 		return a.rewriteRefOfSet(parent, node, replacer)
+	case *SetDefaultRole:
+		return a.rewriteRefOfSetDefaultRole(parent, node, replacer)
 	case *SetExpr:
 		return a.rewriteRefOfSetExpr(parent, node, replacer)
 	case SetExprs:
 		return a.rewriteSetExprs(parent, node, replacer)
+	case *SetPassword:
+		return a.rewriteRefOfSetPassword(parent, node, replacer)
+	case *SetRole:
+		return a.rewriteRefOfSetRole(parent, node, replacer)
 	case *SetTransaction:
 		return a.rewriteRefOfSetTransaction(parent, node, replacer)
 	case *Show:
 		return a.rewriteRefOfShow(parent, node, replacer)
 	case *ShowBasic:
 		return a.rewriteRefOfShowBasic(parent, node, replacer)
+	case *ShowBinlogEvents:
+		return a.rewriteRefOfShowBinlogEvents(parent, node, replacer)
 	case *ShowCreate:
 		return a.rewriteRefOfShowCreate(parent, node, replacer)
+	case *ShowEngine:
+		return a.rewriteRefOfShowEngine(parent, node, replacer)
 	case *ShowFilter:
 		return a.rewriteRefOfShowFilter(parent, node, replacer)
+	case *ShowGrants:
+		return a.rewriteRefOfShowGrants(parent, node, replacer)
 	case *ShowMigrationLogs:
 		return a.rewriteRefOfShowMigrationLogs(parent, node, replacer)
 	case *ShowOther:
 		return a.rewriteRefOfShowOther(parent, node, replacer)
+	case *ShowProfile:
+		return a.rewriteRefOfShowProfile(parent, node, replacer)
 	case *ShowThrottledApps:
 		return a.rewriteRefOfShowThrottledApps(parent, node, replacer)
+	case *Shutdown:
+		return a.rewriteRefOfShutdown(parent, node, replacer)
+	case *Signal:
+		return a.rewriteRefOfSignal(parent, node, replacer)
+	case *SignalInfo:
+		return a.rewriteRefOfSignalInfo(parent, node, replacer)
 	case *StarExpr:
 		return a.rewriteRefOfStarExpr(parent, node, replacer)
+	case *StartReplica:
+		return a.rewriteRefOfStartReplica(parent, node, replacer)
+	case *StopReplica:
+		return a.rewriteRefOfStopReplica(parent, node, replacer)
 	case *Stream:
 		return a.rewriteRefOfStream(parent, node, replacer)
 	case *SubPartition:
@@ -358,6 +564,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfSubquery(parent, node, replacer)
 	case *SubstrExpr:
 		return a.rewriteRefOfSubstrExpr(parent, node, replacer)
+	case *TLSOption:
+		return a.rewriteRefOfTLSOption(parent, node, replacer)
+	case TLSOptions:
+		return a.rewriteTLSOptions(parent, node, replacer)
 	case TableExprs:
 		return a.rewriteTableExprs(parent, node, replacer)
 	case TableIdent:
@@ -370,16 +580,24 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteTableOptions(parent, node, replacer)
 	case *TableSpec:
 		return a.rewriteRefOfTableSpec(parent, node, replacer)
+	case *TableStatement:
+		return a.rewriteRefOfTableStatement(parent, node, replacer)
 	case *TablespaceOperation:
 		return a.rewriteRefOfTablespaceOperation(parent, node, replacer)
 	case *TimestampFuncExpr:
 		return a.rewriteRefOfTimestampFuncExpr(parent, node, replacer)
+	case *TriggerOrder:
+		return a.rewriteRefOfTriggerOrder(parent, node, replacer)
 	case *TrimFuncExpr:
 		return a.rewriteRefOfTrimFuncExpr(parent, node, replacer)
 	case *TruncateTable:
 		return a.rewriteRefOfTruncateTable(parent, node, replacer)
 	case *UnaryExpr:
 		return a.rewriteRefOfUnaryExpr(parent, node, replacer)
+	case *UninstallComponent:
+		return a.rewriteRefOfUninstallComponent(parent, node, replacer)
+	case *UninstallPlugin:
+		return a.rewriteRefOfUninstallPlugin(parent, node, replacer)
 	case *Union:
 		return a.rewriteRefOfUnion(parent, node, replacer)
 	case *UnlockTables:
@@ -392,6 +610,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteUpdateExprs(parent, node, replacer)
 	case *Use:
 		return a.rewriteRefOfUse(parent, node, replacer)
+	case *UserSpec:
+		return a.rewriteRefOfUserSpec(parent, node, replacer)
+	case UserSpecs:
+		return a.rewriteUserSpecs(parent, node, replacer)
 	case *VStream:
 		return a.rewriteRefOfVStream(parent, node, replacer)
 	case ValTuple:
@@ -402,6 +624,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteValues(parent, node, replacer)
 	case *ValuesFuncExpr:
 		return a.rewriteRefOfValuesFuncExpr(parent, node, replacer)
+	case *ValuesStatement:
+		return a.rewriteRefOfValuesStatement(parent, node, replacer)
 	case VindexParam:
 		return a.rewriteVindexParam(parent, node, replacer)
 	case *VindexSpec:
@@ -412,8 +636,20 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfWhen(parent, node, replacer)
 	case *Where:
 		return a.rewriteRefOfWhere(parent, node, replacer)
+	case *WhileStmt:
+		return a.rewriteRefOfWhileStmt(parent, node, replacer)
+	case *WindowDefinition:
+		return a.rewriteRefOfWindowDefinition(parent, node, replacer)
+	case WindowDefinitions:
+		return a.rewriteWindowDefinitions(parent, node, replacer)
+	case *WindowSpecification:
+		return a.rewriteRefOfWindowSpecification(parent, node, replacer)
 	case *With:
 		return a.rewriteRefOfWith(parent, node, replacer)
+	case *XATransaction:
+		return a.rewriteRefOfXATransaction(parent, node, replacer)
+	case *Xid:
+		return a.rewriteRefOfXid(parent, node, replacer)
 	case *XorExpr:
 		return a.rewriteRefOfXorExpr(parent, node, replacer)
 	default:
@@ -421,6 +657,67 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return true
 	}
 }
+func (a *application) rewriteRefOfAccount(parent SQLNode, node *Account, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteAccounts(parent SQLNode, node Accounts, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Accounts)
+			a.cur.revisit = false
+			return a.rewriteAccounts(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Accounts)[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAddColumns(parent SQLNode, node *AddColumns, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -700,6 +997,58 @@ func (a *application) rewriteRefOfAlterDatabase(parent SQLNode, node *AlterDatab
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterEvent(parent SQLNode, node *AlterEvent, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfEventSchedule(node, node.Schedule, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Schedule = newNode.(*EventSchedule)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.RenameTo, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).RenameTo = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Body = newNode.(Statement)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterIndex(parent SQLNode, node *AlterIndex, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -754,6 +1103,47 @@ func (a *application) rewriteRefOfAlterMigration(parent SQLNode, node *AlterMigr
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterRoutine(parent SQLNode, node *AlterRoutine, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterRoutine).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterRoutine).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterRoutine).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterTable(parent SQLNode, node *AlterTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -805,6 +1195,53 @@ func (a *application) rewriteRefOfAlterTable(parent SQLNode, node *AlterTable, r
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterUser(parent SQLNode, node *AlterUser, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteUserSpecs(node, node.Users, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).Users = newNode.(UserSpecs)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfRoleSpec(node, node.DefaultRole, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).DefaultRole = newNode.(*RoleSpec)
+	}) {
+		return false
+	}
+	if !a.rewriteTLSOptions(node, node.TLSOptions, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).TLSOptions = newNode.(TLSOptions)
+	}) {
+		return false
+	}
+	if !a.rewriteResourceOptions(node, node.ResourceOptions, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).ResourceOptions = newNode.(ResourceOptions)
+	}) {
+		return false
+	}
+	if !a.rewritePasswordOptions(node, node.PasswordOptions, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).PasswordOptions = newNode.(PasswordOptions)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterView(parent SQLNode, node *AlterView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -898,7 +1335,7 @@ func (a *application) rewriteRefOfAlterVschema(parent SQLNode, node *AlterVschem
 	}
 	return true
 }
-func (a *application) rewriteRefOfAndExpr(parent SQLNode, node *AndExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfAnalyzeTable(parent SQLNode, node *AnalyzeTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -910,13 +1347,18 @@ func (a *application) rewriteRefOfAndExpr(parent SQLNode, node *AndExpr, replace
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*AndExpr).Left = newNode.(Expr)
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*AnalyzeTable).Tables = newNode.(TableNames)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
-		parent.(*AndExpr).Right = newNode.(Expr)
+	if !a.rewriteColumns(node, node.HistogramColumns, func(newNode, parent SQLNode) {
+		parent.(*AnalyzeTable).HistogramColumns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Buckets, func(newNode, parent SQLNode) {
+		parent.(*AnalyzeTable).Buckets = newNode.(*Literal)
 	}) {
 		return false
 	}
@@ -930,7 +1372,7 @@ func (a *application) rewriteRefOfAndExpr(parent SQLNode, node *AndExpr, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfAutoIncSpec(parent SQLNode, node *AutoIncSpec, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfAndExpr(parent SQLNode, node *AndExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -942,13 +1384,13 @@ func (a *application) rewriteRefOfAutoIncSpec(parent SQLNode, node *AutoIncSpec,
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Column, func(newNode, parent SQLNode) {
-		parent.(*AutoIncSpec).Column = newNode.(ColIdent)
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*AndExpr).Left = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteTableName(node, node.Sequence, func(newNode, parent SQLNode) {
-		parent.(*AutoIncSpec).Sequence = newNode.(TableName)
+	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
+		parent.(*AndExpr).Right = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -962,7 +1404,7 @@ func (a *application) rewriteRefOfAutoIncSpec(parent SQLNode, node *AutoIncSpec,
 	}
 	return true
 }
-func (a *application) rewriteRefOfBegin(parent SQLNode, node *Begin, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfArgumentLessWindowExpr(parent SQLNode, node *ArgumentLessWindowExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -974,19 +1416,22 @@ func (a *application) rewriteRefOfBegin(parent SQLNode, node *Begin, replacer re
 			return true
 		}
 	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*ArgumentLessWindowExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfBetweenExpr(parent SQLNode, node *BetweenExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfAuthOption(parent SQLNode, node *AuthOption, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -998,32 +1443,19 @@ func (a *application) rewriteRefOfBetweenExpr(parent SQLNode, node *BetweenExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*BetweenExpr).Left = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.From, func(newNode, parent SQLNode) {
-		parent.(*BetweenExpr).From = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.To, func(newNode, parent SQLNode) {
-		parent.(*BetweenExpr).To = newNode.(Expr)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfBinaryExpr(parent SQLNode, node *BinaryExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfAutoIncSpec(parent SQLNode, node *AutoIncSpec, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1035,13 +1467,13 @@ func (a *application) rewriteRefOfBinaryExpr(parent SQLNode, node *BinaryExpr, r
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*BinaryExpr).Left = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Column, func(newNode, parent SQLNode) {
+		parent.(*AutoIncSpec).Column = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
-		parent.(*BinaryExpr).Right = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Sequence, func(newNode, parent SQLNode) {
+		parent.(*AutoIncSpec).Sequence = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -1055,7 +1487,7 @@ func (a *application) rewriteRefOfBinaryExpr(parent SQLNode, node *BinaryExpr, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfCallProc(parent SQLNode, node *CallProc, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBadDefinition(parent SQLNode, node *BadDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1067,27 +1499,19 @@ func (a *application) rewriteRefOfCallProc(parent SQLNode, node *CallProc, repla
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*CallProc).Name = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
-		parent.(*CallProc).Params = newNode.(Exprs)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfCaseExpr(parent SQLNode, node *CaseExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBadStmt(parent SQLNode, node *BadStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1099,36 +1523,19 @@ func (a *application) rewriteRefOfCaseExpr(parent SQLNode, node *CaseExpr, repla
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*CaseExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	for x, el := range node.Whens {
-		if !a.rewriteRefOfWhen(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*CaseExpr).Whens[idx] = newNode.(*When)
-			}
-		}(x)) {
-			return false
-		}
-	}
-	if !a.rewriteExpr(node, node.Else, func(newNode, parent SQLNode) {
-		parent.(*CaseExpr).Else = newNode.(Expr)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfChangeColumn(parent SQLNode, node *ChangeColumn, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBegin(parent SQLNode, node *Begin, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1140,32 +1547,19 @@ func (a *application) rewriteRefOfChangeColumn(parent SQLNode, node *ChangeColum
 			return true
 		}
 	}
-	if !a.rewriteRefOfColName(node, node.OldColumn, func(newNode, parent SQLNode) {
-		parent.(*ChangeColumn).OldColumn = newNode.(*ColName)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
-		parent.(*ChangeColumn).NewColDefinition = newNode.(*ColumnDefinition)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
-		parent.(*ChangeColumn).After = newNode.(*ColName)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfCheckConstraintDefinition(parent SQLNode, node *CheckConstraintDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBeginEndBlock(parent SQLNode, node *BeginEndBlock, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1177,43 +1571,27 @@ func (a *application) rewriteRefOfCheckConstraintDefinition(parent SQLNode, node
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*CheckConstraintDefinition).Expr = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*BeginEndBlock).Label = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
-			return false
-		}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*BeginEndBlock).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
 	}
-	return true
-}
-func (a *application) rewriteColIdent(parent SQLNode, node ColIdent, replacer replacerFunc) bool {
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfColName(parent SQLNode, node *ColName, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBetweenExpr(parent SQLNode, node *BetweenExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1225,13 +1603,18 @@ func (a *application) rewriteRefOfColName(parent SQLNode, node *ColName, replace
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*ColName).Name = newNode.(ColIdent)
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*BetweenExpr).Left = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteTableName(node, node.Qualifier, func(newNode, parent SQLNode) {
-		parent.(*ColName).Qualifier = newNode.(TableName)
+	if !a.rewriteExpr(node, node.From, func(newNode, parent SQLNode) {
+		parent.(*BetweenExpr).From = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.To, func(newNode, parent SQLNode) {
+		parent.(*BetweenExpr).To = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1245,7 +1628,7 @@ func (a *application) rewriteRefOfColName(parent SQLNode, node *ColName, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfCollateExpr(parent SQLNode, node *CollateExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfBinaryExpr(parent SQLNode, node *BinaryExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1257,8 +1640,13 @@ func (a *application) rewriteRefOfCollateExpr(parent SQLNode, node *CollateExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*CollateExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*BinaryExpr).Left = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
+		parent.(*BinaryExpr).Right = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1272,7 +1660,7 @@ func (a *application) rewriteRefOfCollateExpr(parent SQLNode, node *CollateExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfColumnDefinition(parent SQLNode, node *ColumnDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCallProc(parent SQLNode, node *CallProc, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1284,8 +1672,13 @@ func (a *application) rewriteRefOfColumnDefinition(parent SQLNode, node *ColumnD
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*ColumnDefinition).Name = newNode.(ColIdent)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CallProc).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
+		parent.(*CallProc).Params = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -1299,7 +1692,7 @@ func (a *application) rewriteRefOfColumnDefinition(parent SQLNode, node *ColumnD
 	}
 	return true
 }
-func (a *application) rewriteRefOfColumnType(parent SQLNode, node *ColumnType, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCaseExpr(parent SQLNode, node *CaseExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1311,13 +1704,22 @@ func (a *application) rewriteRefOfColumnType(parent SQLNode, node *ColumnType, r
 			return true
 		}
 	}
-	if !a.rewriteRefOfLiteral(node, node.Length, func(newNode, parent SQLNode) {
-		parent.(*ColumnType).Length = newNode.(*Literal)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*CaseExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
-		parent.(*ColumnType).Scale = newNode.(*Literal)
+	for x, el := range node.Whens {
+		if !a.rewriteRefOfWhen(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CaseExpr).Whens[idx] = newNode.(*When)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteExpr(node, node.Else, func(newNode, parent SQLNode) {
+		parent.(*CaseExpr).Else = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1331,7 +1733,7 @@ func (a *application) rewriteRefOfColumnType(parent SQLNode, node *ColumnType, r
 	}
 	return true
 }
-func (a *application) rewriteColumns(parent SQLNode, node Columns, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCaseStmt(parent SQLNode, node *CaseStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1339,25 +1741,29 @@ func (a *application) rewriteColumns(parent SQLNode, node Columns, replacer repl
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Columns)
-			a.cur.revisit = false
-			return a.rewriteColumns(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*CaseStmt).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Whens {
+		if !a.rewriteRefOfCaseStmtWhen(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(Columns)[idx] = newNode.(ColIdent)
+				parent.(*CaseStmt).Whens[idx] = newNode.(*CaseStmtWhen)
 			}
 		}(x)) {
 			return false
 		}
 	}
+	if !a.rewriteCompoundStatements(node, node.Else, func(newNode, parent SQLNode) {
+		parent.(*CaseStmt).Else = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -1368,7 +1774,7 @@ func (a *application) rewriteColumns(parent SQLNode, node Columns, replacer repl
 	}
 	return true
 }
-func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCaseStmtWhen(parent SQLNode, node *CaseStmtWhen, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1380,19 +1786,27 @@ func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*CaseStmtWhen).Cond = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*CaseStmtWhen).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfCommonTableExpr(parent SQLNode, node *CommonTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfChangeColumn(parent SQLNode, node *ChangeColumn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1404,18 +1818,18 @@ func (a *application) rewriteRefOfCommonTableExpr(parent SQLNode, node *CommonTa
 			return true
 		}
 	}
-	if !a.rewriteTableIdent(node, node.TableID, func(newNode, parent SQLNode) {
-		parent.(*CommonTableExpr).TableID = newNode.(TableIdent)
+	if !a.rewriteRefOfColName(node, node.OldColumn, func(newNode, parent SQLNode) {
+		parent.(*ChangeColumn).OldColumn = newNode.(*ColName)
 	}) {
 		return false
 	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*CommonTableExpr).Columns = newNode.(Columns)
+	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
+		parent.(*ChangeColumn).NewColDefinition = newNode.(*ColumnDefinition)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
+		parent.(*ChangeColumn).After = newNode.(*ColName)
 	}) {
 		return false
 	}
@@ -1429,7 +1843,7 @@ func (a *application) rewriteRefOfCommonTableExpr(parent SQLNode, node *CommonTa
 	}
 	return true
 }
-func (a *application) rewriteRefOfComparisonExpr(parent SQLNode, node *ComparisonExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfChangeReplicationSource(parent SQLNode, node *ChangeReplicationSource, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1441,18 +1855,8 @@ func (a *application) rewriteRefOfComparisonExpr(parent SQLNode, node *Compariso
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*ComparisonExpr).Left = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
-		parent.(*ComparisonExpr).Right = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Escape, func(newNode, parent SQLNode) {
-		parent.(*ComparisonExpr).Escape = newNode.(Expr)
+	if !a.rewriteReplicationOptions(node, node.Options, func(newNode, parent SQLNode) {
+		parent.(*ChangeReplicationSource).Options = newNode.(ReplicationOptions)
 	}) {
 		return false
 	}
@@ -1466,7 +1870,7 @@ func (a *application) rewriteRefOfComparisonExpr(parent SQLNode, node *Compariso
 	}
 	return true
 }
-func (a *application) rewriteRefOfConstraintDefinition(parent SQLNode, node *ConstraintDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCheckConstraintDefinition(parent SQLNode, node *CheckConstraintDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1478,13 +1882,8 @@ func (a *application) rewriteRefOfConstraintDefinition(parent SQLNode, node *Con
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*ConstraintDefinition).Name = newNode.(ColIdent)
-	}) {
-		return false
-	}
-	if !a.rewriteConstraintInfo(node, node.Details, func(newNode, parent SQLNode) {
-		parent.(*ConstraintDefinition).Details = newNode.(ConstraintInfo)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*CheckConstraintDefinition).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1498,7 +1897,7 @@ func (a *application) rewriteRefOfConstraintDefinition(parent SQLNode, node *Con
 	}
 	return true
 }
-func (a *application) rewriteRefOfConvertExpr(parent SQLNode, node *ConvertExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCheckTable(parent SQLNode, node *CheckTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1510,13 +1909,8 @@ func (a *application) rewriteRefOfConvertExpr(parent SQLNode, node *ConvertExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ConvertExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfConvertType(node, node.Type, func(newNode, parent SQLNode) {
-		parent.(*ConvertExpr).Type = newNode.(*ConvertType)
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*CheckTable).Tables = newNode.(TableNames)
 	}) {
 		return false
 	}
@@ -1530,7 +1924,7 @@ func (a *application) rewriteRefOfConvertExpr(parent SQLNode, node *ConvertExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfChecksumTable(parent SQLNode, node *ChecksumTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1542,13 +1936,8 @@ func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType,
 			return true
 		}
 	}
-	if !a.rewriteRefOfLiteral(node, node.Length, func(newNode, parent SQLNode) {
-		parent.(*ConvertType).Length = newNode.(*Literal)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
-		parent.(*ConvertType).Scale = newNode.(*Literal)
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*ChecksumTable).Tables = newNode.(TableNames)
 	}) {
 		return false
 	}
@@ -1562,7 +1951,7 @@ func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType,
 	}
 	return true
 }
-func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *ConvertUsingExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCloseCursor(parent SQLNode, node *CloseCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1574,8 +1963,8 @@ func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *Convert
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ConvertUsingExpr).Expr = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CloseCursor).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -1589,10 +1978,7 @@ func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *Convert
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDatabase, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
+func (a *application) rewriteColIdent(parent SQLNode, node ColIdent, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -1601,27 +1987,19 @@ func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDat
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateDatabase).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
-		parent.(*CreateDatabase).DBName = newNode.(TableIdent)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfColName(parent SQLNode, node *ColName, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1633,23 +2011,13 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Table = newNode.(TableName)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ColName).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).OptLike = newNode.(*OptLike)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
+	if !a.rewriteTableName(node, node.Qualifier, func(newNode, parent SQLNode) {
+		parent.(*ColName).Qualifier = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -1663,7 +2031,7 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCollateExpr(parent SQLNode, node *CollateExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1675,28 +2043,8 @@ func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
-		parent.(*CreateView).ViewName = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Definer = newNode.(*Definer)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Columns = newNode.(Columns)
-	}) {
-		return false
-	}
-	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Select = newNode.(SelectStatement)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Comments = newNode.(*ParsedComments)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*CollateExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1710,7 +2058,7 @@ func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfColumnDefinition(parent SQLNode, node *ColumnDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1723,12 +2071,7 @@ func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeF
 		}
 	}
 	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*CurTimeFuncExpr).Name = newNode.(ColIdent)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Fsp, func(newNode, parent SQLNode) {
-		parent.(*CurTimeFuncExpr).Fsp = newNode.(Expr)
+		parent.(*ColumnDefinition).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -1742,7 +2085,7 @@ func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeF
 	}
 	return true
 }
-func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *DeallocateStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfColumnType(parent SQLNode, node *ColumnType, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1754,13 +2097,13 @@ func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *Deallocat
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DeallocateStmt).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfLiteral(node, node.Length, func(newNode, parent SQLNode) {
+		parent.(*ColumnType).Length = newNode.(*Literal)
 	}) {
 		return false
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DeallocateStmt).Name = newNode.(ColIdent)
+	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
+		parent.(*ColumnType).Scale = newNode.(*Literal)
 	}) {
 		return false
 	}
@@ -1774,7 +2117,7 @@ func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *Deallocat
 	}
 	return true
 }
-func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replacer replacerFunc) bool {
+func (a *application) rewriteColumns(parent SQLNode, node Columns, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1782,23 +2125,36 @@ func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Columns)
+			a.cur.revisit = false
+			return a.rewriteColumns(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
+	for x, el := range node {
+		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Columns)[idx] = newNode.(ColIdent)
+			}
+		}(x)) {
+			return false
 		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfDefiner(parent SQLNode, node *Definer, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1822,7 +2178,7 @@ func (a *application) rewriteRefOfDefiner(parent SQLNode, node *Definer, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCommonTableExpr(parent SQLNode, node *CommonTableExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1834,43 +2190,18 @@ func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer
 			return true
 		}
 	}
-	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
-		parent.(*Delete).With = newNode.(*With)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Delete).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteTableNames(node, node.Targets, func(newNode, parent SQLNode) {
-		parent.(*Delete).Targets = newNode.(TableNames)
-	}) {
-		return false
-	}
-	if !a.rewriteTableExprs(node, node.TableExprs, func(newNode, parent SQLNode) {
-		parent.(*Delete).TableExprs = newNode.(TableExprs)
-	}) {
-		return false
-	}
-	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
-		parent.(*Delete).Partitions = newNode.(Partitions)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
-		parent.(*Delete).Where = newNode.(*Where)
+	if !a.rewriteTableIdent(node, node.TableID, func(newNode, parent SQLNode) {
+		parent.(*CommonTableExpr).TableID = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
-		parent.(*Delete).OrderBy = newNode.(OrderBy)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*CommonTableExpr).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
-		parent.(*Delete).Limit = newNode.(*Limit)
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
 	}) {
 		return false
 	}
@@ -1884,7 +2215,7 @@ func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfComparisonExpr(parent SQLNode, node *ComparisonExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1896,8 +2227,18 @@ func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTabl
 			return true
 		}
 	}
-	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
-		parent.(*DerivedTable).Select = newNode.(SelectStatement)
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*ComparisonExpr).Left = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
+		parent.(*ComparisonExpr).Right = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Escape, func(newNode, parent SQLNode) {
+		parent.(*ComparisonExpr).Escape = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -1911,7 +2252,7 @@ func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTabl
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, replacer replacerFunc) bool {
+func (a *application) rewriteCompoundStatements(parent SQLNode, node CompoundStatements, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1919,14 +2260,24 @@ func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, r
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(CompoundStatements)
+			a.cur.revisit = false
+			return a.rewriteCompoundStatements(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteRefOfColName(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DropColumn).Name = newNode.(*ColName)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteStatement(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(CompoundStatements)[idx] = newNode.(Statement)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -1938,7 +2289,7 @@ func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabase, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfConstraintDefinition(parent SQLNode, node *ConstraintDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1950,13 +2301,13 @@ func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabas
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropDatabase).Comments = newNode.(*ParsedComments)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ConstraintDefinition).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
-		parent.(*DropDatabase).DBName = newNode.(TableIdent)
+	if !a.rewriteConstraintInfo(node, node.Details, func(newNode, parent SQLNode) {
+		parent.(*ConstraintDefinition).Details = newNode.(ConstraintInfo)
 	}) {
 		return false
 	}
@@ -1970,7 +2321,7 @@ func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabas
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfConvertExpr(parent SQLNode, node *ConvertExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1982,8 +2333,13 @@ func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replace
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DropKey).Name = newNode.(ColIdent)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ConvertExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfConvertType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*ConvertExpr).Type = newNode.(*ConvertType)
 	}) {
 		return false
 	}
@@ -1997,7 +2353,7 @@ func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2009,13 +2365,13 @@ func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, rep
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
-		parent.(*DropTable).FromTables = newNode.(TableNames)
+	if !a.rewriteRefOfLiteral(node, node.Length, func(newNode, parent SQLNode) {
+		parent.(*ConvertType).Length = newNode.(*Literal)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropTable).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
+		parent.(*ConvertType).Scale = newNode.(*Literal)
 	}) {
 		return false
 	}
@@ -2029,7 +2385,7 @@ func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *ConvertUsingExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2041,13 +2397,8 @@ func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, repla
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
-		parent.(*DropView).FromTables = newNode.(TableNames)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropView).Comments = newNode.(*ParsedComments)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ConvertUsingExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2061,7 +2412,7 @@ func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, repla
 	}
 	return true
 }
-func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDatabase, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2073,18 +2424,13 @@ func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt,
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*ExecuteStmt).Name = newNode.(ColIdent)
-	}) {
-		return false
-	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*ExecuteStmt).Comments = newNode.(*ParsedComments)
+		parent.(*CreateDatabase).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteColumns(node, node.Arguments, func(newNode, parent SQLNode) {
-		parent.(*ExecuteStmt).Arguments = newNode.(Columns)
+	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
+		parent.(*CreateDatabase).DBName = newNode.(TableIdent)
 	}) {
 		return false
 	}
@@ -2098,7 +2444,7 @@ func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt,
 	}
 	return true
 }
-func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateEvent(parent SQLNode, node *CreateEvent, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2110,35 +2456,28 @@ func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, r
 			return true
 		}
 	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
-			return false
-		}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Definer = newNode.(*Definer)
+	}) {
+		return false
 	}
-	return true
-}
-func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Name = newNode.(TableName)
+	}) {
+		return false
 	}
-	if a.pre != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
+	if !a.rewriteRefOfEventSchedule(node, node.Schedule, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Schedule = newNode.(*EventSchedule)
+	}) {
+		return false
 	}
-	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
-		parent.(*ExplainStmt).Statement = newNode.(Statement)
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2152,7 +2491,7 @@ func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt,
 	}
 	return true
 }
-func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateFunction(parent SQLNode, node *CreateFunction, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2164,22 +2503,55 @@ func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*ExplainTab).Table = newNode.(TableName)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfProcParameter(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Params[idx] = newNode.(*ProcParameter)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Body = newNode.(Statement)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateProcedure(parent SQLNode, node *CreateProcedure, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2187,25 +2559,48 @@ func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacer
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Exprs)
-			a.cur.revisit = false
-			return a.rewriteExprs(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfProcParameter(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(Exprs)[idx] = newNode.(Expr)
+				parent.(*CreateProcedure).Params[idx] = newNode.(*ProcParameter)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateProcedure).Characteristics[idx] = newNode.(*RoutineCharacteristic)
 			}
 		}(x)) {
 			return false
 		}
 	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Body = newNode.(Statement)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2216,7 +2611,7 @@ func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateRole(parent SQLNode, node *CreateRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2228,8 +2623,8 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
+	if !a.rewriteAccounts(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*CreateRole).Roles = newNode.(Accounts)
 	}) {
 		return false
 	}
@@ -2243,7 +2638,7 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2255,23 +2650,23 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Original = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Table = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
+	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).OptLike = newNode.(*OptLike)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -2285,7 +2680,7 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 	}
 	return true
 }
-func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateTrigger(parent SQLNode, node *CreateTrigger, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2297,8 +2692,33 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
-		parent.(*Flush).TableNames = newNode.(TableNames)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfTriggerOrder(node, node.Order, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Order = newNode.(*TriggerOrder)
+	}) {
+		return false
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2312,7 +2732,7 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateUser(parent SQLNode, node *CreateUser, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2324,42 +2744,28 @@ func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer re
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
-		}
-	}
-	return true
-}
-func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteUserSpecs(node, node.Users, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).Users = newNode.(UserSpecs)
+	}) {
+		return false
 	}
-	if a.pre != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
+	if !a.rewriteRefOfRoleSpec(node, node.DefaultRole, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).DefaultRole = newNode.(*RoleSpec)
+	}) {
+		return false
 	}
-	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
+	if !a.rewriteTLSOptions(node, node.TLSOptions, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).TLSOptions = newNode.(TLSOptions)
 	}) {
 		return false
 	}
-	if !a.rewriteColIdent(node, node.IndexName, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).IndexName = newNode.(ColIdent)
+	if !a.rewriteResourceOptions(node, node.ResourceOptions, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).ResourceOptions = newNode.(ResourceOptions)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
+	if !a.rewritePasswordOptions(node, node.PasswordOptions, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).PasswordOptions = newNode.(PasswordOptions)
 	}) {
 		return false
 	}
@@ -2373,7 +2779,7 @@ func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *For
 	}
 	return true
 }
-func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2385,18 +2791,28 @@ func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, repla
 			return true
 		}
 	}
-	if !a.rewriteTableIdent(node, node.Qualifier, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
+	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
+		parent.(*CreateView).ViewName = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Name = newNode.(ColIdent)
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Definer = newNode.(*Definer)
 	}) {
 		return false
 	}
-	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Select = newNode.(SelectStatement)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -2410,7 +2826,7 @@ func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, repla
 	}
 	return true
 }
-func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2418,24 +2834,19 @@ func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer repl
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(GroupBy)
-			a.cur.revisit = false
-			return a.rewriteGroupBy(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(GroupBy)[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CurTimeFuncExpr).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Fsp, func(newNode, parent SQLNode) {
+		parent.(*CurTimeFuncExpr).Fsp = newNode.(Expr)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2447,7 +2858,7 @@ func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer repl
 	}
 	return true
 }
-func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupConcatExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *DeallocateStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2459,18 +2870,13 @@ func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupCon
 			return true
 		}
 	}
-	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).Exprs = newNode.(SelectExprs)
-	}) {
-		return false
-	}
-	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).OrderBy = newNode.(OrderBy)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DeallocateStmt).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).Limit = newNode.(*Limit)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DeallocateStmt).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -2484,7 +2890,7 @@ func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupCon
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareCondition(parent SQLNode, node *DeclareCondition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2496,8 +2902,13 @@ func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDef
 			return true
 		}
 	}
-	if !a.rewriteRefOfIndexInfo(node, node.Info, func(newNode, parent SQLNode) {
-		parent.(*IndexDefinition).Info = newNode.(*IndexInfo)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DeclareCondition).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfHandlerCondition(node, node.Condition, func(newNode, parent SQLNode) {
+		parent.(*DeclareCondition).Condition = newNode.(*HandlerCondition)
 	}) {
 		return false
 	}
@@ -2511,7 +2922,7 @@ func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDef
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareCursor(parent SQLNode, node *DeclareCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2523,14 +2934,15 @@ func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, rep
 			return true
 		}
 	}
-	for x, el := range node.Indexes {
-		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*IndexHint).Indexes[idx] = newNode.(ColIdent)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DeclareCursor).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*DeclareCursor).Select = newNode.(SelectStatement)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2542,7 +2954,7 @@ func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, rep
 	}
 	return true
 }
-func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareHandler(parent SQLNode, node *DeclareHandler, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2550,25 +2962,24 @@ func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(IndexHints)
-			a.cur.revisit = false
-			return a.rewriteIndexHints(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfIndexHint(node, el, func(idx int) replacerFunc {
+	for x, el := range node.Conditions {
+		if !a.rewriteRefOfHandlerCondition(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(IndexHints)[idx] = newNode.(*IndexHint)
+				parent.(*DeclareHandler).Conditions[idx] = newNode.(*HandlerCondition)
 			}
 		}(x)) {
 			return false
 		}
 	}
+	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*DeclareHandler).Statement = newNode.(Statement)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2579,7 +2990,7 @@ func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareVar(parent SQLNode, node *DeclareVar, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2591,13 +3002,13 @@ func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, rep
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*IndexInfo).Name = newNode.(ColIdent)
+	if !a.rewriteColumns(node, node.Names, func(newNode, parent SQLNode) {
+		parent.(*DeclareVar).Names = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteColIdent(node, node.ConstraintName, func(newNode, parent SQLNode) {
-		parent.(*IndexInfo).ConstraintName = newNode.(ColIdent)
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*DeclareVar).Default = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2611,7 +3022,7 @@ func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2623,33 +3034,91 @@ func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Insert).Comments = newNode.(*ParsedComments)
-	}) {
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfDefiner(parent SQLNode, node *Definer, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
+		parent.(*Delete).With = newNode.(*With)
+	}) {
 		return false
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*Insert).Table = newNode.(TableName)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Delete).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableNames(node, node.Targets, func(newNode, parent SQLNode) {
+		parent.(*Delete).Targets = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if !a.rewriteTableExprs(node, node.TableExprs, func(newNode, parent SQLNode) {
+		parent.(*Delete).TableExprs = newNode.(TableExprs)
 	}) {
 		return false
 	}
 	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
-		parent.(*Insert).Partitions = newNode.(Partitions)
+		parent.(*Delete).Partitions = newNode.(Partitions)
 	}) {
 		return false
 	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*Insert).Columns = newNode.(Columns)
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*Delete).Where = newNode.(*Where)
 	}) {
 		return false
 	}
-	if !a.rewriteInsertRows(node, node.Rows, func(newNode, parent SQLNode) {
-		parent.(*Insert).Rows = newNode.(InsertRows)
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*Delete).OrderBy = newNode.(OrderBy)
 	}) {
 		return false
 	}
-	if !a.rewriteOnDup(node, node.OnDup, func(newNode, parent SQLNode) {
-		parent.(*Insert).OnDup = newNode.(OnDup)
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*Delete).Limit = newNode.(*Limit)
 	}) {
 		return false
 	}
@@ -2663,7 +3132,7 @@ func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfIntervalExpr(parent SQLNode, node *IntervalExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2675,8 +3144,8 @@ func (a *application) rewriteRefOfIntervalExpr(parent SQLNode, node *IntervalExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*IntervalExpr).Expr = newNode.(Expr)
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*DerivedTable).Select = newNode.(SelectStatement)
 	}) {
 		return false
 	}
@@ -2690,7 +3159,7 @@ func (a *application) rewriteRefOfIntervalExpr(parent SQLNode, node *IntervalExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *IntroducerExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDoStmt(parent SQLNode, node *DoStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2702,8 +3171,8 @@ func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *Introduce
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*IntroducerExpr).Expr = newNode.(Expr)
+	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*DoStmt).Exprs = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -2717,7 +3186,7 @@ func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *Introduce
 	}
 	return true
 }
-func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2729,8 +3198,8 @@ func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*IsExpr).Left = newNode.(Expr)
+	if !a.rewriteRefOfColName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropColumn).Name = newNode.(*ColName)
 	}) {
 		return false
 	}
@@ -2744,7 +3213,7 @@ func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabase, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2756,8 +3225,13 @@ func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayE
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
-		parent.(*JSONArrayExpr).Params = newNode.(Exprs)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropDatabase).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
+		parent.(*DropDatabase).DBName = newNode.(TableIdent)
 	}) {
 		return false
 	}
@@ -2771,7 +3245,7 @@ func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONAttributesExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2783,13 +3257,8 @@ func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONA
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONAttributesExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteJSONPathParam(node, node.Path, func(newNode, parent SQLNode) {
-		parent.(*JSONAttributesExpr).Path = newNode.(JSONPathParam)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropKey).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -2803,7 +3272,7 @@ func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONA
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONContainsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropProgram(parent SQLNode, node *DropProgram, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2815,25 +3284,16 @@ func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONCon
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Target, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsExpr).Target = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropProgram).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Candidate, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsExpr).Candidate = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropProgram).Name = newNode.(TableName)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONContainsExpr).PathList[idx] = newNode.(JSONPathParam)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2844,7 +3304,7 @@ func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONCon
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSONContainsPathExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropRole(parent SQLNode, node *DropRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2856,25 +3316,11 @@ func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSO
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsPathExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsPathExpr).OneOrAll = newNode.(Expr)
+	if !a.rewriteAccounts(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*DropRole).Roles = newNode.(Accounts)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONContainsPathExpr).PathList[idx] = newNode.(JSONPathParam)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2885,7 +3331,7 @@ func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSO
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtractExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2897,19 +3343,15 @@ func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtr
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONExtractExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
+		parent.(*DropTable).FromTables = newNode.(TableNames)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONExtractExpr).PathList[idx] = newNode.(JSONPathParam)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropTable).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2921,7 +3363,7 @@ func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtr
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropUser(parent SQLNode, node *DropUser, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2933,20 +3375,11 @@ func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONKeysExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteAccounts(node, node.Users, func(newNode, parent SQLNode) {
+		parent.(*DropUser).Users = newNode.(Accounts)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONKeysExpr).PathList[idx] = newNode.(JSONPathParam)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2957,7 +3390,7 @@ func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjectExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2969,14 +3402,15 @@ func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjec
 			return true
 		}
 	}
-	for x, el := range node.Params {
-		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONObjectExpr).Params[idx] = newNode.(*JSONObjectParam)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
+		parent.(*DropView).FromTables = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropView).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2988,7 +3422,10 @@ func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjec
 	}
 	return true
 }
-func (a *application) rewriteJSONObjectParam(parent SQLNode, node JSONObjectParam, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfElseIf(parent SQLNode, node *ElseIf, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2997,13 +3434,13 @@ func (a *application) rewriteJSONObjectParam(parent SQLNode, node JSONObjectPara
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Key, func(newNode, parent SQLNode) {
-		panic("[BUG] tried to replace 'Key' on 'JSONObjectParam'")
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*ElseIf).Cond = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
-		panic("[BUG] tried to replace 'Value' on 'JSONObjectParam'")
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*ElseIf).Statements = newNode.(CompoundStatements)
 	}) {
 		return false
 	}
@@ -3017,7 +3454,7 @@ func (a *application) rewriteJSONObjectParam(parent SQLNode, node JSONObjectPara
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOverlapsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfEventSchedule(parent SQLNode, node *EventSchedule, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3029,13 +3466,23 @@ func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOve
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc1, func(newNode, parent SQLNode) {
-		parent.(*JSONOverlapsExpr).JSONDoc1 = newNode.(Expr)
+	if !a.rewriteExpr(node, node.At, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).At = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.JSONDoc2, func(newNode, parent SQLNode) {
-		parent.(*JSONOverlapsExpr).JSONDoc2 = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Every, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Every = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Starts, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Starts = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Ends, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Ends = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3049,7 +3496,7 @@ func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOve
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrettyExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3061,8 +3508,18 @@ func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrett
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONPrettyExpr).JSONVal = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ExecuteStmt).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*ExecuteStmt).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Arguments, func(newNode, parent SQLNode) {
+		parent.(*ExecuteStmt).Arguments = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -3076,7 +3533,7 @@ func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrett
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3088,8 +3545,8 @@ func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteE
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.StringArg, func(newNode, parent SQLNode) {
-		parent.(*JSONQuoteExpr).StringArg = newNode.(Expr)
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
 	}) {
 		return false
 	}
@@ -3103,7 +3560,7 @@ func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemoveExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3115,13 +3572,8 @@ func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemov
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONRemoveExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExprs(node, node.PathList, func(newNode, parent SQLNode) {
-		parent.(*JSONRemoveExpr).PathList = newNode.(Exprs)
+	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*ExplainStmt).Statement = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -3135,7 +3587,7 @@ func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemov
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *JSONSchemaValidFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3147,13 +3599,8 @@ func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidFuncExpr).Schema = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidFuncExpr).Document = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*ExplainTab).Table = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -3167,7 +3614,7 @@ func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLNode, node *JSONSchemaValidationReportFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3175,19 +3622,24 @@ func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLN
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Exprs)
+			a.cur.revisit = false
+			return a.rewriteExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidationReportFuncExpr).Schema = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidationReportFuncExpr).Document = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Exprs)[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3199,7 +3651,7 @@ func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLN
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearchExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3211,35 +3663,11 @@ func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearc
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).OneOrAll = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.SearchStr, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).SearchStr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.EscapeChar, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).EscapeChar = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONSearchExpr).PathList[idx] = newNode.(JSONPathParam)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3250,7 +3678,7 @@ func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearc
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSONStorageFreeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3262,8 +3690,23 @@ func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSON
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONStorageFreeExpr).JSONVal = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Original = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3277,7 +3720,7 @@ func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSON
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSONStorageSizeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFetchCursor(parent SQLNode, node *FetchCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3289,8 +3732,13 @@ func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSON
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONStorageSizeExpr).JSONVal = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FetchCursor).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Into, func(newNode, parent SQLNode) {
+		parent.(*FetchCursor).Into = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -3304,7 +3752,7 @@ func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSON
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFirstOrLastValueExpr(parent SQLNode, node *FirstOrLastValueExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3317,29 +3765,20 @@ func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableE
 		}
 	}
 	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Expr = newNode.(Expr)
+		parent.(*FirstOrLastValueExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteTableIdent(node, node.Alias, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Alias = newNode.(TableIdent)
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*FirstOrLastValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Filter = newNode.(Expr)
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*FirstOrLastValueExpr).OverClause = newNode.(*OverClause)
 	}) {
 		return false
 	}
-	for x, el := range node.Columns {
-		if !a.rewriteRefOfJtColumnDefinition(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONTableExpr).Columns[idx] = newNode.(*JtColumnDefinition)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3350,7 +3789,7 @@ func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnquoteExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3362,8 +3801,8 @@ func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnqu
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONValue, func(newNode, parent SQLNode) {
-		parent.(*JSONUnquoteExpr).JSONValue = newNode.(Expr)
+	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
+		parent.(*Flush).TableNames = newNode.(TableNames)
 	}) {
 		return false
 	}
@@ -3377,7 +3816,7 @@ func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnqu
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3389,28 +3828,42 @@ func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueE
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
 	}
-	if !a.rewriteJSONPathParam(node, node.Path, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).Path = newNode.(JSONPathParam)
-	}) {
-		return false
+	return true
+}
+func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
 	}
-	if !a.rewriteRefOfConvertType(node, node.ReturningType, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).ReturningType = newNode.(*ConvertType)
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfJtOnResponse(node, node.EmptyOnResponse, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).EmptyOnResponse = newNode.(*JtOnResponse)
+	if !a.rewriteColIdent(node, node.IndexName, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).IndexName = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfJtOnResponse(node, node.ErrorOnResponse, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).ErrorOnResponse = newNode.(*JtOnResponse)
+	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
 	}) {
 		return false
 	}
@@ -3424,7 +3877,7 @@ func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONValueMergeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFrameClause(parent SQLNode, node *FrameClause, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3436,13 +3889,13 @@ func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONV
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueMergeExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteRefOfFramePoint(node, node.Start, func(newNode, parent SQLNode) {
+		parent.(*FrameClause).Start = newNode.(*FramePoint)
 	}) {
 		return false
 	}
-	if !a.rewriteExprs(node, node.JSONDocList, func(newNode, parent SQLNode) {
-		parent.(*JSONValueMergeExpr).JSONDocList = newNode.(Exprs)
+	if !a.rewriteRefOfFramePoint(node, node.End, func(newNode, parent SQLNode) {
+		parent.(*FrameClause).End = newNode.(*FramePoint)
 	}) {
 		return false
 	}
@@ -3456,7 +3909,7 @@ func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONV
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JSONValueModifierExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFramePoint(parent SQLNode, node *FramePoint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3468,20 +3921,11 @@ func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JS
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueModifierExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*FramePoint).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.Params {
-		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONValueModifierExpr).Params[idx] = newNode.(*JSONObjectParam)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3492,7 +3936,7 @@ func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JS
 	}
 	return true
 }
-func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFromFirstLastClause(parent SQLNode, node *FromFirstLastClause, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3504,27 +3948,19 @@ func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondit
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.On, func(newNode, parent SQLNode) {
-		parent.(*JoinCondition).On = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.Using, func(newNode, parent SQLNode) {
-		parent.(*JoinCondition).Using = newNode.(Columns)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3536,18 +3972,23 @@ func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableE
 			return true
 		}
 	}
-	if !a.rewriteTableExpr(node, node.LeftExpr, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).LeftExpr = newNode.(TableExpr)
+	if !a.rewriteTableIdent(node, node.Qualifier, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteTableExpr(node, node.RightExpr, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfJoinCondition(node, node.Condition, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).Condition = newNode.(*JoinCondition)
+	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.Over, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Over = newNode.(*OverClause)
 	}) {
 		return false
 	}
@@ -3561,7 +4002,7 @@ func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtColumnDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomDistanceExpr(parent SQLNode, node *GeomDistanceExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3573,19 +4014,32 @@ func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtCol
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Geom1, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceExpr).Geom1 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Geom2, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceExpr).Geom2 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Unit, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceExpr).Unit = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnResponse, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomDistanceSphereExpr(parent SQLNode, node *GeomDistanceSphereExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3597,8 +4051,18 @@ func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnRespons
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*JtOnResponse).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Geom1, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceSphereExpr).Geom1 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Geom2, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceSphereExpr).Geom2 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Radius, func(newNode, parent SQLNode) {
+		parent.(*GeomDistanceSphereExpr).Radius = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3612,7 +4076,7 @@ func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnRespons
 	}
 	return true
 }
-func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFormatExpr(parent SQLNode, node *GeomFormatExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3624,19 +4088,27 @@ func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, repla
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
+		parent.(*GeomFormatExpr).Geom = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFormatExpr).AxisOrderOpt = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFromTextExpr(parent SQLNode, node *GeomFromTextExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3648,13 +4120,18 @@ func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer re
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Offset, func(newNode, parent SQLNode) {
-		parent.(*Limit).Offset = newNode.(Expr)
+	if !a.rewriteExpr(node, node.WktText, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).WktText = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Rowcount, func(newNode, parent SQLNode) {
-		parent.(*Limit).Rowcount = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).Srid = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).AxisOrderOpt = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3668,7 +4145,7 @@ func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomRelationExpr(parent SQLNode, node *GeomRelationExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3680,43 +4157,27 @@ func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replace
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Geom1, func(newNode, parent SQLNode) {
+		parent.(*GeomRelationExpr).Geom1 = newNode.(Expr)
+	}) {
+		return false
 	}
-	return true
-}
-func (a *application) rewriteRefOfLoad(parent SQLNode, node *Load, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteExpr(node, node.Geom2, func(newNode, parent SQLNode) {
+		parent.(*GeomRelationExpr).Geom2 = newNode.(Expr)
+	}) {
+		return false
 	}
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomSRIDExpr(parent SQLNode, node *GeomSRIDExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3728,43 +4189,27 @@ func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, r
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
+		parent.(*GeomSRIDExpr).Geom = newNode.(Expr)
+	}) {
+		return false
 	}
-	return true
-}
-func (a *application) rewriteRefOfLockTables(parent SQLNode, node *LockTables, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
+		parent.(*GeomSRIDExpr).Srid = newNode.(Expr)
+	}) {
+		return false
 	}
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGrant(parent SQLNode, node *Grant, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3776,13 +4221,28 @@ func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, rep
 			return true
 		}
 	}
-	if !a.rewriteSelectExprs(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*MatchExpr).Columns = newNode.(SelectExprs)
+	if !a.rewritePrivileges(node, node.Privileges, func(newNode, parent SQLNode) {
+		parent.(*Grant).Privileges = newNode.(Privileges)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*MatchExpr).Expr = newNode.(Expr)
+	if !a.rewriteRefOfGrantTarget(node, node.Target, func(newNode, parent SQLNode) {
+		parent.(*Grant).Target = newNode.(*GrantTarget)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*Grant).Roles = newNode.(Accounts)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfAccount(node, node.Proxy, func(newNode, parent SQLNode) {
+		parent.(*Grant).Proxy = newNode.(*Account)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.To, func(newNode, parent SQLNode) {
+		parent.(*Grant).To = newNode.(Accounts)
 	}) {
 		return false
 	}
@@ -3796,7 +4256,7 @@ func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGrantTarget(parent SQLNode, node *GrantTarget, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3808,13 +4268,13 @@ func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
-		parent.(*MemberOfExpr).Value = newNode.(Expr)
+	if !a.rewriteTableIdent(node, node.Database, func(newNode, parent SQLNode) {
+		parent.(*GrantTarget).Database = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.JSONArr, func(newNode, parent SQLNode) {
-		parent.(*MemberOfExpr).JSONArr = newNode.(Expr)
+	if !a.rewriteTableIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*GrantTarget).Name = newNode.(TableIdent)
 	}) {
 		return false
 	}
@@ -3828,7 +4288,7 @@ func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColumn, replacer replacerFunc) bool {
+func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3836,19 +4296,24 @@ func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColum
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(GroupBy)
+			a.cur.revisit = false
+			return a.rewriteGroupBy(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
-		parent.(*ModifyColumn).NewColDefinition = newNode.(*ColumnDefinition)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
-		parent.(*ModifyColumn).After = newNode.(*ColName)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(GroupBy)[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3860,7 +4325,7 @@ func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColum
 	}
 	return true
 }
-func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupConcatExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3872,8 +4337,18 @@ func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replace
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*Nextval).Expr = newNode.(Expr)
+	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).Exprs = newNode.(SelectExprs)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).Limit = newNode.(*Limit)
 	}) {
 		return false
 	}
@@ -3887,7 +4362,7 @@ func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGroupingExpr(parent SQLNode, node *GroupingExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3899,8 +4374,8 @@ func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replace
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*NotExpr).Expr = newNode.(Expr)
+	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*GroupingExpr).Exprs = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -3914,7 +4389,7 @@ func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfHandlerCondition(parent SQLNode, node *HandlerCondition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3926,19 +4401,22 @@ func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replace
 			return true
 		}
 	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*HandlerCondition).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIfStmt(parent SQLNode, node *IfStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3946,49 +4424,31 @@ func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacer
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(OnDup)
-			a.cur.revisit = false
-			return a.rewriteOnDup(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfUpdateExpr(node, el, func(idx int) replacerFunc {
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*IfStmt).Cond = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*IfStmt).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
+	for x, el := range node.ElseIfs {
+		if !a.rewriteRefOfElseIf(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(OnDup)[idx] = newNode.(*UpdateExpr)
+				parent.(*IfStmt).ElseIfs[idx] = newNode.(*ElseIf)
 			}
 		}(x)) {
 			return false
 		}
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
-			return false
-		}
-	}
-	return true
-}
-func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
-	if a.pre != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if !a.rewriteTableName(node, node.LikeTable, func(newNode, parent SQLNode) {
-		parent.(*OptLike).LikeTable = newNode.(TableName)
+	if !a.rewriteCompoundStatements(node, node.Else, func(newNode, parent SQLNode) {
+		parent.(*IfStmt).Else = newNode.(CompoundStatements)
 	}) {
 		return false
 	}
@@ -4002,7 +4462,7 @@ func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4014,13 +4474,8 @@ func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*OrExpr).Left = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
-		parent.(*OrExpr).Right = newNode.(Expr)
+	if !a.rewriteRefOfIndexInfo(node, node.Info, func(newNode, parent SQLNode) {
+		parent.(*IndexDefinition).Info = newNode.(*IndexInfo)
 	}) {
 		return false
 	}
@@ -4034,7 +4489,7 @@ func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4046,10 +4501,14 @@ func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer re
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*Order).Expr = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node.Indexes {
+		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*IndexHint).Indexes[idx] = newNode.(ColIdent)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4061,7 +4520,7 @@ func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer re
 	}
 	return true
 }
-func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer replacerFunc) bool {
+func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4071,18 +4530,18 @@ func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer repl
 		a.cur.node = node
 		kontinue := !a.pre(&a.cur)
 		if a.cur.revisit {
-			node = a.cur.node.(OrderBy)
+			node = a.cur.node.(IndexHints)
 			a.cur.revisit = false
-			return a.rewriteOrderBy(parent, node, replacer)
+			return a.rewriteIndexHints(parent, node, replacer)
 		}
 		if kontinue {
 			return true
 		}
 	}
 	for x, el := range node {
-		if !a.rewriteRefOfOrder(node, el, func(idx int) replacerFunc {
+		if !a.rewriteRefOfIndexHint(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(OrderBy)[idx] = newNode.(*Order)
+				parent.(IndexHints)[idx] = newNode.(*IndexHint)
 			}
 		}(x)) {
 			return false
@@ -4098,7 +4557,7 @@ func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer repl
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4110,8 +4569,13 @@ func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOpt
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.Cols, func(newNode, parent SQLNode) {
-		parent.(*OrderByOption).Cols = newNode.(Columns)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*IndexInfo).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.ConstraintName, func(newNode, parent SQLNode) {
+		parent.(*IndexInfo).ConstraintName = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -4125,7 +4589,7 @@ func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOpt
 	}
 	return true
 }
-func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4137,23 +4601,56 @@ func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, r
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
-		}
-	}
-	return true
-}
-func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Insert).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
 	}
-	if a.pre != nil {
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*Insert).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
+		parent.(*Insert).Partitions = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*Insert).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteInsertRows(node, node.Rows, func(newNode, parent SQLNode) {
+		parent.(*Insert).Rows = newNode.(InsertRows)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfRowAlias(node, node.RowAlias, func(newNode, parent SQLNode) {
+		parent.(*Insert).RowAlias = newNode.(*RowAlias)
+	}) {
+		return false
+	}
+	if !a.rewriteOnDup(node, node.OnDup, func(newNode, parent SQLNode) {
+		parent.(*Insert).OnDup = newNode.(OnDup)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfInstallComponent(parent SQLNode, node *InstallComponent, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
@@ -4173,7 +4670,7 @@ func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfInstallPlugin(parent SQLNode, node *InstallPlugin, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4185,8 +4682,8 @@ func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTabl
 			return true
 		}
 	}
-	if !a.rewriteTableExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*InstallPlugin).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -4200,7 +4697,7 @@ func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTabl
 	}
 	return true
 }
-func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedComments, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIntervalExpr(parent SQLNode, node *IntervalExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4212,19 +4709,22 @@ func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedCom
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*IntervalExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *PartitionDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *IntroducerExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4236,13 +4736,35 @@ func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *Part
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinition).Name = newNode.(ColIdent)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*IntroducerExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfPartitionDefinitionOptions(node, node.Options, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinition).Options = newNode.(*PartitionDefinitionOptions)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*IsExpr).Left = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4256,7 +4778,7 @@ func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *Part
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, node *PartitionDefinitionOptions, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIterateStmt(parent SQLNode, node *IterateStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4268,33 +4790,67 @@ func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, nod
 			return true
 		}
 	}
-	if !a.rewriteRefOfPartitionValueRange(node, node.ValueRange, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).ValueRange = newNode.(*PartitionValueRange)
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*IterateStmt).Label = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).Comment = newNode.(*Literal)
-	}) {
-		return false
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
 	}
-	if !a.rewriteRefOfPartitionEngine(node, node.Engine, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).Engine = newNode.(*PartitionEngine)
-	}) {
-		return false
+	return true
+}
+func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
 	}
-	if !a.rewriteRefOfLiteral(node, node.DataDirectory, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).DataDirectory = newNode.(*Literal)
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
+		parent.(*JSONArrayExpr).Params = newNode.(Exprs)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLiteral(node, node.IndexDirectory, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).IndexDirectory = newNode.(*Literal)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONAttributesExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONAttributesExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteSubPartitionDefinitions(node, node.SubPartitionDefinitions, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).SubPartitionDefinitions = newNode.(SubPartitionDefinitions)
+	if !a.rewriteJSONPathParam(node, node.Path, func(newNode, parent SQLNode) {
+		parent.(*JSONAttributesExpr).Path = newNode.(JSONPathParam)
 	}) {
 		return false
 	}
@@ -4308,7 +4864,7 @@ func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, nod
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *PartitionEngine, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONContainsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4320,19 +4876,36 @@ func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *Partitio
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
+	if !a.rewriteExpr(node, node.Target, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsExpr).Target = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Candidate, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsExpr).Candidate = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.PathList {
+		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONContainsExpr).PathList[idx] = newNode.(JSONPathParam)
+			}
+		}(x)) {
+			return false
 		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *PartitionOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSONContainsPathExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4344,41 +4917,3149 @@ func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *Partitio
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.ColList, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).ColList = newNode.(Columns)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsPathExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsPathExpr).OneOrAll = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfSubPartition(node, node.SubPartition, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).SubPartition = newNode.(*SubPartition)
+	for x, el := range node.PathList {
+		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONContainsPathExpr).PathList[idx] = newNode.(JSONPathParam)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtractExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONExtractExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.Definitions {
-		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
+	for x, el := range node.PathList {
+		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(*PartitionOption).Definitions[idx] = newNode.(*PartitionDefinition)
+				parent.(*JSONExtractExpr).PathList[idx] = newNode.(JSONPathParam)
 			}
 		}(x)) {
 			return false
 		}
-	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONKeysExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.PathList {
+		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONKeysExpr).PathList[idx] = newNode.(JSONPathParam)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjectExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONObjectExpr).Params[idx] = newNode.(*JSONObjectParam)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteJSONObjectParam(parent SQLNode, node JSONObjectParam, replacer replacerFunc) bool {
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Key, func(newNode, parent SQLNode) {
+		panic("[BUG] tried to replace 'Key' on 'JSONObjectParam'")
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		panic("[BUG] tried to replace 'Value' on 'JSONObjectParam'")
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOverlapsExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc1, func(newNode, parent SQLNode) {
+		parent.(*JSONOverlapsExpr).JSONDoc1 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.JSONDoc2, func(newNode, parent SQLNode) {
+		parent.(*JSONOverlapsExpr).JSONDoc2 = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrettyExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONPrettyExpr).JSONVal = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.StringArg, func(newNode, parent SQLNode) {
+		parent.(*JSONQuoteExpr).StringArg = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemoveExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONRemoveExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.PathList, func(newNode, parent SQLNode) {
+		parent.(*JSONRemoveExpr).PathList = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *JSONSchemaValidFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidFuncExpr).Schema = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidFuncExpr).Document = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLNode, node *JSONSchemaValidationReportFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidationReportFuncExpr).Schema = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidationReportFuncExpr).Document = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearchExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).OneOrAll = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.SearchStr, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).SearchStr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.EscapeChar, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).EscapeChar = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.PathList {
+		if !a.rewriteJSONPathParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONSearchExpr).PathList[idx] = newNode.(JSONPathParam)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSONStorageFreeExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONStorageFreeExpr).JSONVal = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSONStorageSizeExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONStorageSizeExpr).JSONVal = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.Alias, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Alias = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Filter = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Columns {
+		if !a.rewriteRefOfJtColumnDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONTableExpr).Columns[idx] = newNode.(*JtColumnDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnquoteExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONValue, func(newNode, parent SQLNode) {
+		parent.(*JSONUnquoteExpr).JSONValue = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteJSONPathParam(node, node.Path, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).Path = newNode.(JSONPathParam)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfConvertType(node, node.ReturningType, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).ReturningType = newNode.(*ConvertType)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfJtOnResponse(node, node.EmptyOnResponse, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).EmptyOnResponse = newNode.(*JtOnResponse)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfJtOnResponse(node, node.ErrorOnResponse, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).ErrorOnResponse = newNode.(*JtOnResponse)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONValueMergeExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueMergeExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.JSONDocList, func(newNode, parent SQLNode) {
+		parent.(*JSONValueMergeExpr).JSONDocList = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JSONValueModifierExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueModifierExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONValueModifierExpr).Params[idx] = newNode.(*JSONObjectParam)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.On, func(newNode, parent SQLNode) {
+		parent.(*JoinCondition).On = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Using, func(newNode, parent SQLNode) {
+		parent.(*JoinCondition).Using = newNode.(Columns)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableExpr(node, node.LeftExpr, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).LeftExpr = newNode.(TableExpr)
+	}) {
+		return false
+	}
+	if !a.rewriteTableExpr(node, node.RightExpr, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfJoinCondition(node, node.Condition, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).Condition = newNode.(*JoinCondition)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtColumnDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnResponse, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*JtOnResponse).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfKill(parent SQLNode, node *Kill, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLagLeadExpr(parent SQLNode, node *LagLeadExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).N = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).Default = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLeaveStmt(parent SQLNode, node *LeaveStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*LeaveStmt).Label = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Offset, func(newNode, parent SQLNode) {
+		parent.(*Limit).Offset = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Rowcount, func(newNode, parent SQLNode) {
+		parent.(*Limit).Rowcount = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLoad(parent SQLNode, node *Load, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*Load).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
+		parent.(*Load).Partitions = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLoadFields(node, node.Fields, func(newNode, parent SQLNode) {
+		parent.(*Load).Fields = newNode.(*LoadFields)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLoadLines(node, node.Lines, func(newNode, parent SQLNode) {
+		parent.(*Load).Lines = newNode.(*LoadLines)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.IgnoreLines, func(newNode, parent SQLNode) {
+		parent.(*Load).IgnoreLines = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*Load).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteUpdateExprs(node, node.SetExprs, func(newNode, parent SQLNode) {
+		parent.(*Load).SetExprs = newNode.(UpdateExprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLoadFields(parent SQLNode, node *LoadFields, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfLiteral(node, node.Terminated, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).Terminated = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Enclosed, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).Enclosed = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Escaped, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).Escaped = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLoadLines(parent SQLNode, node *LoadLines, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfLiteral(node, node.Starting, func(newNode, parent SQLNode) {
+		parent.(*LoadLines).Starting = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Terminated, func(newNode, parent SQLNode) {
+		parent.(*LoadLines).Terminated = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLockClause(parent SQLNode, node *LockClause, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*LockClause).Tables = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteLockClauses(parent SQLNode, node LockClauses, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(LockClauses)
+			a.cur.revisit = false
+			return a.rewriteLockClauses(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfLockClause(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(LockClauses)[idx] = newNode.(*LockClause)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLockTables(parent SQLNode, node *LockTables, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfLoopStmt(parent SQLNode, node *LoopStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*LoopStmt).Label = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*LoopStmt).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteSelectExprs(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*MatchExpr).Columns = newNode.(SelectExprs)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*MatchExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*MemberOfExpr).Value = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.JSONArr, func(newNode, parent SQLNode) {
+		parent.(*MemberOfExpr).JSONArr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColumn, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
+		parent.(*ModifyColumn).NewColDefinition = newNode.(*ColumnDefinition)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
+		parent.(*ModifyColumn).After = newNode.(*ColName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNTHValueExpr(parent SQLNode, node *NTHValueExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).N = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfFromFirstLastClause(node, node.FromFirstLastClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).FromFirstLastClause = newNode.(*FromFirstLastClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*Nextval).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*NotExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNtileExpr(parent SQLNode, node *NtileExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*NtileExpr).N = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*NtileExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNullTreatmentClause(parent SQLNode, node *NullTreatmentClause, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(OnDup)
+			a.cur.revisit = false
+			return a.rewriteOnDup(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfUpdateExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(OnDup)[idx] = newNode.(*UpdateExpr)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOpenCursor(parent SQLNode, node *OpenCursor, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*OpenCursor).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.LikeTable, func(newNode, parent SQLNode) {
+		parent.(*OptLike).LikeTable = newNode.(TableName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOptimizeTable(parent SQLNode, node *OptimizeTable, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*OptimizeTable).Tables = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*OrExpr).Left = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
+		parent.(*OrExpr).Right = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*Order).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(OrderBy)
+			a.cur.revisit = false
+			return a.rewriteOrderBy(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfOrder(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(OrderBy)[idx] = newNode.(*Order)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Cols, func(newNode, parent SQLNode) {
+		parent.(*OrderByOption).Cols = newNode.(Columns)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOverClause(parent SQLNode, node *OverClause, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.WindowName, func(newNode, parent SQLNode) {
+		parent.(*OverClause).WindowName = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWindowSpecification(node, node.WindowSpec, func(newNode, parent SQLNode) {
+		parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTableExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedComments, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *PartitionDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinition).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfPartitionDefinitionOptions(node, node.Options, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinition).Options = newNode.(*PartitionDefinitionOptions)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, node *PartitionDefinitionOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfPartitionValueRange(node, node.ValueRange, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).ValueRange = newNode.(*PartitionValueRange)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).Comment = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfPartitionEngine(node, node.Engine, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).Engine = newNode.(*PartitionEngine)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.DataDirectory, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).DataDirectory = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.IndexDirectory, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).IndexDirectory = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteSubPartitionDefinitions(node, node.SubPartitionDefinitions, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).SubPartitionDefinitions = newNode.(SubPartitionDefinitions)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *PartitionEngine, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *PartitionOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.ColList, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).ColList = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSubPartition(node, node.SubPartition, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).SubPartition = newNode.(*SubPartition)
+	}) {
+		return false
+	}
+	for x, el := range node.Definitions {
+		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*PartitionOption).Definitions[idx] = newNode.(*PartitionDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewritePartitions(node, node.Names, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).Names = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Number, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).Number = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).TableName = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Definitions {
+		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*PartitionSpec).Definitions[idx] = newNode.(*PartitionDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *PartitionValueRange, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteValTuple(node, node.Range, func(newNode, parent SQLNode) {
+		parent.(*PartitionValueRange).Range = newNode.(ValTuple)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Partitions)
+			a.cur.revisit = false
+			return a.rewritePartitions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Partitions)[idx] = newNode.(ColIdent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPasswordOption(parent SQLNode, node *PasswordOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewritePasswordOptions(parent SQLNode, node PasswordOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(PasswordOptions)
+			a.cur.revisit = false
+			return a.rewritePasswordOptions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfPasswordOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(PasswordOptions)[idx] = newNode.(*PasswordOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPrivilegeSpec(parent SQLNode, node *PrivilegeSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*PrivilegeSpec).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewritePrivileges(parent SQLNode, node Privileges, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Privileges)
+			a.cur.revisit = false
+			return a.rewritePrivileges(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfPrivilegeSpec(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Privileges)[idx] = newNode.(*PrivilegeSpec)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfProcParameter(parent SQLNode, node *ProcParameter, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ProcParameter).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPurgeBinaryLogs(parent SQLNode, node *PurgeBinaryLogs, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Before, func(newNode, parent SQLNode) {
+		parent.(*PurgeBinaryLogs).Before = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *ReferenceDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.ReferencedTable, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).ReferencedTable = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.ReferencedColumns, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).ReferencedColumns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteMatchAction(node, node.Match, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).Match = newNode.(MatchAction)
+	}) {
+		return false
+	}
+	if !a.rewriteReferenceAction(node, node.OnDelete, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).OnDelete = newNode.(ReferenceAction)
+	}) {
+		return false
+	}
+	if !a.rewriteReferenceAction(node, node.OnUpdate, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).OnUpdate = newNode.(ReferenceAction)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpInstrExpr(parent SQLNode, node *RegexpInstrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.ReturnOption, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).ReturnOption = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpLikeExpr(parent SQLNode, node *RegexpLikeExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpReplaceExpr(parent SQLNode, node *RegexpReplaceExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Repl, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Repl = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpSubstrExpr(parent SQLNode, node *RegexpSubstrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*Release).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.OldName, func(newNode, parent SQLNode) {
+		parent.(*RenameIndex).OldName = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.NewName, func(newNode, parent SQLNode) {
+		parent.(*RenameIndex).NewName = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTableName, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*RenameTableName).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameUser(parent SQLNode, node *RenameUser, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRepairTable(parent SQLNode, node *RepairTable, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableNames(node, node.Tables, func(newNode, parent SQLNode) {
+		parent.(*RepairTable).Tables = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRepeatStmt(parent SQLNode, node *RepeatStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*RepeatStmt).Label = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*RepeatStmt).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Until, func(newNode, parent SQLNode) {
+		parent.(*RepeatStmt).Until = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfReplicationOption(parent SQLNode, node *ReplicationOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*ReplicationOption).Value = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteReplicationOptions(parent SQLNode, node ReplicationOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(ReplicationOptions)
+			a.cur.revisit = false
+			return a.rewriteReplicationOptions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfReplicationOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(ReplicationOptions)[idx] = newNode.(*ReplicationOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfResetMaster(parent SQLNode, node *ResetMaster, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfLiteral(node, node.To, func(newNode, parent SQLNode) {
+		parent.(*ResetMaster).To = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfResetReplica(parent SQLNode, node *ResetReplica, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfResourceOption(parent SQLNode, node *ResourceOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteResourceOptions(parent SQLNode, node ResourceOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(ResourceOptions)
+			a.cur.revisit = false
+			return a.rewriteResourceOptions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfResourceOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(ResourceOptions)[idx] = newNode.(*ResourceOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfReturnStmt(parent SQLNode, node *ReturnStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ReturnStmt).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMigration, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*RevertMigration).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRevoke(parent SQLNode, node *Revoke, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewritePrivileges(node, node.Privileges, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Privileges = newNode.(Privileges)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfGrantTarget(node, node.Target, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Target = newNode.(*GrantTarget)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Roles = newNode.(Accounts)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfAccount(node, node.Proxy, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Proxy = newNode.(*Account)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.From, func(newNode, parent SQLNode) {
+		parent.(*Revoke).From = newNode.(Accounts)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRoleSpec(parent SQLNode, node *RoleSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteAccounts(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*RoleSpec).Roles = newNode.(Accounts)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer replacerFunc) bool {
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
+		panic("[BUG] tried to replace 'SQLNode' on 'RootNode'")
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRoutineCharacteristic(parent SQLNode, node *RoutineCharacteristic, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionSpec, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRowAlias(parent SQLNode, node *RowAlias, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4390,30 +8071,16 @@ func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionS
 			return true
 		}
 	}
-	if !a.rewritePartitions(node, node.Names, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).Names = newNode.(Partitions)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.Number, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).Number = newNode.(*Literal)
+	if !a.rewriteTableIdent(node, node.TableName, func(newNode, parent SQLNode) {
+		parent.(*RowAlias).TableName = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).TableName = newNode.(TableName)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*RowAlias).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	for x, el := range node.Definitions {
-		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*PartitionSpec).Definitions[idx] = newNode.(*PartitionDefinition)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4424,7 +8091,7 @@ func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionS
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *PartitionValueRange, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSRollback(parent SQLNode, node *SRollback, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4436,8 +8103,8 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 			return true
 		}
 	}
-	if !a.rewriteValTuple(node, node.Range, func(newNode, parent SQLNode) {
-		parent.(*PartitionValueRange).Range = newNode.(ValTuple)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*SRollback).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -4451,7 +8118,7 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 	}
 	return true
 }
-func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSavepoint(parent SQLNode, node *Savepoint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4459,24 +8126,14 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Partitions)
-			a.cur.revisit = false
-			return a.rewritePartitions(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(Partitions)[idx] = newNode.(ColIdent)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*Savepoint).Name = newNode.(ColIdent)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4488,7 +8145,7 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSelect(parent SQLNode, node *Select, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4500,18 +8157,67 @@ func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt,
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Name = newNode.(ColIdent)
+	for x, el := range node.From {
+		if !a.rewriteTableExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Select).From[idx] = newNode.(TableExpr)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Select).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	if !a.rewriteSelectExprs(node, node.SelectExprs, func(newNode, parent SQLNode) {
+		parent.(*Select).SelectExprs = newNode.(SelectExprs)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*Select).Where = newNode.(*Where)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
+		parent.(*Select).With = newNode.(*With)
+	}) {
+		return false
+	}
+	if !a.rewriteGroupBy(node, node.GroupBy, func(newNode, parent SQLNode) {
+		parent.(*Select).GroupBy = newNode.(GroupBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWhere(node, node.Having, func(newNode, parent SQLNode) {
+		parent.(*Select).Having = newNode.(*Where)
+	}) {
+		return false
+	}
+	if !a.rewriteWindowDefinitions(node, node.Windows, func(newNode, parent SQLNode) {
+		parent.(*Select).Windows = newNode.(WindowDefinitions)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*Select).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*Select).Limit = newNode.(*Limit)
+	}) {
+		return false
+	}
+	if !a.rewriteLockClauses(node, node.Lock, func(newNode, parent SQLNode) {
+		parent.(*Select).Lock = newNode.(LockClauses)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSelectInto(node, node.Into, func(newNode, parent SQLNode) {
+		parent.(*Select).Into = newNode.(*SelectInto)
 	}) {
 		return false
 	}
@@ -4525,7 +8231,7 @@ func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt,
 	}
 	return true
 }
-func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *ReferenceDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteSelectExprs(parent SQLNode, node SelectExprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4533,34 +8239,24 @@ func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *Refe
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(SelectExprs)
+			a.cur.revisit = false
+			return a.rewriteSelectExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.ReferencedTable, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).ReferencedTable = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.ReferencedColumns, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).ReferencedColumns = newNode.(Columns)
-	}) {
-		return false
-	}
-	if !a.rewriteMatchAction(node, node.Match, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).Match = newNode.(MatchAction)
-	}) {
-		return false
-	}
-	if !a.rewriteReferenceAction(node, node.OnDelete, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).OnDelete = newNode.(ReferenceAction)
-	}) {
-		return false
-	}
-	if !a.rewriteReferenceAction(node, node.OnUpdate, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).OnUpdate = newNode.(ReferenceAction)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteSelectExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(SelectExprs)[idx] = newNode.(SelectExpr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4572,7 +8268,7 @@ func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *Refe
 	}
 	return true
 }
-func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSelectInto(parent SQLNode, node *SelectInto, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4584,8 +8280,8 @@ func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replace
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*Release).Name = newNode.(ColIdent)
+	if !a.rewriteColumns(node, node.Variables, func(newNode, parent SQLNode) {
+		parent.(*SelectInto).Variables = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -4599,7 +8295,7 @@ func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4611,13 +8307,13 @@ func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex,
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.OldName, func(newNode, parent SQLNode) {
-		parent.(*RenameIndex).OldName = newNode.(ColIdent)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Set).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteColIdent(node, node.NewName, func(newNode, parent SQLNode) {
-		parent.(*RenameIndex).NewName = newNode.(ColIdent)
+	if !a.rewriteSetExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*Set).Exprs = newNode.(SetExprs)
 	}) {
 		return false
 	}
@@ -4631,7 +8327,7 @@ func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex,
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetDefaultRole(parent SQLNode, node *SetDefaultRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4643,19 +8339,27 @@ func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable,
 			return true
 		}
 	}
+	if !a.rewriteRefOfRoleSpec(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*SetDefaultRole).Roles = newNode.(*RoleSpec)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.To, func(newNode, parent SQLNode) {
+		parent.(*SetDefaultRole).To = newNode.(Accounts)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTableName, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4667,8 +8371,18 @@ func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTa
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*RenameTableName).Table = newNode.(TableName)
+	if !a.rewriteTableIdent(node, node.Qualifier, func(newNode, parent SQLNode) {
+		parent.(*SetExpr).Qualifier = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*SetExpr).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*SetExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4682,7 +8396,7 @@ func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTa
 	}
 	return true
 }
-func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMigration, replacer replacerFunc) bool {
+func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4690,14 +8404,24 @@ func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMi
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(SetExprs)
+			a.cur.revisit = false
+			return a.rewriteSetExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*RevertMigration).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteRefOfSetExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(SetExprs)[idx] = newNode.(*SetExpr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4709,7 +8433,7 @@ func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMi
 	}
 	return true
 }
-func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetPassword(parent SQLNode, node *SetPassword, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4721,19 +8445,25 @@ func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, repla
 			return true
 		}
 	}
+	if !a.rewriteRefOfAccount(node, node.User, func(newNode, parent SQLNode) {
+		parent.(*SetPassword).User = newNode.(*Account)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetRole(parent SQLNode, node *SetRole, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4742,8 +8472,8 @@ func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer re
 			return true
 		}
 	}
-	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
-		panic("[BUG] tried to replace 'SQLNode' on 'RootNode'")
+	if !a.rewriteRefOfRoleSpec(node, node.Roles, func(newNode, parent SQLNode) {
+		parent.(*SetRole).Roles = newNode.(*RoleSpec)
 	}) {
 		return false
 	}
@@ -4757,7 +8487,7 @@ func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfSRollback(parent SQLNode, node *SRollback, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetTransaction(parent SQLNode, node *SetTransaction, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4769,11 +8499,25 @@ func (a *application) rewriteRefOfSRollback(parent SQLNode, node *SRollback, rep
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*SRollback).Name = newNode.(ColIdent)
+	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
+		parent.(*SetTransaction).SQLNode = newNode.(SQLNode)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*SetTransaction).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*SetTransaction).Characteristics[idx] = newNode.(Characteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4784,7 +8528,7 @@ func (a *application) rewriteRefOfSRollback(parent SQLNode, node *SRollback, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfSavepoint(parent SQLNode, node *Savepoint, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShow(parent SQLNode, node *Show, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4796,8 +8540,8 @@ func (a *application) rewriteRefOfSavepoint(parent SQLNode, node *Savepoint, rep
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*Savepoint).Name = newNode.(ColIdent)
+	if !a.rewriteShowInternal(node, node.Internal, func(newNode, parent SQLNode) {
+		parent.(*Show).Internal = newNode.(ShowInternal)
 	}) {
 		return false
 	}
@@ -4811,7 +8555,7 @@ func (a *application) rewriteRefOfSavepoint(parent SQLNode, node *Savepoint, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfSelect(parent SQLNode, node *Select, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowBasic(parent SQLNode, node *ShowBasic, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4823,57 +8567,23 @@ func (a *application) rewriteRefOfSelect(parent SQLNode, node *Select, replacer
 			return true
 		}
 	}
-	for x, el := range node.From {
-		if !a.rewriteTableExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*Select).From[idx] = newNode.(TableExpr)
-			}
-		}(x)) {
-			return false
-		}
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Select).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteSelectExprs(node, node.SelectExprs, func(newNode, parent SQLNode) {
-		parent.(*Select).SelectExprs = newNode.(SelectExprs)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
-		parent.(*Select).Where = newNode.(*Where)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
-		parent.(*Select).With = newNode.(*With)
-	}) {
-		return false
-	}
-	if !a.rewriteGroupBy(node, node.GroupBy, func(newNode, parent SQLNode) {
-		parent.(*Select).GroupBy = newNode.(GroupBy)
+	if !a.rewriteTableName(node, node.Tbl, func(newNode, parent SQLNode) {
+		parent.(*ShowBasic).Tbl = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfWhere(node, node.Having, func(newNode, parent SQLNode) {
-		parent.(*Select).Having = newNode.(*Where)
+	if !a.rewriteTableIdent(node, node.DbName, func(newNode, parent SQLNode) {
+		parent.(*ShowBasic).DbName = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
-		parent.(*Select).OrderBy = newNode.(OrderBy)
+	if !a.rewriteRefOfShowFilter(node, node.Filter, func(newNode, parent SQLNode) {
+		parent.(*ShowBasic).Filter = newNode.(*ShowFilter)
 	}) {
 		return false
 	}
 	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
-		parent.(*Select).Limit = newNode.(*Limit)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfSelectInto(node, node.Into, func(newNode, parent SQLNode) {
-		parent.(*Select).Into = newNode.(*SelectInto)
+		parent.(*ShowBasic).Limit = newNode.(*Limit)
 	}) {
 		return false
 	}
@@ -4887,7 +8597,7 @@ func (a *application) rewriteRefOfSelect(parent SQLNode, node *Select, replacer
 	}
 	return true
 }
-func (a *application) rewriteSelectExprs(parent SQLNode, node SelectExprs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowBinlogEvents(parent SQLNode, node *ShowBinlogEvents, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4895,24 +8605,19 @@ func (a *application) rewriteSelectExprs(parent SQLNode, node SelectExprs, repla
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(SelectExprs)
-			a.cur.revisit = false
-			return a.rewriteSelectExprs(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteSelectExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(SelectExprs)[idx] = newNode.(SelectExpr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfLiteral(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*ShowBinlogEvents).Position = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*ShowBinlogEvents).Limit = newNode.(*Limit)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4924,7 +8629,7 @@ func (a *application) rewriteSelectExprs(parent SQLNode, node SelectExprs, repla
 	}
 	return true
 }
-func (a *application) rewriteRefOfSelectInto(parent SQLNode, node *SelectInto, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowCreate(parent SQLNode, node *ShowCreate, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4936,19 +8641,27 @@ func (a *application) rewriteRefOfSelectInto(parent SQLNode, node *SelectInto, r
 			return true
 		}
 	}
+	if !a.rewriteTableName(node, node.Op, func(newNode, parent SQLNode) {
+		parent.(*ShowCreate).Op = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfAccount(node, node.User, func(newNode, parent SQLNode) {
+		parent.(*ShowCreate).User = newNode.(*Account)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowEngine(parent SQLNode, node *ShowEngine, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4960,13 +8673,8 @@ func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replac
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Set).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteSetExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*Set).Exprs = newNode.(SetExprs)
+	if !a.rewriteColIdent(node, node.Engine, func(newNode, parent SQLNode) {
+		parent.(*ShowEngine).Engine = newNode.(ColIdent)
 	}) {
 		return false
 	}
@@ -4980,7 +8688,7 @@ func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replac
 	}
 	return true
 }
-func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowFilter(parent SQLNode, node *ShowFilter, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4992,13 +8700,8 @@ func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replace
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*SetExpr).Name = newNode.(ColIdent)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*SetExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
+		parent.(*ShowFilter).Filter = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5012,7 +8715,7 @@ func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replace
 	}
 	return true
 }
-func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowGrants(parent SQLNode, node *ShowGrants, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5020,24 +8723,19 @@ func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer re
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(SetExprs)
-			a.cur.revisit = false
-			return a.rewriteSetExprs(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfSetExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(SetExprs)[idx] = newNode.(*SetExpr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfAccount(node, node.For, func(newNode, parent SQLNode) {
+		parent.(*ShowGrants).For = newNode.(*Account)
+	}) {
+		return false
+	}
+	if !a.rewriteAccounts(node, node.Using, func(newNode, parent SQLNode) {
+		parent.(*ShowGrants).Using = newNode.(Accounts)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -5049,7 +8747,7 @@ func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfSetTransaction(parent SQLNode, node *SetTransaction, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowMigrationLogs(parent SQLNode, node *ShowMigrationLogs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5061,25 +8759,11 @@ func (a *application) rewriteRefOfSetTransaction(parent SQLNode, node *SetTransa
 			return true
 		}
 	}
-	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
-		parent.(*SetTransaction).SQLNode = newNode.(SQLNode)
-	}) {
-		return false
-	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*SetTransaction).Comments = newNode.(*ParsedComments)
+		parent.(*ShowMigrationLogs).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	for x, el := range node.Characteristics {
-		if !a.rewriteCharacteristic(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*SetTransaction).Characteristics[idx] = newNode.(Characteristic)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5090,7 +8774,7 @@ func (a *application) rewriteRefOfSetTransaction(parent SQLNode, node *SetTransa
 	}
 	return true
 }
-func (a *application) rewriteRefOfShow(parent SQLNode, node *Show, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowOther(parent SQLNode, node *ShowOther, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5102,22 +8786,19 @@ func (a *application) rewriteRefOfShow(parent SQLNode, node *Show, replacer repl
 			return true
 		}
 	}
-	if !a.rewriteShowInternal(node, node.Internal, func(newNode, parent SQLNode) {
-		parent.(*Show).Internal = newNode.(ShowInternal)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowBasic(parent SQLNode, node *ShowBasic, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowProfile(parent SQLNode, node *ShowProfile, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5129,18 +8810,13 @@ func (a *application) rewriteRefOfShowBasic(parent SQLNode, node *ShowBasic, rep
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Tbl, func(newNode, parent SQLNode) {
-		parent.(*ShowBasic).Tbl = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteTableIdent(node, node.DbName, func(newNode, parent SQLNode) {
-		parent.(*ShowBasic).DbName = newNode.(TableIdent)
+	if !a.rewriteRefOfLiteral(node, node.Query, func(newNode, parent SQLNode) {
+		parent.(*ShowProfile).Query = newNode.(*Literal)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfShowFilter(node, node.Filter, func(newNode, parent SQLNode) {
-		parent.(*ShowBasic).Filter = newNode.(*ShowFilter)
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*ShowProfile).Limit = newNode.(*Limit)
 	}) {
 		return false
 	}
@@ -5154,7 +8830,7 @@ func (a *application) rewriteRefOfShowBasic(parent SQLNode, node *ShowBasic, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowCreate(parent SQLNode, node *ShowCreate, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfShowThrottledApps(parent SQLNode, node *ShowThrottledApps, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5166,22 +8842,43 @@ func (a *application) rewriteRefOfShowCreate(parent SQLNode, node *ShowCreate, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Op, func(newNode, parent SQLNode) {
-		parent.(*ShowCreate).Op = newNode.(TableName)
-	}) {
-		return false
-	}
 	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfShutdown(parent SQLNode, node *Shutdown, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowFilter(parent SQLNode, node *ShowFilter, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSignal(parent SQLNode, node *Signal, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5193,11 +8890,20 @@ func (a *application) rewriteRefOfShowFilter(parent SQLNode, node *ShowFilter, r
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
-		parent.(*ShowFilter).Filter = newNode.(Expr)
+	if !a.rewriteRefOfHandlerCondition(node, node.Condition, func(newNode, parent SQLNode) {
+		parent.(*Signal).Condition = newNode.(*HandlerCondition)
 	}) {
 		return false
 	}
+	for x, el := range node.Info {
+		if !a.rewriteRefOfSignalInfo(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Signal).Info[idx] = newNode.(*SignalInfo)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5208,7 +8914,7 @@ func (a *application) rewriteRefOfShowFilter(parent SQLNode, node *ShowFilter, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowMigrationLogs(parent SQLNode, node *ShowMigrationLogs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSignalInfo(parent SQLNode, node *SignalInfo, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5220,8 +8926,8 @@ func (a *application) rewriteRefOfShowMigrationLogs(parent SQLNode, node *ShowMi
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*ShowMigrationLogs).Comments = newNode.(*ParsedComments)
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*SignalInfo).Value = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5235,7 +8941,7 @@ func (a *application) rewriteRefOfShowMigrationLogs(parent SQLNode, node *ShowMi
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowOther(parent SQLNode, node *ShowOther, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5247,19 +8953,22 @@ func (a *application) rewriteRefOfShowOther(parent SQLNode, node *ShowOther, rep
 			return true
 		}
 	}
+	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
+		parent.(*StarExpr).TableName = newNode.(TableName)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfShowThrottledApps(parent SQLNode, node *ShowThrottledApps, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfStartReplica(parent SQLNode, node *StartReplica, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5271,19 +8980,27 @@ func (a *application) rewriteRefOfShowThrottledApps(parent SQLNode, node *ShowTh
 			return true
 		}
 	}
+	if !a.rewriteReplicationOptions(node, node.Until, func(newNode, parent SQLNode) {
+		parent.(*StartReplica).Until = newNode.(ReplicationOptions)
+	}) {
+		return false
+	}
+	if !a.rewriteReplicationOptions(node, node.ConnectionOptions, func(newNode, parent SQLNode) {
+		parent.(*StartReplica).ConnectionOptions = newNode.(ReplicationOptions)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfStopReplica(parent SQLNode, node *StopReplica, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5295,15 +9012,12 @@ func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, repla
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
-		parent.(*StarExpr).TableName = newNode.(TableName)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
@@ -5554,6 +9268,67 @@ func (a *application) rewriteRefOfSubstrExpr(parent SQLNode, node *SubstrExpr, r
 	}
 	return true
 }
+func (a *application) rewriteRefOfTLSOption(parent SQLNode, node *TLSOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteTLSOptions(parent SQLNode, node TLSOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(TLSOptions)
+			a.cur.revisit = false
+			return a.rewriteTLSOptions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfTLSOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(TLSOptions)[idx] = newNode.(*TLSOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteTableExprs(parent SQLNode, node TableExprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5757,6 +9532,72 @@ func (a *application) rewriteRefOfTableSpec(parent SQLNode, node *TableSpec, rep
 	}) {
 		return false
 	}
+	for x, el := range node.BadDefinitions {
+		if !a.rewriteRefOfBadDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*TableSpec).BadDefinitions[idx] = newNode.(*BadDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfTableStatement(parent SQLNode, node *TableStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).With = newNode.(*With)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).Limit = newNode.(*Limit)
+	}) {
+		return false
+	}
+	if !a.rewriteLockClauses(node, node.Lock, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).Lock = newNode.(LockClauses)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSelectInto(node, node.Into, func(newNode, parent SQLNode) {
+		parent.(*TableStatement).Into = newNode.(*SelectInto)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5823,6 +9664,33 @@ func (a *application) rewriteRefOfTimestampFuncExpr(parent SQLNode, node *Timest
 	}
 	return true
 }
+func (a *application) rewriteRefOfTriggerOrder(parent SQLNode, node *TriggerOrder, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.OtherTrigger, func(newNode, parent SQLNode) {
+		parent.(*TriggerOrder).OtherTrigger = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfTrimFuncExpr(parent SQLNode, node *TrimFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5909,6 +9777,57 @@ func (a *application) rewriteRefOfUnaryExpr(parent SQLNode, node *UnaryExpr, rep
 	}
 	return true
 }
+func (a *application) rewriteRefOfUninstallComponent(parent SQLNode, node *UninstallComponent, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfUninstallPlugin(parent SQLNode, node *UninstallPlugin, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*UninstallPlugin).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfUnion(parent SQLNode, node *Union, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5946,6 +9865,11 @@ func (a *application) rewriteRefOfUnion(parent SQLNode, node *Union, replacer re
 	}) {
 		return false
 	}
+	if !a.rewriteLockClauses(node, node.Lock, func(newNode, parent SQLNode) {
+		parent.(*Union).Lock = newNode.(LockClauses)
+	}) {
+		return false
+	}
 	if !a.rewriteRefOfSelectInto(node, node.Into, func(newNode, parent SQLNode) {
 		parent.(*Union).Into = newNode.(*SelectInto)
 	}) {
@@ -6123,10 +10047,79 @@ func (a *application) rewriteRefOfUse(parent SQLNode, node *Use, replacer replac
 			return true
 		}
 	}
-	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
-		parent.(*Use).DBName = newNode.(TableIdent)
-	}) {
-		return false
+	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
+		parent.(*Use).DBName = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfUserSpec(parent SQLNode, node *UserSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfAccount(node, node.Account, func(newNode, parent SQLNode) {
+		parent.(*UserSpec).Account = newNode.(*Account)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfAuthOption(node, node.Auth, func(newNode, parent SQLNode) {
+		parent.(*UserSpec).Auth = newNode.(*AuthOption)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteUserSpecs(parent SQLNode, node UserSpecs, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(UserSpecs)
+			a.cur.revisit = false
+			return a.rewriteUserSpecs(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfUserSpec(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(UserSpecs)[idx] = newNode.(*UserSpec)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -6310,6 +10303,63 @@ func (a *application) rewriteRefOfValuesFuncExpr(parent SQLNode, node *ValuesFun
 	}
 	return true
 }
+func (a *application) rewriteRefOfValuesStatement(parent SQLNode, node *ValuesStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteValues(node, node.Rows, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).Rows = newNode.(Values)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).With = newNode.(*With)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).Limit = newNode.(*Limit)
+	}) {
+		return false
+	}
+	if !a.rewriteLockClauses(node, node.Lock, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).Lock = newNode.(LockClauses)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSelectInto(node, node.Into, func(newNode, parent SQLNode) {
+		parent.(*ValuesStatement).Into = newNode.(*SelectInto)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteVindexParam(parent SQLNode, node VindexParam, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
@@ -6466,6 +10516,154 @@ func (a *application) rewriteRefOfWhere(parent SQLNode, node *Where, replacer re
 	}
 	return true
 }
+func (a *application) rewriteRefOfWhileStmt(parent SQLNode, node *WhileStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*WhileStmt).Label = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*WhileStmt).Cond = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteCompoundStatements(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*WhileStmt).Statements = newNode.(CompoundStatements)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfWindowDefinition(parent SQLNode, node *WindowDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*WindowDefinition).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWindowSpecification(node, node.WindowSpec, func(newNode, parent SQLNode) {
+		parent.(*WindowDefinition).WindowSpec = newNode.(*WindowSpecification)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteWindowDefinitions(parent SQLNode, node WindowDefinitions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(WindowDefinitions)
+			a.cur.revisit = false
+			return a.rewriteWindowDefinitions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfWindowDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(WindowDefinitions)[idx] = newNode.(*WindowDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfWindowSpecification(parent SQLNode, node *WindowSpecification, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*WindowSpecification).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.PartitionClause, func(newNode, parent SQLNode) {
+		parent.(*WindowSpecification).PartitionClause = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderClause, func(newNode, parent SQLNode) {
+		parent.(*WindowSpecification).OrderClause = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfFrameClause(node, node.FrameClause, func(newNode, parent SQLNode) {
+		parent.(*WindowSpecification).FrameClause = newNode.(*FrameClause)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfWith(parent SQLNode, node *With, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -6497,6 +10695,70 @@ func (a *application) rewriteRefOfWith(parent SQLNode, node *With, replacer repl
 	}
 	return true
 }
+func (a *application) rewriteRefOfXATransaction(parent SQLNode, node *XATransaction, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfXid(node, node.Xid, func(newNode, parent SQLNode) {
+		parent.(*XATransaction).Xid = newNode.(*Xid)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfXid(parent SQLNode, node *Xid, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfLiteral(node, node.Gtrid, func(newNode, parent SQLNode) {
+		parent.(*Xid).Gtrid = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Bqual, func(newNode, parent SQLNode) {
+		parent.(*Xid).Bqual = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.FormatID, func(newNode, parent SQLNode) {
+		parent.(*Xid).FormatID = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfXorExpr(parent SQLNode, node *XorExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -6550,6 +10812,8 @@ func (a *application) rewriteAlterOption(parent SQLNode, node AlterOption, repla
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterIndex:
 		return a.rewriteRefOfAlterIndex(parent, node, replacer)
+	case *BadDefinition:
+		return a.rewriteRefOfBadDefinition(parent, node, replacer)
 	case *ChangeColumn:
 		return a.rewriteRefOfChangeColumn(parent, node, replacer)
 	case *DropColumn:
@@ -6586,6 +10850,8 @@ func (a *application) rewriteCallable(parent SQLNode, node Callable, replacer re
 		return true
 	}
 	switch node := node.(type) {
+	case *ArgumentLessWindowExpr:
+		return a.rewriteRefOfArgumentLessWindowExpr(parent, node, replacer)
 	case *ConvertExpr:
 		return a.rewriteRefOfConvertExpr(parent, node, replacer)
 	case *ConvertUsingExpr:
@@ -6594,10 +10860,26 @@ func (a *application) rewriteCallable(parent SQLNode, node Callable, replacer re
 		return a.rewriteRefOfCurTimeFuncExpr(parent, node, replacer)
 	case *ExtractFuncExpr:
 		return a.rewriteRefOfExtractFuncExpr(parent, node, replacer)
+	case *FirstOrLastValueExpr:
+		return a.rewriteRefOfFirstOrLastValueExpr(parent, node, replacer)
 	case *FuncExpr:
 		return a.rewriteRefOfFuncExpr(parent, node, replacer)
+	case *GeomDistanceExpr:
+		return a.rewriteRefOfGeomDistanceExpr(parent, node, replacer)
+	case *GeomDistanceSphereExpr:
+		return a.rewriteRefOfGeomDistanceSphereExpr(parent, node, replacer)
+	case *GeomFormatExpr:
+		return a.rewriteRefOfGeomFormatExpr(parent, node, replacer)
+	case *GeomFromTextExpr:
+		return a.rewriteRefOfGeomFromTextExpr(parent, node, replacer)
+	case *GeomRelationExpr:
+		return a.rewriteRefOfGeomRelationExpr(parent, node, replacer)
+	case *GeomSRIDExpr:
+		return a.rewriteRefOfGeomSRIDExpr(parent, node, replacer)
 	case *GroupConcatExpr:
 		return a.rewriteRefOfGroupConcatExpr(parent, node, replacer)
+	case *GroupingExpr:
+		return a.rewriteRefOfGroupingExpr(parent, node, replacer)
 	case *JSONArrayExpr:
 		return a.rewriteRefOfJSONArrayExpr(parent, node, replacer)
 	case *JSONAttributesExpr:
@@ -6638,10 +10920,24 @@ func (a *application) rewriteCallable(parent SQLNode, node Callable, replacer re
 		return a.rewriteRefOfJSONValueMergeExpr(parent, node, replacer)
 	case *JSONValueModifierExpr:
 		return a.rewriteRefOfJSONValueModifierExpr(parent, node, replacer)
+	case *LagLeadExpr:
+		return a.rewriteRefOfLagLeadExpr(parent, node, replacer)
 	case *MatchExpr:
 		return a.rewriteRefOfMatchExpr(parent, node, replacer)
 	case *MemberOfExpr:
 		return a.rewriteRefOfMemberOfExpr(parent, node, replacer)
+	case *NTHValueExpr:
+		return a.rewriteRefOfNTHValueExpr(parent, node, replacer)
+	case *NtileExpr:
+		return a.rewriteRefOfNtileExpr(parent, node, replacer)
+	case *RegexpInstrExpr:
+		return a.rewriteRefOfRegexpInstrExpr(parent, node, replacer)
+	case *RegexpLikeExpr:
+		return a.rewriteRefOfRegexpLikeExpr(parent, node, replacer)
+	case *RegexpReplaceExpr:
+		return a.rewriteRefOfRegexpReplaceExpr(parent, node, replacer)
+	case *RegexpSubstrExpr:
+		return a.rewriteRefOfRegexpSubstrExpr(parent, node, replacer)
 	case *SubstrExpr:
 		return a.rewriteRefOfSubstrExpr(parent, node, replacer)
 	case *TimestampFuncExpr:
@@ -6687,6 +10983,50 @@ func (a *application) rewriteColTuple(parent SQLNode, node ColTuple, replacer re
 		return true
 	}
 }
+func (a *application) rewriteCompoundStatement(parent SQLNode, node CompoundStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	switch node := node.(type) {
+	case *BeginEndBlock:
+		return a.rewriteRefOfBeginEndBlock(parent, node, replacer)
+	case *CaseStmt:
+		return a.rewriteRefOfCaseStmt(parent, node, replacer)
+	case *CloseCursor:
+		return a.rewriteRefOfCloseCursor(parent, node, replacer)
+	case *DeclareCondition:
+		return a.rewriteRefOfDeclareCondition(parent, node, replacer)
+	case *DeclareCursor:
+		return a.rewriteRefOfDeclareCursor(parent, node, replacer)
+	case *DeclareHandler:
+		return a.rewriteRefOfDeclareHandler(parent, node, replacer)
+	case *DeclareVar:
+		return a.rewriteRefOfDeclareVar(parent, node, replacer)
+	case *FetchCursor:
+		return a.rewriteRefOfFetchCursor(parent, node, replacer)
+	case *IfStmt:
+		return a.rewriteRefOfIfStmt(parent, node, replacer)
+	case *IterateStmt:
+		return a.rewriteRefOfIterateStmt(parent, node, replacer)
+	case *LeaveStmt:
+		return a.rewriteRefOfLeaveStmt(parent, node, replacer)
+	case *LoopStmt:
+		return a.rewriteRefOfLoopStmt(parent, node, replacer)
+	case *OpenCursor:
+		return a.rewriteRefOfOpenCursor(parent, node, replacer)
+	case *RepeatStmt:
+		return a.rewriteRefOfRepeatStmt(parent, node, replacer)
+	case *ReturnStmt:
+		return a.rewriteRefOfReturnStmt(parent, node, replacer)
+	case *Signal:
+		return a.rewriteRefOfSignal(parent, node, replacer)
+	case *WhileStmt:
+		return a.rewriteRefOfWhileStmt(parent, node, replacer)
+	default:
+		// this should never happen
+		return true
+	}
+}
 func (a *application) rewriteConstraintInfo(parent SQLNode, node ConstraintInfo, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -6722,14 +11062,28 @@ func (a *application) rewriteDDLStatement(parent SQLNode, node DDLStatement, rep
 		return true
 	}
 	switch node := node.(type) {
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
+	case *AlterRoutine:
+		return a.rewriteRefOfAlterRoutine(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
+	case *DropProgram:
+		return a.rewriteRefOfDropProgram(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
 	case *DropView:
@@ -6766,6 +11120,8 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfAndExpr(parent, node, replacer)
 	case Argument:
 		return a.rewriteArgument(parent, node, replacer)
+	case *ArgumentLessWindowExpr:
+		return a.rewriteRefOfArgumentLessWindowExpr(parent, node, replacer)
 	case *BetweenExpr:
 		return a.rewriteRefOfBetweenExpr(parent, node, replacer)
 	case *BinaryExpr:
@@ -6794,10 +11150,26 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfExtractFuncExpr(parent, node, replacer)
 	case *ExtractedSubquery:
 		return a.rewriteRefOfExtractedSubquery(parent, node, replacer)
+	case *FirstOrLastValueExpr:
+		return a.rewriteRefOfFirstOrLastValueExpr(parent, node, replacer)
 	case *FuncExpr:
 		return a.rewriteRefOfFuncExpr(parent, node, replacer)
+	case *GeomDistanceExpr:
+		return a.rewriteRefOfGeomDistanceExpr(parent, node, replacer)
+	case *GeomDistanceSphereExpr:
+		return a.rewriteRefOfGeomDistanceSphereExpr(parent, node, replacer)
+	case *GeomFormatExpr:
+		return a.rewriteRefOfGeomFormatExpr(parent, node, replacer)
+	case *GeomFromTextExpr:
+		return a.rewriteRefOfGeomFromTextExpr(parent, node, replacer)
+	case *GeomRelationExpr:
+		return a.rewriteRefOfGeomRelationExpr(parent, node, replacer)
+	case *GeomSRIDExpr:
+		return a.rewriteRefOfGeomSRIDExpr(parent, node, replacer)
 	case *GroupConcatExpr:
 		return a.rewriteRefOfGroupConcatExpr(parent, node, replacer)
+	case *GroupingExpr:
+		return a.rewriteRefOfGroupingExpr(parent, node, replacer)
 	case *IntervalExpr:
 		return a.rewriteRefOfIntervalExpr(parent, node, replacer)
 	case *IntroducerExpr:
@@ -6844,6 +11216,8 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfJSONValueMergeExpr(parent, node, replacer)
 	case *JSONValueModifierExpr:
 		return a.rewriteRefOfJSONValueModifierExpr(parent, node, replacer)
+	case *LagLeadExpr:
+		return a.rewriteRefOfLagLeadExpr(parent, node, replacer)
 	case ListArg:
 		return a.rewriteListArg(parent, node, replacer)
 	case *Literal:
@@ -6852,14 +11226,26 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfMatchExpr(parent, node, replacer)
 	case *MemberOfExpr:
 		return a.rewriteRefOfMemberOfExpr(parent, node, replacer)
+	case *NTHValueExpr:
+		return a.rewriteRefOfNTHValueExpr(parent, node, replacer)
 	case *NotExpr:
 		return a.rewriteRefOfNotExpr(parent, node, replacer)
+	case *NtileExpr:
+		return a.rewriteRefOfNtileExpr(parent, node, replacer)
 	case *NullVal:
 		return a.rewriteRefOfNullVal(parent, node, replacer)
 	case Offset:
 		return a.rewriteOffset(parent, node, replacer)
 	case *OrExpr:
 		return a.rewriteRefOfOrExpr(parent, node, replacer)
+	case *RegexpInstrExpr:
+		return a.rewriteRefOfRegexpInstrExpr(parent, node, replacer)
+	case *RegexpLikeExpr:
+		return a.rewriteRefOfRegexpLikeExpr(parent, node, replacer)
+	case *RegexpReplaceExpr:
+		return a.rewriteRefOfRegexpReplaceExpr(parent, node, replacer)
+	case *RegexpSubstrExpr:
+		return a.rewriteRefOfRegexpSubstrExpr(parent, node, replacer)
 	case *Subquery:
 		return a.rewriteRefOfSubquery(parent, node, replacer)
 	case *SubstrExpr:
@@ -6890,10 +11276,14 @@ func (a *application) rewriteInsertRows(parent SQLNode, node InsertRows, replace
 	switch node := node.(type) {
 	case *Select:
 		return a.rewriteRefOfSelect(parent, node, replacer)
+	case *TableStatement:
+		return a.rewriteRefOfTableStatement(parent, node, replacer)
 	case *Union:
 		return a.rewriteRefOfUnion(parent, node, replacer)
 	case Values:
 		return a.rewriteValues(parent, node, replacer)
+	case *ValuesStatement:
+		return a.rewriteRefOfValuesStatement(parent, node, replacer)
 	default:
 		// this should never happen
 		return true
@@ -6908,6 +11298,8 @@ func (a *application) rewriteJSONPathParam(parent SQLNode, node JSONPathParam, r
 		return a.rewriteRefOfAndExpr(parent, node, replacer)
 	case Argument:
 		return a.rewriteArgument(parent, node, replacer)
+	case *ArgumentLessWindowExpr:
+		return a.rewriteRefOfArgumentLessWindowExpr(parent, node, replacer)
 	case *BetweenExpr:
 		return a.rewriteRefOfBetweenExpr(parent, node, replacer)
 	case *BinaryExpr:
@@ -6936,10 +11328,26 @@ func (a *application) rewriteJSONPathParam(parent SQLNode, node JSONPathParam, r
 		return a.rewriteRefOfExtractFuncExpr(parent, node, replacer)
 	case *ExtractedSubquery:
 		return a.rewriteRefOfExtractedSubquery(parent, node, replacer)
+	case *FirstOrLastValueExpr:
+		return a.rewriteRefOfFirstOrLastValueExpr(parent, node, replacer)
 	case *FuncExpr:
 		return a.rewriteRefOfFuncExpr(parent, node, replacer)
+	case *GeomDistanceExpr:
+		return a.rewriteRefOfGeomDistanceExpr(parent, node, replacer)
+	case *GeomDistanceSphereExpr:
+		return a.rewriteRefOfGeomDistanceSphereExpr(parent, node, replacer)
+	case *GeomFormatExpr:
+		return a.rewriteRefOfGeomFormatExpr(parent, node, replacer)
+	case *GeomFromTextExpr:
+		return a.rewriteRefOfGeomFromTextExpr(parent, node, replacer)
+	case *GeomRelationExpr:
+		return a.rewriteRefOfGeomRelationExpr(parent, node, replacer)
+	case *GeomSRIDExpr:
+		return a.rewriteRefOfGeomSRIDExpr(parent, node, replacer)
 	case *GroupConcatExpr:
 		return a.rewriteRefOfGroupConcatExpr(parent, node, replacer)
+	case *GroupingExpr:
+		return a.rewriteRefOfGroupingExpr(parent, node, replacer)
 	case *IntervalExpr:
 		return a.rewriteRefOfIntervalExpr(parent, node, replacer)
 	case *IntroducerExpr:
@@ -6986,6 +11394,8 @@ func (a *application) rewriteJSONPathParam(parent SQLNode, node JSONPathParam, r
 		return a.rewriteRefOfJSONValueMergeExpr(parent, node, replacer)
 	case *JSONValueModifierExpr:
 		return a.rewriteRefOfJSONValueModifierExpr(parent, node, replacer)
+	case *LagLeadExpr:
+		return a.rewriteRefOfLagLeadExpr(parent, node, replacer)
 	case ListArg:
 		return a.rewriteListArg(parent, node, replacer)
 	case *Literal:
@@ -6994,14 +11404,26 @@ func (a *application) rewriteJSONPathParam(parent SQLNode, node JSONPathParam, r
 		return a.rewriteRefOfMatchExpr(parent, node, replacer)
 	case *MemberOfExpr:
 		return a.rewriteRefOfMemberOfExpr(parent, node, replacer)
+	case *NTHValueExpr:
+		return a.rewriteRefOfNTHValueExpr(parent, node, replacer)
 	case *NotExpr:
 		return a.rewriteRefOfNotExpr(parent, node, replacer)
+	case *NtileExpr:
+		return a.rewriteRefOfNtileExpr(parent, node, replacer)
 	case *NullVal:
 		return a.rewriteRefOfNullVal(parent, node, replacer)
 	case Offset:
 		return a.rewriteOffset(parent, node, replacer)
 	case *OrExpr:
 		return a.rewriteRefOfOrExpr(parent, node, replacer)
+	case *RegexpInstrExpr:
+		return a.rewriteRefOfRegexpInstrExpr(parent, node, replacer)
+	case *RegexpLikeExpr:
+		return a.rewriteRefOfRegexpLikeExpr(parent, node, replacer)
+	case *RegexpReplaceExpr:
+		return a.rewriteRefOfRegexpReplaceExpr(parent, node, replacer)
+	case *RegexpSubstrExpr:
+		return a.rewriteRefOfRegexpSubstrExpr(parent, node, replacer)
 	case *Subquery:
 		return a.rewriteRefOfSubquery(parent, node, replacer)
 	case *SubstrExpr:
@@ -7048,8 +11470,12 @@ func (a *application) rewriteSelectStatement(parent SQLNode, node SelectStatemen
 	switch node := node.(type) {
 	case *Select:
 		return a.rewriteRefOfSelect(parent, node, replacer)
+	case *TableStatement:
+		return a.rewriteRefOfTableStatement(parent, node, replacer)
 	case *Union:
 		return a.rewriteRefOfUnion(parent, node, replacer)
+	case *ValuesStatement:
+		return a.rewriteRefOfValuesStatement(parent, node, replacer)
 	default:
 		// this should never happen
 		return true
@@ -7062,10 +11488,18 @@ func (a *application) rewriteShowInternal(parent SQLNode, node ShowInternal, rep
 	switch node := node.(type) {
 	case *ShowBasic:
 		return a.rewriteRefOfShowBasic(parent, node, replacer)
+	case *ShowBinlogEvents:
+		return a.rewriteRefOfShowBinlogEvents(parent, node, replacer)
 	case *ShowCreate:
 		return a.rewriteRefOfShowCreate(parent, node, replacer)
+	case *ShowEngine:
+		return a.rewriteRefOfShowEngine(parent, node, replacer)
+	case *ShowGrants:
+		return a.rewriteRefOfShowGrants(parent, node, replacer)
 	case *ShowOther:
 		return a.rewriteRefOfShowOther(parent, node, replacer)
+	case *ShowProfile:
+		return a.rewriteRefOfShowProfile(parent, node, replacer)
 	default:
 		// this should never happen
 		return true
@@ -7092,34 +11526,84 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 	switch node := node.(type) {
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterRoutine:
+		return a.rewriteRefOfAlterRoutine(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
+	case *AlterUser:
+		return a.rewriteRefOfAlterUser(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
 	case *AlterVschema:
 		return a.rewriteRefOfAlterVschema(parent, node, replacer)
+	case *AnalyzeTable:
+		return a.rewriteRefOfAnalyzeTable(parent, node, replacer)
+	case *BadStmt:
+		return a.rewriteRefOfBadStmt(parent, node, replacer)
 	case *Begin:
 		return a.rewriteRefOfBegin(parent, node, replacer)
+	case *BeginEndBlock:
+		return a.rewriteRefOfBeginEndBlock(parent, node, replacer)
 	case *CallProc:
 		return a.rewriteRefOfCallProc(parent, node, replacer)
+	case *CaseStmt:
+		return a.rewriteRefOfCaseStmt(parent, node, replacer)
+	case *ChangeReplicationSource:
+		return a.rewriteRefOfChangeReplicationSource(parent, node, replacer)
+	case *CheckTable:
+		return a.rewriteRefOfCheckTable(parent, node, replacer)
+	case *ChecksumTable:
+		return a.rewriteRefOfChecksumTable(parent, node, replacer)
+	case *CloseCursor:
+		return a.rewriteRefOfCloseCursor(parent, node, replacer)
 	case *Commit:
 		return a.rewriteRefOfCommit(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
+	case *CreateRole:
+		return a.rewriteRefOfCreateRole(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateUser:
+		return a.rewriteRefOfCreateUser(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
 	case *DeallocateStmt:
 		return a.rewriteRefOfDeallocateStmt(parent, node, replacer)
+	case *DeclareCondition:
+		return a.rewriteRefOfDeclareCondition(parent, node, replacer)
+	case *DeclareCursor:
+		return a.rewriteRefOfDeclareCursor(parent, node, replacer)
+	case *DeclareHandler:
+		return a.rewriteRefOfDeclareHandler(parent, node, replacer)
+	case *DeclareVar:
+		return a.rewriteRefOfDeclareVar(parent, node, replacer)
 	case *Delete:
 		return a.rewriteRefOfDelete(parent, node, replacer)
+	case *DoStmt:
+		return a.rewriteRefOfDoStmt(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
+	case *DropProgram:
+		return a.rewriteRefOfDropProgram(parent, node, replacer)
+	case *DropRole:
+		return a.rewriteRefOfDropRole(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
+	case *DropUser:
+		return a.rewriteRefOfDropUser(parent, node, replacer)
 	case *DropView:
 		return a.rewriteRefOfDropView(parent, node, replacer)
 	case *ExecuteStmt:
@@ -7128,26 +11612,64 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfExplainStmt(parent, node, replacer)
 	case *ExplainTab:
 		return a.rewriteRefOfExplainTab(parent, node, replacer)
+	case *FetchCursor:
+		return a.rewriteRefOfFetchCursor(parent, node, replacer)
 	case *Flush:
 		return a.rewriteRefOfFlush(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
+	case *IfStmt:
+		return a.rewriteRefOfIfStmt(parent, node, replacer)
 	case *Insert:
 		return a.rewriteRefOfInsert(parent, node, replacer)
+	case *InstallComponent:
+		return a.rewriteRefOfInstallComponent(parent, node, replacer)
+	case *InstallPlugin:
+		return a.rewriteRefOfInstallPlugin(parent, node, replacer)
+	case *IterateStmt:
+		return a.rewriteRefOfIterateStmt(parent, node, replacer)
+	case *Kill:
+		return a.rewriteRefOfKill(parent, node, replacer)
+	case *LeaveStmt:
+		return a.rewriteRefOfLeaveStmt(parent, node, replacer)
 	case *Load:
 		return a.rewriteRefOfLoad(parent, node, replacer)
 	case *LockTables:
 		return a.rewriteRefOfLockTables(parent, node, replacer)
+	case *LoopStmt:
+		return a.rewriteRefOfLoopStmt(parent, node, replacer)
+	case *OpenCursor:
+		return a.rewriteRefOfOpenCursor(parent, node, replacer)
+	case *OptimizeTable:
+		return a.rewriteRefOfOptimizeTable(parent, node, replacer)
 	case *OtherAdmin:
 		return a.rewriteRefOfOtherAdmin(parent, node, replacer)
 	case *OtherRead:
 		return a.rewriteRefOfOtherRead(parent, node, replacer)
 	case *PrepareStmt:
 		return a.rewriteRefOfPrepareStmt(parent, node, replacer)
+	case *PurgeBinaryLogs:
+		return a.rewriteRefOfPurgeBinaryLogs(parent, node, replacer)
 	case *Release:
 		return a.rewriteRefOfRelease(parent, node, replacer)
 	case *RenameTable:
 		return a.rewriteRefOfRenameTable(parent, node, replacer)
+	case *RenameUser:
+		return a.rewriteRefOfRenameUser(parent, node, replacer)
+	case *RepairTable:
+		return a.rewriteRefOfRepairTable(parent, node, replacer)
+	case *RepeatStmt:
+		return a.rewriteRefOfRepeatStmt(parent, node, replacer)
+	case *ResetMaster:
+		return a.rewriteRefOfResetMaster(parent, node, replacer)
+	case *ResetReplica:
+		return a.rewriteRefOfResetReplica(parent, node, replacer)
+	case *ReturnStmt:
+		return a.rewriteRefOfReturnStmt(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
 	case *SRollback:
@@ -7158,6 +11680,12 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfSelect(parent, node, replacer)
 	case *Set:
 		return a.rewriteRefOfSet(parent, node, replacer)
+	case *SetDefaultRole:
+		return a.rewriteRefOfSetDefaultRole(parent, node, replacer)
+	case *SetPassword:
+		return a.rewriteRefOfSetPassword(parent, node, replacer)
+	case *SetRole:
+		return a.rewriteRefOfSetRole(parent, node, replacer)
 	case *SetTransaction:
 		return a.rewriteRefOfSetTransaction(parent, node, replacer)
 	case *Show:
@@ -7166,10 +11694,24 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfShowMigrationLogs(parent, node, replacer)
 	case *ShowThrottledApps:
 		return a.rewriteRefOfShowThrottledApps(parent, node, replacer)
+	case *Shutdown:
+		return a.rewriteRefOfShutdown(parent, node, replacer)
+	case *Signal:
+		return a.rewriteRefOfSignal(parent, node, replacer)
+	case *StartReplica:
+		return a.rewriteRefOfStartReplica(parent, node, replacer)
+	case *StopReplica:
+		return a.rewriteRefOfStopReplica(parent, node, replacer)
 	case *Stream:
 		return a.rewriteRefOfStream(parent, node, replacer)
+	case *TableStatement:
+		return a.rewriteRefOfTableStatement(parent, node, replacer)
 	case *TruncateTable:
 		return a.rewriteRefOfTruncateTable(parent, node, replacer)
+	case *UninstallComponent:
+		return a.rewriteRefOfUninstallComponent(parent, node, replacer)
+	case *UninstallPlugin:
+		return a.rewriteRefOfUninstallPlugin(parent, node, replacer)
 	case *Union:
 		return a.rewriteRefOfUnion(parent, node, replacer)
 	case *UnlockTables:
@@ -7180,6 +11722,12 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfUse(parent, node, replacer)
 	case *VStream:
 		return a.rewriteRefOfVStream(parent, node, replacer)
+	case *ValuesStatement:
+		return a.rewriteRefOfValuesStatement(parent, node, replacer)
+	case *WhileStmt:
+		return a.rewriteRefOfWhileStmt(parent, node, replacer)
+	case *XATransaction:
+		return a.rewriteRefOfXATransaction(parent, node, replacer)
 	default:
 		// this should never happen
 		return true
@@ -7203,6 +11751,26 @@ func (a *application) rewriteTableExpr(parent SQLNode, node TableExpr, replacer
 		return true
 	}
 }
+func (a *application) rewriteTableMaintenanceStatement(parent SQLNode, node TableMaintenanceStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	switch node := node.(type) {
+	case *AnalyzeTable:
+		return a.rewriteRefOfAnalyzeTable(parent, node, replacer)
+	case *CheckTable:
+		return a.rewriteRefOfCheckTable(parent, node, replacer)
+	case *ChecksumTable:
+		return a.rewriteRefOfChecksumTable(parent, node, replacer)
+	case *OptimizeTable:
+		return a.rewriteRefOfOptimizeTable(parent, node, replacer)
+	case *RepairTable:
+		return a.rewriteRefOfRepairTable(parent, node, replacer)
+	default:
+		// this should never happen
+		return true
+	}
+}
 func (a *application) rewriteAccessMode(parent SQLNode, node AccessMode, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/wind-c/cosqlparser/sqltypes"
 )
 
 func TestAppend(t *testing.T) {
@@ -256,6 +258,38 @@ func TestDDL(t *testing.T) {
 	}
 }
 
+func TestTableMaintenanceAffectedTables(t *testing.T) {
+	testcases := []struct {
+		query    string
+		affected TableNames
+	}{{
+		query:    "analyze table a, b",
+		affected: TableNames{{Name: NewTableIdent("a")}, {Name: NewTableIdent("b")}},
+	}, {
+		query:    "analyze table db.a update histogram on c with 8 buckets",
+		affected: TableNames{{Qualifier: NewTableIdent("db"), Name: NewTableIdent("a")}},
+	}, {
+		query:    "optimize local table a",
+		affected: TableNames{{Name: NewTableIdent("a")}},
+	}, {
+		query:    "repair table a, b quick",
+		affected: TableNames{{Name: NewTableIdent("a")}, {Name: NewTableIdent("b")}},
+	}, {
+		query:    "check table a for upgrade",
+		affected: TableNames{{Name: NewTableIdent("a")}},
+	}, {
+		query:    "checksum table a, b",
+		affected: TableNames{{Name: NewTableIdent("a")}, {Name: NewTableIdent("b")}},
+	}}
+	for _, tcase := range testcases {
+		got, err := Parse(tcase.query)
+		require.NoError(t, err)
+		stmt, ok := got.(TableMaintenanceStatement)
+		require.True(t, ok, "%T is not a TableMaintenanceStatement", got)
+		assert.Equal(t, tcase.affected, stmt.AffectedTables(), tcase.query)
+	}
+}
+
 func TestSetAutocommitON(t *testing.T) {
 	stmt, err := Parse("SET autocommit=ON")
 	require.NoError(t, err)
@@ -391,6 +425,11 @@ func TestIsAggregate(t *testing.T) {
 	if f.IsAggregate() {
 		t.Error("IsAggregate: true, want false")
 	}
+
+	f = FuncExpr{Name: NewColIdent("sum"), Over: &OverClause{WindowName: NewColIdent("w")}}
+	if f.IsAggregate() {
+		t.Error("IsAggregate: true, want false")
+	}
 }
 
 func TestIsImpossible(t *testing.T) {
@@ -634,9 +673,9 @@ func TestColIdentMarshal(t *testing.T) {
 
 func TestColIdentSize(t *testing.T) {
 	size := unsafe.Sizeof(NewColIdent(""))
-	want := 2*unsafe.Sizeof("") + 8
+	want := 2*unsafe.Sizeof("") + 8 + unsafe.Sizeof(&Span{})
 	if size != want {
-		t.Errorf("Size of ColIdent: %d, want 32", want)
+		t.Errorf("Size of ColIdent: %d, want %d", size, want)
 	}
 }
 
@@ -799,12 +838,46 @@ func TestSplitStatementToPieces(t *testing.T) {
 	}
 }
 
+func TestSplitStatementToPiecesStoredPrograms(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output []string
+	}{{
+		input:  "create procedure p() begin declare x int; set x = 1; if x then select 1; end if; end; select 4",
+		output: []string{"create procedure p() begin declare x int; set x = 1; if x then select 1; end if; end", " select 4"},
+	}, {
+		input:  "create definer = current_user() trigger t before insert on a for each row begin /* end */ select 1; end; select 2; select 3",
+		output: []string{"create definer = current_user() trigger t before insert on a for each row begin /* end */ select 1; end", " select 2", " select 3"},
+	}, {
+		input:  "create procedure p() l1:begin select 1; end l1; select 2",
+		output: []string{"create procedure p() l1:begin select 1; end l1", " select 2"},
+	}, {
+		input:  "create table t (a int); begin; select 1",
+		output: []string{"create table t (a int)", " begin", " select 1"},
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			stmtPieces, err := SplitStatementToPieces(tcase.input)
+			require.NoError(t, err)
+			require.Equal(t, tcase.output, stmtPieces)
+		})
+	}
+}
+
 func TestTypeConversion(t *testing.T) {
 	ct1 := &ColumnType{Type: "BIGINT"}
 	ct2 := &ColumnType{Type: "bigint"}
 	assert.Equal(t, ct1.SQLType(), ct2.SQLType())
 }
 
+func TestSpatialTypeConversion(t *testing.T) {
+	for _, typ := range []string{"geometry", "POINT", "linestring", "polygon", "multipoint", "multilinestring", "multipolygon", "geometrycollection", "geomcollection"} {
+		ct := &ColumnType{Type: typ}
+		assert.Equal(t, sqltypes.Geometry, ct.SQLType(), typ)
+	}
+}
+
 func TestDefaultStatus(t *testing.T) {
 	assert.Equal(t,
 		String(&Default{ColName: "status"}),
@@ -846,3 +919,237 @@ func BenchmarkStringTraces(b *testing.B) {
 		})
 	}
 }
+
+func TestSetOperations(t *testing.T) {
+	stmt, err := Parse("select 1 from a union select 1 from b intersect select 1 from c except all select 1 from d")
+	require.NoError(t, err)
+
+	// INTERSECT binds tighter than UNION and EXCEPT, which are left-associative.
+	except, ok := stmt.(*Union)
+	require.True(t, ok)
+	assert.Equal(t, ExceptOp, except.Operator)
+	assert.False(t, except.Distinct)
+	union, ok := except.Left.(*Union)
+	require.True(t, ok)
+	assert.Equal(t, UnionOp, union.Operator)
+	assert.True(t, union.Distinct)
+	intersect, ok := union.Right.(*Union)
+	require.True(t, ok)
+	assert.Equal(t, IntersectOp, intersect.Operator)
+
+	var tables []string
+	for _, sel := range GetAllSelects(except) {
+		tables = append(tables, String(TableExprs(sel.From)))
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, tables)
+	assert.Equal(t, "a", String(TableExprs(GetFirstSelect(except).From)))
+}
+
+func TestTableValueConstructors(t *testing.T) {
+	testcases := []struct {
+		sql         string
+		columnCount int
+	}{
+		{"values row(1, 2, 3), row(4, 5, 6)", 3},
+		{"table t", 1},
+		{"values row(1, 2) union select a, b from t", 2},
+		{"table t order by a limit 1", 1},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		require.NoError(t, err)
+		sel, ok := stmt.(SelectStatement)
+		require.True(t, ok, tcase.sql)
+		assert.Equal(t, tcase.columnCount, sel.GetColumnCount(), tcase.sql)
+	}
+
+	stmt, err := Parse("table t union values row(1)")
+	require.NoError(t, err)
+	assert.Nil(t, GetFirstSelect(stmt.(SelectStatement)))
+	assert.Empty(t, GetAllSelects(stmt.(SelectStatement)))
+}
+
+func TestCloneAndEquals(t *testing.T) {
+	testcases := []string{
+		"repair local table foo, bar quick extended use_frm",
+		"check table foo, bar for upgrade quick changed",
+		"checksum table foo extended",
+		"install component 'file://component_validator', 'file://component_log_sink_json'",
+		"uninstall component 'file://component_validator', 'file://component_log_sink_json'",
+		"show profile cpu, block io for query 1",
+	}
+	for _, sql := range testcases {
+		t.Run(sql, func(t *testing.T) {
+			stmt, err := Parse(sql)
+			require.NoError(t, err)
+			clone := CloneStatement(stmt)
+			assert.True(t, EqualsStatement(stmt, clone))
+			assert.Equal(t, sql, String(clone))
+		})
+	}
+}
+
+func TestLateralDerivedTable(t *testing.T) {
+	stmt, err := Parse("select * from t, lateral (select * from u where x = t.id) as d")
+	require.NoError(t, err)
+	sel := stmt.(*Select)
+	derived := sel.From[1].(*AliasedTableExpr).Expr.(*DerivedTable)
+	assert.True(t, derived.Lateral)
+
+	other, err := Parse("select * from t, (select * from u where x = t.id) as d")
+	require.NoError(t, err)
+	assert.False(t, EqualsSQLNode(stmt, other))
+	assert.True(t, EqualsSQLNode(stmt, CloneSQLNode(stmt)))
+
+	// The tables preceding the lateral derived table are visited before the
+	// column references inside it.
+	var order []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *AliasedTableExpr:
+			if tableName, ok := node.Expr.(TableName); ok {
+				order = append(order, String(tableName))
+			}
+		case *ColName:
+			order = append(order, String(node))
+		}
+		return true, nil
+	}, stmt)
+	assert.Equal(t, []string{"t", "u", "x", "t.id"}, order)
+
+	rewritten := Rewrite(stmt, nil, func(cursor *Cursor) bool {
+		if col, ok := cursor.Node().(*ColName); ok && col.Qualifier.Name.String() == "t" {
+			cursor.Replace(NewColName("outer_id"))
+		}
+		return true
+	})
+	assert.Equal(t, "select * from t, lateral (select * from u where x = outer_id) as d", String(rewritten))
+}
+
+func TestRegexpExprs(t *testing.T) {
+	stmt, err := Parse("select regexp_instr(a, 'b+', 2, 3, 1, 'i'), regexp_replace(a, 'b', 'c', 4) from t")
+	require.NoError(t, err)
+	exprs := stmt.(*Select).SelectExprs
+
+	instr, ok := exprs[0].(*AliasedExpr).Expr.(*RegexpInstrExpr)
+	require.True(t, ok)
+	assert.Equal(t, "a", String(instr.Expr))
+	assert.Equal(t, "'b+'", String(instr.Pattern))
+	assert.Equal(t, "2", String(instr.Position))
+	assert.Equal(t, "3", String(instr.Occurrence))
+	assert.Equal(t, "1", String(instr.ReturnOption))
+	assert.Equal(t, "'i'", String(instr.MatchType))
+
+	replace, ok := exprs[1].(*AliasedExpr).Expr.(*RegexpReplaceExpr)
+	require.True(t, ok)
+	assert.Equal(t, "'c'", String(replace.Repl))
+	assert.Equal(t, "4", String(replace.Position))
+	assert.Nil(t, replace.Occurrence)
+	assert.Nil(t, replace.MatchType)
+}
+
+func TestXATransaction(t *testing.T) {
+	stmt, err := Parse("xa commit 'gtrid', X'6271', 7 one phase")
+	require.NoError(t, err)
+	xa, ok := stmt.(*XATransaction)
+	require.True(t, ok)
+	assert.Equal(t, XACommit, xa.Action)
+	assert.Equal(t, OnePhaseXAOption, xa.Option)
+	assert.Equal(t, NewStrLiteral("gtrid"), xa.Xid.Gtrid)
+	assert.Equal(t, NewHexLiteral("6271"), xa.Xid.Bqual)
+	assert.Equal(t, NewIntLiteral("7"), xa.Xid.FormatID)
+	assert.Equal(t, "XA_COMMIT", ASTToStatementType(stmt).String())
+}
+
+func TestReplicationOptions(t *testing.T) {
+	stmt, err := Parse("change master to master_host = 'db1', source_port = 3306, master_auto_position = 1 for channel 'ch1'")
+	require.NoError(t, err)
+	change, ok := stmt.(*ChangeReplicationSource)
+	require.True(t, ok)
+	assert.True(t, change.Master)
+	assert.Equal(t, "ch1", change.Channel)
+	assert.Equal(t, NewStrLiteral("db1"), change.Options.Get("source_host").Value)
+	assert.Equal(t, NewIntLiteral("3306"), change.Options.Get("MASTER_PORT").Value)
+	assert.Equal(t, NewIntLiteral("1"), change.Options.Get("source_auto_position").Value)
+	assert.Nil(t, change.Options.Get("source_ssl"))
+
+	stmt, err = Parse("start replica io_thread, sql_thread until master_log_file = 'b.1', master_log_pos = 4 user = 'repl'")
+	require.NoError(t, err)
+	start, ok := stmt.(*StartReplica)
+	require.True(t, ok)
+	assert.Equal(t, IOThread|SQLThread, start.Threads)
+	assert.Equal(t, NewStrLiteral("b.1"), start.Until.Get("source_log_file").Value)
+	assert.Equal(t, NewStrLiteral("repl"), start.ConnectionOptions.Get("user").Value)
+}
+
+func TestAdminStatements(t *testing.T) {
+	stmt, err := Parse("kill query 42")
+	require.NoError(t, err)
+	kill, ok := stmt.(*Kill)
+	require.True(t, ok)
+	assert.Equal(t, QueryKill, kill.Type)
+	assert.Equal(t, uint64(42), kill.ProcesslistID)
+
+	stmt, err = Parse("install plugin validate_password soname 'validate_password.so'")
+	require.NoError(t, err)
+	plugin, ok := stmt.(*InstallPlugin)
+	require.True(t, ok)
+	assert.Equal(t, "validate_password", plugin.Name.String())
+	assert.Equal(t, "validate_password.so", plugin.Library)
+
+	stmt, err = Parse("uninstall component 'file://a', 'file://b'")
+	require.NoError(t, err)
+	component, ok := stmt.(*UninstallComponent)
+	require.True(t, ok)
+	assert.Equal(t, []string{"file://a", "file://b"}, component.Components)
+}
+
+func TestShowStatements(t *testing.T) {
+	stmt, err := Parse("show events from db like 'e%'")
+	require.NoError(t, err)
+	basic, ok := stmt.(*Show).Internal.(*ShowBasic)
+	require.True(t, ok)
+	assert.Equal(t, Events, basic.Command)
+	assert.Equal(t, "db", basic.DbName.String())
+	assert.Equal(t, "e%", basic.Filter.Like)
+
+	stmt, err = Parse("show warnings limit 5")
+	require.NoError(t, err)
+	basic = stmt.(*Show).Internal.(*ShowBasic)
+	assert.Equal(t, Warnings, basic.Command)
+	assert.Equal(t, "5", String(basic.Limit.Rowcount))
+
+	stmt, err = Parse("show slave status for channel c1")
+	require.NoError(t, err)
+	basic = stmt.(*Show).Internal.(*ShowBasic)
+	assert.Equal(t, ReplicaStatus, basic.Command)
+	assert.Equal(t, "c1", basic.Channel)
+
+	stmt, err = Parse("show create user 'u'@'localhost'")
+	require.NoError(t, err)
+	create, ok := stmt.(*Show).Internal.(*ShowCreate)
+	require.True(t, ok)
+	assert.Equal(t, CreateUsr, create.Command)
+	assert.Equal(t, &Account{User: "u", Host: "localhost"}, create.User)
+
+	stmt, err = Parse("show grants for u using r")
+	require.NoError(t, err)
+	grants, ok := stmt.(*Show).Internal.(*ShowGrants)
+	require.True(t, ok)
+	assert.Equal(t, "u", grants.For.User)
+	assert.Equal(t, Accounts{{User: "r"}}, grants.Using)
+
+	stmt, err = Parse("show profile block io, cpu for query 3")
+	require.NoError(t, err)
+	profile, ok := stmt.(*Show).Internal.(*ShowProfile)
+	require.True(t, ok)
+	assert.Equal(t, []string{"block io", "cpu"}, profile.Types)
+	assert.Equal(t, "3", profile.Query.Val)
+
+	stmt, err = Parse("show engine innodb mutex")
+	require.NoError(t, err)
+	engine, ok := stmt.(*Show).Internal.(*ShowEngine)
+	require.True(t, ok)
+	assert.Equal(t, "innodb", engine.Engine.String())
+	assert.True(t, engine.Mutex)
+}
@@ -24,6 +24,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 	switch in := in.(type) {
 	case AccessMode:
 		return VisitAccessMode(in, f)
+	case *Account:
+		return VisitRefOfAccount(in, f)
+	case Accounts:
+		return VisitAccounts(in, f)
 	case *AddColumns:
 		return VisitRefOfAddColumns(in, f)
 	case *AddConstraintDefinition:
@@ -44,24 +48,42 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
 	case *AlterIndex:
 		return VisitRefOfAlterIndex(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterRoutine:
+		return VisitRefOfAlterRoutine(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
+	case *AlterUser:
+		return VisitRefOfAlterUser(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
 		return VisitRefOfAlterVschema(in, f)
+	case *AnalyzeTable:
+		return VisitRefOfAnalyzeTable(in, f)
 	case *AndExpr:
 		return VisitRefOfAndExpr(in, f)
 	case Argument:
 		return VisitArgument(in, f)
+	case *ArgumentLessWindowExpr:
+		return VisitRefOfArgumentLessWindowExpr(in, f)
+	case *AuthOption:
+		return VisitRefOfAuthOption(in, f)
 	case *AutoIncSpec:
 		return VisitRefOfAutoIncSpec(in, f)
+	case *BadDefinition:
+		return VisitRefOfBadDefinition(in, f)
+	case *BadStmt:
+		return VisitRefOfBadStmt(in, f)
 	case *Begin:
 		return VisitRefOfBegin(in, f)
+	case *BeginEndBlock:
+		return VisitRefOfBeginEndBlock(in, f)
 	case *BetweenExpr:
 		return VisitRefOfBetweenExpr(in, f)
 	case *BinaryExpr:
@@ -72,10 +94,22 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCallProc(in, f)
 	case *CaseExpr:
 		return VisitRefOfCaseExpr(in, f)
+	case *CaseStmt:
+		return VisitRefOfCaseStmt(in, f)
+	case *CaseStmtWhen:
+		return VisitRefOfCaseStmtWhen(in, f)
 	case *ChangeColumn:
 		return VisitRefOfChangeColumn(in, f)
+	case *ChangeReplicationSource:
+		return VisitRefOfChangeReplicationSource(in, f)
 	case *CheckConstraintDefinition:
 		return VisitRefOfCheckConstraintDefinition(in, f)
+	case *CheckTable:
+		return VisitRefOfCheckTable(in, f)
+	case *ChecksumTable:
+		return VisitRefOfChecksumTable(in, f)
+	case *CloseCursor:
+		return VisitRefOfCloseCursor(in, f)
 	case ColIdent:
 		return VisitColIdent(in, f)
 	case *ColName:
@@ -94,6 +128,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCommonTableExpr(in, f)
 	case *ComparisonExpr:
 		return VisitRefOfComparisonExpr(in, f)
+	case CompoundStatements:
+		return VisitCompoundStatements(in, f)
 	case *ConstraintDefinition:
 		return VisitRefOfConstraintDefinition(in, f)
 	case *ConvertExpr:
@@ -104,14 +140,34 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfConvertUsingExpr(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
+	case *CreateRole:
+		return VisitRefOfCreateRole(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateUser:
+		return VisitRefOfCreateUser(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
 	case *CurTimeFuncExpr:
 		return VisitRefOfCurTimeFuncExpr(in, f)
 	case *DeallocateStmt:
 		return VisitRefOfDeallocateStmt(in, f)
+	case *DeclareCondition:
+		return VisitRefOfDeclareCondition(in, f)
+	case *DeclareCursor:
+		return VisitRefOfDeclareCursor(in, f)
+	case *DeclareHandler:
+		return VisitRefOfDeclareHandler(in, f)
+	case *DeclareVar:
+		return VisitRefOfDeclareVar(in, f)
 	case *Default:
 		return VisitRefOfDefault(in, f)
 	case *Definer:
@@ -120,16 +176,28 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfDelete(in, f)
 	case *DerivedTable:
 		return VisitRefOfDerivedTable(in, f)
+	case *DoStmt:
+		return VisitRefOfDoStmt(in, f)
 	case *DropColumn:
 		return VisitRefOfDropColumn(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
 	case *DropKey:
 		return VisitRefOfDropKey(in, f)
+	case *DropProgram:
+		return VisitRefOfDropProgram(in, f)
+	case *DropRole:
+		return VisitRefOfDropRole(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
+	case *DropUser:
+		return VisitRefOfDropUser(in, f)
 	case *DropView:
 		return VisitRefOfDropView(in, f)
+	case *ElseIf:
+		return VisitRefOfElseIf(in, f)
+	case *EventSchedule:
+		return VisitRefOfEventSchedule(in, f)
 	case *ExecuteStmt:
 		return VisitRefOfExecuteStmt(in, f)
 	case *ExistsExpr:
@@ -144,18 +212,50 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfExtractFuncExpr(in, f)
 	case *ExtractedSubquery:
 		return VisitRefOfExtractedSubquery(in, f)
+	case *FetchCursor:
+		return VisitRefOfFetchCursor(in, f)
+	case *FirstOrLastValueExpr:
+		return VisitRefOfFirstOrLastValueExpr(in, f)
 	case *Flush:
 		return VisitRefOfFlush(in, f)
 	case *Force:
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
+	case *FromFirstLastClause:
+		return VisitRefOfFromFirstLastClause(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
+	case *GeomDistanceExpr:
+		return VisitRefOfGeomDistanceExpr(in, f)
+	case *GeomDistanceSphereExpr:
+		return VisitRefOfGeomDistanceSphereExpr(in, f)
+	case *GeomFormatExpr:
+		return VisitRefOfGeomFormatExpr(in, f)
+	case *GeomFromTextExpr:
+		return VisitRefOfGeomFromTextExpr(in, f)
+	case *GeomRelationExpr:
+		return VisitRefOfGeomRelationExpr(in, f)
+	case *GeomSRIDExpr:
+		return VisitRefOfGeomSRIDExpr(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
+	case *GrantTarget:
+		return VisitRefOfGrantTarget(in, f)
 	case GroupBy:
 		return VisitGroupBy(in, f)
 	case *GroupConcatExpr:
 		return VisitRefOfGroupConcatExpr(in, f)
+	case *GroupingExpr:
+		return VisitRefOfGroupingExpr(in, f)
+	case *HandlerCondition:
+		return VisitRefOfHandlerCondition(in, f)
+	case *IfStmt:
+		return VisitRefOfIfStmt(in, f)
 	case *IndexDefinition:
 		return VisitRefOfIndexDefinition(in, f)
 	case *IndexHint:
@@ -166,6 +266,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfIndexInfo(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *InstallComponent:
+		return VisitRefOfInstallComponent(in, f)
+	case *InstallPlugin:
+		return VisitRefOfInstallPlugin(in, f)
 	case *IntervalExpr:
 		return VisitRefOfIntervalExpr(in, f)
 	case *IntroducerExpr:
@@ -174,6 +278,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfIsExpr(in, f)
 	case IsolationLevel:
 		return VisitIsolationLevel(in, f)
+	case *IterateStmt:
+		return VisitRefOfIterateStmt(in, f)
 	case *JSONArrayExpr:
 		return VisitRefOfJSONArrayExpr(in, f)
 	case *JSONAttributesExpr:
@@ -228,6 +334,12 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfJtOnResponse(in, f)
 	case *KeyState:
 		return VisitRefOfKeyState(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
+	case *LagLeadExpr:
+		return VisitRefOfLagLeadExpr(in, f)
+	case *LeaveStmt:
+		return VisitRefOfLeaveStmt(in, f)
 	case *Limit:
 		return VisitRefOfLimit(in, f)
 	case ListArg:
@@ -236,10 +348,20 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfLiteral(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
+	case *LoadFields:
+		return VisitRefOfLoadFields(in, f)
+	case *LoadLines:
+		return VisitRefOfLoadLines(in, f)
+	case *LockClause:
+		return VisitRefOfLockClause(in, f)
+	case LockClauses:
+		return VisitLockClauses(in, f)
 	case *LockOption:
 		return VisitRefOfLockOption(in, f)
 	case *LockTables:
 		return VisitRefOfLockTables(in, f)
+	case *LoopStmt:
+		return VisitRefOfLoopStmt(in, f)
 	case MatchAction:
 		return VisitMatchAction(in, f)
 	case *MatchExpr:
@@ -248,18 +370,28 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfMemberOfExpr(in, f)
 	case *ModifyColumn:
 		return VisitRefOfModifyColumn(in, f)
+	case *NTHValueExpr:
+		return VisitRefOfNTHValueExpr(in, f)
 	case *Nextval:
 		return VisitRefOfNextval(in, f)
 	case *NotExpr:
 		return VisitRefOfNotExpr(in, f)
+	case *NtileExpr:
+		return VisitRefOfNtileExpr(in, f)
+	case *NullTreatmentClause:
+		return VisitRefOfNullTreatmentClause(in, f)
 	case *NullVal:
 		return VisitRefOfNullVal(in, f)
 	case Offset:
 		return VisitOffset(in, f)
 	case OnDup:
 		return VisitOnDup(in, f)
+	case *OpenCursor:
+		return VisitRefOfOpenCursor(in, f)
 	case *OptLike:
 		return VisitRefOfOptLike(in, f)
+	case *OptimizeTable:
+		return VisitRefOfOptimizeTable(in, f)
 	case *OrExpr:
 		return VisitRefOfOrExpr(in, f)
 	case *Order:
@@ -272,6 +404,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *OverClause:
+		return VisitRefOfOverClause(in, f)
 	case *ParenTableExpr:
 		return VisitRefOfParenTableExpr(in, f)
 	case *ParsedComments:
@@ -290,12 +424,32 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfPartitionValueRange(in, f)
 	case Partitions:
 		return VisitPartitions(in, f)
+	case *PasswordOption:
+		return VisitRefOfPasswordOption(in, f)
+	case PasswordOptions:
+		return VisitPasswordOptions(in, f)
 	case *PrepareStmt:
 		return VisitRefOfPrepareStmt(in, f)
+	case *PrivilegeSpec:
+		return VisitRefOfPrivilegeSpec(in, f)
+	case Privileges:
+		return VisitPrivileges(in, f)
+	case *ProcParameter:
+		return VisitRefOfProcParameter(in, f)
+	case *PurgeBinaryLogs:
+		return VisitRefOfPurgeBinaryLogs(in, f)
 	case ReferenceAction:
 		return VisitReferenceAction(in, f)
 	case *ReferenceDefinition:
 		return VisitRefOfReferenceDefinition(in, f)
+	case *RegexpInstrExpr:
+		return VisitRefOfRegexpInstrExpr(in, f)
+	case *RegexpLikeExpr:
+		return VisitRefOfRegexpLikeExpr(in, f)
+	case *RegexpReplaceExpr:
+		return VisitRefOfRegexpReplaceExpr(in, f)
+	case *RegexpSubstrExpr:
+		return VisitRefOfRegexpSubstrExpr(in, f)
 	case *Release:
 		return VisitRefOfRelease(in, f)
 	case *RenameIndex:
@@ -304,12 +458,40 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfRenameTable(in, f)
 	case *RenameTableName:
 		return VisitRefOfRenameTableName(in, f)
+	case *RenameUser:
+		return VisitRefOfRenameUser(in, f)
+	case *RepairTable:
+		return VisitRefOfRepairTable(in, f)
+	case *RepeatStmt:
+		return VisitRefOfRepeatStmt(in, f)
+	case *ReplicationOption:
+		return VisitRefOfReplicationOption(in, f)
+	case ReplicationOptions:
+		return VisitReplicationOptions(in, f)
+	case *ResetMaster:
+		return VisitRefOfResetMaster(in, f)
+	case *ResetReplica:
+		return VisitRefOfResetReplica(in, f)
+	case *ResourceOption:
+		return VisitRefOfResourceOption(in, f)
+	case ResourceOptions:
+		return VisitResourceOptions(in, f)
+	case *ReturnStmt:
+		return VisitRefOfReturnStmt(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
+	case *RoleSpec:
+		return VisitRefOfRoleSpec(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
 	case RootNode:
 		return VisitRootNode(in, f)
+	case *RoutineCharacteristic:
+		return VisitRefOfRoutineCharacteristic(in, f)
+	case *RowAlias:
+		return VisitRefOfRowAlias(in, f)
 	case *SRollback:
 		return VisitRefOfSRollback(in, f)
 	case *Savepoint:
@@ -322,28 +504,52 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfSelectInto(in, f)
 	case *Set:
 		return VisitRefOfSet(in, f)
+	case *SetDefaultRole:
+		return VisitRefOfSetDefaultRole(in, f)
 	case *SetExpr:
 		return VisitRefOfSetExpr(in, f)
 	case SetExprs:
 		return VisitSetExprs(in, f)
+	case *SetPassword:
+		return VisitRefOfSetPassword(in, f)
+	case *SetRole:
+		return VisitRefOfSetRole(in, f)
 	case *SetTransaction:
 		return VisitRefOfSetTransaction(in, f)
 	case *Show:
 		return VisitRefOfShow(in, f)
 	case *ShowBasic:
 		return VisitRefOfShowBasic(in, f)
+	case *ShowBinlogEvents:
+		return VisitRefOfShowBinlogEvents(in, f)
 	case *ShowCreate:
 		return VisitRefOfShowCreate(in, f)
+	case *ShowEngine:
+		return VisitRefOfShowEngine(in, f)
 	case *ShowFilter:
 		return VisitRefOfShowFilter(in, f)
+	case *ShowGrants:
+		return VisitRefOfShowGrants(in, f)
 	case *ShowMigrationLogs:
 		return VisitRefOfShowMigrationLogs(in, f)
 	case *ShowOther:
 		return VisitRefOfShowOther(in, f)
+	case *ShowProfile:
+		return VisitRefOfShowProfile(in, f)
 	case *ShowThrottledApps:
 		return VisitRefOfShowThrottledApps(in, f)
+	case *Shutdown:
+		return VisitRefOfShutdown(in, f)
+	case *Signal:
+		return VisitRefOfSignal(in, f)
+	case *SignalInfo:
+		return VisitRefOfSignalInfo(in, f)
 	case *StarExpr:
 		return VisitRefOfStarExpr(in, f)
+	case *StartReplica:
+		return VisitRefOfStartReplica(in, f)
+	case *StopReplica:
+		return VisitRefOfStopReplica(in, f)
 	case *Stream:
 		return VisitRefOfStream(in, f)
 	case *SubPartition:
@@ -358,6 +564,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfSubquery(in, f)
 	case *SubstrExpr:
 		return VisitRefOfSubstrExpr(in, f)
+	case *TLSOption:
+		return VisitRefOfTLSOption(in, f)
+	case TLSOptions:
+		return VisitTLSOptions(in, f)
 	case TableExprs:
 		return VisitTableExprs(in, f)
 	case TableIdent:
@@ -370,16 +580,24 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitTableOptions(in, f)
 	case *TableSpec:
 		return VisitRefOfTableSpec(in, f)
+	case *TableStatement:
+		return VisitRefOfTableStatement(in, f)
 	case *TablespaceOperation:
 		return VisitRefOfTablespaceOperation(in, f)
 	case *TimestampFuncExpr:
 		return VisitRefOfTimestampFuncExpr(in, f)
+	case *TriggerOrder:
+		return VisitRefOfTriggerOrder(in, f)
 	case *TrimFuncExpr:
 		return VisitRefOfTrimFuncExpr(in, f)
 	case *TruncateTable:
 		return VisitRefOfTruncateTable(in, f)
 	case *UnaryExpr:
 		return VisitRefOfUnaryExpr(in, f)
+	case *UninstallComponent:
+		return VisitRefOfUninstallComponent(in, f)
+	case *UninstallPlugin:
+		return VisitRefOfUninstallPlugin(in, f)
 	case *Union:
 		return VisitRefOfUnion(in, f)
 	case *UnlockTables:
@@ -392,6 +610,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitUpdateExprs(in, f)
 	case *Use:
 		return VisitRefOfUse(in, f)
+	case *UserSpec:
+		return VisitRefOfUserSpec(in, f)
+	case UserSpecs:
+		return VisitUserSpecs(in, f)
 	case *VStream:
 		return VisitRefOfVStream(in, f)
 	case ValTuple:
@@ -402,6 +624,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitValues(in, f)
 	case *ValuesFuncExpr:
 		return VisitRefOfValuesFuncExpr(in, f)
+	case *ValuesStatement:
+		return VisitRefOfValuesStatement(in, f)
 	case VindexParam:
 		return VisitVindexParam(in, f)
 	case *VindexSpec:
@@ -412,8 +636,20 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WhileStmt:
+		return VisitRefOfWhileStmt(in, f)
+	case *WindowDefinition:
+		return VisitRefOfWindowDefinition(in, f)
+	case WindowDefinitions:
+		return VisitWindowDefinitions(in, f)
+	case *WindowSpecification:
+		return VisitRefOfWindowSpecification(in, f)
 	case *With:
 		return VisitRefOfWith(in, f)
+	case *XATransaction:
+		return VisitRefOfXATransaction(in, f)
+	case *Xid:
+		return VisitRefOfXid(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
@@ -421,6 +657,29 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return nil
 	}
 }
+func VisitRefOfAccount(in *Account, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitAccounts(in Accounts, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfAddColumns(in *AddColumns, f Visit) error {
 	if in == nil {
 		return nil
@@ -549,6 +808,33 @@ func VisitRefOfAlterDatabase(in *AlterDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterEvent(in *AlterEvent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfEventSchedule(in.Schedule, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.RenameTo, f); err != nil {
+		return err
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterIndex(in *AlterIndex, f Visit) error {
 	if in == nil {
 		return nil
@@ -573,6 +859,26 @@ func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterRoutine(in *AlterRoutine, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	if in == nil {
 		return nil
@@ -599,6 +905,30 @@ func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterUser(in *AlterUser, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitUserSpecs(in.Users, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfRoleSpec(in.DefaultRole, f); err != nil {
+		return err
+	}
+	if err := VisitTLSOptions(in.TLSOptions, f); err != nil {
+		return err
+	}
+	if err := VisitResourceOptions(in.ResourceOptions, f); err != nil {
+		return err
+	}
+	if err := VisitPasswordOptions(in.PasswordOptions, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterView(in *AlterView, f Visit) error {
 	if in == nil {
 		return nil
@@ -646,6 +976,24 @@ func VisitRefOfAlterVschema(in *AlterVschema, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAnalyzeTable(in *AnalyzeTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.HistogramColumns, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Buckets, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAndExpr(in *AndExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -661,6 +1009,27 @@ func VisitRefOfAndExpr(in *AndExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfArgumentLessWindowExpr(in *ArgumentLessWindowExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.OverClause, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAuthOption(in *AuthOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAutoIncSpec(in *AutoIncSpec, f Visit) error {
 	if in == nil {
 		return nil
@@ -676,6 +1045,24 @@ func VisitRefOfAutoIncSpec(in *AutoIncSpec, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfBadDefinition(in *BadDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfBadStmt(in *BadStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfBegin(in *Begin, f Visit) error {
 	if in == nil {
 		return nil
@@ -685,6 +1072,21 @@ func VisitRefOfBegin(in *Begin, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfBeginEndBlock(in *BeginEndBlock, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfBetweenExpr(in *BetweenExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -753,6 +1155,41 @@ func VisitRefOfCaseExpr(in *CaseExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCaseStmt(in *CaseStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	for _, el := range in.Whens {
+		if err := VisitRefOfCaseStmtWhen(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitCompoundStatements(in.Else, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCaseStmtWhen(in *CaseStmtWhen, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfChangeColumn(in *ChangeColumn, f Visit) error {
 	if in == nil {
 		return nil
@@ -771,6 +1208,18 @@ func VisitRefOfChangeColumn(in *ChangeColumn, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfChangeReplicationSource(in *ChangeReplicationSource, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitReplicationOptions(in.Options, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCheckConstraintDefinition(in *CheckConstraintDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -783,6 +1232,42 @@ func VisitRefOfCheckConstraintDefinition(in *CheckConstraintDefinition, f Visit)
 	}
 	return nil
 }
+func VisitRefOfCheckTable(in *CheckTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfChecksumTable(in *ChecksumTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCloseCursor(in *CloseCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitColIdent(in ColIdent, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
@@ -902,6 +1387,20 @@ func VisitRefOfComparisonExpr(in *ComparisonExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitCompoundStatements(in CompoundStatements, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitStatement(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfConstraintDefinition(in *ConstraintDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -974,214 +1473,231 @@ func VisitRefOfCreateDatabase(in *CreateDatabase, f Visit) error {
 	}
 	return nil
 }
-func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
+func VisitRefOfCreateEvent(in *CreateEvent, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.Table, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfTableSpec(in.TableSpec, f); err != nil {
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfOptLike(in.OptLike, f); err != nil {
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitRefOfEventSchedule(in.Schedule, f); err != nil {
+		return err
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfCreateView(in *CreateView, f Visit) error {
+func VisitRefOfCreateFunction(in *CreateFunction, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.ViewName, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
 		return err
 	}
-	if err := VisitColumns(in.Columns, f); err != nil {
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitSelectStatement(in.Select, f); err != nil {
-		return err
+	for _, el := range in.Params {
+		if err := VisitRefOfProcParameter(el, f); err != nil {
+			return err
+		}
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfCurTimeFuncExpr(in *CurTimeFuncExpr, f Visit) error {
+func VisitRefOfCreateProcedure(in *CreateProcedure, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Fsp, f); err != nil {
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfDeallocateStmt(in *DeallocateStmt, f Visit) error {
-	if in == nil {
-		return nil
-	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
-		return err
+	for _, el := range in.Params {
+		if err := VisitRefOfProcParameter(el, f); err != nil {
+			return err
+		}
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDefault(in *Default, f Visit) error {
+func VisitRefOfCreateRole(in *CreateRole, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitAccounts(in.Roles, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfDefiner(in *Definer, f Visit) error {
+func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfTableSpec(in.TableSpec, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOptLike(in.OptLike, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfDelete(in *Delete, f Visit) error {
+func VisitRefOfCreateTrigger(in *CreateTrigger, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfWith(in.With, f); err != nil {
-		return err
-	}
 	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitTableNames(in.Targets, f); err != nil {
-		return err
-	}
-	if err := VisitTableExprs(in.TableExprs, f); err != nil {
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
 		return err
 	}
-	if err := VisitPartitions(in.Partitions, f); err != nil {
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfWhere(in.Where, f); err != nil {
+	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
-	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+	if err := VisitRefOfTriggerOrder(in.Order, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+	if err := VisitStatement(in.Body, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDerivedTable(in *DerivedTable, f Visit) error {
+func VisitRefOfCreateUser(in *CreateUser, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSelectStatement(in.Select, f); err != nil {
+	if err := VisitUserSpecs(in.Users, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfDropColumn(in *DropColumn, f Visit) error {
-	if in == nil {
-		return nil
+	if err := VisitRefOfRoleSpec(in.DefaultRole, f); err != nil {
+		return err
 	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitTLSOptions(in.TLSOptions, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfColName(in.Name, f); err != nil {
+	if err := VisitResourceOptions(in.ResourceOptions, f); err != nil {
+		return err
+	}
+	if err := VisitPasswordOptions(in.PasswordOptions, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropDatabase(in *DropDatabase, f Visit) error {
+func VisitRefOfCreateView(in *CreateView, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitTableName(in.ViewName, f); err != nil {
 		return err
 	}
-	if err := VisitTableIdent(in.DBName, f); err != nil {
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfDropKey(in *DropKey, f Visit) error {
-	if in == nil {
-		return nil
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
 	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitSelectStatement(in.Select, f); err != nil {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropTable(in *DropTable, f Visit) error {
+func VisitRefOfCurTimeFuncExpr(in *CurTimeFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableNames(in.FromTables, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitExpr(in.Fsp, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropView(in *DropView, f Visit) error {
+func VisitRefOfDeallocateStmt(in *DeallocateStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableNames(in.FromTables, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfExecuteStmt(in *ExecuteStmt, f Visit) error {
+func VisitRefOfDeclareCondition(in *DeclareCondition, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -1191,849 +1707,1877 @@ func VisitRefOfExecuteStmt(in *ExecuteStmt, f Visit) error {
 	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
-		return err
-	}
-	if err := VisitColumns(in.Arguments, f); err != nil {
+	if err := VisitRefOfHandlerCondition(in.Condition, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfExistsExpr(in *ExistsExpr, f Visit) error {
+func VisitRefOfDeclareCursor(in *DeclareCursor, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitSelectStatement(in.Select, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfExplainStmt(in *ExplainStmt, f Visit) error {
+func VisitRefOfDeclareHandler(in *DeclareHandler, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	for _, el := range in.Conditions {
+		if err := VisitRefOfHandlerCondition(el, f); err != nil {
+			return err
+		}
+	}
 	if err := VisitStatement(in.Statement, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfExplainTab(in *ExplainTab, f Visit) error {
+func VisitRefOfDeclareVar(in *DeclareVar, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.Table, f); err != nil {
+	if err := VisitColumns(in.Names, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Default, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitExprs(in Exprs, f Visit) error {
+func VisitRefOfDefault(in *Default, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in {
-		if err := VisitExpr(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfExtractFuncExpr(in *ExtractFuncExpr, f Visit) error {
+func VisitRefOfDefiner(in *Definer, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
-		return err
-	}
 	return nil
 }
-func VisitRefOfExtractedSubquery(in *ExtractedSubquery, f Visit) error {
+func VisitRefOfDelete(in *Delete, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Original, f); err != nil {
+	if err := VisitRefOfWith(in.With, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.OtherSide, f); err != nil {
+	if err := VisitTableNames(in.Targets, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.alternative, f); err != nil {
+	if err := VisitTableExprs(in.TableExprs, f); err != nil {
+		return err
+	}
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWhere(in.Where, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfFlush(in *Flush, f Visit) error {
+func VisitRefOfDerivedTable(in *DerivedTable, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableNames(in.TableNames, f); err != nil {
+	if err := VisitSelectStatement(in.Select, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfForce(in *Force, f Visit) error {
+func VisitRefOfDoStmt(in *DoStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExprs(in.Exprs, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
+func VisitRefOfDropColumn(in *DropColumn, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColumns(in.Source, f); err != nil {
-		return err
-	}
-	if err := VisitColIdent(in.IndexName, f); err != nil {
-		return err
-	}
-	if err := VisitRefOfReferenceDefinition(in.ReferenceDefinition, f); err != nil {
+	if err := VisitRefOfColName(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
+func VisitRefOfDropDatabase(in *DropDatabase, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableIdent(in.Qualifier, f); err != nil {
-		return err
-	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitSelectExprs(in.Exprs, f); err != nil {
+	if err := VisitTableIdent(in.DBName, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitGroupBy(in GroupBy, f Visit) error {
+func VisitRefOfDropKey(in *DropKey, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in {
-		if err := VisitExpr(el, f); err != nil {
-			return err
-		}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitRefOfGroupConcatExpr(in *GroupConcatExpr, f Visit) error {
+func VisitRefOfDropProgram(in *DropProgram, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSelectExprs(in.Exprs, f); err != nil {
-		return err
-	}
-	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfIndexDefinition(in *IndexDefinition, f Visit) error {
+func VisitRefOfDropRole(in *DropRole, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfIndexInfo(in.Info, f); err != nil {
+	if err := VisitAccounts(in.Roles, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfIndexHint(in *IndexHint, f Visit) error {
+func VisitRefOfDropTable(in *DropTable, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in.Indexes {
-		if err := VisitColIdent(el, f); err != nil {
-			return err
-		}
+	if err := VisitTableNames(in.FromTables, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitIndexHints(in IndexHints, f Visit) error {
+func VisitRefOfDropUser(in *DropUser, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in {
-		if err := VisitRefOfIndexHint(el, f); err != nil {
-			return err
-		}
+	if err := VisitAccounts(in.Users, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitRefOfIndexInfo(in *IndexInfo, f Visit) error {
+func VisitRefOfDropView(in *DropView, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitTableNames(in.FromTables, f); err != nil {
 		return err
 	}
-	if err := VisitColIdent(in.ConstraintName, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfInsert(in *Insert, f Visit) error {
+func VisitRefOfElseIf(in *ElseIf, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitExpr(in.Cond, f); err != nil {
 		return err
 	}
-	if err := VisitTableName(in.Table, f); err != nil {
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
 		return err
 	}
-	if err := VisitPartitions(in.Partitions, f); err != nil {
+	return nil
+}
+func VisitRefOfEventSchedule(in *EventSchedule, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColumns(in.Columns, f); err != nil {
+	if err := VisitExpr(in.At, f); err != nil {
 		return err
 	}
-	if err := VisitInsertRows(in.Rows, f); err != nil {
+	if err := VisitExpr(in.Every, f); err != nil {
 		return err
 	}
-	if err := VisitOnDup(in.OnDup, f); err != nil {
+	if err := VisitExpr(in.Starts, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Ends, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfIntervalExpr(in *IntervalExpr, f Visit) error {
+func VisitRefOfExecuteStmt(in *ExecuteStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfIntroducerExpr(in *IntroducerExpr, f Visit) error {
-	if in == nil {
-		return nil
-	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitColumns(in.Arguments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfIsExpr(in *IsExpr, f Visit) error {
+func VisitRefOfExistsExpr(in *ExistsExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Left, f); err != nil {
+	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONArrayExpr(in *JSONArrayExpr, f Visit) error {
+func VisitRefOfExplainStmt(in *ExplainStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExprs(in.Params, f); err != nil {
+	if err := VisitStatement(in.Statement, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONAttributesExpr(in *JSONAttributesExpr, f Visit) error {
+func VisitRefOfExplainTab(in *ExplainTab, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
-		return err
-	}
-	if err := VisitJSONPathParam(in.Path, f); err != nil {
+	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONContainsExpr(in *JSONContainsExpr, f Visit) error {
+func VisitExprs(in Exprs, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Target, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.Candidate, f); err != nil {
-		return err
-	}
-	for _, el := range in.PathList {
-		if err := VisitJSONPathParam(el, f); err != nil {
+	for _, el := range in {
+		if err := VisitExpr(el, f); err != nil {
 			return err
 		}
 	}
 	return nil
 }
-func VisitRefOfJSONContainsPathExpr(in *JSONContainsPathExpr, f Visit) error {
+func VisitRefOfExtractFuncExpr(in *ExtractFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.OneOrAll, f); err != nil {
+	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
-	for _, el := range in.PathList {
-		if err := VisitJSONPathParam(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfJSONExtractExpr(in *JSONExtractExpr, f Visit) error {
+func VisitRefOfExtractedSubquery(in *ExtractedSubquery, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitExpr(in.Original, f); err != nil {
 		return err
 	}
-	for _, el := range in.PathList {
-		if err := VisitJSONPathParam(el, f); err != nil {
-			return err
-		}
+	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.OtherSide, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.alternative, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitRefOfJSONKeysExpr(in *JSONKeysExpr, f Visit) error {
+func VisitRefOfFetchCursor(in *FetchCursor, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	for _, el := range in.PathList {
-		if err := VisitJSONPathParam(el, f); err != nil {
-			return err
-		}
+	if err := VisitColumns(in.Into, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitRefOfJSONObjectExpr(in *JSONObjectExpr, f Visit) error {
+func VisitRefOfFirstOrLastValueExpr(in *FirstOrLastValueExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in.Params {
-		if err := VisitRefOfJSONObjectParam(el, f); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func VisitJSONObjectParam(in JSONObjectParam, f Visit) error {
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Key, f); err != nil {
+	if err := VisitRefOfNullTreatmentClause(in.NullTreatmentClause, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Value, f); err != nil {
+	if err := VisitRefOfOverClause(in.OverClause, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONOverlapsExpr(in *JSONOverlapsExpr, f Visit) error {
+func VisitRefOfFlush(in *Flush, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc1, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.JSONDoc2, f); err != nil {
+	if err := VisitTableNames(in.TableNames, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONPrettyExpr(in *JSONPrettyExpr, f Visit) error {
+func VisitRefOfForce(in *Force, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONVal, f); err != nil {
-		return err
-	}
 	return nil
 }
-func VisitRefOfJSONQuoteExpr(in *JSONQuoteExpr, f Visit) error {
+func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.StringArg, f); err != nil {
+	if err := VisitColumns(in.Source, f); err != nil {
+		return err
+	}
+	if err := VisitColIdent(in.IndexName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfReferenceDefinition(in.ReferenceDefinition, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONRemoveExpr(in *JSONRemoveExpr, f Visit) error {
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
 		return err
 	}
-	if err := VisitExprs(in.PathList, f); err != nil {
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONSchemaValidFuncExpr(in *JSONSchemaValidFuncExpr, f Visit) error {
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Schema, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.Document, f); err != nil {
+	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONSchemaValidationReportFuncExpr(in *JSONSchemaValidationReportFuncExpr, f Visit) error {
+func VisitRefOfFromFirstLastClause(in *FromFirstLastClause, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Schema, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.Document, f); err != nil {
-		return err
-	}
 	return nil
 }
-func VisitRefOfJSONSearchExpr(in *JSONSearchExpr, f Visit) error {
+func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitTableIdent(in.Qualifier, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.OneOrAll, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.SearchStr, f); err != nil {
+	if err := VisitSelectExprs(in.Exprs, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.EscapeChar, f); err != nil {
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
 		return err
 	}
-	for _, el := range in.PathList {
-		if err := VisitJSONPathParam(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfJSONStorageFreeExpr(in *JSONStorageFreeExpr, f Visit) error {
+func VisitRefOfGeomDistanceExpr(in *GeomDistanceExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONVal, f); err != nil {
+	if err := VisitExpr(in.Geom1, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfJSONStorageSizeExpr(in *JSONStorageSizeExpr, f Visit) error {
-	if in == nil {
-		return nil
-	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitExpr(in.Geom2, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.JSONVal, f); err != nil {
+	if err := VisitExpr(in.Unit, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONTableExpr(in *JSONTableExpr, f Visit) error {
+func VisitRefOfGeomDistanceSphereExpr(in *GeomDistanceSphereExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitExpr(in.Geom1, f); err != nil {
 		return err
 	}
-	if err := VisitTableIdent(in.Alias, f); err != nil {
+	if err := VisitExpr(in.Geom2, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Filter, f); err != nil {
+	if err := VisitExpr(in.Radius, f); err != nil {
 		return err
 	}
-	for _, el := range in.Columns {
-		if err := VisitRefOfJtColumnDefinition(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfJSONUnquoteExpr(in *JSONUnquoteExpr, f Visit) error {
+func VisitRefOfGeomFormatExpr(in *GeomFormatExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONValue, f); err != nil {
+	if err := VisitExpr(in.Geom, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.AxisOrderOpt, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONValueExpr(in *JSONValueExpr, f Visit) error {
+func VisitRefOfGeomFromTextExpr(in *GeomFromTextExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
-		return err
-	}
-	if err := VisitJSONPathParam(in.Path, f); err != nil {
-		return err
-	}
-	if err := VisitRefOfConvertType(in.ReturningType, f); err != nil {
+	if err := VisitExpr(in.WktText, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfJtOnResponse(in.EmptyOnResponse, f); err != nil {
+	if err := VisitExpr(in.Srid, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfJtOnResponse(in.ErrorOnResponse, f); err != nil {
+	if err := VisitExpr(in.AxisOrderOpt, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONValueMergeExpr(in *JSONValueMergeExpr, f Visit) error {
+func VisitRefOfGeomRelationExpr(in *GeomRelationExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitExpr(in.Geom1, f); err != nil {
 		return err
 	}
-	if err := VisitExprs(in.JSONDocList, f); err != nil {
+	if err := VisitExpr(in.Geom2, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJSONValueModifierExpr(in *JSONValueModifierExpr, f Visit) error {
+func VisitRefOfGeomSRIDExpr(in *GeomSRIDExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.JSONDoc, f); err != nil {
+	if err := VisitExpr(in.Geom, f); err != nil {
 		return err
 	}
-	for _, el := range in.Params {
-		if err := VisitRefOfJSONObjectParam(el, f); err != nil {
-			return err
-		}
+	if err := VisitExpr(in.Srid, f); err != nil {
+		return err
 	}
 	return nil
 }
-func VisitRefOfJoinCondition(in *JoinCondition, f Visit) error {
+func VisitRefOfGrant(in *Grant, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.On, f); err != nil {
+	if err := VisitPrivileges(in.Privileges, f); err != nil {
 		return err
 	}
-	if err := VisitColumns(in.Using, f); err != nil {
+	if err := VisitRefOfGrantTarget(in.Target, f); err != nil {
+		return err
+	}
+	if err := VisitAccounts(in.Roles, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfAccount(in.Proxy, f); err != nil {
+		return err
+	}
+	if err := VisitAccounts(in.To, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJoinTableExpr(in *JoinTableExpr, f Visit) error {
+func VisitRefOfGrantTarget(in *GrantTarget, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableExpr(in.LeftExpr, f); err != nil {
-		return err
-	}
-	if err := VisitTableExpr(in.RightExpr, f); err != nil {
+	if err := VisitTableIdent(in.Database, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfJoinCondition(in.Condition, f); err != nil {
+	if err := VisitTableIdent(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfJtColumnDefinition(in *JtColumnDefinition, f Visit) error {
+func VisitGroupBy(in GroupBy, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	for _, el := range in {
+		if err := VisitExpr(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfJtOnResponse(in *JtOnResponse, f Visit) error {
+func VisitRefOfGroupConcatExpr(in *GroupConcatExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitSelectExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfKeyState(in *KeyState, f Visit) error {
+func VisitRefOfGroupingExpr(in *GroupingExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExprs(in.Exprs, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfLimit(in *Limit, f Visit) error {
+func VisitRefOfHandlerCondition(in *HandlerCondition, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Offset, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.Rowcount, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfLiteral(in *Literal, f Visit) error {
+func VisitRefOfIfStmt(in *IfStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
+		return err
+	}
+	for _, el := range in.ElseIfs {
+		if err := VisitRefOfElseIf(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitCompoundStatements(in.Else, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfLoad(in *Load, f Visit) error {
+func VisitRefOfIndexDefinition(in *IndexDefinition, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitRefOfIndexInfo(in.Info, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfLockOption(in *LockOption, f Visit) error {
+func VisitRefOfIndexHint(in *IndexHint, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	for _, el := range in.Indexes {
+		if err := VisitColIdent(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfLockTables(in *LockTables, f Visit) error {
+func VisitIndexHints(in IndexHints, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	for _, el := range in {
+		if err := VisitRefOfIndexHint(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfMatchExpr(in *MatchExpr, f Visit) error {
+func VisitRefOfIndexInfo(in *IndexInfo, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSelectExprs(in.Columns, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitColIdent(in.ConstraintName, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfMemberOfExpr(in *MemberOfExpr, f Visit) error {
+func VisitRefOfInsert(in *Insert, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Value, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.JSONArr, f); err != nil {
+	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfModifyColumn(in *ModifyColumn, f Visit) error {
-	if in == nil {
-		return nil
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
 	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitColumns(in.Columns, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfColumnDefinition(in.NewColDefinition, f); err != nil {
+	if err := VisitInsertRows(in.Rows, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfColName(in.After, f); err != nil {
+	if err := VisitRefOfRowAlias(in.RowAlias, f); err != nil {
+		return err
+	}
+	if err := VisitOnDup(in.OnDup, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfNextval(in *Nextval, f Visit) error {
+func VisitRefOfInstallComponent(in *InstallComponent, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
-		return err
-	}
 	return nil
 }
-func VisitRefOfNotExpr(in *NotExpr, f Visit) error {
+func VisitRefOfInstallPlugin(in *InstallPlugin, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfIntervalExpr(in *IntervalExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfIntroducerExpr(in *IntroducerExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfIsExpr(in *IsExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Left, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfIterateStmt(in *IterateStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Label, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONArrayExpr(in *JSONArrayExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExprs(in.Params, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONAttributesExpr(in *JSONAttributesExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitJSONPathParam(in.Path, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONContainsExpr(in *JSONContainsExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Target, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Candidate, f); err != nil {
+		return err
+	}
+	for _, el := range in.PathList {
+		if err := VisitJSONPathParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONContainsPathExpr(in *JSONContainsPathExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.OneOrAll, f); err != nil {
+		return err
+	}
+	for _, el := range in.PathList {
+		if err := VisitJSONPathParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONExtractExpr(in *JSONExtractExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	for _, el := range in.PathList {
+		if err := VisitJSONPathParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONKeysExpr(in *JSONKeysExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	for _, el := range in.PathList {
+		if err := VisitJSONPathParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONObjectExpr(in *JSONObjectExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Params {
+		if err := VisitRefOfJSONObjectParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitJSONObjectParam(in JSONObjectParam, f Visit) error {
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Key, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Value, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONOverlapsExpr(in *JSONOverlapsExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc1, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc2, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONPrettyExpr(in *JSONPrettyExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONVal, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONQuoteExpr(in *JSONQuoteExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.StringArg, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONRemoveExpr(in *JSONRemoveExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.PathList, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONSchemaValidFuncExpr(in *JSONSchemaValidFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Schema, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Document, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONSchemaValidationReportFuncExpr(in *JSONSchemaValidationReportFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Schema, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Document, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONSearchExpr(in *JSONSearchExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.OneOrAll, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.SearchStr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.EscapeChar, f); err != nil {
+		return err
+	}
+	for _, el := range in.PathList {
+		if err := VisitJSONPathParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONStorageFreeExpr(in *JSONStorageFreeExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONVal, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONStorageSizeExpr(in *JSONStorageSizeExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONVal, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONTableExpr(in *JSONTableExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitTableIdent(in.Alias, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Filter, f); err != nil {
+		return err
+	}
+	for _, el := range in.Columns {
+		if err := VisitRefOfJtColumnDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJSONUnquoteExpr(in *JSONUnquoteExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONValue, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONValueExpr(in *JSONValueExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitJSONPathParam(in.Path, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfConvertType(in.ReturningType, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfJtOnResponse(in.EmptyOnResponse, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfJtOnResponse(in.ErrorOnResponse, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONValueMergeExpr(in *JSONValueMergeExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.JSONDocList, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJSONValueModifierExpr(in *JSONValueModifierExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.JSONDoc, f); err != nil {
+		return err
+	}
+	for _, el := range in.Params {
+		if err := VisitRefOfJSONObjectParam(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfJoinCondition(in *JoinCondition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.On, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Using, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJoinTableExpr(in *JoinTableExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableExpr(in.LeftExpr, f); err != nil {
+		return err
+	}
+	if err := VisitTableExpr(in.RightExpr, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfJoinCondition(in.Condition, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJtColumnDefinition(in *JtColumnDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfJtOnResponse(in *JtOnResponse, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfKeyState(in *KeyState, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfKill(in *Kill, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLagLeadExpr(in *LagLeadExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.N, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Default, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfNullTreatmentClause(in.NullTreatmentClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.OverClause, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLeaveStmt(in *LeaveStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Label, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLimit(in *Limit, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Offset, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Rowcount, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLiteral(in *Literal, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoad(in *Load, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLoadFields(in.Fields, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLoadLines(in.Lines, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.IgnoreLines, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitUpdateExprs(in.SetExprs, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoadFields(in *LoadFields, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Terminated, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Enclosed, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Escaped, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoadLines(in *LoadLines, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Starting, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Terminated, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLockClause(in *LockClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitLockClauses(in LockClauses, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfLockClause(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfLockOption(in *LockOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLockTables(in *LockTables, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoopStmt(in *LoopStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfMatchExpr(in *MatchExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitSelectExprs(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfMemberOfExpr(in *MemberOfExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Value, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.JSONArr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfModifyColumn(in *ModifyColumn, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfColumnDefinition(in.NewColDefinition, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfColName(in.After, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNTHValueExpr(in *NTHValueExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.N, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFromFirstLastClause(in.FromFirstLastClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfNullTreatmentClause(in.NullTreatmentClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.OverClause, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNextval(in *Nextval, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNotExpr(in *NotExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNtileExpr(in *NtileExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.N, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.OverClause, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNullTreatmentClause(in *NullTreatmentClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfNullVal(in *NullVal, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitOnDup(in OnDup, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfUpdateExpr(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfOpenCursor(in *OpenCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOptLike(in *OptLike, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.LikeTable, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOptimizeTable(in *OptimizeTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOrExpr(in *OrExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Left, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Right, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOrder(in *Order, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitOrderBy(in OrderBy, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfOrder(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfOrderByOption(in *OrderByOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Cols, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOtherAdmin(in *OtherAdmin, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfOverClause(in *OverClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.WindowName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfParenTableExpr(in *ParenTableExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfParsedComments(in *ParsedComments, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionDefinition(in *PartitionDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfPartitionDefinitionOptions(in.Options, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionDefinitionOptions(in *PartitionDefinitionOptions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfPartitionValueRange(in.ValueRange, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Comment, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfPartitionEngine(in.Engine, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.DataDirectory, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.IndexDirectory, f); err != nil {
+		return err
+	}
+	if err := VisitSubPartitionDefinitions(in.SubPartitionDefinitions, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionEngine(in *PartitionEngine, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionOption(in *PartitionOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.ColList, f); err != nil {
+		return err
+	}
 	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfSubPartition(in.SubPartition, f); err != nil {
+		return err
+	}
+	for _, el := range in.Definitions {
+		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfNullVal(in *NullVal, f Visit) error {
+func VisitRefOfPartitionSpec(in *PartitionSpec, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitPartitions(in.Names, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Number, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.TableName, f); err != nil {
+		return err
+	}
+	for _, el := range in.Definitions {
+		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfPartitionValueRange(in *PartitionValueRange, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitValTuple(in.Range, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitOnDup(in OnDup, f Visit) error {
+func VisitPartitions(in Partitions, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -2041,52 +3585,66 @@ func VisitOnDup(in OnDup, f Visit) error {
 		return err
 	}
 	for _, el := range in {
-		if err := VisitRefOfUpdateExpr(el, f); err != nil {
+		if err := VisitColIdent(el, f); err != nil {
 			return err
 		}
 	}
 	return nil
 }
-func VisitRefOfOptLike(in *OptLike, f Visit) error {
+func VisitRefOfPasswordOption(in *PasswordOption, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.LikeTable, f); err != nil {
+	return nil
+}
+func VisitPasswordOptions(in PasswordOptions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	for _, el := range in {
+		if err := VisitRefOfPasswordOption(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfOrExpr(in *OrExpr, f Visit) error {
+func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Left, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Right, f); err != nil {
+	if err := VisitExpr(in.Statement, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfOrder(in *Order, f Visit) error {
+func VisitRefOfPrivilegeSpec(in *PrivilegeSpec, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Expr, f); err != nil {
+	if err := VisitColumns(in.Columns, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitOrderBy(in OrderBy, f Visit) error {
+func VisitPrivileges(in Privileges, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -2094,106 +3652,205 @@ func VisitOrderBy(in OrderBy, f Visit) error {
 		return err
 	}
 	for _, el := range in {
-		if err := VisitRefOfOrder(el, f); err != nil {
+		if err := VisitRefOfPrivilegeSpec(el, f); err != nil {
 			return err
 		}
 	}
 	return nil
 }
-func VisitRefOfOrderByOption(in *OrderByOption, f Visit) error {
+func VisitRefOfProcParameter(in *ProcParameter, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColumns(in.Cols, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfOtherAdmin(in *OtherAdmin, f Visit) error {
+func VisitRefOfPurgeBinaryLogs(in *PurgeBinaryLogs, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExpr(in.Before, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
+func VisitRefOfReferenceDefinition(in *ReferenceDefinition, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableName(in.ReferencedTable, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.ReferencedColumns, f); err != nil {
+		return err
+	}
+	if err := VisitMatchAction(in.Match, f); err != nil {
+		return err
+	}
+	if err := VisitReferenceAction(in.OnDelete, f); err != nil {
+		return err
+	}
+	if err := VisitReferenceAction(in.OnUpdate, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfParenTableExpr(in *ParenTableExpr, f Visit) error {
+func VisitRefOfRegexpInstrExpr(in *RegexpInstrExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableExprs(in.Exprs, f); err != nil {
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Pattern, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Position, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Occurrence, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.ReturnOption, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.MatchType, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfParsedComments(in *ParsedComments, f Visit) error {
+func VisitRefOfRegexpLikeExpr(in *RegexpLikeExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Pattern, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.MatchType, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRegexpReplaceExpr(in *RegexpReplaceExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Pattern, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Repl, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Position, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Occurrence, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.MatchType, f); err != nil {
+		return err
+	}
 	return nil
 }
-func VisitRefOfPartitionDefinition(in *PartitionDefinition, f Visit) error {
+func VisitRefOfRegexpSubstrExpr(in *RegexpSubstrExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfPartitionDefinitionOptions(in.Options, f); err != nil {
+	if err := VisitExpr(in.Pattern, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Position, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Occurrence, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.MatchType, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfPartitionDefinitionOptions(in *PartitionDefinitionOptions, f Visit) error {
+func VisitRefOfRelease(in *Release, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfPartitionValueRange(in.ValueRange, f); err != nil {
+	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLiteral(in.Comment, f); err != nil {
+	return nil
+}
+func VisitRefOfRenameIndex(in *RenameIndex, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfPartitionEngine(in.Engine, f); err != nil {
+	if err := VisitColIdent(in.OldName, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLiteral(in.DataDirectory, f); err != nil {
+	if err := VisitColIdent(in.NewName, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLiteral(in.IndexDirectory, f); err != nil {
+	return nil
+}
+func VisitRefOfRenameTable(in *RenameTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRenameTableName(in *RenameTableName, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSubPartitionDefinitions(in.SubPartitionDefinitions, f); err != nil {
+	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfPartitionEngine(in *PartitionEngine, f Visit) error {
+func VisitRefOfRenameUser(in *RenameUser, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -2202,65 +3859,49 @@ func VisitRefOfPartitionEngine(in *PartitionEngine, f Visit) error {
 	}
 	return nil
 }
-func VisitRefOfPartitionOption(in *PartitionOption, f Visit) error {
+func VisitRefOfRepairTable(in *RepairTable, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColumns(in.ColList, f); err != nil {
-		return err
-	}
-	if err := VisitExpr(in.Expr, f); err != nil {
-		return err
-	}
-	if err := VisitRefOfSubPartition(in.SubPartition, f); err != nil {
+	if err := VisitTableNames(in.Tables, f); err != nil {
 		return err
 	}
-	for _, el := range in.Definitions {
-		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfPartitionSpec(in *PartitionSpec, f Visit) error {
+func VisitRefOfRepeatStmt(in *RepeatStmt, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitPartitions(in.Names, f); err != nil {
+	if err := VisitColIdent(in.Label, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfLiteral(in.Number, f); err != nil {
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
 		return err
 	}
-	if err := VisitTableName(in.TableName, f); err != nil {
+	if err := VisitExpr(in.Until, f); err != nil {
 		return err
 	}
-	for _, el := range in.Definitions {
-		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
-			return err
-		}
-	}
 	return nil
 }
-func VisitRefOfPartitionValueRange(in *PartitionValueRange, f Visit) error {
+func VisitRefOfReplicationOption(in *ReplicationOption, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitValTuple(in.Range, f); err != nil {
+	if err := VisitExpr(in.Value, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitPartitions(in Partitions, f Visit) error {
+func VisitReplicationOptions(in ReplicationOptions, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -2268,115 +3909,135 @@ func VisitPartitions(in Partitions, f Visit) error {
 		return err
 	}
 	for _, el := range in {
-		if err := VisitColIdent(el, f); err != nil {
+		if err := VisitRefOfReplicationOption(el, f); err != nil {
 			return err
 		}
 	}
 	return nil
 }
-func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
+func VisitRefOfResetMaster(in *ResetMaster, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitRefOfLiteral(in.To, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Statement, f); err != nil {
-		return err
+	return nil
+}
+func VisitRefOfResetReplica(in *ResetReplica, f Visit) error {
+	if in == nil {
+		return nil
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
 	return nil
 }
-func VisitRefOfReferenceDefinition(in *ReferenceDefinition, f Visit) error {
+func VisitRefOfResourceOption(in *ResourceOption, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.ReferencedTable, f); err != nil {
-		return err
+	return nil
+}
+func VisitResourceOptions(in ResourceOptions, f Visit) error {
+	if in == nil {
+		return nil
 	}
-	if err := VisitColumns(in.ReferencedColumns, f); err != nil {
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitMatchAction(in.Match, f); err != nil {
-		return err
+	for _, el := range in {
+		if err := VisitRefOfResourceOption(el, f); err != nil {
+			return err
+		}
 	}
-	if err := VisitReferenceAction(in.OnDelete, f); err != nil {
+	return nil
+}
+func VisitRefOfReturnStmt(in *ReturnStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitReferenceAction(in.OnUpdate, f); err != nil {
+	if err := VisitExpr(in.Expr, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfRelease(in *Release, f Visit) error {
+func VisitRefOfRevertMigration(in *RevertMigration, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.Name, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfRenameIndex(in *RenameIndex, f Visit) error {
+func VisitRefOfRevoke(in *Revoke, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColIdent(in.OldName, f); err != nil {
+	if err := VisitPrivileges(in.Privileges, f); err != nil {
 		return err
 	}
-	if err := VisitColIdent(in.NewName, f); err != nil {
+	if err := VisitRefOfGrantTarget(in.Target, f); err != nil {
 		return err
 	}
-	return nil
-}
-func VisitRefOfRenameTable(in *RenameTable, f Visit) error {
-	if in == nil {
-		return nil
+	if err := VisitAccounts(in.Roles, f); err != nil {
+		return err
 	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitRefOfAccount(in.Proxy, f); err != nil {
+		return err
+	}
+	if err := VisitAccounts(in.From, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfRenameTableName(in *RenameTableName, f Visit) error {
+func VisitRefOfRoleSpec(in *RoleSpec, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.Table, f); err != nil {
+	if err := VisitAccounts(in.Roles, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfRevertMigration(in *RevertMigration, f Visit) error {
+func VisitRefOfRollback(in *Rollback, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	return nil
+}
+func VisitRootNode(in RootNode, f Visit) error {
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitSQLNode(in.SQLNode, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfRollback(in *Rollback, f Visit) error {
+func VisitRefOfRoutineCharacteristic(in *RoutineCharacteristic, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -2385,11 +4046,17 @@ func VisitRefOfRollback(in *Rollback, f Visit) error {
 	}
 	return nil
 }
-func VisitRootNode(in RootNode, f Visit) error {
+func VisitRefOfRowAlias(in *RowAlias, f Visit) error {
+	if in == nil {
+		return nil
+	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSQLNode(in.SQLNode, f); err != nil {
+	if err := VisitTableIdent(in.TableName, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
 		return err
 	}
 	return nil
@@ -2448,12 +4115,18 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfWhere(in.Having, f); err != nil {
 		return err
 	}
+	if err := VisitWindowDefinitions(in.Windows, f); err != nil {
+		return err
+	}
 	if err := VisitOrderBy(in.OrderBy, f); err != nil {
 		return err
 	}
 	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitLockClauses(in.Lock, f); err != nil {
+		return err
+	}
 	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
 		return err
 	}
@@ -2480,6 +4153,9 @@ func VisitRefOfSelectInto(in *SelectInto, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitColumns(in.Variables, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfSet(in *Set, f Visit) error {
@@ -2497,6 +4173,21 @@ func VisitRefOfSet(in *Set, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSetDefaultRole(in *SetDefaultRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfRoleSpec(in.Roles, f); err != nil {
+		return err
+	}
+	if err := VisitAccounts(in.To, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfSetExpr(in *SetExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2504,6 +4195,9 @@ func VisitRefOfSetExpr(in *SetExpr, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableIdent(in.Qualifier, f); err != nil {
+		return err
+	}
 	if err := VisitColIdent(in.Name, f); err != nil {
 		return err
 	}
@@ -2526,6 +4220,30 @@ func VisitSetExprs(in SetExprs, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSetPassword(in *SetPassword, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.User, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfSetRole(in *SetRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfRoleSpec(in.Roles, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfSetTransaction(in *SetTransaction, f Visit) error {
 	if in == nil {
 		return nil
@@ -2574,72 +4292,197 @@ func VisitRefOfShowBasic(in *ShowBasic, f Visit) error {
 	if err := VisitRefOfShowFilter(in.Filter, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowBinlogEvents(in *ShowBinlogEvents, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Position, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowCreate(in *ShowCreate, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Op, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfAccount(in.User, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowEngine(in *ShowEngine, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Engine, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowFilter(in *ShowFilter, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Filter, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowGrants(in *ShowGrants, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.For, f); err != nil {
+		return err
+	}
+	if err := VisitAccounts(in.Using, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowMigrationLogs(in *ShowMigrationLogs, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowOther(in *ShowOther, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowProfile(in *ShowProfile, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Query, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShowThrottledApps(in *ShowThrottledApps, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfShutdown(in *Shutdown, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
 	return nil
 }
-func VisitRefOfShowCreate(in *ShowCreate, f Visit) error {
+func VisitRefOfSignal(in *Signal, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.Op, f); err != nil {
+	if err := VisitRefOfHandlerCondition(in.Condition, f); err != nil {
 		return err
 	}
+	for _, el := range in.Info {
+		if err := VisitRefOfSignalInfo(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
-func VisitRefOfShowFilter(in *ShowFilter, f Visit) error {
+func VisitRefOfSignalInfo(in *SignalInfo, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Filter, f); err != nil {
+	if err := VisitExpr(in.Value, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfShowMigrationLogs(in *ShowMigrationLogs, f Visit) error {
+func VisitRefOfStarExpr(in *StarExpr, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitTableName(in.TableName, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfShowOther(in *ShowOther, f Visit) error {
+func VisitRefOfStartReplica(in *StartReplica, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	return nil
-}
-func VisitRefOfShowThrottledApps(in *ShowThrottledApps, f Visit) error {
-	if in == nil {
-		return nil
+	if err := VisitReplicationOptions(in.Until, f); err != nil {
+		return err
 	}
-	if cont, err := f(in); err != nil || !cont {
+	if err := VisitReplicationOptions(in.ConnectionOptions, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfStarExpr(in *StarExpr, f Visit) error {
+func VisitRefOfStopReplica(in *StopReplica, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableName(in.TableName, f); err != nil {
-		return err
-	}
 	return nil
 }
 func VisitRefOfStream(in *Stream, f Visit) error {
@@ -2755,6 +4598,29 @@ func VisitRefOfSubstrExpr(in *SubstrExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTLSOption(in *TLSOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitTLSOptions(in TLSOptions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfTLSOption(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitTableExprs(in TableExprs, f Visit) error {
 	if in == nil {
 		return nil
@@ -2833,6 +4699,41 @@ func VisitRefOfTableSpec(in *TableSpec, f Visit) error {
 	if err := VisitRefOfPartitionOption(in.PartitionOption, f); err != nil {
 		return err
 	}
+	for _, el := range in.BadDefinitions {
+		if err := VisitRefOfBadDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfTableStatement(in *TableStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+		return err
+	}
+	if err := VisitLockClauses(in.Lock, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfTablespaceOperation(in *TablespaceOperation, f Visit) error {
@@ -2859,6 +4760,18 @@ func VisitRefOfTimestampFuncExpr(in *TimestampFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTriggerOrder(in *TriggerOrder, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.OtherTrigger, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfTrimFuncExpr(in *TrimFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2898,6 +4811,27 @@ func VisitRefOfUnaryExpr(in *UnaryExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfUninstallComponent(in *UninstallComponent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfUninstallPlugin(in *UninstallPlugin, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfUnion(in *Union, f Visit) error {
 	if in == nil {
 		return nil
@@ -2920,6 +4854,9 @@ func VisitRefOfUnion(in *Union, f Visit) error {
 	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitLockClauses(in.Lock, f); err != nil {
+		return err
+	}
 	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
 		return err
 	}
@@ -3005,6 +4942,35 @@ func VisitRefOfUse(in *Use, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfUserSpec(in *UserSpec, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.Account, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfAuthOption(in.Auth, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitUserSpecs(in UserSpecs, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfUserSpec(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfVStream(in *VStream, f Visit) error {
 	if in == nil {
 		return nil
@@ -3078,6 +5044,36 @@ func VisitRefOfValuesFuncExpr(in *ValuesFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfValuesStatement(in *ValuesStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitValues(in.Rows, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLimit(in.Limit, f); err != nil {
+		return err
+	}
+	if err := VisitLockClauses(in.Lock, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitVindexParam(in VindexParam, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
@@ -3149,6 +5145,74 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWhileStmt(in *WhileStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitCompoundStatements(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWindowDefinition(in *WindowDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitWindowDefinitions(in WindowDefinitions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfWindowDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfWindowSpecification(in *WindowSpecification, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.PartitionClause, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.FrameClause, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfWith(in *With, f Visit) error {
 	if in == nil {
 		return nil
@@ -3163,6 +5227,36 @@ func VisitRefOfWith(in *With, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfXATransaction(in *XATransaction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfXid(in.Xid, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfXid(in *Xid, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Gtrid, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Bqual, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.FormatID, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfXorExpr(in *XorExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3199,6 +5293,8 @@ func VisitAlterOption(in AlterOption, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterIndex:
 		return VisitRefOfAlterIndex(in, f)
+	case *BadDefinition:
+		return VisitRefOfBadDefinition(in, f)
 	case *ChangeColumn:
 		return VisitRefOfChangeColumn(in, f)
 	case *DropColumn:
@@ -3235,6 +5331,8 @@ func VisitCallable(in Callable, f Visit) error {
 		return nil
 	}
 	switch in := in.(type) {
+	case *ArgumentLessWindowExpr:
+		return VisitRefOfArgumentLessWindowExpr(in, f)
 	case *ConvertExpr:
 		return VisitRefOfConvertExpr(in, f)
 	case *ConvertUsingExpr:
@@ -3243,10 +5341,26 @@ func VisitCallable(in Callable, f Visit) error {
 		return VisitRefOfCurTimeFuncExpr(in, f)
 	case *ExtractFuncExpr:
 		return VisitRefOfExtractFuncExpr(in, f)
+	case *FirstOrLastValueExpr:
+		return VisitRefOfFirstOrLastValueExpr(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
+	case *GeomDistanceExpr:
+		return VisitRefOfGeomDistanceExpr(in, f)
+	case *GeomDistanceSphereExpr:
+		return VisitRefOfGeomDistanceSphereExpr(in, f)
+	case *GeomFormatExpr:
+		return VisitRefOfGeomFormatExpr(in, f)
+	case *GeomFromTextExpr:
+		return VisitRefOfGeomFromTextExpr(in, f)
+	case *GeomRelationExpr:
+		return VisitRefOfGeomRelationExpr(in, f)
+	case *GeomSRIDExpr:
+		return VisitRefOfGeomSRIDExpr(in, f)
 	case *GroupConcatExpr:
 		return VisitRefOfGroupConcatExpr(in, f)
+	case *GroupingExpr:
+		return VisitRefOfGroupingExpr(in, f)
 	case *JSONArrayExpr:
 		return VisitRefOfJSONArrayExpr(in, f)
 	case *JSONAttributesExpr:
@@ -3287,10 +5401,24 @@ func VisitCallable(in Callable, f Visit) error {
 		return VisitRefOfJSONValueMergeExpr(in, f)
 	case *JSONValueModifierExpr:
 		return VisitRefOfJSONValueModifierExpr(in, f)
+	case *LagLeadExpr:
+		return VisitRefOfLagLeadExpr(in, f)
 	case *MatchExpr:
 		return VisitRefOfMatchExpr(in, f)
 	case *MemberOfExpr:
 		return VisitRefOfMemberOfExpr(in, f)
+	case *NTHValueExpr:
+		return VisitRefOfNTHValueExpr(in, f)
+	case *NtileExpr:
+		return VisitRefOfNtileExpr(in, f)
+	case *RegexpInstrExpr:
+		return VisitRefOfRegexpInstrExpr(in, f)
+	case *RegexpLikeExpr:
+		return VisitRefOfRegexpLikeExpr(in, f)
+	case *RegexpReplaceExpr:
+		return VisitRefOfRegexpReplaceExpr(in, f)
+	case *RegexpSubstrExpr:
+		return VisitRefOfRegexpSubstrExpr(in, f)
 	case *SubstrExpr:
 		return VisitRefOfSubstrExpr(in, f)
 	case *TimestampFuncExpr:
@@ -3336,6 +5464,50 @@ func VisitColTuple(in ColTuple, f Visit) error {
 		return nil
 	}
 }
+func VisitCompoundStatement(in CompoundStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	switch in := in.(type) {
+	case *BeginEndBlock:
+		return VisitRefOfBeginEndBlock(in, f)
+	case *CaseStmt:
+		return VisitRefOfCaseStmt(in, f)
+	case *CloseCursor:
+		return VisitRefOfCloseCursor(in, f)
+	case *DeclareCondition:
+		return VisitRefOfDeclareCondition(in, f)
+	case *DeclareCursor:
+		return VisitRefOfDeclareCursor(in, f)
+	case *DeclareHandler:
+		return VisitRefOfDeclareHandler(in, f)
+	case *DeclareVar:
+		return VisitRefOfDeclareVar(in, f)
+	case *FetchCursor:
+		return VisitRefOfFetchCursor(in, f)
+	case *IfStmt:
+		return VisitRefOfIfStmt(in, f)
+	case *IterateStmt:
+		return VisitRefOfIterateStmt(in, f)
+	case *LeaveStmt:
+		return VisitRefOfLeaveStmt(in, f)
+	case *LoopStmt:
+		return VisitRefOfLoopStmt(in, f)
+	case *OpenCursor:
+		return VisitRefOfOpenCursor(in, f)
+	case *RepeatStmt:
+		return VisitRefOfRepeatStmt(in, f)
+	case *ReturnStmt:
+		return VisitRefOfReturnStmt(in, f)
+	case *Signal:
+		return VisitRefOfSignal(in, f)
+	case *WhileStmt:
+		return VisitRefOfWhileStmt(in, f)
+	default:
+		// this should never happen
+		return nil
+	}
+}
 func VisitConstraintInfo(in ConstraintInfo, f Visit) error {
 	if in == nil {
 		return nil
@@ -3371,14 +5543,28 @@ func VisitDDLStatement(in DDLStatement, f Visit) error {
 		return nil
 	}
 	switch in := in.(type) {
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
+	case *AlterRoutine:
+		return VisitRefOfAlterRoutine(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
+	case *DropProgram:
+		return VisitRefOfDropProgram(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
 	case *DropView:
@@ -3415,6 +5601,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfAndExpr(in, f)
 	case Argument:
 		return VisitArgument(in, f)
+	case *ArgumentLessWindowExpr:
+		return VisitRefOfArgumentLessWindowExpr(in, f)
 	case *BetweenExpr:
 		return VisitRefOfBetweenExpr(in, f)
 	case *BinaryExpr:
@@ -3443,10 +5631,26 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfExtractFuncExpr(in, f)
 	case *ExtractedSubquery:
 		return VisitRefOfExtractedSubquery(in, f)
+	case *FirstOrLastValueExpr:
+		return VisitRefOfFirstOrLastValueExpr(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
+	case *GeomDistanceExpr:
+		return VisitRefOfGeomDistanceExpr(in, f)
+	case *GeomDistanceSphereExpr:
+		return VisitRefOfGeomDistanceSphereExpr(in, f)
+	case *GeomFormatExpr:
+		return VisitRefOfGeomFormatExpr(in, f)
+	case *GeomFromTextExpr:
+		return VisitRefOfGeomFromTextExpr(in, f)
+	case *GeomRelationExpr:
+		return VisitRefOfGeomRelationExpr(in, f)
+	case *GeomSRIDExpr:
+		return VisitRefOfGeomSRIDExpr(in, f)
 	case *GroupConcatExpr:
 		return VisitRefOfGroupConcatExpr(in, f)
+	case *GroupingExpr:
+		return VisitRefOfGroupingExpr(in, f)
 	case *IntervalExpr:
 		return VisitRefOfIntervalExpr(in, f)
 	case *IntroducerExpr:
@@ -3493,6 +5697,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfJSONValueMergeExpr(in, f)
 	case *JSONValueModifierExpr:
 		return VisitRefOfJSONValueModifierExpr(in, f)
+	case *LagLeadExpr:
+		return VisitRefOfLagLeadExpr(in, f)
 	case ListArg:
 		return VisitListArg(in, f)
 	case *Literal:
@@ -3501,14 +5707,26 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfMatchExpr(in, f)
 	case *MemberOfExpr:
 		return VisitRefOfMemberOfExpr(in, f)
+	case *NTHValueExpr:
+		return VisitRefOfNTHValueExpr(in, f)
 	case *NotExpr:
 		return VisitRefOfNotExpr(in, f)
+	case *NtileExpr:
+		return VisitRefOfNtileExpr(in, f)
 	case *NullVal:
 		return VisitRefOfNullVal(in, f)
 	case Offset:
 		return VisitOffset(in, f)
 	case *OrExpr:
 		return VisitRefOfOrExpr(in, f)
+	case *RegexpInstrExpr:
+		return VisitRefOfRegexpInstrExpr(in, f)
+	case *RegexpLikeExpr:
+		return VisitRefOfRegexpLikeExpr(in, f)
+	case *RegexpReplaceExpr:
+		return VisitRefOfRegexpReplaceExpr(in, f)
+	case *RegexpSubstrExpr:
+		return VisitRefOfRegexpSubstrExpr(in, f)
 	case *Subquery:
 		return VisitRefOfSubquery(in, f)
 	case *SubstrExpr:
@@ -3539,10 +5757,14 @@ func VisitInsertRows(in InsertRows, f Visit) error {
 	switch in := in.(type) {
 	case *Select:
 		return VisitRefOfSelect(in, f)
+	case *TableStatement:
+		return VisitRefOfTableStatement(in, f)
 	case *Union:
 		return VisitRefOfUnion(in, f)
 	case Values:
 		return VisitValues(in, f)
+	case *ValuesStatement:
+		return VisitRefOfValuesStatement(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -3557,6 +5779,8 @@ func VisitJSONPathParam(in JSONPathParam, f Visit) error {
 		return VisitRefOfAndExpr(in, f)
 	case Argument:
 		return VisitArgument(in, f)
+	case *ArgumentLessWindowExpr:
+		return VisitRefOfArgumentLessWindowExpr(in, f)
 	case *BetweenExpr:
 		return VisitRefOfBetweenExpr(in, f)
 	case *BinaryExpr:
@@ -3585,10 +5809,26 @@ func VisitJSONPathParam(in JSONPathParam, f Visit) error {
 		return VisitRefOfExtractFuncExpr(in, f)
 	case *ExtractedSubquery:
 		return VisitRefOfExtractedSubquery(in, f)
+	case *FirstOrLastValueExpr:
+		return VisitRefOfFirstOrLastValueExpr(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
+	case *GeomDistanceExpr:
+		return VisitRefOfGeomDistanceExpr(in, f)
+	case *GeomDistanceSphereExpr:
+		return VisitRefOfGeomDistanceSphereExpr(in, f)
+	case *GeomFormatExpr:
+		return VisitRefOfGeomFormatExpr(in, f)
+	case *GeomFromTextExpr:
+		return VisitRefOfGeomFromTextExpr(in, f)
+	case *GeomRelationExpr:
+		return VisitRefOfGeomRelationExpr(in, f)
+	case *GeomSRIDExpr:
+		return VisitRefOfGeomSRIDExpr(in, f)
 	case *GroupConcatExpr:
 		return VisitRefOfGroupConcatExpr(in, f)
+	case *GroupingExpr:
+		return VisitRefOfGroupingExpr(in, f)
 	case *IntervalExpr:
 		return VisitRefOfIntervalExpr(in, f)
 	case *IntroducerExpr:
@@ -3635,6 +5875,8 @@ func VisitJSONPathParam(in JSONPathParam, f Visit) error {
 		return VisitRefOfJSONValueMergeExpr(in, f)
 	case *JSONValueModifierExpr:
 		return VisitRefOfJSONValueModifierExpr(in, f)
+	case *LagLeadExpr:
+		return VisitRefOfLagLeadExpr(in, f)
 	case ListArg:
 		return VisitListArg(in, f)
 	case *Literal:
@@ -3643,14 +5885,26 @@ func VisitJSONPathParam(in JSONPathParam, f Visit) error {
 		return VisitRefOfMatchExpr(in, f)
 	case *MemberOfExpr:
 		return VisitRefOfMemberOfExpr(in, f)
+	case *NTHValueExpr:
+		return VisitRefOfNTHValueExpr(in, f)
 	case *NotExpr:
 		return VisitRefOfNotExpr(in, f)
+	case *NtileExpr:
+		return VisitRefOfNtileExpr(in, f)
 	case *NullVal:
 		return VisitRefOfNullVal(in, f)
 	case Offset:
 		return VisitOffset(in, f)
 	case *OrExpr:
 		return VisitRefOfOrExpr(in, f)
+	case *RegexpInstrExpr:
+		return VisitRefOfRegexpInstrExpr(in, f)
+	case *RegexpLikeExpr:
+		return VisitRefOfRegexpLikeExpr(in, f)
+	case *RegexpReplaceExpr:
+		return VisitRefOfRegexpReplaceExpr(in, f)
+	case *RegexpSubstrExpr:
+		return VisitRefOfRegexpSubstrExpr(in, f)
 	case *Subquery:
 		return VisitRefOfSubquery(in, f)
 	case *SubstrExpr:
@@ -3697,8 +5951,12 @@ func VisitSelectStatement(in SelectStatement, f Visit) error {
 	switch in := in.(type) {
 	case *Select:
 		return VisitRefOfSelect(in, f)
+	case *TableStatement:
+		return VisitRefOfTableStatement(in, f)
 	case *Union:
 		return VisitRefOfUnion(in, f)
+	case *ValuesStatement:
+		return VisitRefOfValuesStatement(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -3711,10 +5969,18 @@ func VisitShowInternal(in ShowInternal, f Visit) error {
 	switch in := in.(type) {
 	case *ShowBasic:
 		return VisitRefOfShowBasic(in, f)
+	case *ShowBinlogEvents:
+		return VisitRefOfShowBinlogEvents(in, f)
 	case *ShowCreate:
 		return VisitRefOfShowCreate(in, f)
+	case *ShowEngine:
+		return VisitRefOfShowEngine(in, f)
+	case *ShowGrants:
+		return VisitRefOfShowGrants(in, f)
 	case *ShowOther:
 		return VisitRefOfShowOther(in, f)
+	case *ShowProfile:
+		return VisitRefOfShowProfile(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -3741,34 +6007,84 @@ func VisitStatement(in Statement, f Visit) error {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterRoutine:
+		return VisitRefOfAlterRoutine(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
+	case *AlterUser:
+		return VisitRefOfAlterUser(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
 		return VisitRefOfAlterVschema(in, f)
+	case *AnalyzeTable:
+		return VisitRefOfAnalyzeTable(in, f)
+	case *BadStmt:
+		return VisitRefOfBadStmt(in, f)
 	case *Begin:
 		return VisitRefOfBegin(in, f)
+	case *BeginEndBlock:
+		return VisitRefOfBeginEndBlock(in, f)
 	case *CallProc:
 		return VisitRefOfCallProc(in, f)
+	case *CaseStmt:
+		return VisitRefOfCaseStmt(in, f)
+	case *ChangeReplicationSource:
+		return VisitRefOfChangeReplicationSource(in, f)
+	case *CheckTable:
+		return VisitRefOfCheckTable(in, f)
+	case *ChecksumTable:
+		return VisitRefOfChecksumTable(in, f)
+	case *CloseCursor:
+		return VisitRefOfCloseCursor(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
+	case *CreateRole:
+		return VisitRefOfCreateRole(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateUser:
+		return VisitRefOfCreateUser(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
 	case *DeallocateStmt:
 		return VisitRefOfDeallocateStmt(in, f)
+	case *DeclareCondition:
+		return VisitRefOfDeclareCondition(in, f)
+	case *DeclareCursor:
+		return VisitRefOfDeclareCursor(in, f)
+	case *DeclareHandler:
+		return VisitRefOfDeclareHandler(in, f)
+	case *DeclareVar:
+		return VisitRefOfDeclareVar(in, f)
 	case *Delete:
 		return VisitRefOfDelete(in, f)
+	case *DoStmt:
+		return VisitRefOfDoStmt(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
+	case *DropProgram:
+		return VisitRefOfDropProgram(in, f)
+	case *DropRole:
+		return VisitRefOfDropRole(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
+	case *DropUser:
+		return VisitRefOfDropUser(in, f)
 	case *DropView:
 		return VisitRefOfDropView(in, f)
 	case *ExecuteStmt:
@@ -3777,26 +6093,64 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfExplainStmt(in, f)
 	case *ExplainTab:
 		return VisitRefOfExplainTab(in, f)
+	case *FetchCursor:
+		return VisitRefOfFetchCursor(in, f)
 	case *Flush:
 		return VisitRefOfFlush(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
+	case *IfStmt:
+		return VisitRefOfIfStmt(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *InstallComponent:
+		return VisitRefOfInstallComponent(in, f)
+	case *InstallPlugin:
+		return VisitRefOfInstallPlugin(in, f)
+	case *IterateStmt:
+		return VisitRefOfIterateStmt(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
+	case *LeaveStmt:
+		return VisitRefOfLeaveStmt(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
 		return VisitRefOfLockTables(in, f)
+	case *LoopStmt:
+		return VisitRefOfLoopStmt(in, f)
+	case *OpenCursor:
+		return VisitRefOfOpenCursor(in, f)
+	case *OptimizeTable:
+		return VisitRefOfOptimizeTable(in, f)
 	case *OtherAdmin:
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
 	case *PrepareStmt:
 		return VisitRefOfPrepareStmt(in, f)
+	case *PurgeBinaryLogs:
+		return VisitRefOfPurgeBinaryLogs(in, f)
 	case *Release:
 		return VisitRefOfRelease(in, f)
 	case *RenameTable:
 		return VisitRefOfRenameTable(in, f)
+	case *RenameUser:
+		return VisitRefOfRenameUser(in, f)
+	case *RepairTable:
+		return VisitRefOfRepairTable(in, f)
+	case *RepeatStmt:
+		return VisitRefOfRepeatStmt(in, f)
+	case *ResetMaster:
+		return VisitRefOfResetMaster(in, f)
+	case *ResetReplica:
+		return VisitRefOfResetReplica(in, f)
+	case *ReturnStmt:
+		return VisitRefOfReturnStmt(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
 	case *SRollback:
@@ -3807,6 +6161,12 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfSelect(in, f)
 	case *Set:
 		return VisitRefOfSet(in, f)
+	case *SetDefaultRole:
+		return VisitRefOfSetDefaultRole(in, f)
+	case *SetPassword:
+		return VisitRefOfSetPassword(in, f)
+	case *SetRole:
+		return VisitRefOfSetRole(in, f)
 	case *SetTransaction:
 		return VisitRefOfSetTransaction(in, f)
 	case *Show:
@@ -3815,10 +6175,24 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfShowMigrationLogs(in, f)
 	case *ShowThrottledApps:
 		return VisitRefOfShowThrottledApps(in, f)
+	case *Shutdown:
+		return VisitRefOfShutdown(in, f)
+	case *Signal:
+		return VisitRefOfSignal(in, f)
+	case *StartReplica:
+		return VisitRefOfStartReplica(in, f)
+	case *StopReplica:
+		return VisitRefOfStopReplica(in, f)
 	case *Stream:
 		return VisitRefOfStream(in, f)
+	case *TableStatement:
+		return VisitRefOfTableStatement(in, f)
 	case *TruncateTable:
 		return VisitRefOfTruncateTable(in, f)
+	case *UninstallComponent:
+		return VisitRefOfUninstallComponent(in, f)
+	case *UninstallPlugin:
+		return VisitRefOfUninstallPlugin(in, f)
 	case *Union:
 		return VisitRefOfUnion(in, f)
 	case *UnlockTables:
@@ -3829,6 +6203,12 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfUse(in, f)
 	case *VStream:
 		return VisitRefOfVStream(in, f)
+	case *ValuesStatement:
+		return VisitRefOfValuesStatement(in, f)
+	case *WhileStmt:
+		return VisitRefOfWhileStmt(in, f)
+	case *XATransaction:
+		return VisitRefOfXATransaction(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -3852,6 +6232,26 @@ func VisitTableExpr(in TableExpr, f Visit) error {
 		return nil
 	}
 }
+func VisitTableMaintenanceStatement(in TableMaintenanceStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	switch in := in.(type) {
+	case *AnalyzeTable:
+		return VisitRefOfAnalyzeTable(in, f)
+	case *CheckTable:
+		return VisitRefOfCheckTable(in, f)
+	case *ChecksumTable:
+		return VisitRefOfChecksumTable(in, f)
+	case *OptimizeTable:
+		return VisitRefOfOptimizeTable(in, f)
+	case *RepairTable:
+		return VisitRefOfRepairTable(in, f)
+	default:
+		// this should never happen
+		return nil
+	}
+}
 func VisitAccessMode(in AccessMode, f Visit) error {
 	_, err := f(in)
 	return err
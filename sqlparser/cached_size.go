@@ -29,6 +29,20 @@ type cachedObject interface {
 	CachedSize(alloc bool) int64
 }
 
+func (cached *Account) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field User string
+	size += hack.RuntimeAllocSize(int64(len(cached.User)))
+	// field Host string
+	size += hack.RuntimeAllocSize(int64(len(cached.Host)))
+	return size
+}
 func (cached *AddColumns) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -94,15 +108,17 @@ func (cached *AliasedTableExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.SimpleTableExpr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	// field Partitions vitess.io/vitess/go/vt/sqlparser.Partitions
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(48))
 		for _, elem := range cached.Partitions {
 			size += elem.CachedSize(false)
 		}
@@ -118,7 +134,7 @@ func (cached *AliasedTableExpr) CachedSize(alloc bool) int64 {
 	}
 	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -145,7 +161,7 @@ func (cached *AlterCheck) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -175,8 +191,10 @@ func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field DBName vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.DBName.CachedSize(false)
 	// field AlterOptions []vitess.io/vitess/go/vt/sqlparser.DatabaseOption
@@ -188,13 +206,41 @@ func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *AlterEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(176)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Schedule *vitess.io/vitess/go/vt/sqlparser.EventSchedule
+	size += cached.Schedule.CachedSize(true)
+	// field RenameTo vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.RenameTo.CachedSize(false)
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *AlterIndex) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -206,8 +252,10 @@ func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field UUID string
 	size += hack.RuntimeAllocSize(int64(len(cached.UUID)))
 	// field Expire string
@@ -216,7 +264,7 @@ func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	size += cached.Ratio.CachedSize(true)
 	return size
 }
-func (cached *AlterTable) CachedSize(alloc bool) int64 {
+func (cached *AlterRoutine) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -224,6 +272,31 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(96)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *AlterTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	// field AlterOptions []vitess.io/vitess/go/vt/sqlparser.AlterOption
@@ -243,14 +316,66 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *AlterUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Users vitess.io/vitess/go/vt/sqlparser.UserSpecs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field DefaultRole *vitess.io/vitess/go/vt/sqlparser.RoleSpec
+	size += cached.DefaultRole.CachedSize(true)
+	// field TLSOptions vitess.io/vitess/go/vt/sqlparser.TLSOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.TLSOptions)) * int64(8))
+		for _, elem := range cached.TLSOptions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ResourceOptions vitess.io/vitess/go/vt/sqlparser.ResourceOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ResourceOptions)) * int64(8))
+		for _, elem := range cached.ResourceOptions {
+			if elem != nil {
+				size += hack.RuntimeAllocSize(int64(16))
+			}
+		}
+	}
+	// field PasswordOptions vitess.io/vitess/go/vt/sqlparser.PasswordOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PasswordOptions)) * int64(8))
+		for _, elem := range cached.PasswordOptions {
+			if elem != nil {
+				size += hack.RuntimeAllocSize(int64(16))
+			}
+		}
+	}
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
+	// field Attribute string
+	size += hack.RuntimeAllocSize(int64(len(cached.Attribute)))
+	return size
+}
 func (cached *AlterView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(160)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field ViewName vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.ViewName.CachedSize(false)
 	// field Algorithm string
@@ -261,7 +386,7 @@ func (cached *AlterView) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Security)))
 	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -282,15 +407,17 @@ func (cached *AlterVschema) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(112)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	// field VindexSpec *vitess.io/vitess/go/vt/sqlparser.VindexSpec
 	size += cached.VindexSpec.CachedSize(true)
 	// field VindexCols []vitess.io/vitess/go/vt/sqlparser.ColIdent
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.VindexCols)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.VindexCols)) * int64(48))
 		for _, elem := range cached.VindexCols {
 			size += elem.CachedSize(false)
 		}
@@ -299,14 +426,44 @@ func (cached *AlterVschema) CachedSize(alloc bool) int64 {
 	size += cached.AutoIncSpec.CachedSize(true)
 	return size
 }
+func (cached *AnalyzeTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field HistogramColumns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.HistogramColumns)) * int64(48))
+		for _, elem := range cached.HistogramColumns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Buckets *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Buckets.CachedSize(true)
+	return size
+}
 func (cached *AndExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -317,13 +474,43 @@ func (cached *AndExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ArgumentLessWindowExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field OverClause *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.OverClause.CachedSize(true)
+	return size
+}
+func (cached *AuthOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Plugin string
+	size += hack.RuntimeAllocSize(int64(len(cached.Plugin)))
+	// field AuthString string
+	size += hack.RuntimeAllocSize(int64(len(cached.AuthString)))
+	// field Replace string
+	size += hack.RuntimeAllocSize(int64(len(cached.Replace)))
+	return size
+}
 func (cached *AutoIncSpec) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(96)
 	}
 	// field Column vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Column.CachedSize(false)
@@ -331,6 +518,69 @@ func (cached *AutoIncSpec) CachedSize(alloc bool) int64 {
 	size += cached.Sequence.CachedSize(false)
 	return size
 }
+func (cached *BadDefinition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field SQL string
+	size += hack.RuntimeAllocSize(int64(len(cached.SQL)))
+	return size
+}
+func (cached *BadStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field SQL string
+	size += hack.RuntimeAllocSize(int64(len(cached.SQL)))
+	return size
+}
+func (cached *Begin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *BeginEndBlock) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *BetweenExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -339,6 +589,8 @@ func (cached *BetweenExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -361,6 +613,8 @@ func (cached *BinaryExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -377,8 +631,10 @@ func (cached *CallProc) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Name.CachedSize(false)
 	// field Params vitess.io/vitess/go/vt/sqlparser.Exprs
@@ -400,6 +656,8 @@ func (cached *CaseExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -417,99 +675,239 @@ func (cached *CaseExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
-func (cached *ChangeColumn) CachedSize(alloc bool) int64 {
+func (cached *CaseStmt) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Whens []*vitess.io/vitess/go/vt/sqlparser.CaseStmtWhen
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Whens)) * int64(8))
+		for _, elem := range cached.Whens {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Else)) * int64(16))
+		for _, elem := range cached.Else {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
 	}
-	// field OldColumn *vitess.io/vitess/go/vt/sqlparser.ColName
-	size += cached.OldColumn.CachedSize(true)
-	// field NewColDefinition *vitess.io/vitess/go/vt/sqlparser.ColumnDefinition
-	size += cached.NewColDefinition.CachedSize(true)
-	// field After *vitess.io/vitess/go/vt/sqlparser.ColName
-	size += cached.After.CachedSize(true)
 	return size
 }
-func (cached *CheckConstraintDefinition) CachedSize(alloc bool) int64 {
+func (cached *CaseStmtWhen) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(48)
 	}
-	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
-	if cc, ok := cached.Expr.(cachedObject); ok {
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
 	return size
 }
-func (cached *ColIdent) CachedSize(alloc bool) int64 {
+func (cached *ChangeColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(32)
 	}
-	// field val string
-	size += hack.RuntimeAllocSize(int64(len(cached.val)))
-	// field lowered string
-	size += hack.RuntimeAllocSize(int64(len(cached.lowered)))
+	// field OldColumn *vitess.io/vitess/go/vt/sqlparser.ColName
+	size += cached.OldColumn.CachedSize(true)
+	// field NewColDefinition *vitess.io/vitess/go/vt/sqlparser.ColumnDefinition
+	size += cached.NewColDefinition.CachedSize(true)
+	// field After *vitess.io/vitess/go/vt/sqlparser.ColName
+	size += cached.After.CachedSize(true)
 	return size
 }
-func (cached *ColName) CachedSize(alloc bool) int64 {
+func (cached *ChangeReplicationSource) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(64)
 	}
-	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
-	size += cached.Name.CachedSize(false)
-	// field Qualifier vitess.io/vitess/go/vt/sqlparser.TableName
-	size += cached.Qualifier.CachedSize(false)
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Options vitess.io/vitess/go/vt/sqlparser.ReplicationOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Options)) * int64(8))
+		for _, elem := range cached.Options {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
 	return size
 }
-func (cached *CollateExpr) CachedSize(alloc bool) int64 {
+func (cached *CheckConstraintDefinition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(24)
 	}
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
-	// field Collation string
-	size += hack.RuntimeAllocSize(int64(len(cached.Collation)))
 	return size
 }
-func (cached *ColumnCharset) CachedSize(alloc bool) int64 {
+func (cached *CheckTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Options []vitess.io/vitess/go/vt/sqlparser.MaintenanceOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Options)))
 	}
-	// field Name string
-	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
 	return size
 }
-func (cached *ColumnDefinition) CachedSize(alloc bool) int64 {
+func (cached *ChecksumTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Options []vitess.io/vitess/go/vt/sqlparser.MaintenanceOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Options)))
+	}
+	return size
+}
+func (cached *CloseCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *ColIdent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field val string
+	size += hack.RuntimeAllocSize(int64(len(cached.val)))
+	// field lowered string
+	size += hack.RuntimeAllocSize(int64(len(cached.lowered)))
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *ColName) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Qualifier vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Qualifier.CachedSize(false)
+	return size
+}
+func (cached *CollateExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Collation string
+	size += hack.RuntimeAllocSize(int64(len(cached.Collation)))
+	return size
+}
+func (cached *ColumnCharset) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	return size
+}
+func (cached *ColumnDefinition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -582,19 +980,31 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	size += cached.SRID.CachedSize(true)
 	return size
 }
+func (cached *Commit) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
 func (cached *CommonTableExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field TableID vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.TableID.CachedSize(false)
 	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -611,6 +1021,8 @@ func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -647,8 +1059,10 @@ func (cached *ConvertExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -681,8 +1095,10 @@ func (cached *ConvertUsingExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -697,8 +1113,10 @@ func (cached *CreateDatabase) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field DBName vitess.io/vitess/go/vt/sqlparser.TableIdent
@@ -712,14 +1130,135 @@ func (cached *CreateDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CreateEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Schedule *vitess.io/vitess/go/vt/sqlparser.EventSchedule
+	size += cached.Schedule.CachedSize(true)
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(240)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Params []*vitess.io/vitess/go/vt/sqlparser.ProcParameter
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(8))
+		for _, elem := range cached.Params {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Returns vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Returns.CachedSize(false)
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateProcedure) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Params []*vitess.io/vitess/go/vt/sqlparser.ProcParameter
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(8))
+		for _, elem := range cached.Params {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Roles vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *CreateTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(112)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	// field TableSpec *vitess.io/vitess/go/vt/sqlparser.TableSpec
@@ -730,14 +1269,92 @@ func (cached *CreateTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *CreateTrigger) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Order *vitess.io/vitess/go/vt/sqlparser.TriggerOrder
+	size += cached.Order.CachedSize(true)
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Users vitess.io/vitess/go/vt/sqlparser.UserSpecs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field DefaultRole *vitess.io/vitess/go/vt/sqlparser.RoleSpec
+	size += cached.DefaultRole.CachedSize(true)
+	// field TLSOptions vitess.io/vitess/go/vt/sqlparser.TLSOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.TLSOptions)) * int64(8))
+		for _, elem := range cached.TLSOptions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ResourceOptions vitess.io/vitess/go/vt/sqlparser.ResourceOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ResourceOptions)) * int64(8))
+		for _, elem := range cached.ResourceOptions {
+			if elem != nil {
+				size += hack.RuntimeAllocSize(int64(16))
+			}
+		}
+	}
+	// field PasswordOptions vitess.io/vitess/go/vt/sqlparser.PasswordOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PasswordOptions)) * int64(8))
+		for _, elem := range cached.PasswordOptions {
+			if elem != nil {
+				size += hack.RuntimeAllocSize(int64(16))
+			}
+		}
+	}
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
+	// field Attribute string
+	size += hack.RuntimeAllocSize(int64(len(cached.Attribute)))
+	return size
+}
 func (cached *CreateView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(176)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field ViewName vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.ViewName.CachedSize(false)
 	// field Algorithm string
@@ -748,7 +1365,7 @@ func (cached *CreateView) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Security)))
 	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -769,8 +1386,10 @@ func (cached *CurTimeFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	// field Fsp vitess.io/vitess/go/vt/sqlparser.Expr
@@ -797,41 +1416,127 @@ func (cached *DeallocateStmt) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	return size
 }
-func (cached *Default) CachedSize(alloc bool) int64 {
+func (cached *DeclareCondition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(64)
 	}
-	// field ColName string
-	size += hack.RuntimeAllocSize(int64(len(cached.ColName)))
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Condition *vitess.io/vitess/go/vt/sqlparser.HandlerCondition
+	size += cached.Condition.CachedSize(true)
 	return size
 }
-func (cached *Definer) CachedSize(alloc bool) int64 {
+func (cached *DeclareCursor) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(80)
 	}
-	// field Name string
-	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
-	// field Address string
-	size += hack.RuntimeAllocSize(int64(len(cached.Address)))
-	return size
-}
-func (cached *Delete) CachedSize(alloc bool) int64 {
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Select vitess.io/vitess/go/vt/sqlparser.SelectStatement
+	if cc, ok := cached.Select.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DeclareHandler) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Conditions []*vitess.io/vitess/go/vt/sqlparser.HandlerCondition
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Conditions)) * int64(8))
+		for _, elem := range cached.Conditions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Statement vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Statement.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DeclareVar) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Names vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Names)) * int64(48))
+		for _, elem := range cached.Names {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Type vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Type.CachedSize(false)
+	// field Default vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Default.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *Default) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field ColName string
+	size += hack.RuntimeAllocSize(int64(len(cached.ColName)))
+	return size
+}
+func (cached *Definer) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Address string
+	size += hack.RuntimeAllocSize(int64(len(cached.Address)))
+	return size
+}
+func (cached *Delete) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -839,13 +1544,15 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(144)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field With *vitess.io/vitess/go/vt/sqlparser.With
 	size += cached.With.CachedSize(true)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field Targets vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Targets)) * int64(32))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Targets)) * int64(48))
 		for _, elem := range cached.Targets {
 			size += elem.CachedSize(false)
 		}
@@ -861,7 +1568,7 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	}
 	// field Partitions vitess.io/vitess/go/vt/sqlparser.Partitions
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(48))
 		for _, elem := range cached.Partitions {
 			size += elem.CachedSize(false)
 		}
@@ -893,6 +1600,27 @@ func (cached *DerivedTable) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *DoStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *DropColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -911,8 +1639,10 @@ func (cached *DropDatabase) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field DBName vitess.io/vitess/go/vt/sqlparser.TableIdent
@@ -925,13 +1655,29 @@ func (cached *DropKey) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	return size
 }
-func (cached *DropTable) CachedSize(alloc bool) int64 {
+func (cached *DropProgram) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *DropRole) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -939,9 +1685,30 @@ func (cached *DropTable) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Roles vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *DropTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field FromTables vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.FromTables)) * int64(32))
+		size += hack.RuntimeAllocSize(int64(cap(cached.FromTables)) * int64(48))
 		for _, elem := range cached.FromTables {
 			size += elem.CachedSize(false)
 		}
@@ -950,6 +1717,25 @@ func (cached *DropTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *DropUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Users vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *DropView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -958,9 +1744,11 @@ func (cached *DropView) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field FromTables vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.FromTables)) * int64(32))
+		size += hack.RuntimeAllocSize(int64(cap(cached.FromTables)) * int64(48))
 		for _, elem := range cached.FromTables {
 			size += elem.CachedSize(false)
 		}
@@ -969,7 +1757,30 @@ func (cached *DropView) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
-func (cached *ExecuteStmt) CachedSize(alloc bool) int64 {
+func (cached *ElseIf) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *EventSchedule) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -977,13 +1788,43 @@ func (cached *ExecuteStmt) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(80)
 	}
+	// field At vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.At.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Every vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Every.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field EveryUnit string
+	size += hack.RuntimeAllocSize(int64(len(cached.EveryUnit)))
+	// field Starts vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Starts.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Ends vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Ends.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *ExecuteStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field Arguments vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(48))
 		for _, elem := range cached.Arguments {
 			size += elem.CachedSize(false)
 		}
@@ -996,8 +1837,10 @@ func (cached *ExistsExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(8)
+		size += int64(16)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Subquery *vitess.io/vitess/go/vt/sqlparser.Subquery
 	size += cached.Subquery.CachedSize(true)
 	return size
@@ -1008,8 +1851,10 @@ func (cached *ExplainStmt) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Statement vitess.io/vitess/go/vt/sqlparser.Statement
 	if cc, ok := cached.Statement.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1022,8 +1867,10 @@ func (cached *ExplainTab) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	// field Wild string
@@ -1036,8 +1883,10 @@ func (cached *ExtractFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1052,6 +1901,8 @@ func (cached *ExtractedSubquery) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(112)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Original vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Original.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1072,14 +1923,59 @@ func (cached *ExtractedSubquery) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *FetchCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Into vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Into)) * int64(48))
+		for _, elem := range cached.Into {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *FirstOrLastValueExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field NullTreatmentClause *vitess.io/vitess/go/vt/sqlparser.NullTreatmentClause
+	if cached.NullTreatmentClause != nil {
+		size += hack.RuntimeAllocSize(int64(1))
+	}
+	// field OverClause *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.OverClause.CachedSize(true)
+	return size
+}
 func (cached *Flush) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field FlushOptions []string
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.FlushOptions)) * int64(16))
@@ -1089,7 +1985,7 @@ func (cached *Flush) CachedSize(alloc bool) int64 {
 	}
 	// field TableNames vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.TableNames)) * int64(32))
+		size += hack.RuntimeAllocSize(int64(cap(cached.TableNames)) * int64(48))
 		for _, elem := range cached.TableNames {
 			size += elem.CachedSize(false)
 		}
@@ -1106,7 +2002,7 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	}
 	// field Source vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Source)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Source)) * int64(48))
 		for _, elem := range cached.Source {
 			size += elem.CachedSize(false)
 		}
@@ -1117,14 +2013,54 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	size += cached.ReferenceDefinition.CachedSize(true)
 	return size
 }
+func (cached *FrameClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Start *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.Start.CachedSize(true)
+	// field End *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.End.CachedSize(true)
+	return size
+}
+func (cached *FramePoint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *FromFirstLastClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	return size
+}
 func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(128)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Qualifier vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Qualifier.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
@@ -1138,26 +2074,213 @@ func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 			}
 		}
 	}
+	// field Over *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.Over.CachedSize(true)
 	return size
 }
-func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
+func (cached *GeomDistanceExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(64)
 	}
-	// field Exprs vitess.io/vitess/go/vt/sqlparser.SelectExprs
-	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
-		for _, elem := range cached.Exprs {
-			if cc, ok := elem.(cachedObject); ok {
-				size += cc.CachedSize(true)
-			}
-		}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Geom1 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom1.(cachedObject); ok {
+		size += cc.CachedSize(true)
 	}
-	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
+	// field Geom2 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom2.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Unit vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Unit.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *GeomDistanceSphereExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Geom1 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom1.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Geom2 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom2.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Radius vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Radius.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *GeomFormatExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Geom vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field AxisOrderOpt vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.AxisOrderOpt.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *GeomFromTextExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field WktText vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.WktText.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Srid vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Srid.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field AxisOrderOpt vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.AxisOrderOpt.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *GeomRelationExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Geom1 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom1.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Geom2 vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom2.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *GeomSRIDExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Geom vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Geom.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Srid vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Srid.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *Grant) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Privileges vitess.io/vitess/go/vt/sqlparser.Privileges
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Target *vitess.io/vitess/go/vt/sqlparser.GrantTarget
+	size += cached.Target.CachedSize(true)
+	// field Roles vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Proxy *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Proxy.CachedSize(true)
+	// field To vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.To)) * int64(8))
+		for _, elem := range cached.To {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *GrantTarget) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Database vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.Database.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
 		for _, elem := range cached.OrderBy {
@@ -1170,6 +2293,96 @@ func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
 	size += cached.Limit.CachedSize(true)
 	return size
 }
+func (cached *GroupingExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *HandlerCondition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *HintTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field QueryBlock string
+	size += hack.RuntimeAllocSize(int64(len(cached.QueryBlock)))
+	return size
+}
+func (cached *IfStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field ElseIfs []*vitess.io/vitess/go/vt/sqlparser.ElseIf
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ElseIfs)) * int64(8))
+		for _, elem := range cached.ElseIfs {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Else)) * int64(16))
+		for _, elem := range cached.Else {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *IndexColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1224,7 +2437,7 @@ func (cached *IndexHint) CachedSize(alloc bool) int64 {
 	}
 	// field Indexes []vitess.io/vitess/go/vt/sqlparser.ColIdent
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Indexes)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Indexes)) * int64(48))
 		for _, elem := range cached.Indexes {
 			size += elem.CachedSize(false)
 		}
@@ -1237,7 +2450,7 @@ func (cached *IndexInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -1247,6 +2460,29 @@ func (cached *IndexInfo) CachedSize(alloc bool) int64 {
 	size += cached.ConstraintName.CachedSize(false)
 	return size
 }
+func (cached *IndexLevelHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field QueryBlock string
+	size += hack.RuntimeAllocSize(int64(len(cached.QueryBlock)))
+	// field Table vitess.io/vitess/go/vt/sqlparser.HintTable
+	size += cached.Table.CachedSize(false)
+	// field Indexes []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Indexes)) * int64(16))
+		for _, elem := range cached.Indexes {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	return size
+}
 func (cached *IndexOption) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1269,22 +2505,24 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(176)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	// field Partitions vitess.io/vitess/go/vt/sqlparser.Partitions
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(48))
 		for _, elem := range cached.Partitions {
 			size += elem.CachedSize(false)
 		}
 	}
 	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -1293,6 +2531,8 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Rows.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field RowAlias *vitess.io/vitess/go/vt/sqlparser.RowAlias
+	size += cached.RowAlias.CachedSize(true)
 	// field OnDup vitess.io/vitess/go/vt/sqlparser.OnDup
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.OnDup)) * int64(8))
@@ -1302,7 +2542,7 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
-func (cached *IntervalExpr) CachedSize(alloc bool) int64 {
+func (cached *InstallComponent) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -1310,6 +2550,43 @@ func (cached *IntervalExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Components []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Components)) * int64(16))
+		for _, elem := range cached.Components {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	return size
+}
+func (cached *InstallPlugin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Library string
+	size += hack.RuntimeAllocSize(int64(len(cached.Library)))
+	return size
+}
+func (cached *IntervalExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1324,8 +2601,10 @@ func (cached *IntroducerExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field CharacterSet string
 	size += hack.RuntimeAllocSize(int64(len(cached.CharacterSet)))
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
@@ -1340,22 +2619,40 @@ func (cached *IsExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
+func (cached *IterateStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	return size
+}
 func (cached *JSONArrayExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Params vitess.io/vitess/go/vt/sqlparser.Exprs
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(16))
@@ -1375,6 +2672,8 @@ func (cached *JSONAttributesExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1393,6 +2692,8 @@ func (cached *JSONContainsExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Target vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Target.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1420,6 +2721,8 @@ func (cached *JSONContainsPathExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1447,6 +2750,8 @@ func (cached *JSONExtractExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1470,6 +2775,8 @@ func (cached *JSONKeysExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1491,8 +2798,10 @@ func (cached *JSONObjectExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Params []*vitess.io/vitess/go/vt/sqlparser.JSONObjectParam
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(8))
@@ -1526,8 +2835,10 @@ func (cached *JSONOverlapsExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc1 vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc1.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1544,8 +2855,10 @@ func (cached *JSONPrettyExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONVal vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONVal.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1558,8 +2871,10 @@ func (cached *JSONQuoteExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field StringArg vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.StringArg.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1574,6 +2889,8 @@ func (cached *JSONRemoveExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1595,8 +2912,10 @@ func (cached *JSONSchemaValidFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Schema vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Schema.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1613,8 +2932,10 @@ func (cached *JSONSchemaValidationReportFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Schema vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Schema.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1633,6 +2954,8 @@ func (cached *JSONSearchExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(96)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1666,8 +2989,10 @@ func (cached *JSONStorageFreeExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONVal vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONVal.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1680,8 +3005,10 @@ func (cached *JSONStorageSizeExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONVal vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONVal.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1694,8 +3021,10 @@ func (cached *JSONTableExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(96)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1721,8 +3050,10 @@ func (cached *JSONUnquoteExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONValue vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONValue.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1737,6 +3068,8 @@ func (cached *JSONValueExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1759,8 +3092,10 @@ func (cached *JSONValueMergeExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1782,8 +3117,10 @@ func (cached *JSONValueModifierExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field JSONDoc vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.JSONDoc.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1811,21 +3148,44 @@ func (cached *JoinCondition) CachedSize(alloc bool) int64 {
 	}
 	// field Using vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Using)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Using)) * int64(48))
 		for _, elem := range cached.Using {
 			size += elem.CachedSize(false)
 		}
 	}
 	return size
 }
+func (cached *JoinOrderHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field QueryBlock string
+	size += hack.RuntimeAllocSize(int64(len(cached.QueryBlock)))
+	// field Tables []vitess.io/vitess/go/vt/sqlparser.HintTable
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(32))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *JoinTableExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field LeftExpr vitess.io/vitess/go/vt/sqlparser.TableExpr
 	if cc, ok := cached.LeftExpr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1907,7 +3267,7 @@ func (cached *JtPathColDef) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(192)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -1933,6 +3293,62 @@ func (cached *KeyState) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Kill) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *LagLeadExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field N vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.N.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Default vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Default.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field NullTreatmentClause *vitess.io/vitess/go/vt/sqlparser.NullTreatmentClause
+	if cached.NullTreatmentClause != nil {
+		size += hack.RuntimeAllocSize(int64(1))
+	}
+	// field OverClause *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.OverClause.CachedSize(true)
+	return size
+}
+func (cached *LeaveStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	return size
+}
 func (cached *Limit) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1957,12 +3373,106 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Val string
 	size += hack.RuntimeAllocSize(int64(len(cached.Val)))
 	return size
 }
+func (cached *Load) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(208)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field FileName string
+	size += hack.RuntimeAllocSize(int64(len(cached.FileName)))
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Partitions vitess.io/vitess/go/vt/sqlparser.Partitions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(48))
+		for _, elem := range cached.Partitions {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Charset string
+	size += hack.RuntimeAllocSize(int64(len(cached.Charset)))
+	// field Fields *vitess.io/vitess/go/vt/sqlparser.LoadFields
+	size += cached.Fields.CachedSize(true)
+	// field Lines *vitess.io/vitess/go/vt/sqlparser.LoadLines
+	size += cached.Lines.CachedSize(true)
+	// field IgnoreLines *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.IgnoreLines.CachedSize(true)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field SetExprs vitess.io/vitess/go/vt/sqlparser.UpdateExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.SetExprs)) * int64(8))
+		for _, elem := range cached.SetExprs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *LoadFields) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Terminated *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Terminated.CachedSize(true)
+	// field Enclosed *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Enclosed.CachedSize(true)
+	// field Escaped *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Escaped.CachedSize(true)
+	return size
+}
+func (cached *LoadLines) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Starting *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Starting.CachedSize(true)
+	// field Terminated *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Terminated.CachedSize(true)
+	return size
+}
+func (cached *LockClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *LockOption) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1979,8 +3489,10 @@ func (cached *LockTables) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Tables vitess.io/vitess/go/vt/sqlparser.TableAndLockTypes
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(8))
@@ -1990,14 +3502,39 @@ func (cached *LockTables) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *LoopStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *MatchExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Columns vitess.io/vitess/go/vt/sqlparser.SelectExprs
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(16))
@@ -2013,14 +3550,26 @@ func (cached *MatchExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *MaxExecutionTimeHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	return size
+}
 func (cached *MemberOfExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Value vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Value.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -2045,6 +3594,36 @@ func (cached *ModifyColumn) CachedSize(alloc bool) int64 {
 	size += cached.After.CachedSize(true)
 	return size
 }
+func (cached *NTHValueExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field N vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.N.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field FromFirstLastClause *vitess.io/vitess/go/vt/sqlparser.FromFirstLastClause
+	if cached.FromFirstLastClause != nil {
+		size += hack.RuntimeAllocSize(int64(1))
+	}
+	// field NullTreatmentClause *vitess.io/vitess/go/vt/sqlparser.NullTreatmentClause
+	if cached.NullTreatmentClause != nil {
+		size += hack.RuntimeAllocSize(int64(1))
+	}
+	// field OverClause *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.OverClause.CachedSize(true)
+	return size
+}
 func (cached *Nextval) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2065,15 +3644,17 @@ func (cached *NotExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
-func (cached *OptLike) CachedSize(alloc bool) int64 {
+func (cached *NtileExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -2081,60 +3662,84 @@ func (cached *OptLike) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(32)
 	}
-	// field LikeTable vitess.io/vitess/go/vt/sqlparser.TableName
-	size += cached.LikeTable.CachedSize(false)
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field N vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.N.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field OverClause *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.OverClause.CachedSize(true)
 	return size
 }
-func (cached *OrExpr) CachedSize(alloc bool) int64 {
+func (cached *NullTreatmentClause) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(8)
 	}
-	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
-	if cc, ok := cached.Left.(cachedObject); ok {
-		size += cc.CachedSize(true)
+	return size
+}
+func (cached *NullVal) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
 	}
-	// field Right vitess.io/vitess/go/vt/sqlparser.Expr
-	if cc, ok := cached.Right.(cachedObject); ok {
-		size += cc.CachedSize(true)
+	size := int64(0)
+	if alloc {
+		size += int64(8)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	return size
 }
-func (cached *Order) CachedSize(alloc bool) int64 {
+func (cached *OpenCursor) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(64)
 	}
-	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
-	if cc, ok := cached.Expr.(cachedObject); ok {
-		size += cc.CachedSize(true)
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *OptLike) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
 	}
+	// field LikeTable vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.LikeTable.CachedSize(false)
 	return size
 }
-func (cached *OrderByOption) CachedSize(alloc bool) int64 {
+func (cached *OptimizeTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(48)
 	}
-	// field Cols vitess.io/vitess/go/vt/sqlparser.Columns
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(40))
-		for _, elem := range cached.Cols {
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
 			size += elem.CachedSize(false)
 		}
 	}
 	return size
 }
-func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
+func (cached *OptimizerHintError) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -2142,26 +3747,129 @@ func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(24)
 	}
-	// field Exprs vitess.io/vitess/go/vt/sqlparser.TableExprs
-	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
-		for _, elem := range cached.Exprs {
-			if cc, ok := elem.(cachedObject); ok {
-				size += cc.CachedSize(true)
-			}
-		}
-	}
+	// field Message string
+	size += hack.RuntimeAllocSize(int64(len(cached.Message)))
 	return size
 }
-
-//go:nocheckptr
-func (cached *ParsedComments) CachedSize(alloc bool) int64 {
+func (cached *OrExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *Order) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *OrderByOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Cols vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(48))
+		for _, elem := range cached.Cols {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *OtherAdmin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *OtherRead) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *OverClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field WindowName vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.WindowName.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
+func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.TableExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+
+//go:nocheckptr
+func (cached *ParsedComments) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
 	}
 	// field comments vitess.io/vitess/go/vt/sqlparser.Comments
 	{
@@ -2185,6 +3893,22 @@ func (cached *ParsedComments) CachedSize(alloc bool) int64 {
 			size += hack.RuntimeAllocSize(int64(len(v)))
 		}
 	}
+	// field hints vitess.io/vitess/go/vt/sqlparser.OptimizerHints
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.hints)) * int64(16))
+		for _, elem := range cached.hints {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field hintErrors []*vitess.io/vitess/go/vt/sqlparser.OptimizerHintError
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.hintErrors)) * int64(8))
+		for _, elem := range cached.hintErrors {
+			size += elem.CachedSize(true)
+		}
+	}
 	return size
 }
 
@@ -2194,7 +3918,7 @@ func (cached *PartitionDefinition) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -2257,7 +3981,7 @@ func (cached *PartitionOption) CachedSize(alloc bool) int64 {
 	}
 	// field ColList vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.ColList)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.ColList)) * int64(48))
 		for _, elem := range cached.ColList {
 			size += elem.CachedSize(false)
 		}
@@ -2283,11 +4007,11 @@ func (cached *PartitionSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Names vitess.io/vitess/go/vt/sqlparser.Partitions
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Names)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Names)) * int64(48))
 		for _, elem := range cached.Names {
 			size += elem.CachedSize(false)
 		}
@@ -2324,14 +4048,26 @@ func (cached *PartitionValueRange) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *PasswordOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	// field Statement vitess.io/vitess/go/vt/sqlparser.Expr
@@ -2342,33 +4078,226 @@ func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *PrivilegeSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *ProcParameter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Type vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Type.CachedSize(false)
+	return size
+}
+func (cached *PurgeBinaryLogs) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field To string
+	size += hack.RuntimeAllocSize(int64(len(cached.To)))
+	// field Before vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Before.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *QBNameHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	return size
+}
 func (cached *ReferenceDefinition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(96)
 	}
 	// field ReferencedTable vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.ReferencedTable.CachedSize(false)
 	// field ReferencedColumns vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.ReferencedColumns)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.ReferencedColumns)) * int64(48))
 		for _, elem := range cached.ReferencedColumns {
 			size += elem.CachedSize(false)
 		}
 	}
 	return size
 }
+func (cached *RegexpInstrExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Pattern vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Pattern.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Position vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Position.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Occurrence vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Occurrence.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field ReturnOption vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.ReturnOption.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field MatchType vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.MatchType.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *RegexpLikeExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Pattern vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Pattern.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field MatchType vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.MatchType.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *RegexpReplaceExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Pattern vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Pattern.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Repl vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Repl.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Position vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Position.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Occurrence vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Occurrence.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field MatchType vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.MatchType.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *RegexpSubstrExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Pattern vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Pattern.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Position vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Position.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Occurrence vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Occurrence.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field MatchType vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.MatchType.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Release) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	return size
@@ -2379,7 +4308,7 @@ func (cached *RenameIndex) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(96)
 	}
 	// field OldName vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.OldName.CachedSize(false)
@@ -2393,8 +4322,10 @@ func (cached *RenameTable) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field TablePairs []*vitess.io/vitess/go/vt/sqlparser.RenameTablePair
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.TablePairs)) * int64(8))
@@ -2410,27 +4341,288 @@ func (cached *RenameTableName) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	return size
 }
-func (cached *RenameTablePair) CachedSize(alloc bool) int64 {
+func (cached *RenameTablePair) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field FromTable vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.FromTable.CachedSize(false)
+	// field ToTable vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.ToTable.CachedSize(false)
+	return size
+}
+func (cached *RenameUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field UserPairs []*vitess.io/vitess/go/vt/sqlparser.RenameUserPair
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.UserPairs)) * int64(8))
+		for _, elem := range cached.UserPairs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *RenameUserPair) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field FromUser *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.FromUser.CachedSize(true)
+	// field ToUser *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.ToUser.CachedSize(true)
+	return size
+}
+func (cached *RepairTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Options []vitess.io/vitess/go/vt/sqlparser.MaintenanceOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Options)))
+	}
+	return size
+}
+func (cached *RepeatStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Until vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Until.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *ReplicationOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *ResetMaster) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field To *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.To.CachedSize(true)
+	return size
+}
+func (cached *ResetReplica) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
+	return size
+}
+func (cached *ResourceGroupHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	return size
+}
+func (cached *ResourceOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
+func (cached *ReturnStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *RevertMigration) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field UUID string
+	size += hack.RuntimeAllocSize(int64(len(cached.UUID)))
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *Revoke) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Privileges vitess.io/vitess/go/vt/sqlparser.Privileges
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Target *vitess.io/vitess/go/vt/sqlparser.GrantTarget
+	size += cached.Target.CachedSize(true)
+	// field Roles vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Proxy *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Proxy.CachedSize(true)
+	// field From vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.From)) * int64(8))
+		for _, elem := range cached.From {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *RoleSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Roles vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *Rollback) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *RootNode) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(16)
+	}
+	// field SQLNode vitess.io/vitess/go/vt/sqlparser.SQLNode
+	if cc, ok := cached.SQLNode.(cachedObject); ok {
+		size += cc.CachedSize(true)
 	}
-	// field FromTable vitess.io/vitess/go/vt/sqlparser.TableName
-	size += cached.FromTable.CachedSize(false)
-	// field ToTable vitess.io/vitess/go/vt/sqlparser.TableName
-	size += cached.ToTable.CachedSize(false)
 	return size
 }
-func (cached *RevertMigration) CachedSize(alloc bool) int64 {
+func (cached *RoutineCharacteristic) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -2438,23 +4630,26 @@ func (cached *RevertMigration) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(24)
 	}
-	// field UUID string
-	size += hack.RuntimeAllocSize(int64(len(cached.UUID)))
-	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
-	size += cached.Comments.CachedSize(true)
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
 	return size
 }
-func (cached *RootNode) CachedSize(alloc bool) int64 {
+func (cached *RowAlias) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(48)
 	}
-	// field SQLNode vitess.io/vitess/go/vt/sqlparser.SQLNode
-	if cc, ok := cached.SQLNode.(cachedObject); ok {
-		size += cc.CachedSize(true)
+	// field TableName vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.TableName.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(48))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
 	}
 	return size
 }
@@ -2464,8 +4659,10 @@ func (cached *SRollback) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	return size
@@ -2476,8 +4673,10 @@ func (cached *Savepoint) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	return size
@@ -2488,8 +4687,10 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(224)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Cache *bool
 	size += hack.RuntimeAllocSize(int64(1))
 	// field From []vitess.io/vitess/go/vt/sqlparser.TableExpr
@@ -2527,6 +4728,13 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	// field Having *vitess.io/vitess/go/vt/sqlparser.Where
 	size += cached.Having.CachedSize(true)
+	// field Windows vitess.io/vitess/go/vt/sqlparser.WindowDefinitions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Windows)) * int64(8))
+		for _, elem := range cached.Windows {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
@@ -2536,6 +4744,13 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
 	size += cached.Limit.CachedSize(true)
+	// field Lock vitess.io/vitess/go/vt/sqlparser.LockClauses
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Lock)) * int64(8))
+		for _, elem := range cached.Lock {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
 	size += cached.Into.CachedSize(true)
 	return size
@@ -2546,7 +4761,7 @@ func (cached *SelectInto) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(144)
 	}
 	// field FileName string
 	size += hack.RuntimeAllocSize(int64(len(cached.FileName)))
@@ -2560,6 +4775,13 @@ func (cached *SelectInto) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Manifest)))
 	// field Overwrite string
 	size += hack.RuntimeAllocSize(int64(len(cached.Overwrite)))
+	// field Variables vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Variables)) * int64(48))
+		for _, elem := range cached.Variables {
+			size += elem.CachedSize(false)
+		}
+	}
 	return size
 }
 func (cached *Set) CachedSize(alloc bool) int64 {
@@ -2568,8 +4790,10 @@ func (cached *Set) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field Exprs vitess.io/vitess/go/vt/sqlparser.SetExprs
@@ -2581,14 +4805,37 @@ func (cached *Set) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *SetDefaultRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Roles *vitess.io/vitess/go/vt/sqlparser.RoleSpec
+	size += cached.Roles.CachedSize(true)
+	// field To vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.To)) * int64(8))
+		for _, elem := range cached.To {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *SetExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(96)
 	}
+	// field Qualifier vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.Qualifier.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
@@ -2597,6 +4844,38 @@ func (cached *SetExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *SetPassword) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field User *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.User.CachedSize(true)
+	// field Password string
+	size += hack.RuntimeAllocSize(int64(len(cached.Password)))
+	// field Replace string
+	size += hack.RuntimeAllocSize(int64(len(cached.Replace)))
+	return size
+}
+func (cached *SetRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Roles *vitess.io/vitess/go/vt/sqlparser.RoleSpec
+	size += cached.Roles.CachedSize(true)
+	return size
+}
 func (cached *SetTransaction) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2605,6 +4884,8 @@ func (cached *SetTransaction) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field SQLNode vitess.io/vitess/go/vt/sqlparser.SQLNode
 	if cc, ok := cached.SQLNode.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -2622,14 +4903,30 @@ func (cached *SetTransaction) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *SetVarHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	return size
+}
 func (cached *Show) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Internal vitess.io/vitess/go/vt/sqlparser.ShowInternal
 	if cc, ok := cached.Internal.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -2642,7 +4939,7 @@ func (cached *ShowBasic) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(112)
 	}
 	// field Tbl vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Tbl.CachedSize(false)
@@ -2650,6 +4947,28 @@ func (cached *ShowBasic) CachedSize(alloc bool) int64 {
 	size += cached.DbName.CachedSize(false)
 	// field Filter *vitess.io/vitess/go/vt/sqlparser.ShowFilter
 	size += cached.Filter.CachedSize(true)
+	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
+	size += cached.Limit.CachedSize(true)
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
+	return size
+}
+func (cached *ShowBinlogEvents) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field LogName string
+	size += hack.RuntimeAllocSize(int64(len(cached.LogName)))
+	// field Position *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Position.CachedSize(true)
+	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
+	size += cached.Limit.CachedSize(true)
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
 	return size
 }
 func (cached *ShowCreate) CachedSize(alloc bool) int64 {
@@ -2658,10 +4977,24 @@ func (cached *ShowCreate) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Op vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Op.CachedSize(false)
+	// field User *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.User.CachedSize(true)
+	return size
+}
+func (cached *ShowEngine) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Engine vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Engine.CachedSize(false)
 	return size
 }
 func (cached *ShowFilter) CachedSize(alloc bool) int64 {
@@ -2680,14 +5013,35 @@ func (cached *ShowFilter) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ShowGrants) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field For *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.For.CachedSize(true)
+	// field Using vitess.io/vitess/go/vt/sqlparser.Accounts
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Using)) * int64(8))
+		for _, elem := range cached.Using {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *ShowMigrationLogs) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field UUID string
 	size += hack.RuntimeAllocSize(int64(len(cached.UUID)))
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
@@ -2706,14 +5060,37 @@ func (cached *ShowOther) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Command)))
 	return size
 }
+func (cached *ShowProfile) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Types []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Types)) * int64(16))
+		for _, elem := range cached.Types {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field Query *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Query.CachedSize(true)
+	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
+	size += cached.Limit.CachedSize(true)
+	return size
+}
 func (cached *ShowThrottledApps) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Comments)) * int64(16))
@@ -2723,7 +5100,40 @@ func (cached *ShowThrottledApps) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
-func (cached *StarExpr) CachedSize(alloc bool) int64 {
+func (cached *Shutdown) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *Signal) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Condition *vitess.io/vitess/go/vt/sqlparser.HandlerCondition
+	size += cached.Condition.CachedSize(true)
+	// field Info []*vitess.io/vitess/go/vt/sqlparser.SignalInfo
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Info)) * int64(8))
+		for _, elem := range cached.Info {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *SignalInfo) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -2731,18 +5141,78 @@ func (cached *StarExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(32)
 	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *StarExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
 	// field TableName vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.TableName.CachedSize(false)
 	return size
 }
+func (cached *StartReplica) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Until vitess.io/vitess/go/vt/sqlparser.ReplicationOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Until)) * int64(8))
+		for _, elem := range cached.Until {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ConnectionOptions vitess.io/vitess/go/vt/sqlparser.ReplicationOptions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ConnectionOptions)) * int64(8))
+		for _, elem := range cached.ConnectionOptions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
+	return size
+}
+func (cached *StopReplica) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Channel string
+	size += hack.RuntimeAllocSize(int64(len(cached.Channel)))
+	return size
+}
 func (cached *Stream) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field SelectExpr vitess.io/vitess/go/vt/sqlparser.SelectExpr
@@ -2763,7 +5233,7 @@ func (cached *SubPartition) CachedSize(alloc bool) int64 {
 	}
 	// field ColList vitess.io/vitess/go/vt/sqlparser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.ColList)) * int64(40))
+		size += hack.RuntimeAllocSize(int64(cap(cached.ColList)) * int64(48))
 		for _, elem := range cached.ColList {
 			size += elem.CachedSize(false)
 		}
@@ -2780,7 +5250,7 @@ func (cached *SubPartitionDefinition) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -2818,22 +5288,47 @@ func (cached *Subquery) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Select vitess.io/vitess/go/vt/sqlparser.SelectStatement
 	if cc, ok := cached.Select.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
+func (cached *SubqueryHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field QueryBlock string
+	size += hack.RuntimeAllocSize(int64(len(cached.QueryBlock)))
+	// field Strategies []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Strategies)) * int64(16))
+		for _, elem := range cached.Strategies {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	return size
+}
 func (cached *SubstrExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Name.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -2848,6 +5343,18 @@ func (cached *SubstrExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *TLSOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	return size
+}
 func (cached *TableAndLockType) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2868,10 +5375,33 @@ func (cached *TableIdent) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(24)
 	}
 	// field v string
 	size += hack.RuntimeAllocSize(int64(len(cached.v)))
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
+func (cached *TableLevelHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field QueryBlock string
+	size += hack.RuntimeAllocSize(int64(len(cached.QueryBlock)))
+	// field Tables []vitess.io/vitess/go/vt/sqlparser.HintTable
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(32))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(false)
+		}
+	}
 	return size
 }
 func (cached *TableName) CachedSize(alloc bool) int64 {
@@ -2880,7 +5410,7 @@ func (cached *TableName) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Name.CachedSize(false)
@@ -2904,7 +5434,7 @@ func (cached *TableOption) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.String)))
 	// field Tables vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(32))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(48))
 		for _, elem := range cached.Tables {
 			size += elem.CachedSize(false)
 		}
@@ -2917,7 +5447,7 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Columns []*vitess.io/vitess/go/vt/sqlparser.ColumnDefinition
 	{
@@ -2949,6 +5479,49 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	// field PartitionOption *vitess.io/vitess/go/vt/sqlparser.PartitionOption
 	size += cached.PartitionOption.CachedSize(true)
+	// field BadDefinitions []*vitess.io/vitess/go/vt/sqlparser.BadDefinition
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.BadDefinitions)) * int64(8))
+		for _, elem := range cached.BadDefinitions {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *TableStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
+	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
+		for _, elem := range cached.OrderBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
+	size += cached.Limit.CachedSize(true)
+	// field Lock vitess.io/vitess/go/vt/sqlparser.LockClauses
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Lock)) * int64(8))
+		for _, elem := range cached.Lock {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
+	size += cached.Into.CachedSize(true)
 	return size
 }
 func (cached *TablespaceOperation) CachedSize(alloc bool) int64 {
@@ -2967,8 +5540,10 @@ func (cached *TimestampFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name string
 	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
 	// field Expr1 vitess.io/vitess/go/vt/sqlparser.Expr
@@ -2983,6 +5558,18 @@ func (cached *TimestampFuncExpr) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Unit)))
 	return size
 }
+func (cached *TriggerOrder) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field OtherTrigger vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.OtherTrigger.CachedSize(false)
+	return size
+}
 func (cached *TrimFuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2991,6 +5578,8 @@ func (cached *TrimFuncExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(48)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field TrimArg vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.TrimArg.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -3007,8 +5596,10 @@ func (cached *TruncateTable) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(64)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
 	return size
@@ -3019,22 +5610,59 @@ func (cached *UnaryExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
+func (cached *UninstallComponent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Components []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Components)) * int64(16))
+		for _, elem := range cached.Components {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	return size
+}
+func (cached *UninstallPlugin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *Union) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(128)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.SelectStatement
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -3054,18 +5682,53 @@ func (cached *Union) CachedSize(alloc bool) int64 {
 	size += cached.With.CachedSize(true)
 	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
 	size += cached.Limit.CachedSize(true)
+	// field Lock vitess.io/vitess/go/vt/sqlparser.LockClauses
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Lock)) * int64(8))
+		for _, elem := range cached.Lock {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
 	size += cached.Into.CachedSize(true)
 	return size
 }
+func (cached *UnknownHint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Args string
+	size += hack.RuntimeAllocSize(int64(len(cached.Args)))
+	return size
+}
+func (cached *UnlockTables) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	return size
+}
 func (cached *Update) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field With *vitess.io/vitess/go/vt/sqlparser.With
 	size += cached.With.CachedSize(true)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
@@ -3121,20 +5784,38 @@ func (cached *Use) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field DBName vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.DBName.CachedSize(false)
 	return size
 }
+func (cached *UserSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Account *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Account.CachedSize(true)
+	// field Auth *vitess.io/vitess/go/vt/sqlparser.AuthOption
+	size += cached.Auth.CachedSize(true)
+	return size
+}
 func (cached *VStream) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(96)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	// field SelectExpr vitess.io/vitess/go/vt/sqlparser.SelectExpr
@@ -3165,12 +5846,62 @@ func (cached *ValuesFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(8)
+		size += int64(16)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Name *vitess.io/vitess/go/vt/sqlparser.ColName
 	size += cached.Name.CachedSize(true)
 	return size
 }
+func (cached *ValuesStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Rows vitess.io/vitess/go/vt/sqlparser.Values
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Rows)) * int64(24))
+		for _, elem := range cached.Rows {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(16))
+				for _, elem := range elem {
+					if cc, ok := elem.(cachedObject); ok {
+						size += cc.CachedSize(true)
+					}
+				}
+			}
+		}
+	}
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
+	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
+		for _, elem := range cached.OrderBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
+	size += cached.Limit.CachedSize(true)
+	// field Lock vitess.io/vitess/go/vt/sqlparser.LockClauses
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Lock)) * int64(8))
+		for _, elem := range cached.Lock {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
+	size += cached.Into.CachedSize(true)
+	return size
+}
 func (cached *VindexParam) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3191,7 +5922,7 @@ func (cached *VindexSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -3199,7 +5930,7 @@ func (cached *VindexSpec) CachedSize(alloc bool) int64 {
 	size += cached.Type.CachedSize(false)
 	// field Params []vitess.io/vitess/go/vt/sqlparser.VindexParam
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(56))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(64))
 		for _, elem := range cached.Params {
 			size += elem.CachedSize(false)
 		}
@@ -3212,8 +5943,10 @@ func (cached *WeightStringFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Expr.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -3254,6 +5987,77 @@ func (cached *Where) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *WhileStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Label vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Label.CachedSize(false)
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.CompoundStatements
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *WindowDefinition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
+func (cached *WindowSpecification) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field PartitionClause vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PartitionClause)) * int64(16))
+		for _, elem := range cached.PartitionClause {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderClause vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderClause)) * int64(8))
+		for _, elem := range cached.OrderClause {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field FrameClause *vitess.io/vitess/go/vt/sqlparser.FrameClause
+	size += cached.FrameClause.CachedSize(true)
+	return size
+}
 func (cached *With) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3271,7 +6075,7 @@ func (cached *With) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
-func (cached *XorExpr) CachedSize(alloc bool) int64 {
+func (cached *XATransaction) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -3279,6 +6083,38 @@ func (cached *XorExpr) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(32)
 	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
+	// field Xid *vitess.io/vitess/go/vt/sqlparser.Xid
+	size += cached.Xid.CachedSize(true)
+	return size
+}
+func (cached *Xid) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Gtrid *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Gtrid.CachedSize(true)
+	// field Bqual *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Bqual.CachedSize(true)
+	// field FormatID *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.FormatID.CachedSize(true)
+	return size
+}
+func (cached *XorExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field _span vitess.io/vitess/go/vt/sqlparser._span
+	size += cached._span.CachedSize(false)
 	// field Left vitess.io/vitess/go/vt/sqlparser.Expr
 	if cc, ok := cached.Left.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -3289,3 +6125,17 @@ func (cached *XorExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *_span) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field span *vitess.io/vitess/go/vt/sqlparser.Span
+	if cached.span != nil {
+		size += hack.RuntimeAllocSize(int64(48))
+	}
+	return size
+}
@@ -24,10 +24,16 @@ const (
 	StraightJoinHint    = "straight_join "
 	SQLCalcFoundRowsStr = "sql_calc_found_rows "
 
+	// Select.WithRollup
+	WithRollupStr = " with rollup"
+
 	// Select.Lock
-	NoLockStr    = ""
-	ForUpdateStr = " for update"
-	ShareModeStr = " lock in share mode"
+	NoLockStr     = ""
+	ForUpdateStr  = " for update"
+	ShareModeStr  = " lock in share mode"
+	ForShareStr   = " for share"
+	NoWaitStr     = " nowait"
+	SkipLockedStr = " skip locked"
 
 	// Select.Cache
 	SQLCacheStr   = "sql_cache "
@@ -38,6 +44,10 @@ const (
 	UnionAllStr      = "union all"
 	UnionDistinctStr = "union distinct"
 
+	// Union.Operator
+	IntersectStr = "intersect"
+	ExceptStr    = "except"
+
 	// DDL strings.
 	InsertStr  = "insert"
 	ReplaceStr = "replace"
@@ -225,6 +235,7 @@ const (
 	IntoOutfileStr   = " into outfile "
 	IntoOutfileS3Str = " into outfile s3 "
 	IntoDumpfileStr  = " into dumpfile "
+	IntoVariablesStr = " into "
 
 	// Order.Direction
 	AscScr  = "asc"
@@ -297,6 +308,17 @@ const (
 	VschemaTablesStr           = " vschema tables"
 	VschemaVindexesStr         = " vschema vindexes"
 	WarningsStr                = " warnings"
+	BinaryLogsStr              = " binary logs"
+	CountErrorsStr             = " count(*) errors"
+	CountWarningsStr           = " count(*) warnings"
+	CreateUsrStr               = " create user"
+	ErrorsStr                  = " errors"
+	EventsStr                  = " events"
+	MasterStatusStr            = " master status"
+	ProcesslistStr             = " processlist"
+	ProfilesStr                = " profiles"
+	ReplicasStr                = " replicas"
+	ReplicaStatusStr           = " replica status"
 
 	// DropKeyType strings
 	PrimaryKeyTypeStr = "primary key"
@@ -332,6 +354,225 @@ const (
 	JSONMergePatchStr    = "json_merge_patch"
 	JSONMergePreserveStr = "json_merge_preserve"
 
+	// FrameUnitType strings
+	FrameRowsStr  = "rows"
+	FrameRangeStr = "range"
+
+	// FramePointType strings
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
+	// GeomFromTextType strings
+	GeometryFromTextStr           = "st_geomfromtext"
+	GeometryCollectionFromTextStr = "st_geomcollfromtext"
+	PointFromTextStr              = "st_pointfromtext"
+	LineStringFromTextStr         = "st_linefromtext"
+	PolygonFromTextStr            = "st_polyfromtext"
+	MultiPointFromTextStr         = "st_mpointfromtext"
+	MultiLineStringFromTextStr    = "st_mlinefromtext"
+	MultiPolygonFromTextStr       = "st_mpolyfromtext"
+
+	// GeomRelationType strings
+	ContainsRelationStr      = "st_contains"
+	CrossesRelationStr       = "st_crosses"
+	DisjointRelationStr      = "st_disjoint"
+	EqualsRelationStr        = "st_equals"
+	IntersectsRelationStr    = "st_intersects"
+	OverlapsRelationStr      = "st_overlaps"
+	TouchesRelationStr       = "st_touches"
+	WithinRelationStr        = "st_within"
+	MBRContainsRelationStr   = "mbrcontains"
+	MBRCoveredByRelationStr  = "mbrcoveredby"
+	MBRCoversRelationStr     = "mbrcovers"
+	MBRDisjointRelationStr   = "mbrdisjoint"
+	MBREqualsRelationStr     = "mbrequals"
+	MBRIntersectsRelationStr = "mbrintersects"
+	MBROverlapsRelationStr   = "mbroverlaps"
+	MBRTouchesRelationStr    = "mbrtouches"
+	MBRWithinRelationStr     = "mbrwithin"
+
+	// GeomFormatType strings
+	AsTextFormatStr   = "st_astext"
+	AsBinaryFormatStr = "st_asbinary"
+
+	// ArgumentLessWindowExprType strings
+	CumeDistExprStr    = "cume_dist"
+	DenseRankExprStr   = "dense_rank"
+	PercentRankExprStr = "percent_rank"
+	RankExprStr        = "rank"
+	RowNumberExprStr   = "row_number"
+
+	// NullTreatmentType strings
+	RespectNullsStr = "respect nulls"
+	IgnoreNullsStr  = "ignore nulls"
+
+	// FromFirstLastType strings
+	FromFirstStr = "from first"
+	FromLastStr  = "from last"
+
+	// FirstOrLastValueExprType strings
+	FirstValueExprStr = "first_value"
+	LastValueExprStr  = "last_value"
+
+	// LagLeadExprType strings
+	LagExprStr  = "lag"
+	LeadExprStr = "lead"
+
+	// GrantObjectType strings
+	TableObjectStr     = "table"
+	FunctionObjectStr  = "function"
+	ProcedureObjectStr = "procedure"
+
+	// PrivilegeLevel strings
+	GlobalPrivilegeLevelStr   = "global"
+	DatabasePrivilegeLevelStr = "database"
+	TablePrivilegeLevelStr    = "table"
+	ColumnPrivilegeLevelStr   = "column"
+	RoutinePrivilegeLevelStr  = "routine"
+
+	// RoleSpecType strings
+	DefaultRoleSpecStr   = "default"
+	NoneRoleSpecStr      = "none"
+	AllRoleSpecStr       = "all"
+	AllExceptRoleSpecStr = "all except"
+
+	// AccountLockType strings
+	LockAccountStr   = "account lock"
+	UnlockAccountStr = "account unlock"
+
+	// TLSOptionType strings
+	RequireNoneStr    = "none"
+	RequireSSLStr     = "ssl"
+	RequireX509Str    = "x509"
+	RequireCipherStr  = "cipher"
+	RequireIssuerStr  = "issuer"
+	RequireSubjectStr = "subject"
+
+	// ResourceOptionType strings
+	MaxQueriesPerHourStr     = "max_queries_per_hour"
+	MaxUpdatesPerHourStr     = "max_updates_per_hour"
+	MaxConnectionsPerHourStr = "max_connections_per_hour"
+	MaxUserConnectionsStr    = "max_user_connections"
+
+	// PasswordOptionType strings
+	PasswordExpireStr                 = "password expire"
+	PasswordExpireDefaultStr          = "password expire default"
+	PasswordExpireNeverStr            = "password expire never"
+	PasswordExpireIntervalStr         = "password expire interval"
+	PasswordHistoryStr                = "password history"
+	PasswordHistoryDefaultStr         = "password history default"
+	PasswordReuseIntervalStr          = "password reuse interval"
+	PasswordReuseIntervalDefaultStr   = "password reuse interval default"
+	PasswordRequireCurrentStr         = "password require current"
+	PasswordRequireCurrentDefaultStr  = "password require current default"
+	PasswordRequireCurrentOptionalStr = "password require current optional"
+	FailedLoginAttemptsStr            = "failed_login_attempts"
+	PasswordLockTimeStr               = "password_lock_time"
+	PasswordLockTimeUnboundedStr      = "password_lock_time unbounded"
+
+	// ProcParameterMode strings
+	InParameterModeStr    = "in"
+	OutParameterModeStr   = "out"
+	InOutParameterModeStr = "inout"
+
+	// RoutineCharacteristicType strings
+	CommentCharacteristicStr            = "comment"
+	LanguageSQLCharacteristicStr        = "language sql"
+	DeterministicCharacteristicStr      = "deterministic"
+	NotDeterministicCharacteristicStr   = "not deterministic"
+	ContainsSQLCharacteristicStr        = "contains sql"
+	NoSQLCharacteristicStr              = "no sql"
+	ReadsSQLDataCharacteristicStr       = "reads sql data"
+	ModifiesSQLDataCharacteristicStr    = "modifies sql data"
+	SQLSecurityDefinerCharacteristicStr = "sql security definer"
+	SQLSecurityInvokerCharacteristicStr = "sql security invoker"
+
+	// TriggerTimingType strings
+	BeforeTriggerStr = "before"
+	AfterTriggerStr  = "after"
+
+	// TriggerEventType strings
+	InsertTriggerStr = "insert"
+	UpdateTriggerStr = "update"
+	DeleteTriggerStr = "delete"
+
+	// TriggerOrderType strings
+	FollowsTriggerStr  = "follows"
+	PrecedesTriggerStr = "precedes"
+
+	// EventOnCompletionType strings
+	OnCompletionPreserveStr    = "on completion preserve"
+	OnCompletionNotPreserveStr = "on completion not preserve"
+
+	// EventStatusType strings
+	EnableEventStr           = "enable"
+	DisableEventStr          = "disable"
+	DisableOnReplicaEventStr = "disable on slave"
+
+	// ProgramType strings
+	ProcedureTypeStr = "procedure"
+	FunctionTypeStr  = "function"
+	TriggerTypeStr   = "trigger"
+	EventTypeStr     = "event"
+
+	// HandlerActionType strings
+	ContinueHandlerStr = "continue"
+	ExitHandlerStr     = "exit"
+	UndoHandlerStr     = "undo"
+
+	// HandlerConditionType strings
+	SQLStateConditionStr     = "sqlstate"
+	SQLWarningConditionStr   = "sqlwarning"
+	NotFoundConditionStr     = "not found"
+	SQLExceptionConditionStr = "sqlexception"
+
+	// LoadPriority strings
+	LowPriorityLoadStr = "low_priority "
+	ConcurrentLoadStr  = "concurrent "
+
+	// LoadDuplicateType strings
+	ReplaceLoadDuplicateStr = " replace"
+	IgnoreLoadDuplicateStr  = " ignore"
+
+	// HistogramActionType strings
+	UpdateHistogramStr = "update histogram"
+	DropHistogramStr   = "drop histogram"
+
+	// MaintenanceOption strings
+	QuickOptionStr      = "quick"
+	FastOptionStr       = "fast"
+	MediumOptionStr     = "medium"
+	ExtendedOptionStr   = "extended"
+	ChangedOptionStr    = "changed"
+	UseFrmOptionStr     = "use_frm"
+	ForUpgradeOptionStr = "for upgrade"
+
+	// XAAction strings
+	XAStartStr    = "start"
+	XAEndStr      = "end"
+	XAPrepareStr  = "prepare"
+	XACommitStr   = "commit"
+	XARollbackStr = "rollback"
+	XARecoverStr  = "recover"
+
+	// XAOption strings
+	JoinXAOptionStr              = " join"
+	ResumeXAOptionStr            = " resume"
+	SuspendXAOptionStr           = " suspend"
+	SuspendForMigrateXAOptionStr = " suspend for migrate"
+	OnePhaseXAOptionStr          = " one phase"
+
+	// KillType strings
+	ConnectionKillStr = "connection"
+	QueryKillStr      = "query"
+
+	// ReplicaThreads strings
+	IOThreadStr  = "io_thread"
+	SQLThreadStr = "sql_thread"
+
 	// LockOptionType strings
 	NoneTypeStr      = "none"
 	SharedTypeStr    = "shared"
@@ -367,7 +608,7 @@ const (
 	ReadWrite
 )
 
-//Constants for Enum type - IsolationLevel
+// Constants for Enum type - IsolationLevel
 const (
 	ReadUncommitted IsolationLevel = iota
 	ReadCommitted
@@ -414,6 +655,14 @@ const (
 	NoLock Lock = iota
 	ForUpdateLock
 	ShareModeLock
+	ForShareLock
+)
+
+// Constants for Enum Type - LockWaitType
+const (
+	DefaultWait LockWaitType = iota
+	NoWait
+	SkipLocked
 )
 
 // Constants for Enum Type - TrimType
@@ -455,6 +704,314 @@ const (
 	JSONMergePreserveType
 )
 
+// Constants for Enum Type - GeomFromTextType
+const (
+	GeometryFromText GeomFromTextType = iota
+	GeometryCollectionFromText
+	PointFromText
+	LineStringFromText
+	PolygonFromText
+	MultiPointFromText
+	MultiLineStringFromText
+	MultiPolygonFromText
+)
+
+// Constants for Enum Type - GeomRelationType
+const (
+	ContainsRelation GeomRelationType = iota
+	CrossesRelation
+	DisjointRelation
+	EqualsRelation
+	IntersectsRelation
+	OverlapsRelation
+	TouchesRelation
+	WithinRelation
+	MBRContainsRelation
+	MBRCoveredByRelation
+	MBRCoversRelation
+	MBRDisjointRelation
+	MBREqualsRelation
+	MBRIntersectsRelation
+	MBROverlapsRelation
+	MBRTouchesRelation
+	MBRWithinRelation
+)
+
+// Constants for Enum Type - GeomFormatType
+const (
+	AsTextFormat GeomFormatType = iota
+	AsBinaryFormat
+)
+
+// Constants for Enum Type - FrameUnitType
+const (
+	FrameRowsType FrameUnitType = iota
+	FrameRangeType
+)
+
+// Constants for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
+// Constants for Enum Type - ArgumentLessWindowExprType
+const (
+	CumeDistExprType ArgumentLessWindowExprType = iota
+	DenseRankExprType
+	PercentRankExprType
+	RankExprType
+	RowNumberExprType
+)
+
+// Constants for Enum Type - NullTreatmentType
+const (
+	RespectNullsType NullTreatmentType = iota
+	IgnoreNullsType
+)
+
+// Constants for Enum Type - FromFirstLastType
+const (
+	FromFirstType FromFirstLastType = iota
+	FromLastType
+)
+
+// Constants for Enum Type - FirstOrLastValueExprType
+const (
+	FirstValueExprType FirstOrLastValueExprType = iota
+	LastValueExprType
+)
+
+// Constants for Enum Type - LagLeadExprType
+const (
+	LagExprType LagLeadExprType = iota
+	LeadExprType
+)
+
+// Constants for Enum Type - GrantObjectType
+const (
+	NoObjectType GrantObjectType = iota
+	TableObjectType
+	FunctionObjectType
+	ProcedureObjectType
+)
+
+// Constants for Enum Type - PrivilegeLevel
+const (
+	GlobalPrivilegeLevel PrivilegeLevel = iota
+	DatabasePrivilegeLevel
+	TablePrivilegeLevel
+	ColumnPrivilegeLevel
+	RoutinePrivilegeLevel
+)
+
+// Constants for Enum Type - RoleSpecType
+const (
+	ListRoleSpec RoleSpecType = iota
+	DefaultRoleSpec
+	NoneRoleSpec
+	AllRoleSpec
+	AllExceptRoleSpec
+)
+
+// Constants for Enum Type - AccountLockType
+const (
+	NoAccountLock AccountLockType = iota
+	LockAccount
+	UnlockAccount
+)
+
+// Constants for Enum Type - TLSOptionType
+const (
+	RequireNone TLSOptionType = iota
+	RequireSSL
+	RequireX509
+	RequireCipher
+	RequireIssuer
+	RequireSubject
+)
+
+// Constants for Enum Type - ResourceOptionType
+const (
+	MaxQueriesPerHour ResourceOptionType = iota
+	MaxUpdatesPerHour
+	MaxConnectionsPerHour
+	MaxUserConnections
+)
+
+// Constants for Enum Type - PasswordOptionType
+const (
+	PasswordExpire PasswordOptionType = iota
+	PasswordExpireDefault
+	PasswordExpireNever
+	PasswordExpireInterval
+	PasswordHistory
+	PasswordHistoryDefault
+	PasswordReuseInterval
+	PasswordReuseIntervalDefault
+	PasswordRequireCurrent
+	PasswordRequireCurrentDefault
+	PasswordRequireCurrentOptional
+	FailedLoginAttempts
+	PasswordLockTime
+	PasswordLockTimeUnbounded
+)
+
+// Constants for Enum Type - ProcParameterMode
+const (
+	NoParameterMode ProcParameterMode = iota
+	InParameterMode
+	OutParameterMode
+	InOutParameterMode
+)
+
+// Constants for Enum Type - RoutineCharacteristicType
+const (
+	CommentCharacteristic RoutineCharacteristicType = iota
+	LanguageSQLCharacteristic
+	DeterministicCharacteristic
+	NotDeterministicCharacteristic
+	ContainsSQLCharacteristic
+	NoSQLCharacteristic
+	ReadsSQLDataCharacteristic
+	ModifiesSQLDataCharacteristic
+	SQLSecurityDefinerCharacteristic
+	SQLSecurityInvokerCharacteristic
+)
+
+// Constants for Enum Type - TriggerTimingType
+const (
+	BeforeTrigger TriggerTimingType = iota
+	AfterTrigger
+)
+
+// Constants for Enum Type - TriggerEventType
+const (
+	InsertTrigger TriggerEventType = iota
+	UpdateTrigger
+	DeleteTrigger
+)
+
+// Constants for Enum Type - TriggerOrderType
+const (
+	FollowsTrigger TriggerOrderType = iota
+	PrecedesTrigger
+)
+
+// Constants for Enum Type - EventOnCompletionType
+const (
+	NoOnCompletion EventOnCompletionType = iota
+	OnCompletionPreserve
+	OnCompletionNotPreserve
+)
+
+// Constants for Enum Type - EventStatusType
+const (
+	NoEventStatus EventStatusType = iota
+	EnableEvent
+	DisableEvent
+	DisableOnReplicaEvent
+)
+
+// Constants for Enum Type - ProgramType
+const (
+	ProcedureType ProgramType = iota
+	FunctionType
+	TriggerType
+	EventType
+)
+
+// Constants for Enum Type - HandlerActionType
+const (
+	ContinueHandler HandlerActionType = iota
+	ExitHandler
+	UndoHandler
+)
+
+// Constants for Enum Type - HandlerConditionType
+const (
+	ErrorCodeCondition HandlerConditionType = iota
+	SQLStateCondition
+	NamedCondition
+	SQLWarningCondition
+	NotFoundCondition
+	SQLExceptionCondition
+)
+
+// Constants for Enum Type - SetOperator
+const (
+	UnionOp SetOperator = iota
+	IntersectOp
+	ExceptOp
+)
+
+// Constants for Enum Type - LoadPriority
+const (
+	DefaultLoadPriority LoadPriority = iota
+	LowPriorityLoad
+	ConcurrentLoad
+)
+
+// Constants for Enum Type - LoadDuplicateType
+const (
+	NoLoadDuplicate LoadDuplicateType = iota
+	ReplaceLoadDuplicate
+	IgnoreLoadDuplicate
+)
+
+// Constants for Enum Type - HistogramActionType
+const (
+	NoHistogram HistogramActionType = iota
+	UpdateHistogram
+	DropHistogram
+)
+
+// Constants for Enum Type - MaintenanceOption
+const (
+	QuickOption MaintenanceOption = iota
+	FastOption
+	MediumOption
+	ExtendedOption
+	ChangedOption
+	UseFrmOption
+	ForUpgradeOption
+)
+
+// Constants for Enum Type - XAAction
+const (
+	XAStart XAAction = iota
+	XAEnd
+	XAPrepare
+	XACommit
+	XARollback
+	XARecover
+)
+
+// Constants for Enum Type - XAOption
+const (
+	NoXAOption XAOption = iota
+	JoinXAOption
+	ResumeXAOption
+	SuspendXAOption
+	SuspendForMigrateXAOption
+	OnePhaseXAOption
+)
+
+// Constants for Enum Type - KillType
+const (
+	ConnectionKill KillType = iota
+	QueryKill
+)
+
+// Constants for Enum Type - ReplicaThreads
+const (
+	IOThread ReplicaThreads = 1 << iota
+	SQLThread
+)
+
 // Constants for Enum Type - WhereType
 const (
 	WhereClause WhereType = iota
@@ -603,6 +1160,7 @@ const (
 	IntoOutfile SelectIntoType = iota
 	IntoOutfileS3
 	IntoDumpfile
+	IntoVariables
 )
 
 // Constant for Enum Type - DeallocateStmtType
@@ -676,6 +1234,17 @@ const (
 	VschemaVindexes
 	Warnings
 	Keyspace
+	BinaryLogs
+	CountErrors
+	CountWarnings
+	CreateUsr
+	Errors
+	Events
+	MasterStatus
+	Processlist
+	Profiles
+	Replicas
+	ReplicaStatus
 )
 
 // DropKeyType constants
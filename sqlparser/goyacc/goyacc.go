@@ -127,6 +127,7 @@ const (
 	STRUCT
 	UNION
 	ERROR
+	LOCATIONS
 )
 
 const ENDFILE = 0
@@ -164,6 +165,7 @@ var vflag string  // -v [y.output]	- y.output file
 var lflag bool    // -l			- disable line directives
 var prefix string // name prefix for identifiers, default yy
 var allowFastAppend bool
+var locations bool // %locations	- track the input offsets of the grammar symbols
 
 func init() {
 	flag.StringVar(&oflag, "o", "y.go", "parser output")
@@ -327,6 +329,7 @@ var resrv = []Resrv{
 	{"union", UNION},
 	{"struct", STRUCT},
 	{"error", ERROR},
+	{"locations", LOCATIONS},
 }
 
 type Error struct {
@@ -423,6 +426,9 @@ outer:
 			}
 			start = chfind(1, tokname)
 
+		case LOCATIONS:
+			locations = true
+
 		case ERROR:
 			lno := lineno
 			var tokens []string
@@ -1092,8 +1098,15 @@ func typeinfo() {
 		}
 	}
 	ftable.Write(ftypes.Bytes())
+	if locations {
+		fmt.Fprintf(ftable, "\n\tloc %sLoc", prefix)
+	}
 	fmt.Fprintf(ftable, "\n\tyys int")
 	fmt.Fprintf(ftable, "\n}\n\n")
+	if locations {
+		fmt.Fprintf(ftable, "// %sLoc is the range of input offsets covered by a grammar symbol.\n", prefix)
+		fmt.Fprintf(ftable, "type %sLoc struct {\n\tStart, End int\n}\n\n", prefix)
+	}
 
 	var sortedTypes []string
 	for member, tt := range gotypes {
@@ -1456,6 +1469,32 @@ loop:
 		case '{':
 			brac++
 
+		case '@':
+			if !locations {
+				break
+			}
+			c = getrune(finput)
+			if c == '$' {
+				fmt.Fprintf(fcode, "%sVAL.loc", prefix)
+				continue loop
+			}
+			if !isdigit(c) {
+				fcode.WriteRune('@')
+				ungetrune(finput, c)
+				continue loop
+			}
+			j := 0
+			for isdigit(c) {
+				j = j*10 + int(c-'0')
+				c = getrune(finput)
+			}
+			ungetrune(finput, c)
+			if j >= max {
+				errorf("Illegal use of @%v", j)
+			}
+			fmt.Fprintf(fcode, "%sDollar[%v].loc", prefix, j)
+			continue loop
+
 		case '$':
 			s := 1
 			tok := -1
@@ -3115,6 +3154,12 @@ func others() {
 		fmt.Fprintf(ftable, "\n//line yaccpar:1\n")
 	}
 
+	locate := ""
+	if locations {
+		locate = strings.Replace(yaccloctext, "$$", prefix, -1)
+	}
+	yaccpar = strings.Replace(yaccpar, "\t"+prefix+"locate()\n", locate, 1)
+
 	parts := strings.SplitN(yaccpar, prefix+"run()", 2)
 	fmt.Fprintf(ftable, "%v", parts[0])
 	ftable.Write(fcode.Bytes())
@@ -3422,6 +3467,19 @@ func gofmt() {
 }
 
 var yaccpar string // will be processed version of yaccpartext: s/$$/prefix/g
+
+// yaccloctext computes the location of $$ with %locations: it spans from the
+// first non-empty symbol of the production to the last one, and an empty
+// production is located at the end of the symbol before it.
+var yaccloctext = `	if $$R2[$$n] == 0 {
+		$$VAL.loc = $$Loc{$$S[$$p].loc.End, $$S[$$p].loc.End}
+	} else {
+		$$VAL.loc = $$Loc{$$S[$$p+1].loc.Start, $$S[$$pt].loc.End}
+		for $$i := $$p + 1; $$i < $$pt && $$S[$$i].loc.Start == $$S[$$i].loc.End; $$i++ {
+			$$VAL.loc.Start = $$S[$$i+1].loc.Start
+		}
+	}
+`
 var yaccpartext = `
 /*	parser for yacc output	*/
 
@@ -3443,6 +3501,15 @@ type $$Lexer interface {
 	Error(s string)
 }
 
+// $$ExpectingLexer is a $$Lexer that is told which tokens were expected
+// when the parser finds a syntax error.
+type $$ExpectingLexer interface {
+	$$Lexer
+	// Expecting is called right before Error with the names of the expected
+	// tokens, or nil if the parser cannot tell them.
+	Expecting(tokens []string)
+}
+
 type $$Parser interface {
 	Parse($$Lexer) int
 	Lookahead() int
@@ -3482,6 +3549,44 @@ func $$Statname(s int) string {
 	return __yyfmt__.Sprintf("state-%v", s)
 }
 
+// $$ExpectedTokens returns the names of all the tokens that are accepted in
+// state, or nil if the parser cannot tell them.
+func $$ExpectedTokens(state int) []string {
+	const TOKSTART = 4
+
+	var expected []string
+
+	// Look for shiftable tokens.
+	base := $$Pact[state]
+	for tok := TOKSTART; tok-1 < len($$Toknames); tok++ {
+		if n := base + tok; n >= 0 && n < $$Last && $$Chk[$$Act[n]] == tok {
+			expected = append(expected, $$Tokname(tok))
+		}
+	}
+
+	if $$Def[state] == -2 {
+		i := 0
+		for $$Exca[i] != -1 || $$Exca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; $$Exca[i] >= 0; i += 2 {
+			tok := $$Exca[i]
+			if tok < TOKSTART || $$Exca[i+1] == 0 {
+				continue
+			}
+			expected = append(expected, $$Tokname(tok))
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if $$Exca[i+1] != 0 {
+			return nil
+		}
+	}
+	return expected
+}
+
 func $$ErrorMessage(state, lookAhead int) string {
 	const TOKSTART = 4
 
@@ -3682,6 +3787,9 @@ $$default:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
+			if $$elex, ok := $$lex.($$ExpectingLexer); ok {
+				$$elex.Expecting($$ExpectedTokens($$state))
+			}
 			$$lex.Error($$ErrorMessage($$state, $$token))
 			Nerrs++
 			if $$Debug >= 1 {
@@ -3743,6 +3851,7 @@ $$default:
 		$$S = nyys
 	}
 	$$VAL = $$S[$$p+1]
+	$$locate()
 
 	/* consult goto table to find next state */
 	$$n = $$R1[$$n]
@@ -115,8 +115,10 @@ var keywords = []keyword{
 	{"_utf8mb4", UNDERSCORE_UTF8MB4},
 	{"_utf8mb3", UNDERSCORE_UTF8MB3},
 	{"accessible", UNUSED},
+	{"account", ACCOUNT},
 	{"action", ACTION},
 	{"add", ADD},
+	{"admin", ADMIN},
 	{"after", AFTER},
 	{"against", AGAINST},
 	{"algorithm", ALGORITHM},
@@ -129,19 +131,23 @@ var keywords = []keyword{
 	{"asc", ASC},
 	{"ascii", ASCII},
 	{"asensitive", UNUSED},
+	{"at", AT},
+	{"attribute", ATTRIBUTE},
 	{"auto_increment", AUTO_INCREMENT},
 	{"autoextend_size", AUTOEXTEND_SIZE},
 	{"avg_row_length", AVG_ROW_LENGTH},
-	{"before", UNUSED},
+	{"before", BEFORE},
 	{"begin", BEGIN},
 	{"between", BETWEEN},
 	{"bigint", BIGINT},
 	{"binary", BINARY},
+	{"binlog", BINLOG},
 	{"bit", BIT},
 	{"blob", BLOB},
 	{"bool", BOOL},
 	{"boolean", BOOLEAN},
 	{"both", BOTH},
+	{"buckets", BUCKETS},
 	{"by", BY},
 	{"byte", BYTE},
 	{"call", CALL},
@@ -152,12 +158,15 @@ var keywords = []keyword{
 	{"cast", CAST},
 	{"channel", CHANNEL},
 	{"change", CHANGE},
+	{"changed", CHANGED},
 	{"char", CHAR},
 	{"character", CHARACTER},
 	{"charset", CHARSET},
 	{"check", CHECK},
 	{"checksum", CHECKSUM},
+	{"cipher", CIPHER},
 	{"cleanup", CLEANUP},
+	{"close", CLOSE},
 	{"coalesce", COALESCE},
 	{"code", CODE},
 	{"collate", COLLATE},
@@ -170,15 +179,19 @@ var keywords = []keyword{
 	{"commit", COMMIT},
 	{"compact", COMPACT},
 	{"complete", COMPLETE},
+	{"completion", COMPLETION},
+	{"component", COMPONENT},
 	{"compressed", COMPRESSED},
 	{"compression", COMPRESSION},
-	{"condition", UNUSED},
+	{"concurrent", CONCURRENT},
+	{"condition", CONDITION},
 	{"connection", CONNECTION},
 	{"constraint", CONSTRAINT},
-	{"continue", UNUSED},
+	{"contains", CONTAINS},
+	{"continue", CONTINUE},
 	{"convert", CONVERT},
 	{"copy", COPY},
-	{"cume_dist", UNUSED},
+	{"cume_dist", CUME_DIST},
 	{"substr", SUBSTRING},
 	{"subpartition", SUBPARTITION},
 	{"subpartitions", SUBPARTITIONS},
@@ -187,11 +200,12 @@ var keywords = []keyword{
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
 	{"current_user", CURRENT_USER},
-	{"cursor", UNUSED},
+	{"cursor", CURSOR},
 	{"data", DATA},
 	{"database", DATABASE},
 	{"databases", DATABASES},
@@ -205,16 +219,16 @@ var keywords = []keyword{
 	{"deallocate", DEALLOCATE},
 	{"dec", UNUSED},
 	{"decimal", DECIMAL_TYPE},
-	{"declare", UNUSED},
+	{"declare", DECLARE},
 	{"default", DEFAULT},
 	{"definer", DEFINER},
 	{"delay_key_write", DELAY_KEY_WRITE},
 	{"delayed", UNUSED},
 	{"delete", DELETE},
-	{"dense_rank", UNUSED},
+	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
-	{"deterministic", UNUSED},
+	{"deterministic", DETERMINISTIC},
 	{"directory", DIRECTORY},
 	{"disable", DISABLE},
 	{"discard", DISCARD},
@@ -228,86 +242,103 @@ var keywords = []keyword{
 	{"dumpfile", DUMPFILE},
 	{"duplicate", DUPLICATE},
 	{"dynamic", DYNAMIC},
-	{"each", UNUSED},
+	{"each", EACH},
 	{"else", ELSE},
-	{"elseif", UNUSED},
+	{"elseif", ELSEIF},
 	{"empty", EMPTY},
 	{"enable", ENABLE},
 	{"enclosed", ENCLOSED},
 	{"encryption", ENCRYPTION},
 	{"end", END},
+	{"ends", ENDS},
 	{"enforced", ENFORCED},
 	{"engine", ENGINE},
 	{"engine_attribute", ENGINE_ATTRIBUTE},
 	{"engines", ENGINES},
 	{"enum", ENUM},
 	{"error", ERROR},
+	{"errors", ERRORS},
 	{"escape", ESCAPE},
 	{"escaped", ESCAPED},
 	{"event", EVENT},
+	{"events", EVENTS},
+	{"every", EVERY},
+	{"except", EXCEPT},
 	{"exchange", EXCHANGE},
 	{"exclusive", EXCLUSIVE},
 	{"execute", EXECUTE},
 	{"exists", EXISTS},
-	{"exit", UNUSED},
+	{"exit", EXIT},
 	{"explain", EXPLAIN},
 	{"expansion", EXPANSION},
 	{"expire", EXPIRE},
 	{"export", EXPORT},
 	{"extended", EXTENDED},
 	{"extract", EXTRACT},
+	{"failed_login_attempts", FAILED_LOGIN_ATTEMPTS},
 	{"false", FALSE},
-	{"fetch", UNUSED},
+	{"fast", FAST},
+	{"fetch", FETCH},
 	{"fields", FIELDS},
 	{"first", FIRST},
-	{"first_value", UNUSED},
+	{"first_value", FIRST_VALUE},
 	{"fixed", FIXED},
 	{"float", FLOAT_TYPE},
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
+	{"follows", FOLLOWS},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
 	{"format", FORMAT},
+	{"found", FOUND},
 	{"from", FROM},
 	{"full", FULL},
 	{"fulltext", FULLTEXT},
 	{"function", FUNCTION},
 	{"general", GENERAL},
 	{"generated", GENERATED},
+	{"geomcollection", GEOMCOLLECTION},
 	{"geometry", GEOMETRY},
 	{"geometrycollection", GEOMETRYCOLLECTION},
 	{"get", UNUSED},
 	{"global", GLOBAL},
 	{"gtid_executed", GTID_EXECUTED},
-	{"grant", UNUSED},
+	{"grant", GRANT},
+	{"grants", GRANTS},
 	{"group", GROUP},
-	{"grouping", UNUSED},
+	{"grouping", GROUPING},
 	{"groups", UNUSED},
 	{"group_concat", GROUP_CONCAT},
+	{"handler", HANDLER},
 	{"hash", HASH},
 	{"having", HAVING},
 	{"header", HEADER},
 	{"high_priority", UNUSED},
+	{"histogram", HISTOGRAM},
+	{"history", HISTORY},
 	{"hosts", HOSTS},
 	{"hour", HOUR},
 	{"hour_microsecond", HOUR_MICROSECOND},
 	{"hour_minute", HOUR_MINUTE},
 	{"hour_second", HOUR_SECOND},
+	{"identified", IDENTIFIED},
 	{"if", IF},
 	{"ignore", IGNORE},
 	{"import", IMPORT},
 	{"in", IN},
 	{"index", INDEX},
 	{"indexes", INDEXES},
-	{"infile", UNUSED},
-	{"inout", UNUSED},
+	{"infile", INFILE},
+	{"inout", INOUT},
 	{"inner", INNER},
 	{"inplace", INPLACE},
 	{"insensitive", UNUSED},
 	{"insert", INSERT},
 	{"insert_method", INSERT_METHOD},
+	{"install", INSTALL},
 	{"instant", INSTANT},
 	{"invisible", INVISIBLE},
 	{"int", INT},
@@ -317,12 +348,15 @@ var keywords = []keyword{
 	{"int4", UNUSED},
 	{"int8", UNUSED},
 	{"integer", INTEGER},
+	{"intersect", INTERSECT},
 	{"interval", INTERVAL},
 	{"into", INTO},
 	{"io_after_gtids", UNUSED},
+	{"io_thread", IO_THREAD},
 	{"is", IS},
 	{"isolation", ISOLATION},
-	{"iterate", UNUSED},
+	{"issuer", ISSUER},
+	{"iterate", ITERATE},
 	{"invoker", INVOKER},
 	{"join", JOIN},
 	{"json", JSON},
@@ -360,16 +394,16 @@ var keywords = []keyword{
 	{"keys", KEYS},
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
-	{"kill", UNUSED},
-	{"lag", UNUSED},
+	{"kill", KILL},
+	{"lag", LAG},
 	{"language", LANGUAGE},
 	{"last", LAST},
-	{"last_value", UNUSED},
+	{"last_value", LAST_VALUE},
 	{"last_insert_id", LAST_INSERT_ID},
 	{"lateral", LATERAL},
-	{"lead", UNUSED},
+	{"lead", LEAD},
 	{"leading", LEADING},
-	{"leave", UNUSED},
+	{"leave", LEAVE},
 	{"left", LEFT},
 	{"less", LESS},
 	{"level", LEVEL},
@@ -384,18 +418,34 @@ var keywords = []keyword{
 	{"localtime", LOCALTIME},
 	{"localtimestamp", LOCALTIMESTAMP},
 	{"lock", LOCK},
+	{"locked", LOCKED},
 	{"logs", LOGS},
 	{"long", UNUSED},
 	{"longblob", LONGBLOB},
 	{"longtext", LONGTEXT},
-	{"loop", UNUSED},
+	{"loop", LOOP},
 	{"low_priority", LOW_PRIORITY},
 	{"ltrim", LTRIM},
 	{"manifest", MANIFEST},
+	{"master", MASTER},
 	{"master_bind", UNUSED},
 	{"match", MATCH},
+	{"max_connections_per_hour", MAX_CONNECTIONS_PER_HOUR},
+	{"max_queries_per_hour", MAX_QUERIES_PER_HOUR},
 	{"max_rows", MAX_ROWS},
+	{"max_updates_per_hour", MAX_UPDATES_PER_HOUR},
+	{"max_user_connections", MAX_USER_CONNECTIONS},
 	{"maxvalue", MAXVALUE},
+	{"mbrcontains", MBRCONTAINS},
+	{"mbrcoveredby", MBRCOVEREDBY},
+	{"mbrcovers", MBRCOVERS},
+	{"mbrdisjoint", MBRDISJOINT},
+	{"mbrequals", MBREQUALS},
+	{"mbrintersects", MBRINTERSECTS},
+	{"mbroverlaps", MBROVERLAPS},
+	{"mbrtouches", MBRTOUCHES},
+	{"mbrwithin", MBRWITHIN},
+	{"medium", MEDIUM},
 	{"mediumblob", MEDIUMBLOB},
 	{"mediumint", MEDIUMINT},
 	{"mediumtext", MEDIUMTEXT},
@@ -404,6 +454,7 @@ var keywords = []keyword{
 	{"merge", MERGE},
 	{"microsecond", MICROSECOND},
 	{"middleint", UNUSED},
+	{"migrate", MIGRATE},
 	{"min_rows", MIN_ROWS},
 	{"minute", MINUTE},
 	{"minute_microsecond", MINUTE_MICROSECOND},
@@ -411,15 +462,17 @@ var keywords = []keyword{
 	{"mod", MOD},
 	{"mode", MODE},
 	{"modify", MODIFY},
-	{"modifies", UNUSED},
+	{"modifies", MODIFIES},
 	{"multilinestring", MULTILINESTRING},
 	{"multipoint", MULTIPOINT},
 	{"multipolygon", MULTIPOLYGON},
 	{"month", MONTH},
+	{"mutex", MUTEX},
 	{"name", NAME},
 	{"names", NAMES},
 	{"natural", NATURAL},
 	{"nchar", NCHAR},
+	{"never", NEVER},
 	{"next", NEXT},
 	{"nested", NESTED},
 	{"no", NO},
@@ -427,27 +480,31 @@ var keywords = []keyword{
 	{"not", NOT},
 	{"now", NOW},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
-	{"nth_value", UNUSED},
-	{"ntile", UNUSED},
+	{"nowait", NOWAIT},
+	{"nth_value", NTH_VALUE},
+	{"ntile", NTILE},
 	{"null", NULL},
+	{"nulls", NULLS},
 	{"numeric", NUMERIC},
 	{"of", OF},
 	{"off", OFF},
 	{"offset", OFFSET},
 	{"on", ON},
+	{"one", ONE},
 	{"only", ONLY},
 	{"open", OPEN},
 	{"optimize", OPTIMIZE},
 	{"optimizer_costs", OPTIMIZER_COSTS},
 	{"option", OPTION},
+	{"optional", OPTIONAL},
 	{"optionally", OPTIONALLY},
 	{"or", OR},
 	{"order", ORDER},
 	{"ordinality", ORDINALITY},
-	{"out", UNUSED},
+	{"out", OUT},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -456,58 +513,89 @@ var keywords = []keyword{
 	{"partitions", PARTITIONS},
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
+	{"password_lock_time", PASSWORD_LOCK_TIME},
 	{"path", PATH},
-	{"percent_rank", UNUSED},
+	{"percent_rank", PERCENT_RANK},
+	{"phase", PHASE},
+	{"plugin", PLUGIN},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"precedes", PRECEDES},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"prepare", PREPARE},
+	{"preserve", PRESERVE},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
+	{"profile", PROFILE},
+	{"profiles", PROFILES},
+	{"proxy", PROXY},
+	{"purge", PURGE},
 	{"query", QUERY},
 	{"range", RANGE},
 	{"quarter", QUARTER},
-	{"rank", UNUSED},
+	{"quick", QUICK},
+	{"random", RANDOM},
+	{"rank", RANK},
 	{"ratio", RATIO},
 	{"read", READ},
-	{"reads", UNUSED},
+	{"reads", READS},
 	{"read_write", UNUSED},
 	{"real", REAL},
 	{"rebuild", REBUILD},
+	{"recover", RECOVER},
 	{"recursive", RECURSIVE},
 	{"redundant", REDUNDANT},
 	{"references", REFERENCES},
 	{"regexp", REGEXP},
+	{"regexp_instr", REGEXP_INSTR},
+	{"regexp_like", REGEXP_LIKE},
+	{"regexp_replace", REGEXP_REPLACE},
+	{"regexp_substr", REGEXP_SUBSTR},
 	{"relay", RELAY},
+	{"relay_thread", RELAY_THREAD},
+	{"relaylog", RELAYLOG},
 	{"release", RELEASE},
 	{"remove", REMOVE},
 	{"rename", RENAME},
 	{"reorganize", REORGANIZE},
 	{"repair", REPAIR},
-	{"repeat", UNUSED},
+	{"repeat", REPEAT},
 	{"repeatable", REPEATABLE},
 	{"replace", REPLACE},
-	{"require", UNUSED},
-	{"resignal", UNUSED},
+	{"replica", REPLICA},
+	{"replicas", REPLICAS},
+	{"replication", REPLICATION},
+	{"require", REQUIRE},
+	{"reset", RESET},
+	{"resignal", RESIGNAL},
+	{"respect", RESPECT},
 	{"restrict", RESTRICT},
-	{"return", UNUSED},
+	{"resume", RESUME},
+	{"retain", RETAIN},
+	{"return", RETURN},
 	{"returning", RETURNING},
 	{"retry", RETRY},
+	{"returns", RETURNS},
+	{"reuse", REUSE},
 	{"revert", REVERT},
-	{"revoke", UNUSED},
+	{"revoke", REVOKE},
 	{"right", RIGHT},
 	{"rlike", REGEXP},
+	{"role", ROLE},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"routine", ROUTINE},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
-	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"row_number", ROW_NUMBER},
+	{"rows", ROWS},
 	{"rtrim", RTRIM},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
+	{"schedule", SCHEDULE},
 	{"schema", SCHEMA},
 	{"schemas", SCHEMAS},
 	{"second", SECOND},
@@ -524,33 +612,64 @@ var keywords = []keyword{
 	{"share", SHARE},
 	{"shared", SHARED},
 	{"show", SHOW},
-	{"signal", UNUSED},
+	{"shutdown", SHUTDOWN},
+	{"signal", SIGNAL},
 	{"signed", SIGNED},
 	{"simple", SIMPLE},
+	{"skip", SKIP},
+	{"slave", SLAVE},
 	{"slow", SLOW},
 	{"smallint", SMALLINT},
+	{"soname", SONAME},
+	{"source", SOURCE},
 	{"spatial", SPATIAL},
 	{"specific", UNUSED},
 	{"sql", SQL},
-	{"sqlexception", UNUSED},
-	{"sqlstate", UNUSED},
-	{"sqlwarning", UNUSED},
+	{"sqlexception", SQLEXCEPTION},
+	{"sqlstate", SQLSTATE},
+	{"sqlwarning", SQLWARNING},
 	{"sql_big_result", UNUSED},
 	{"sql_cache", SQL_CACHE},
 	{"sql_calc_found_rows", SQL_CALC_FOUND_ROWS},
 	{"sql_no_cache", SQL_NO_CACHE},
 	{"sql_small_result", UNUSED},
-	{"ssl", UNUSED},
+	{"sql_thread", SQL_THREAD},
+	{"ssl", SSL},
+	{"st_asbinary", ST_ASBINARY},
+	{"st_astext", ST_ASTEXT},
+	{"st_contains", ST_CONTAINS},
+	{"st_crosses", ST_CROSSES},
+	{"st_disjoint", ST_DISJOINT},
+	{"st_distance", ST_DISTANCE},
+	{"st_distance_sphere", ST_DISTANCE_SPHERE},
+	{"st_equals", ST_EQUALS},
+	{"st_geomcollfromtext", ST_GEOMCOLLFROMTEXT},
+	{"st_geomfromtext", ST_GEOMFROMTEXT},
+	{"st_intersects", ST_INTERSECTS},
+	{"st_linefromtext", ST_LINEFROMTEXT},
+	{"st_mlinefromtext", ST_MLINEFROMTEXT},
+	{"st_mpointfromtext", ST_MPOINTFROMTEXT},
+	{"st_mpolyfromtext", ST_MPOLYFROMTEXT},
+	{"st_overlaps", ST_OVERLAPS},
+	{"st_pointfromtext", ST_POINTFROMTEXT},
+	{"st_polyfromtext", ST_POLYFROMTEXT},
+	{"st_srid", ST_SRID},
+	{"st_touches", ST_TOUCHES},
+	{"st_within", ST_WITHIN},
 	{"start", START},
 	{"starting", STARTING},
+	{"starts", STARTS},
 	{"stats_auto_recalc", STATS_AUTO_RECALC},
 	{"stats_persistent", STATS_PERSISTENT},
 	{"stats_sample_pages", STATS_SAMPLE_PAGES},
 	{"status", STATUS},
+	{"stop", STOP},
 	{"storage", STORAGE},
 	{"stored", STORED},
 	{"straight_join", STRAIGHT_JOIN},
 	{"stream", STREAM},
+	{"subject", SUBJECT},
+	{"suspend", SUSPEND},
 	{"system", UNUSED},
 	{"table", TABLE},
 	{"tables", TABLES},
@@ -579,19 +698,23 @@ var keywords = []keyword{
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
 	{"trim", TRIM},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
-	{"undo", UNUSED},
+	{"undo", UNDO},
 	{"unicode", UNICODE},
+	{"uninstall", UNINSTALL},
 	{"union", UNION},
 	{"unique", UNIQUE},
 	{"unlock", UNLOCK},
 	{"unsigned", UNSIGNED},
 	{"unthrottle", UNTHROTTLE},
+	{"until", UNTIL},
 	{"update", UPDATE},
 	{"upgrade", UPGRADE},
-	{"usage", UNUSED},
+	{"usage", USAGE},
 	{"use", USE},
+	{"use_frm", USE_FRM},
 	{"user", USER},
 	{"user_resources", USER_RESOURCES},
 	{"using", USING},
@@ -626,13 +749,16 @@ var keywords = []keyword{
 	{"weight_string", WEIGHT_STRING},
 	{"when", WHEN},
 	{"where", WHERE},
-	{"while", UNUSED},
-	{"window", UNUSED},
+	{"while", WHILE},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
 	{"write", WRITE},
 	{"visible", VISIBLE},
+	{"x509", X509},
+	{"xa", XA},
+	{"xid", XID},
 	{"xor", XOR},
 	{"year", YEAR},
 	{"year_month", YEAR_MONTH},
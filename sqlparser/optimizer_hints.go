@@ -0,0 +1,791 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Optimizer hints
+type (
+	// OptimizerHint represents a single hint of an optimizer hint comment,
+	// such as JOIN_ORDER(t1, t2) in /*+ JOIN_ORDER(t1, t2) */.
+	OptimizerHint interface {
+		iOptimizerHint()
+		// HintName returns the upper case name of the hint.
+		HintName() string
+		// String returns the hint in MySQL hint syntax.
+		String() string
+	}
+
+	// OptimizerHints represents the hints of an optimizer hint comment.
+	OptimizerHints []OptimizerHint
+
+	// HintTable represents a table of an optimizer hint: tbl_name[@query_block_name].
+	HintTable struct {
+		Name       string
+		QueryBlock string
+	}
+
+	// JoinOrderHint represents the JOIN_FIXED_ORDER, JOIN_ORDER,
+	// JOIN_PREFIX and JOIN_SUFFIX hints.
+	JoinOrderHint struct {
+		Name       string
+		QueryBlock string
+		Tables     []HintTable
+	}
+
+	// TableLevelHint represents the table-level hints, such as BKA, HASH_JOIN and MERGE.
+	TableLevelHint struct {
+		Name       string
+		QueryBlock string
+		Tables     []HintTable
+	}
+
+	// IndexLevelHint represents the index-level hints, such as INDEX, NO_INDEX and SKIP_SCAN.
+	IndexLevelHint struct {
+		Name       string
+		QueryBlock string
+		Table      HintTable
+		Indexes    []string
+	}
+
+	// SubqueryHint represents the SEMIJOIN, NO_SEMIJOIN and SUBQUERY hints.
+	SubqueryHint struct {
+		Name       string
+		QueryBlock string
+		Strategies []string
+	}
+
+	// MaxExecutionTimeHint represents a MAX_EXECUTION_TIME(N) hint, N being in milliseconds.
+	MaxExecutionTimeHint struct {
+		Milliseconds uint64
+	}
+
+	// SetVarHint represents a SET_VAR(var_name = value) hint.
+	// Value holds the value as written, e.g. 16M or 'ON'.
+	SetVarHint struct {
+		Name  string
+		Value string
+	}
+
+	// ResourceGroupHint represents a RESOURCE_GROUP(group_name) hint.
+	ResourceGroupHint struct {
+		Name string
+	}
+
+	// QBNameHint represents a QB_NAME(name) hint.
+	QBNameHint struct {
+		Name string
+	}
+
+	// UnknownHint represents a hint with a name that is not known to the parser,
+	// such as a hint of a newer MySQL version or of another vendor.
+	// Args holds the text between the parentheses as written.
+	UnknownHint struct {
+		Name string
+		Args string
+	}
+)
+
+func (*JoinOrderHint) iOptimizerHint()        {}
+func (*TableLevelHint) iOptimizerHint()       {}
+func (*IndexLevelHint) iOptimizerHint()       {}
+func (*SubqueryHint) iOptimizerHint()         {}
+func (*MaxExecutionTimeHint) iOptimizerHint() {}
+func (*SetVarHint) iOptimizerHint()           {}
+func (*ResourceGroupHint) iOptimizerHint()    {}
+func (*QBNameHint) iOptimizerHint()           {}
+func (*UnknownHint) iOptimizerHint()          {}
+
+// Names of the optimizer hints that are not part of a family of hints.
+const (
+	MaxExecutionTimeHintName = "MAX_EXECUTION_TIME"
+	SetVarHintName           = "SET_VAR"
+	ResourceGroupHintName    = "RESOURCE_GROUP"
+	QBNameHintName           = "QB_NAME"
+)
+
+// OptimizerHintError is the error of an optimizer hint that was skipped.
+// Offset is where the error is in the text of the hint comment, or -1 if the
+// error is about the whole comment.
+type OptimizerHintError struct {
+	Message string
+	Offset  int
+}
+
+// Error returns the message with the offset.
+func (e *OptimizerHintError) Error() string {
+	if e.Offset < 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("optimizer hint syntax error at position %d: %s", e.Offset, e.Message)
+}
+
+type optimizerHintKind int8
+
+const (
+	joinOrderHintKind optimizerHintKind = iota
+	tableLevelHintKind
+	indexLevelHintKind
+	subqueryHintKind
+	maxExecutionTimeHintKind
+	setVarHintKind
+	resourceGroupHintKind
+	qbNameHintKind
+)
+
+// optimizerHintKinds maps the name of every known optimizer hint to its kind.
+var optimizerHintKinds = map[string]optimizerHintKind{
+	"JOIN_FIXED_ORDER":              joinOrderHintKind,
+	"JOIN_ORDER":                    joinOrderHintKind,
+	"JOIN_PREFIX":                   joinOrderHintKind,
+	"JOIN_SUFFIX":                   joinOrderHintKind,
+	"BKA":                           tableLevelHintKind,
+	"NO_BKA":                        tableLevelHintKind,
+	"BNL":                           tableLevelHintKind,
+	"NO_BNL":                        tableLevelHintKind,
+	"DERIVED_CONDITION_PUSHDOWN":    tableLevelHintKind,
+	"NO_DERIVED_CONDITION_PUSHDOWN": tableLevelHintKind,
+	"HASH_JOIN":                     tableLevelHintKind,
+	"NO_HASH_JOIN":                  tableLevelHintKind,
+	"MERGE":                         tableLevelHintKind,
+	"NO_MERGE":                      tableLevelHintKind,
+	"GROUP_INDEX":                   indexLevelHintKind,
+	"NO_GROUP_INDEX":                indexLevelHintKind,
+	"INDEX":                         indexLevelHintKind,
+	"NO_INDEX":                      indexLevelHintKind,
+	"INDEX_MERGE":                   indexLevelHintKind,
+	"NO_INDEX_MERGE":                indexLevelHintKind,
+	"JOIN_INDEX":                    indexLevelHintKind,
+	"NO_JOIN_INDEX":                 indexLevelHintKind,
+	"MRR":                           indexLevelHintKind,
+	"NO_MRR":                        indexLevelHintKind,
+	"NO_ICP":                        indexLevelHintKind,
+	"NO_RANGE_OPTIMIZATION":         indexLevelHintKind,
+	"ORDER_INDEX":                   indexLevelHintKind,
+	"NO_ORDER_INDEX":                indexLevelHintKind,
+	"SKIP_SCAN":                     indexLevelHintKind,
+	"NO_SKIP_SCAN":                  indexLevelHintKind,
+	"SEMIJOIN":                      subqueryHintKind,
+	"NO_SEMIJOIN":                   subqueryHintKind,
+	"SUBQUERY":                      subqueryHintKind,
+	MaxExecutionTimeHintName:        maxExecutionTimeHintKind,
+	SetVarHintName:                  setVarHintKind,
+	ResourceGroupHintName:           resourceGroupHintKind,
+	QBNameHintName:                  qbNameHintKind,
+}
+
+// HintName implements the OptimizerHint interface.
+func (hint *JoinOrderHint) HintName() string { return hint.Name }
+
+// HintName implements the OptimizerHint interface.
+func (hint *TableLevelHint) HintName() string { return hint.Name }
+
+// HintName implements the OptimizerHint interface.
+func (hint *IndexLevelHint) HintName() string { return hint.Name }
+
+// HintName implements the OptimizerHint interface.
+func (hint *SubqueryHint) HintName() string { return hint.Name }
+
+// HintName implements the OptimizerHint interface.
+func (*MaxExecutionTimeHint) HintName() string { return MaxExecutionTimeHintName }
+
+// HintName implements the OptimizerHint interface.
+func (*SetVarHint) HintName() string { return SetVarHintName }
+
+// HintName implements the OptimizerHint interface.
+func (*ResourceGroupHint) HintName() string { return ResourceGroupHintName }
+
+// HintName implements the OptimizerHint interface.
+func (*QBNameHint) HintName() string { return QBNameHintName }
+
+// HintName implements the OptimizerHint interface.
+func (hint *UnknownHint) HintName() string { return hint.Name }
+
+// String returns the table in MySQL hint syntax.
+func (table HintTable) String() string {
+	if table.QueryBlock == "" {
+		return formatHintID(table.Name)
+	}
+	return formatHintID(table.Name) + "@" + formatHintID(table.QueryBlock)
+}
+
+func (hint *JoinOrderHint) String() string {
+	return formatHintWithTables(hint.Name, hint.QueryBlock, hint.Tables)
+}
+
+func (hint *TableLevelHint) String() string {
+	return formatHintWithTables(hint.Name, hint.QueryBlock, hint.Tables)
+}
+
+func (hint *IndexLevelHint) String() string {
+	var buf strings.Builder
+	buf.WriteString(hint.Name)
+	buf.WriteByte('(')
+	if hint.QueryBlock != "" {
+		buf.WriteString("@" + formatHintID(hint.QueryBlock) + " ")
+	}
+	buf.WriteString(hint.Table.String())
+	for i, index := range hint.Indexes {
+		if i == 0 {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(formatHintID(index))
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+func (hint *SubqueryHint) String() string {
+	var args []string
+	if hint.QueryBlock != "" {
+		args = append(args, "@"+formatHintID(hint.QueryBlock))
+	}
+	if len(hint.Strategies) > 0 {
+		args = append(args, strings.Join(hint.Strategies, ", "))
+	}
+	return hint.Name + "(" + strings.Join(args, " ") + ")"
+}
+
+func (hint *MaxExecutionTimeHint) String() string {
+	return MaxExecutionTimeHintName + "(" + strconv.FormatUint(hint.Milliseconds, 10) + ")"
+}
+
+func (hint *SetVarHint) String() string {
+	return SetVarHintName + "(" + hint.Name + " = " + hint.Value + ")"
+}
+
+func (hint *ResourceGroupHint) String() string {
+	return ResourceGroupHintName + "(" + formatHintID(hint.Name) + ")"
+}
+
+func (hint *QBNameHint) String() string {
+	return QBNameHintName + "(" + formatHintID(hint.Name) + ")"
+}
+
+func (hint *UnknownHint) String() string {
+	return hint.Name + "(" + hint.Args + ")"
+}
+
+func formatHintWithTables(name, queryBlock string, tables []HintTable) string {
+	var buf strings.Builder
+	buf.WriteString(name)
+	buf.WriteByte('(')
+	if queryBlock != "" {
+		buf.WriteString("@" + formatHintID(queryBlock))
+		if len(tables) > 0 {
+			buf.WriteByte(' ')
+		}
+	}
+	for i, table := range tables {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(table.String())
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// formatHintID quotes an identifier of an optimizer hint with backticks
+// when it is not a plain identifier.
+func formatHintID(id string) string {
+	plain := id != ""
+	for _, c := range id {
+		if !isHintIDChar(c) {
+			plain = false
+			break
+		}
+	}
+	if plain && strings.TrimFunc(id, isHintDigit) != "" {
+		return id
+	}
+	return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+}
+
+func isHintDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHintIDChar(c rune) bool {
+	return c == '_' || c == '$' || isHintDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// String returns the hints as an optimizer hint comment, e.g. /*+ BKA(t1) NO_ICP(t2) */.
+// It returns an empty string if there are no hints.
+func (hints OptimizerHints) String() string {
+	if len(hints) == 0 {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString(queryOptimizerPrefix)
+	for _, hint := range hints {
+		buf.WriteByte(' ')
+		buf.WriteString(hint.String())
+	}
+	buf.WriteString(" */")
+	return buf.String()
+}
+
+// Get returns the first hint with the given name, or nil if there is none.
+func (hints OptimizerHints) Get(name string) OptimizerHint {
+	name = strings.ToUpper(name)
+	for _, hint := range hints {
+		if hint.HintName() == name {
+			return hint
+		}
+	}
+	return nil
+}
+
+// Add returns the hints with the given hint appended.
+func (hints OptimizerHints) Add(hint OptimizerHint) OptimizerHints {
+	return append(hints[:len(hints):len(hints)], hint)
+}
+
+// Remove returns the hints without the ones with the given name.
+func (hints OptimizerHints) Remove(name string) OptimizerHints {
+	name = strings.ToUpper(name)
+	var kept OptimizerHints
+	for _, hint := range hints {
+		if hint.HintName() != name {
+			kept = append(kept, hint)
+		}
+	}
+	return kept
+}
+
+// Replace returns the hints with the ones named like the given hint replaced by it.
+// The hint takes the position of the first replaced hint, or is appended if there
+// is no hint with its name.
+func (hints OptimizerHints) Replace(hint OptimizerHint) OptimizerHints {
+	var replaced OptimizerHints
+	found := false
+	for _, h := range hints {
+		if h.HintName() != hint.HintName() {
+			replaced = append(replaced, h)
+			continue
+		}
+		if !found {
+			replaced = append(replaced, hint)
+			found = true
+		}
+	}
+	if !found {
+		replaced = append(replaced, hint)
+	}
+	return replaced
+}
+
+// OptimizerHints returns the hints of the optimizer hint comment, which are
+// parsed along with the comments, and the errors of the hints that were skipped.
+// It returns nil if there is no optimizer hint comment.
+func (c *ParsedComments) OptimizerHints() (OptimizerHints, []*OptimizerHintError) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.hints, c.hintErrors
+}
+
+// parseOptimizerHintComment parses the optimizer hint comment of the comments.
+func parseOptimizerHintComment(comments Comments) (OptimizerHints, []*OptimizerHintError) {
+	var hints OptimizerHints
+	var errs []*OptimizerHintError
+	found := false
+	for _, comment := range comments {
+		if !strings.HasPrefix(comment, queryOptimizerPrefix) {
+			continue
+		}
+		if found {
+			errs = append(errs, &OptimizerHintError{Message: "Must have only one query hint", Offset: -1})
+			continue
+		}
+		found = true
+		if !strings.HasSuffix(comment, "*/") || len(comment) < len(queryOptimizerPrefix)+2 {
+			errs = append(errs, &OptimizerHintError{Message: "Query hint comment is malformed", Offset: -1})
+			continue
+		}
+		var hintErrs []*OptimizerHintError
+		hints, hintErrs = ParseOptimizerHints(comment[len(queryOptimizerPrefix) : len(comment)-2])
+		errs = append(errs, hintErrs...)
+	}
+	return hints, errs
+}
+
+// SetOptimizerHints returns the comments with the optimizer hint comment replaced
+// by one holding the given hints. The hint comment is removed if there are no hints.
+func (c *ParsedComments) SetOptimizerHints(hints OptimizerHints) Comments {
+	var newComments Comments
+	if hint := hints.String(); hint != "" {
+		newComments = append(newComments, hint)
+	}
+	if c != nil {
+		for _, comment := range c.comments {
+			if !strings.HasPrefix(comment, queryOptimizerPrefix) {
+				newComments = append(newComments, comment)
+			}
+		}
+	}
+	return newComments
+}
+
+// GetOptimizerHints returns the optimizer hints of the statement and the errors
+// of the hints that were skipped.
+func GetOptimizerHints(stmt SupportOptimizerHint) (OptimizerHints, []*OptimizerHintError) {
+	return stmt.GetParsedComments().OptimizerHints()
+}
+
+// SetOptimizerHints replaces the optimizer hints of the statement.
+func SetOptimizerHints(stmt SupportOptimizerHint, hints OptimizerHints) {
+	stmt.SetComments(stmt.GetParsedComments().SetOptimizerHints(hints))
+}
+
+// ParseOptimizerHints parses the text of an optimizer hint comment,
+// without the /*+ and */ delimiters. Like MySQL, which ignores invalid hints
+// with a warning, it skips a hint with a syntax error and goes on with the
+// next one. The errors of the skipped hints are returned along with the hints.
+func ParseOptimizerHints(text string) (OptimizerHints, []*OptimizerHintError) {
+	p := &hintParser{text: text}
+	var hints OptimizerHints
+	var errs []*OptimizerHintError
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.text) {
+			return hints, errs
+		}
+		start := p.pos
+		hint, err := p.parseHint()
+		if err != nil {
+			errs = append(errs, err)
+			if !p.skipHint(start) {
+				return hints, errs
+			}
+			continue
+		}
+		hints = append(hints, hint)
+	}
+}
+
+// hintParser parses the hints of an optimizer hint comment.
+type hintParser struct {
+	text string
+	pos  int
+}
+
+func (p *hintParser) errorf(format string, args ...interface{}) *OptimizerHintError {
+	return &OptimizerHintError{Message: fmt.Sprintf(format, args...), Offset: p.pos}
+}
+
+func (p *hintParser) skipSpaces() {
+	for p.pos < len(p.text) && strings.IndexByte(" \t\r\n", p.text[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// peek returns the next character that is not a space, or 0 at the end of the text.
+func (p *hintParser) peek() byte {
+	p.skipSpaces()
+	if p.pos == len(p.text) {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *hintParser) expect(c byte) *OptimizerHintError {
+	if p.peek() != c {
+		return p.errorf("expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+// ident reads a plain or backtick quoted identifier.
+func (p *hintParser) ident() (string, *OptimizerHintError) {
+	p.skipSpaces()
+	if p.pos < len(p.text) && p.text[p.pos] == '`' {
+		var buf strings.Builder
+		for p.pos++; p.pos < len(p.text); p.pos++ {
+			if p.text[p.pos] != '`' {
+				buf.WriteByte(p.text[p.pos])
+				continue
+			}
+			if p.pos+1 < len(p.text) && p.text[p.pos+1] == '`' {
+				buf.WriteByte('`')
+				p.pos++
+				continue
+			}
+			p.pos++
+			return buf.String(), nil
+		}
+		return "", p.errorf("unterminated quoted identifier")
+	}
+	start := p.pos
+	for p.pos < len(p.text) && isHintIDChar(rune(p.text[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("expected identifier")
+	}
+	return p.text[start:p.pos], nil
+}
+
+// args reads the text between the parentheses of a hint as written. It returns
+// false if the closing parenthesis is missing.
+func (p *hintParser) args() (string, bool) {
+	if p.peek() != '(' {
+		return "", false
+	}
+	p.pos++
+	start := p.pos
+	depth := 0
+	for ; p.pos < len(p.text); p.pos++ {
+		switch c := p.text[p.pos]; c {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				args := p.text[start:p.pos]
+				p.pos++
+				return strings.TrimSpace(args), true
+			}
+			depth--
+		case '`', '\'', '"':
+			for p.pos++; p.pos < len(p.text) && p.text[p.pos] != c; p.pos++ {
+				if c != '`' && p.text[p.pos] == '\\' {
+					p.pos++
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// skipHint skips the hint that starts at start, so that parsing can go on with
+// the next hint. It returns false if the end of the hint can't be found.
+func (p *hintParser) skipHint(start int) bool {
+	p.pos = start
+	if _, err := p.ident(); err != nil {
+		return false
+	}
+	if p.peek() != '(' {
+		return true
+	}
+	_, ok := p.args()
+	return ok
+}
+
+// queryBlockOpt reads an optional @query_block_name.
+func (p *hintParser) queryBlockOpt() (string, *OptimizerHintError) {
+	if p.peek() != '@' {
+		return "", nil
+	}
+	p.pos++
+	return p.ident()
+}
+
+func (p *hintParser) table() (HintTable, *OptimizerHintError) {
+	name, err := p.ident()
+	if err != nil {
+		return HintTable{}, err
+	}
+	queryBlock, err := p.queryBlockOpt()
+	if err != nil {
+		return HintTable{}, err
+	}
+	return HintTable{Name: name, QueryBlock: queryBlock}, nil
+}
+
+// tableList reads an optional comma separated list of tables.
+func (p *hintParser) tableList() ([]HintTable, *OptimizerHintError) {
+	var tables []HintTable
+	for p.peek() != ')' {
+		if len(tables) > 0 {
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+		}
+		table, err := p.table()
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// identList reads an optional comma separated list of identifiers.
+func (p *hintParser) identList() ([]string, *OptimizerHintError) {
+	var ids []string
+	for p.peek() != ')' {
+		if len(ids) > 0 {
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+		}
+		id, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// value reads the value of a SET_VAR hint as written.
+func (p *hintParser) value() (string, *OptimizerHintError) {
+	p.skipSpaces()
+	start := p.pos
+	if p.pos < len(p.text) && (p.text[p.pos] == '\'' || p.text[p.pos] == '"') {
+		quote := p.text[p.pos]
+		for p.pos++; p.pos < len(p.text); p.pos++ {
+			if p.text[p.pos] == '\\' {
+				p.pos++
+				continue
+			}
+			if p.text[p.pos] == quote {
+				p.pos++
+				return p.text[start:p.pos], nil
+			}
+		}
+		return "", p.errorf("unterminated string")
+	}
+	for p.pos < len(p.text) && strings.IndexByte(" \t\r\n()", p.text[p.pos]) < 0 {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("expected value")
+	}
+	return p.text[start:p.pos], nil
+}
+
+func (p *hintParser) parseHint() (OptimizerHint, *OptimizerHintError) {
+	start := p.pos
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	name = strings.ToUpper(name)
+	kind, ok := optimizerHintKinds[name]
+	if !ok {
+		if p.peek() != '(' {
+			return nil, p.errorf("expected '('")
+		}
+		args, ok := p.args()
+		if !ok {
+			p.pos = start
+			return nil, p.errorf("missing ')' of hint '%s'", name)
+		}
+		return &UnknownHint{Name: name, Args: args}, nil
+	}
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var hint OptimizerHint
+	switch kind {
+	case joinOrderHintKind, tableLevelHintKind:
+		queryBlock, err := p.queryBlockOpt()
+		if err != nil {
+			return nil, err
+		}
+		tables, err := p.tableList()
+		if err != nil {
+			return nil, err
+		}
+		if kind == tableLevelHintKind {
+			hint = &TableLevelHint{Name: name, QueryBlock: queryBlock, Tables: tables}
+			break
+		}
+		if (name == "JOIN_FIXED_ORDER") != (len(tables) == 0) {
+			return nil, p.errorf("unexpected table list of %s", name)
+		}
+		hint = &JoinOrderHint{Name: name, QueryBlock: queryBlock, Tables: tables}
+	case indexLevelHintKind:
+		queryBlock, err := p.queryBlockOpt()
+		if err != nil {
+			return nil, err
+		}
+		table, err := p.table()
+		if err != nil {
+			return nil, err
+		}
+		indexes, err := p.identList()
+		if err != nil {
+			return nil, err
+		}
+		hint = &IndexLevelHint{Name: name, QueryBlock: queryBlock, Table: table, Indexes: indexes}
+	case subqueryHintKind:
+		queryBlock, err := p.queryBlockOpt()
+		if err != nil {
+			return nil, err
+		}
+		strategies, err := p.identList()
+		if err != nil {
+			return nil, err
+		}
+		for i, strategy := range strategies {
+			strategies[i] = strings.ToUpper(strategy)
+		}
+		if name == "SUBQUERY" && len(strategies) != 1 {
+			return nil, p.errorf("SUBQUERY takes one strategy")
+		}
+		hint = &SubqueryHint{Name: name, QueryBlock: queryBlock, Strategies: strategies}
+	case maxExecutionTimeHintKind:
+		p.skipSpaces()
+		start := p.pos
+		for p.pos < len(p.text) && isHintDigit(rune(p.text[p.pos])) {
+			p.pos++
+		}
+		milliseconds, err := strconv.ParseUint(p.text[start:p.pos], 10, 64)
+		if err != nil {
+			p.pos = start
+			return nil, p.errorf("expected number of milliseconds")
+		}
+		hint = &MaxExecutionTimeHint{Milliseconds: milliseconds}
+	case setVarHintKind:
+		variable, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		hint = &SetVarHint{Name: variable, Value: value}
+	case resourceGroupHintKind, qbNameHintKind:
+		id, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if kind == resourceGroupHintKind {
+			hint = &ResourceGroupHint{Name: id}
+		} else {
+			hint = &QBNameHint{Name: id}
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	return hint, nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptimizerHints(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+		hints  OptimizerHints
+	}{{
+		input:  " join_order(t1, t2@qb1) ",
+		output: "/*+ JOIN_ORDER(t1, t2@qb1) */",
+		hints:  OptimizerHints{&JoinOrderHint{Name: "JOIN_ORDER", Tables: []HintTable{{Name: "t1"}, {Name: "t2", QueryBlock: "qb1"}}}},
+	}, {
+		input:  "JOIN_FIXED_ORDER(@qb1)",
+		output: "/*+ JOIN_FIXED_ORDER(@qb1) */",
+		hints:  OptimizerHints{&JoinOrderHint{Name: "JOIN_FIXED_ORDER", QueryBlock: "qb1"}},
+	}, {
+		input:  "BKA() NO_HASH_JOIN(@qb1 t1, `my table`)",
+		output: "/*+ BKA() NO_HASH_JOIN(@qb1 t1, `my table`) */",
+		hints: OptimizerHints{
+			&TableLevelHint{Name: "BKA"},
+			&TableLevelHint{Name: "NO_HASH_JOIN", QueryBlock: "qb1", Tables: []HintTable{{Name: "t1"}, {Name: "my table"}}},
+		},
+	}, {
+		input:  "INDEX(t1 idx_a, idx_b) no_icp(t2@qb2)",
+		output: "/*+ INDEX(t1 idx_a, idx_b) NO_ICP(t2@qb2) */",
+		hints: OptimizerHints{
+			&IndexLevelHint{Name: "INDEX", Table: HintTable{Name: "t1"}, Indexes: []string{"idx_a", "idx_b"}},
+			&IndexLevelHint{Name: "NO_ICP", Table: HintTable{Name: "t2", QueryBlock: "qb2"}},
+		},
+	}, {
+		input:  "SEMIJOIN(@qb1 firstmatch, loosescan) SUBQUERY(INTOEXISTS)",
+		output: "/*+ SEMIJOIN(@qb1 FIRSTMATCH, LOOSESCAN) SUBQUERY(INTOEXISTS) */",
+		hints: OptimizerHints{
+			&SubqueryHint{Name: "SEMIJOIN", QueryBlock: "qb1", Strategies: []string{"FIRSTMATCH", "LOOSESCAN"}},
+			&SubqueryHint{Name: "SUBQUERY", Strategies: []string{"INTOEXISTS"}},
+		},
+	}, {
+		input:  "MAX_EXECUTION_TIME( 1000 ) SET_VAR(sort_buffer_size=16M) SET_VAR(optimizer_switch = 'mrr=on,mrr_cost_based=off')",
+		output: "/*+ MAX_EXECUTION_TIME(1000) SET_VAR(sort_buffer_size = 16M) SET_VAR(optimizer_switch = 'mrr=on,mrr_cost_based=off') */",
+		hints: OptimizerHints{
+			&MaxExecutionTimeHint{Milliseconds: 1000},
+			&SetVarHint{Name: "sort_buffer_size", Value: "16M"},
+			&SetVarHint{Name: "optimizer_switch", Value: "'mrr=on,mrr_cost_based=off'"},
+		},
+	}, {
+		input:  "RESOURCE_GROUP(rg1) QB_NAME(`1`)",
+		output: "/*+ RESOURCE_GROUP(rg1) QB_NAME(`1`) */",
+		hints:  OptimizerHints{&ResourceGroupHint{Name: "rg1"}, &QBNameHint{Name: "1"}},
+	}, {
+		input:  "BKA(t1) foo_hint( a, (b), ')' ) NO_BKA(t2)",
+		output: "/*+ BKA(t1) FOO_HINT(a, (b), ')') NO_BKA(t2) */",
+		hints: OptimizerHints{
+			&TableLevelHint{Name: "BKA", Tables: []HintTable{{Name: "t1"}}},
+			&UnknownHint{Name: "FOO_HINT", Args: "a, (b), ')'"},
+			&TableLevelHint{Name: "NO_BKA", Tables: []HintTable{{Name: "t2"}}},
+		},
+	}, {
+		input:  "  ",
+		output: "",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			hints, errs := ParseOptimizerHints(tcase.input)
+			require.Empty(t, errs)
+			assert.Equal(t, tcase.hints, hints)
+			assert.Equal(t, tcase.output, hints.String())
+		})
+	}
+}
+
+func TestParseOptimizerHintsErrors(t *testing.T) {
+	testcases := []struct {
+		input  string
+		err    string
+		output string
+	}{{
+		input:  "BKA(t1) FOO BNL(t2)",
+		err:    "optimizer hint syntax error at position 12: expected '('",
+		output: "/*+ BKA(t1) BNL(t2) */",
+	}, {
+		input:  "INDEX() NO_ICP(t1)",
+		err:    "optimizer hint syntax error at position 6: expected identifier",
+		output: "/*+ NO_ICP(t1) */",
+	}, {
+		input: "JOIN_ORDER()",
+		err:   "optimizer hint syntax error at position 11: unexpected table list of JOIN_ORDER",
+	}, {
+		input: "MAX_EXECUTION_TIME(abc)",
+		err:   "optimizer hint syntax error at position 19: expected number of milliseconds",
+	}, {
+		input: "SET_VAR(x 1)",
+		err:   "optimizer hint syntax error at position 10: expected '='",
+	}, {
+		input: "BKA(t1 t2)",
+		err:   "optimizer hint syntax error at position 7: expected ','",
+	}, {
+		input: "QB_NAME(`qb",
+		err:   "optimizer hint syntax error at position 11: unterminated quoted identifier",
+	}, {
+		input:  "BKA(t1) FOO(t2",
+		err:    "optimizer hint syntax error at position 8: missing ')' of hint 'FOO'",
+		output: "/*+ BKA(t1) */",
+	}, {
+		input:  "BKA(t1) + BNL(t2)",
+		err:    "optimizer hint syntax error at position 8: expected identifier",
+		output: "/*+ BKA(t1) */",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			hints, errs := ParseOptimizerHints(tcase.input)
+			require.Len(t, errs, 1)
+			require.EqualError(t, errs[0], tcase.err)
+			assert.Equal(t, tcase.output, hints.String())
+		})
+	}
+}
+
+func TestStatementOptimizerHints(t *testing.T) {
+	stmt, err := Parse("select /* lead */ /*+ JOIN_ORDER(t2, t1) MAX_EXECUTION_TIME(100) */ * from t1 join t2")
+	require.NoError(t, err)
+	sel := stmt.(*Select)
+
+	hints, errs := GetOptimizerHints(sel)
+	require.Empty(t, errs)
+	require.Len(t, hints, 2)
+	assert.Equal(t, &MaxExecutionTimeHint{Milliseconds: 100}, hints.Get("max_execution_time"))
+	assert.Nil(t, hints.Get("QB_NAME"))
+
+	hints = hints.Replace(&MaxExecutionTimeHint{Milliseconds: 2000})
+	hints = hints.Add(&IndexLevelHint{Name: "NO_INDEX", Table: HintTable{Name: "t1"}, Indexes: []string{"idx"}})
+	hints = hints.Remove("join_order")
+	SetOptimizerHints(sel, hints)
+	assert.Equal(t, "select /*+ MAX_EXECUTION_TIME(2000) NO_INDEX(t1 idx) */ /* lead */ * from t1 join t2", String(sel))
+
+	SetOptimizerHints(sel, nil)
+	assert.Equal(t, "select /* lead */ * from t1 join t2", String(sel))
+
+	upd, err := Parse("update t set a = 1")
+	require.NoError(t, err)
+	hints, errs = GetOptimizerHints(upd.(*Update))
+	require.Empty(t, errs)
+	assert.Nil(t, hints)
+	SetOptimizerHints(upd.(*Update), hints.Add(&SetVarHint{Name: "foreign_key_checks", Value: "OFF"}))
+	assert.Equal(t, "update /*+ SET_VAR(foreign_key_checks = OFF) */ t set a = 1", String(upd))
+
+	hints, errs = Comments{"/*+ BKA(t1) */", "/*+ BNL(t1) */"}.Parsed().OptimizerHints()
+	require.Len(t, errs, 1)
+	require.EqualError(t, errs[0], "Must have only one query hint")
+	assert.Equal(t, "/*+ BKA(t1) */", hints.String())
+
+	stmt, err = Parse("select /*+ BKA(t1) vendor_hint(x) INDEX() */ * from t1")
+	require.NoError(t, err)
+	hints, errs = GetOptimizerHints(stmt.(*Select))
+	require.Len(t, errs, 1)
+	assert.Equal(t, OptimizerHints{
+		&TableLevelHint{Name: "BKA", Tables: []HintTable{{Name: "t1"}}},
+		&UnknownHint{Name: "VENDOR_HINT", Args: "x"},
+	}, hints)
+}
+
+func TestOptimizerHintsAreNotShared(t *testing.T) {
+	hints := make(OptimizerHints, 1, 2)
+	hints[0] = &QBNameHint{Name: "qb1"}
+	first := hints.Add(&QBNameHint{Name: "qb2"})
+	second := hints.Add(&QBNameHint{Name: "qb3"})
+	assert.Equal(t, "/*+ QB_NAME(qb1) QB_NAME(qb2) */", first.String())
+	assert.Equal(t, "/*+ QB_NAME(qb1) QB_NAME(qb3) */", second.String())
+}
+
+func TestOptimizerHintsOfClone(t *testing.T) {
+	stmt, err := Parse("select /*+ INDEX(t1 idx) INDEX() */ * from t1")
+	require.NoError(t, err)
+	sel := stmt.(*Select)
+	clone := CloneRefOfSelect(sel)
+	require.True(t, EqualsRefOfSelect(sel, clone))
+
+	hints, errs := GetOptimizerHints(clone)
+	require.Len(t, errs, 1)
+	hints[0].(*IndexLevelHint).Indexes[0] = "idx2"
+	errs[0].Offset = 0
+
+	hints, errs = GetOptimizerHints(sel)
+	assert.Equal(t, "/*+ INDEX(t1 idx) */", hints.String())
+	assert.NotZero(t, errs[0].Offset)
+}
@@ -18,7 +18,9 @@ package sqlparser
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -215,3 +217,197 @@ func TestParseNextStrictNonStrict(t *testing.T) {
 		t.Fatalf("ParseNext(%q) = %q, want %q", input, got, want)
 	}
 }
+
+// TestParseScript tests that ParseScript recovers from syntax errors and
+// reports all of them.
+func TestParseScript(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   []string
+		errors []string
+	}{{
+		name:  "No errors",
+		input: "select 1 from a; update a set b = 2;",
+		want:  []string{"select 1 from a", "update a set b = 2"},
+	}, {
+		name:   "Bad statements",
+		input:  "select 1 from a; selec 2 from a;\nupdate a set b = 2; delete from",
+		want:   []string{"select 1 from a", "selec 2 from a", "update a set b = 2", "delete from"},
+		errors: []string{"1:18: syntax error near 'selec'", "2:32: syntax error"},
+	}, {
+		name:   "Bad column definitions",
+		input:  "create table a (b int, c foo bar, d int, e int unsigned signed, f int)",
+		want:   []string{"create table a (\n\tb int,\n\tc foo bar,\n\td int,\n\te int unsigned,\n\tsigned,\n\tf int\n)"},
+		errors: []string{"1:26: syntax error near 'foo'", "1:57: syntax error near 'signed'"},
+	}, {
+		name:   "Bad first column definition",
+		input:  "create table a (b foo, c int); select 1 from a",
+		want:   []string{"create table a (\n\tb foo,\n\tc int\n)", "select 1 from a"},
+		errors: []string{"1:19: syntax error near 'foo'"},
+	}, {
+		name:   "Bad nested column definition",
+		input:  "create table a (b int, c decimal(10, foo), d int,, e int)",
+		want:   []string{"create table a (\n\tb int,\n\tc decimal(10, foo),\n\td int,\n\te int\n)"},
+		errors: []string{"1:38: syntax error near 'foo'", "1:50: syntax error"},
+	}, {
+		name:   "Bad definition before an index",
+		input:  "create table t (foo bar, primary key (a))",
+		want:   []string{"create table t (\n\tfoo bar,\n\tprimary key (a)\n)"},
+		errors: []string{"1:21: syntax error near 'bar'"},
+	}, {
+		name:   "Bad alter option",
+		input:  "alter table a add column b foo; select 1 from a",
+		want:   []string{"alter table a add column b foo", "select 1 from a"},
+		errors: []string{"1:28: syntax error near 'foo'"},
+	}, {
+		name:   "Bad alter options",
+		input:  "alter table a add column b int, add foo, add index (c d), algorithm = inplace",
+		want:   []string{"alter table a add column b int, add foo, add index (c d), algorithm = inplace"},
+		errors: []string{"1:40: syntax error", "1:55: syntax error near 'd'"},
+	}, {
+		name:  "Stored programs",
+		input: "create procedure p() begin declare a int; if a then begin end; end if; case a when 1 then select 1 from a; end case; select case a when 1 then 2 end from a; end; create trigger t before insert on a for each row begin while 1 do select 1 from a; end while; end; begin; select 1 from a",
+		want: []string{
+			"create procedure p() begin declare a int; if a then begin end; end if; case a when 1 then select 1 from a; end case; select case a when 1 then 2 end from a; end",
+			"create trigger t before insert on a for each row begin while 1 do select 1 from a; end while; end",
+			"begin",
+			"select 1 from a",
+		},
+	}, {
+		name:  "Stored program with a definer",
+		input: "create definer = current_user() procedure p() begin select 1 from a; select 2 from a; end; select 3 from a",
+		want: []string{
+			"create definer = current_user procedure p() begin select 1 from a; select 2 from a; end",
+			"select 3 from a",
+		},
+	}, {
+		name:   "Bad statement in a stored program",
+		input:  "create procedure p() begin select 1 frm t; select 2; begin select 3; end; end; select 4 from a",
+		want:   []string{"create procedure p() begin select 1 frm t; select 2; begin select 3; end; end", "select 4 from a"},
+		errors: []string{"1:41: syntax error near 't'"},
+	}, {
+		name:   "Error of a grammar action",
+		input:  "show profile foo; select 1 from a",
+		want:   []string{"show profile foo", "select 1 from a"},
+		errors: []string{"1:17: unknown profile type 'foo'"},
+	}, {
+		name:   "Unterminated string",
+		input:  "select 1 from a; select 'b",
+		want:   []string{"select 1 from a", "select 'b"},
+		errors: []string{"1:25: syntax error near 'b'"},
+	}}
+
+	for _, test := range tests {
+		stmts, errs := ParseScript(test.input)
+		var got []string
+		for _, stmt := range stmts {
+			got = append(got, String(stmt))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ParseScript(%q) = %q, want %q", test.name, test.input, got, test.want)
+		}
+		var gotErrors []string
+		for _, err := range errs {
+			msg := fmt.Sprintf("%v: %s", err.Position, err.Message)
+			if err.Near != "" {
+				msg += fmt.Sprintf(" near '%s'", err.Near)
+			}
+			gotErrors = append(gotErrors, msg)
+		}
+		if !reflect.DeepEqual(gotErrors, test.errors) {
+			t.Errorf("%s: ParseScript(%q) errors = %q, want %q", test.name, test.input, gotErrors, test.errors)
+		}
+		for _, stmt := range stmts {
+			ddl, ok := stmt.(DDLStatement)
+			if ok && ddl.IsFullyParsed() != (len(errs) == 0) {
+				t.Errorf("%s: ParseScript(%q) fully parsed = %v", test.name, test.input, ddl.IsFullyParsed())
+			}
+		}
+	}
+}
+
+// TestParseScriptErrors checks that ParseScript recovers from all the invalid
+// SQL test cases and parses the statement that follows them.
+func TestParseScriptErrors(t *testing.T) {
+	for _, tcase := range invalidSQL {
+		if tcase.excludeMulti {
+			// Skip tests which leave unclosed strings, or comments.
+			continue
+		}
+
+		sql := tcase.input + "; select 1 from t"
+		stmts, errs := ParseScript(sql)
+		if len(errs) == 0 || errs[0].Error() != tcase.output {
+			t.Errorf("ParseScript(%q) errors = %v, want %q", sql, errs, tcase.output)
+			continue
+		}
+		if len(stmts) == 0 || String(stmts[len(stmts)-1]) != "select 1 from t" {
+			t.Errorf("ParseScript(%q) did not recover: %v", sql, stmts)
+		}
+	}
+}
+
+// TestParseNextRecover tests that ParseNext does not return the syntax errors
+// when the tokenizer recovers from them, and that the BadStmt has a position.
+func TestParseNextRecover(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "select 1 from a;\n  selec 2 from a ;"
+	tokens := parser.NewStringTokenizer(input)
+	tokens.Recover = true
+	if _, err := parser.ParseNext(tokens); err != nil {
+		t.Fatalf("ParseNext(%q) err = %q, want nil", input, err)
+	}
+	tree, err := parser.ParseNext(tokens)
+	if err != nil {
+		t.Fatalf("ParseNext(%q) err = %q, want nil", input, err)
+	}
+	bad, ok := tree.(*BadStmt)
+	if !ok || bad.SQL != "selec 2 from a" {
+		t.Fatalf("ParseNext(%q) = %#v, want a BadStmt", input, tree)
+	}
+	if got, want := SpanOf(bad).String(), "2:3-2:17"; got != want {
+		t.Errorf("ParseNext(%q) span = %s, want %s", input, got, want)
+	}
+	if len(tokens.SyntaxErrors) != 1 || tokens.SyntaxErrors[0] != tokens.SyntaxError {
+		t.Errorf("ParseNext(%q) errors = %v", input, tokens.SyntaxErrors)
+	}
+	if tree, err := parser.ParseNext(tokens); err != io.EOF {
+		t.Errorf("ParseNext(%q) = (%q, %v) want io.EOF", input, String(tree), err)
+	}
+}
+
+// TestParseScriptBadDefinitions tests that the definitions with a syntax error
+// are kept in the tree as a BadDefinition with a position.
+func TestParseScriptBadDefinitions(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "create table a (b int,\n  c foo bar );\nalter table a add column d int, add e"
+	stmts, errs := parser.ParseScript(input)
+	if len(stmts) != 2 || len(errs) != 2 {
+		t.Fatalf("ParseScript(%q) = %v, %v", input, stmts, errs)
+	}
+	create := stmts[0].(*CreateTable)
+	if len(create.TableSpec.BadDefinitions) != 1 {
+		t.Fatalf("ParseScript(%q) bad definitions = %v", input, create.TableSpec.BadDefinitions)
+	}
+	bad := create.TableSpec.BadDefinitions[0]
+	if got, want := bad.SQL, "c foo bar"; got != want {
+		t.Errorf("ParseScript(%q) bad definition = %q, want %q", input, got, want)
+	}
+	if got, want := SpanOf(bad).String(), "2:3-2:12"; got != want {
+		t.Errorf("ParseScript(%q) span = %s, want %s", input, got, want)
+	}
+	alter := stmts[1].(*AlterTable)
+	if bad, ok := alter.AlterOptions[len(alter.AlterOptions)-1].(*BadDefinition); !ok || bad.SQL != "add e" {
+		t.Errorf("ParseScript(%q) alter options = %v", input, alter.AlterOptions)
+	}
+	if alter.IsFullyParsed() {
+		t.Errorf("ParseScript(%q) fully parsed alter table", input)
+	}
+}
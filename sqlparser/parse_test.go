@@ -316,6 +316,47 @@ var (
 		output: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
 	}, {
 		input: "select * from t1 join (select * from t2 union select * from t3) as t",
+	}, {
+		input: "select 1 from t union select 1 from u intersect select 1 from v",
+	}, {
+		input:  "(select 1 from t union select 1 from u) intersect select 1 from v",
+		output: "(select 1 from t union select 1 from u) intersect select 1 from v",
+	}, {
+		input:  "select 1 from t except all select 1 from u except distinct select 1 from v",
+		output: "select 1 from t except all select 1 from u except select 1 from v",
+	}, {
+		input: "select 1 from t except (select 1 from u except select 1 from v)",
+	}, {
+		input:  "select 1 from t union (select 1 from u union all select 1 from v)",
+		output: "select 1 from t union (select 1 from u union all select 1 from v)",
+	}, {
+		input:  "(select 1 from t) intersect all select 1 from u order by 1 limit 1",
+		output: "select 1 from t intersect all select 1 from u order by 1 asc limit 1",
+	}, {
+		input:  "with x as (select 1 from t) select * from x except select 2 from u",
+		output: "with x as (select 1 from t) select * from x except select 2 from u",
+	}, {
+		input: "values row(1, 2), row(3, 4)",
+	}, {
+		input: "values /* values */ row(1, 'a'), row(2, 'b') order by column_1 desc limit 1",
+	}, {
+		input:  "table t order by a limit 1 offset 2",
+		output: "table t order by a asc limit 2, 1",
+	}, {
+		input: "table /* table */ db.t into @a",
+	}, {
+		input: "select a, b from t union values row(1, 2) union table u",
+	}, {
+		input:  "(values row(1)) union (table t order by a)",
+		output: "values row(1) union (table t order by a asc)",
+	}, {
+		input: "select * from (values row(1, 2), row(3, 4)) as t(a, b)",
+	}, {
+		input: "select * from t where (a, b) in (table u)",
+	}, {
+		input: "with cte as (select 1 from dual) table cte",
+	}, {
+		input: "insert into t table u",
 	}, {
 		// Ensure this doesn't generate: ""select * from t1 join t2 on a = b join t3 on a = b".
 		input: "select * from t1 join t2 on a = b join t3",
@@ -355,6 +396,19 @@ var (
 		input: "select /* for update */ 1 from t for update",
 	}, {
 		input: "select /* lock in share mode */ 1 from t lock in share mode",
+	}, {
+		input: "select /* for share */ 1 from t for share",
+	}, {
+		input: "select /* for update nowait */ 1 from t for update nowait",
+	}, {
+		input: "select /* for share skip locked */ 1 from t for share skip locked",
+	}, {
+		input: "select /* locking of tables */ 1 from t1, t2 for update of t1 nowait for share of t2, db.t3 skip locked",
+	}, {
+		input: "select /* locking before into */ 1 from t for update of t into @a",
+	}, {
+		input:  "select /* locking after into */ 1 from t into @a for share",
+		output: "select /* locking after into */ 1 from t for share into @a",
 	}, {
 		input: "select /* select list */ 1, 2 from t",
 	}, {
@@ -938,6 +992,13 @@ var (
 		input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(b), c = d",
 	}, {
 		input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(a.b), c = d",
+	}, {
+		input: "insert /* row alias */ into a(b, c) values (1, 2), (3, 4) as new on duplicate key update b = new.b + new.c",
+	}, {
+		input: "insert /* row and column alias */ into a values (1, 2) as new(m, n) on duplicate key update b = m + n",
+	}, {
+		input:  "insert /* row alias with set */ into a set b = 1, c = 2 as new on duplicate key update c = new.c",
+		output: "insert /* row alias with set */ into a(b, c) values (1, 2) as new on duplicate key update c = new.c",
 	}, {
 		input: "insert /* bool expression on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = a > d",
 	}, {
@@ -1119,6 +1180,167 @@ var (
 		input: "set @variable = 42",
 	}, {
 		input: "set @period.variable = 42",
+	}, {
+		input:  "set @'my var' = 1",
+		output: "set @`my var` = 1",
+	}, {
+		input:  "select @\"my var\", @'x' from dual",
+		output: "select @`my var`, @x from dual",
+	}, {
+		input:  "prepare s from @'my var'",
+		output: "prepare s from @`my var`",
+	}, {
+		input: "alter user current_user() identified by 'x'",
+	}, {
+		input: "set password for current_user() = 'x'",
+	}, {
+		input:  "drop user current_user",
+		output: "drop user current_user()",
+	}, {
+		input:  "grant r1 to current_user()",
+		output: "grant 'r1' to current_user()",
+	}, {
+		input: "revoke select on t from current_user()",
+	}, {
+		input:  "show grants for current_user using r",
+		output: "show grants for current_user() using 'r'",
+	}, {
+		input: "grant select, insert on *.* to 'u'@'%'",
+	}, {
+		input:  "GRANT SELECT (a, b), UPDATE (c) ON TABLE db.t TO u@localhost, 'v' WITH GRANT OPTION",
+		output: "grant select (a, b), update (c) on table db.t to 'u'@'localhost', 'v' with grant option",
+	}, {
+		input:  "grant all privileges on db.* to u",
+		output: "grant all on db.* to 'u'",
+	}, {
+		input:  "grant execute, alter routine on procedure db.p to u",
+		output: "grant execute, alter routine on procedure db.p to 'u'",
+	}, {
+		input:  "grant create temporary tables, lock tables, show view, replication slave, backup_admin on * to u",
+		output: "grant create temporary tables, lock tables, show view, replication slave, backup_admin on * to 'u'",
+	}, {
+		input:  "grant r1, 'r2'@'%' to u with admin option",
+		output: "grant 'r1', 'r2'@'%' to 'u' with admin option",
+	}, {
+		input:  "revoke if exists select on t from u",
+		output: "revoke if exists select on t from 'u'",
+	}, {
+		input:  "revoke all privileges, grant option from u",
+		output: "revoke all, grant option from 'u'",
+	}, {
+		input:  "revoke r1 from u",
+		output: "revoke 'r1' from 'u'",
+	}, {
+		input:  "GRANT PROXY ON ''@'' TO 'root'@'localhost' WITH GRANT OPTION",
+		output: "grant proxy on '' to 'root'@'localhost' with grant option",
+	}, {
+		input:  "revoke if exists proxy on u from v, w",
+		output: "revoke if exists proxy on 'u' from 'v', 'w'",
+	}, {
+		input:  "grant proxy, select on t to u",
+		output: "grant proxy, select on t to 'u'",
+	}, {
+		input:  "create user if not exists 'u'@'localhost' identified by 'secret', v identified with caching_sha2_password by random password default role r account lock",
+		output: "create user if not exists 'u'@'localhost' identified by 'secret', 'v' identified with caching_sha2_password by random password default role 'r' account lock",
+	}, {
+		input:  "create user u identified with mysql_native_password as '*hash'",
+		output: "create user 'u' identified with mysql_native_password as '*hash'",
+	}, {
+		input:  "alter user if exists u identified by 'x' account unlock",
+		output: "alter user if exists 'u' identified by 'x' account unlock",
+	}, {
+		input:  "alter user u default role all",
+		output: "alter user 'u' default role all",
+	}, {
+		input:  "CREATE USER 'u'@'%' IDENTIFIED WITH 'caching_sha2_password' AS 'hash' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK PASSWORD HISTORY DEFAULT PASSWORD REUSE INTERVAL DEFAULT PASSWORD REQUIRE CURRENT DEFAULT",
+		output: "create user 'u'@'%' identified with caching_sha2_password as 'hash' require none password expire default password history default password reuse interval default password require current default account unlock",
+	}, {
+		input:  "create user u require ssl and x509 cipher 'c' issuer 'i' subject 's' with max_queries_per_hour 10 max_updates_per_hour 20 max_connections_per_hour 30 max_user_connections 2 password expire interval 90 day failed_login_attempts 3 password_lock_time unbounded account lock comment 'c'",
+		output: "create user 'u' require ssl x509 cipher 'c' issuer 'i' subject 's' with max_queries_per_hour 10 max_updates_per_hour 20 max_connections_per_hour 30 max_user_connections 2 password expire interval 90 day failed_login_attempts 3 password_lock_time unbounded account lock comment 'c'",
+	}, {
+		input:  "create user u password expire never password_lock_time 2 attribute 'a'",
+		output: "create user 'u' password expire never password_lock_time 2 attribute 'a'",
+	}, {
+		input:  "alter user u identified by 'new' replace 'old' retain current password password expire password history 5 password reuse interval 30 day password require current optional",
+		output: "alter user 'u' identified by 'new' replace 'old' retain current password password expire password history 5 password reuse interval 30 day password require current optional",
+	}, {
+		input:  "alter user u identified with mysql_native_password by random password replace 'x', v discard old password password require current",
+		output: "alter user 'u' identified with mysql_native_password by random password replace 'x', 'v' discard old password password require current",
+	}, {
+		input:  "rename user u to v, 'a'@'b' to 'c'@'d'",
+		output: "rename user 'u' to 'v', 'a'@'b' to 'c'@'d'",
+	}, {
+		input:  "drop user if exists u, 'v'@'%'",
+		output: "drop user if exists 'u', 'v'@'%'",
+	}, {
+		input:  "create role if not exists admin, 'dev'",
+		output: "create role if not exists 'admin', 'dev'",
+	}, {
+		input:  "drop role admin",
+		output: "drop role 'admin'",
+	}, {
+		input: "set role default",
+	}, {
+		input:  "set role all except r1, r2",
+		output: "set role all except 'r1', 'r2'",
+	}, {
+		input: "set role none",
+	}, {
+		input:  "set default role r1 to u",
+		output: "set default role 'r1' to 'u'",
+	}, {
+		input: "set password = 'secret'",
+	}, {
+		input:  "set password for u@'%' to random replace 'old' retain current password",
+		output: "set password for 'u'@'%' to random replace 'old' retain current password",
+	}, {
+		input:  "create procedure p(in a int, out b varchar(10), inout c int) select a into b",
+		output: "create procedure p(in a int, out b varchar(10), inout c int) select a from dual into b",
+	}, {
+		input:  "CREATE DEFINER = CURRENT_USER PROCEDURE IF NOT EXISTS db.p() COMMENT 'x' LANGUAGE SQL NOT DETERMINISTIC READS SQL DATA SQL SECURITY INVOKER SELECT 1",
+		output: "create definer = CURRENT_USER procedure if not exists db.p() comment 'x' language sql not deterministic reads sql data sql security invoker select 1 from dual",
+	}, {
+		input: "create function f(a int) returns int deterministic return a + 1",
+	}, {
+		input: "create function f(s char(20)) returns char(50) no sql return concat('hello, ', s, '!')",
+	}, {
+		input: "create trigger t1 before insert on t for each row set new.a = new.a + 1",
+	}, {
+		input: "create trigger if not exists t2 after update on db.t for each row follows t1 insert into log values (old.id)",
+	}, {
+		input:  "create event e on schedule at current_timestamp + interval 1 hour on completion preserve disable do delete from t",
+		output: "create event e on schedule at current_timestamp() + interval 1 hour on completion preserve disable do delete from t",
+	}, {
+		input: "create event e on schedule every 1 day starts '2024-01-01' ends '2025-01-01' comment 'daily' do call p()",
+	}, {
+		input: "create procedure p() signal sqlstate '45000' set message_text = 'oops', mysql_errno = 1001",
+	}, {
+		input:  "create procedure p() begin work",
+		output: "create procedure p() start transaction",
+	}, {
+		input: "drop procedure p",
+	}, {
+		input: "drop procedure if exists db.p",
+	}, {
+		input: "drop function if exists f",
+	}, {
+		input: "drop trigger db.t1",
+	}, {
+		input: "drop event if exists e",
+	}, {
+		input: "alter procedure p comment 'x' sql security invoker",
+	}, {
+		input: "alter function f",
+	}, {
+		input: "alter event e disable",
+	}, {
+		input:  "alter definer = 'a'@'b' event e on schedule every 1 day on completion not preserve rename to db.e2 enable comment 'c' do select 1",
+		output: "alter definer = 'a'@'b' event e on schedule every 1 day on completion not preserve rename to db.e2 enable comment 'c' do select 1 from dual",
+	}, {
+		input:  "select a, b into x, y from t",
+		output: "select a, b from t into x, y",
+	}, {
+		input: "select repeat('a', 3) from dual",
 	}, {
 		input:  "set S= +++-++-+(4+1)",
 		output: "set S = - -(4 + 1)",
@@ -1700,8 +1922,14 @@ var (
 		input:  "drop index `PRIMARY` on a lock none",
 		output: "alter table a drop primary key, lock none",
 	}, {
-		input:  "analyze table a",
-		output: "otherread",
+		input: "analyze table a",
+	}, {
+		input:  "ANALYZE NO_WRITE_TO_BINLOG TABLES a, db.b",
+		output: "analyze local table a, db.b",
+	}, {
+		input: "analyze local table t update histogram on c1, c2 with 16 buckets",
+	}, {
+		input: "analyze table t drop histogram on c1",
 	}, {
 		input: "flush tables",
 	}, {
@@ -1721,8 +1949,10 @@ var (
 		input:  "show binary logs",
 		output: "show binary logs",
 	}, {
-		input:  "show binlog events",
-		output: "show binlog",
+		input:  "show master logs",
+		output: "show binary logs",
+	}, {
+		input: "show binlog events",
 	}, {
 		input:  "show character set",
 		output: "show charset",
@@ -1761,7 +1991,17 @@ var (
 		input: "show create trigger t",
 	}, {
 		input:  "show create user u",
-		output: "show create user",
+		output: "show create user 'u'",
+	}, {
+		input: "show create user 'u'@'%'",
+	}, {
+		input:  "show create schema d",
+		output: "show create database d",
+	}, {
+		input: "show create database if not exists d",
+	}, {
+		input:  "SHOW CREATE SCHEMA IF NOT EXISTS `d`",
+		output: "show create database if not exists d",
 	}, {
 		input: "show create view v",
 	}, {
@@ -1777,24 +2017,44 @@ var (
 		input:  "show schemas like '%'",
 		output: "show databases like '%'",
 	}, {
-		input:  "show engine INNODB",
-		output: "show engine",
+		input: "show engine INNODB status",
+	}, {
+		input: "show engine performance_schema mutex",
 	}, {
 		input: "show engines",
 	}, {
 		input:  "show storage engines",
-		output: "show storage",
+		output: "show engines",
 	}, {
 		input: "show errors",
+	}, {
+		input: "show errors limit 1, 10",
+	}, {
+		input:  "show count(*) errors",
+		output: "show count(*) errors",
+	}, {
+		input:  "show COUNT ( * ) warnings",
+		output: "show count(*) warnings",
 	}, {
 		input: "show events",
+	}, {
+		input:  "show events in db like 'e%'",
+		output: "show events from db like 'e%'",
+	}, {
+		input: "show events from db where `Status` = 'ENABLED'",
 	}, {
 		input: "show function code func",
 	}, {
 		input: "show function status",
 	}, {
-		input:  "show grants for 'root@localhost'",
+		input: "show grants for 'root@localhost'",
+	}, {
+		input: "show grants",
+	}, {
+		input:  "show grants for current_user()",
 		output: "show grants",
+	}, {
+		input: "show grants for 'u'@'%' using 'r1'@'%', 'r2'@'%'",
 	}, {
 		input:  "show index from t",
 		output: "show indexes from t",
@@ -1804,8 +2064,10 @@ var (
 		input:  "show keys from t",
 		output: "show indexes from t",
 	}, {
-		input:  "show master status",
-		output: "show master",
+		input:  "show extended index from t",
+		output: "show extended indexes from t",
+	}, {
+		input: "show master status",
 	}, {
 		input: "show open tables",
 	}, {
@@ -1822,23 +2084,32 @@ var (
 		input:  "show processlist",
 		output: "show processlist",
 	}, {
-		input:  "show full processlist",
-		output: "show processlist",
+		input: "show full processlist",
+	}, {
+		input: "show profile cpu for query 1",
 	}, {
-		input:  "show profile cpu for query 1",
-		output: "show profile",
+		input:  "show profile BLOCK IO, Context Switches, all limit 5",
+		output: "show profile block io, context switches, all limit 5",
+	}, {
+		input: "show profile",
 	}, {
 		input:  "show profiles",
 		output: "show profiles",
 	}, {
-		input:  "show relaylog events",
-		output: "show relaylog",
+		input: "show relaylog events",
+	}, {
+		input: "show relaylog events in 'relay.000002' from 4 limit 2 for channel 'c1'",
+	}, {
+		input:  "show replicas",
+		output: "show replicas",
 	}, {
 		input:  "show slave hosts",
-		output: "show slave",
+		output: "show replicas",
 	}, {
 		input:  "show slave status",
-		output: "show slave",
+		output: "show replica status",
+	}, {
+		input: "show replica status for channel 'c1'",
 	}, {
 		input:  "show status",
 		output: "show status",
@@ -2000,6 +2271,8 @@ var (
 		input: "alter vitess_migration throttle all expire '1h' ratio 0.7",
 	}, {
 		input: "show warnings",
+	}, {
+		input: "show warnings limit 10",
 	}, {
 		input:  "select warnings from t",
 		output: "select `warnings` from t",
@@ -2070,11 +2343,20 @@ var (
 		input:  "truncate foo",
 		output: "truncate table foo",
 	}, {
-		input:  "repair foo",
-		output: "otheradmin",
+		input: "repair table foo",
+	}, {
+		input:  "repair no_write_to_binlog tables foo, bar quick extended use_frm",
+		output: "repair local table foo, bar quick extended use_frm",
 	}, {
-		input:  "optimize foo",
-		output: "otheradmin",
+		input: "optimize table foo",
+	}, {
+		input:  "optimize local tables foo, bar",
+		output: "optimize local table foo, bar",
+	}, {
+		input: "check table foo, bar for upgrade quick changed",
+	}, {
+		input:  "checksum tables foo extended",
+		output: "checksum table foo extended",
 	}, {
 		input:  "lock tables foo read",
 		output: "lock tables foo read",
@@ -2131,6 +2413,14 @@ var (
 		input: "select * from t1 where k like 'Müller' collate latin1_german2_ci",
 	}, {
 		input: "select k from t1 group by k having k = 'Müller' collate latin1_german2_ci",
+	}, {
+		input: "select 名字, Straße as größe from 用户表 as 表 where 表.编号 = 1 and @变量 = ÿ",
+	}, {
+		input:  "select `名字`, 1名 from `Müller`",
+		output: "select 名字, `1名` from Müller",
+	}, {
+		input:  "create table 订单 (编号 int, `😀` int)",
+		output: "create table 订单 (\n\t编号 int,\n\t`😀` int\n)",
 	}, {
 		input: "select k from t1 join t2 order by a collate latin1_german2_ci asc, b collate latin1_german2_ci asc",
 	}, {
@@ -2191,6 +2481,22 @@ var (
 	}, {
 		input:  "SELECT id FROM blog_posts USE INDEX (PRIMARY) WHERE id = 10",
 		output: "select id from blog_posts use index (`PRIMARY`) where id = 10",
+	}, {
+		input: "select a, b, sum(c), grouping(a, b) from t group by a, b with rollup",
+	}, {
+		input:  "SELECT a FROM t GROUP BY a WITH   ROLLUP HAVING GROUPING(a) = 1 ORDER BY a",
+		output: "select a from t group by a with rollup having grouping(a) = 1 order by a asc",
+	}, {
+		input:  "create view v as select a from t group by a with check option",
+		output: "create view v as select a from t group by a with cascaded check option",
+	}, {
+		input:  "select grouping, grouping(grouping) from t group by grouping with rollup",
+		output: "select `grouping`, grouping(`grouping`) from t group by `grouping` with rollup",
+	}, {
+		input:  "with rollup as (select 1 as a) select * from rollup",
+		output: "with rollup as (select 1 as a from dual) select * from rollup",
+	}, {
+		input: "select a from t group by a, (select b from u group by b with rollup) with rollup",
 	}, {
 		input:  "select name, group_concat(score) from t group by name",
 		output: "select `name`, group_concat(score) from t group by `name`",
@@ -2235,6 +2541,9 @@ var (
 	}, {
 		input:  "start transaction",
 		output: "begin",
+	}, {
+		input:  "begin work",
+		output: "begin",
 	}, {
 		input: "commit",
 	}, {
@@ -2309,10 +2618,10 @@ var (
 		output: "show full tables from jiradb like '%'",
 	}, {
 		input:  "SHOW EXTENDED INDEX FROM `AO_E8B6CC_PROJECT_MAPPING` FROM `jiradb`",
-		output: "show indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
+		output: "show extended indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
 	}, {
 		input:  "SHOW EXTENDED KEYS FROM `AO_E8B6CC_ISSUE_MAPPING` FROM `jiradb`",
-		output: "show indexes from AO_E8B6CC_ISSUE_MAPPING from jiradb",
+		output: "show extended indexes from AO_E8B6CC_ISSUE_MAPPING from jiradb",
 	}, {
 		input:  "SHOW CREATE TABLE `jiradb`.`AO_E8B6CC_ISSUE_MAPPING`",
 		output: "show create table jiradb.AO_E8B6CC_ISSUE_MAPPING",
@@ -2336,16 +2645,17 @@ var (
 		output: "show full tables from jiradb like '%'",
 	}, {
 		input:  "SHOW EXTENDED INDEXES FROM `AO_E8B6CC_PROJECT_MAPPING` FROM `jiradb`",
-		output: "show indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
+		output: "show extended indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
 	}, {
 		input:  "SHOW EXTENDED INDEXES IN `AO_E8B6CC_PROJECT_MAPPING` IN `jiradb`",
-		output: "show indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
+		output: "show extended indexes from AO_E8B6CC_PROJECT_MAPPING from jiradb",
+	}, {
+		input: "do 1",
 	}, {
-		input:  "do 1",
-		output: "otheradmin",
+		input: "do funcCall(), 2 = 1, 3 + 1",
 	}, {
-		input:  "do funcCall(), 2 = 1, 3 + 1",
-		output: "otheradmin",
+		input:  "DO SLEEP(5), get_lock('x', 10)",
+		output: "do SLEEP(5), get_lock('x', 10)",
 	}, {
 		input: "savepoint a",
 	}, {
@@ -2365,6 +2675,97 @@ var (
 		output: "rollback to a",
 	}, {
 		input: "release savepoint a",
+	}, {
+		input:  "XA START 'trx'",
+		output: "xa start 'trx'",
+	}, {
+		input:  "xa begin 'trx', 'branch' join",
+		output: "xa start 'trx', 'branch' join",
+	}, {
+		input: "xa start X'7472', 'branch', 3 resume",
+	}, {
+		input: "xa end 'trx' suspend",
+	}, {
+		input: "xa end 'trx', 'branch', 1 suspend for migrate",
+	}, {
+		input: "xa prepare 'trx'",
+	}, {
+		input: "xa commit 'trx', 'branch' one phase",
+	}, {
+		input: "xa rollback 0x7472",
+	}, {
+		input: "xa recover",
+	}, {
+		input: "xa recover convert xid",
+	}, {
+		input:  "kill 123",
+		output: "kill connection 123",
+	}, {
+		input: "kill connection 18446744073709551615",
+	}, {
+		input:  "KILL QUERY 42",
+		output: "kill query 42",
+	}, {
+		input: "shutdown",
+	}, {
+		input:  "INSTALL PLUGIN validate_password SONAME 'validate_password.so'",
+		output: "install plugin validate_password soname 'validate_password.so'",
+	}, {
+		input: "uninstall plugin validate_password",
+	}, {
+		input:  "install component \"file://component_validator\", 'file://component_log_sink_json'",
+		output: "install component 'file://component_validator', 'file://component_log_sink_json'",
+	}, {
+		input: "uninstall component 'file://component_validator'",
+	}, {
+		input:  "CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'db1', SOURCE_PORT = 3306, SOURCE_AUTO_POSITION = 1, SOURCE_SSL = 1, SOURCE_SSL_CA = '/etc/ca.pem' FOR CHANNEL 'ch1'",
+		output: "change replication source to source_host = 'db1', source_port = 3306, source_auto_position = 1, source_ssl = 1, source_ssl_ca = '/etc/ca.pem' for channel 'ch1'",
+	}, {
+		input:  "change master to master_host = 'db1', master_user = 'repl', master_password = 'x', master_log_file = 'binlog.000001', master_log_pos = 4, master_heartbeat_period = 1.5",
+		output: "change master to master_host = 'db1', master_user = 'repl', master_password = 'x', master_log_file = 'binlog.000001', master_log_pos = 4, master_heartbeat_period = 1.5",
+	}, {
+		input: "change replication source to ignore_server_ids = (1, 2, 3), privilege_checks_user = null",
+	}, {
+		input:  "change replication source to ignore_server_ids = () for channel ch1",
+		output: "change replication source to ignore_server_ids = () for channel 'ch1'",
+	}, {
+		input: "start replica",
+	}, {
+		input:  "START SLAVE IO_THREAD, SQL_THREAD",
+		output: "start slave io_thread, sql_thread",
+	}, {
+		input:  "start replica relay_thread until source_log_file = 'binlog.000002', source_log_pos = 120",
+		output: "start replica io_thread until source_log_file = 'binlog.000002', source_log_pos = 120",
+	}, {
+		input: "start replica sql_thread until sql_before_gtids = '3e11fa47-71ca-11e1-9e33-c80aa9429562:11-56'",
+	}, {
+		input: "start replica until sql_after_mts_gaps",
+	}, {
+		input:  "start replica user = 'repl' password = 'x' default_auth = 'caching_sha2_password' plugin_dir = '/p' for channel 'ch1'",
+		output: "start replica user = 'repl' password = 'x' default_auth = 'caching_sha2_password' plugin_dir = '/p' for channel 'ch1'",
+	}, {
+		input: "stop replica",
+	}, {
+		input: "stop slave io_thread for channel 'ch1'",
+	}, {
+		input: "reset replica",
+	}, {
+		input: "reset slave all for channel 'ch1'",
+	}, {
+		input: "reset master",
+	}, {
+		input: "reset master to 1234",
+	}, {
+		input:  "PURGE BINARY LOGS TO 'binlog.000010'",
+		output: "purge binary logs to 'binlog.000010'",
+	}, {
+		input: "purge master logs before '2022-04-02 22:46:26'",
+	}, {
+		input: "purge binary logs before now() - interval 3 day",
+	}, {
+		input: "show binlog events in 'binlog.000001' from 4 limit 2, 10",
+	}, {
+		input: "show binlog events limit 10",
 	}, {
 		input: "release savepoint `@@@;a`",
 	}, {
@@ -2679,6 +3080,33 @@ var (
 	}, {
 		input:  `SELECT JSON_SEARCH('{\"a\": 1, \"b\": 2, \"c\": {\"d\": 4}}', 'all', '10', NULL)`,
 		output: `select json_search('{\"a\": 1, \"b\": 2, \"c\": {\"d\": 4}}', 'all', '10', null) from dual`,
+	}, {
+		input:  "SELECT ST_AsText(ST_GeomFromText('POINT(1 1)', 4326, 'axis-order=lat-long'))",
+		output: "select st_astext(st_geomfromtext('POINT(1 1)', 4326, 'axis-order=lat-long')) from dual",
+	}, {
+		input: "select * from t where st_contains(g, st_pointfromtext('POINT(1 1)', 4326)) and mbrcoveredby(g, @area)",
+	}, {
+		input: "select st_distance_sphere(p1, p2), st_distance_sphere(p1, p2, 6370986), st_distance(p1, p2, 'metre') from t",
+	}, {
+		input: "select st_srid(g), st_srid(g, 4326), st_asbinary(g), st_astext(g, 'axis-order=long-lat') from t",
+	}, {
+		input: "select st_within(a, b), st_crosses(a, b), st_disjoint(a, b), mbrequals(a, b), mbrintersects(a, b), mbrcovers(a, b) from t",
+	}, {
+		input: "select st_linefromtext(a), st_polyfromtext(a), st_mpointfromtext(a), st_mlinefromtext(a), st_mpolyfromtext(a), st_geomcollfromtext(a) from t",
+	}, {
+		input:  "SELECT REGEXP_LIKE('Michael!', '.*'), regexp_like('a', 'A', 'c')",
+		output: "select regexp_like('Michael!', '.*'), regexp_like('a', 'A', 'c') from dual",
+	}, {
+		input:  "SELECT REGEXP_INSTR('dog cat dog', 'dog'), REGEXP_INSTR('aa aaa aaaa', 'a{3}', 1, 2, 1, 'i')",
+		output: "select regexp_instr('dog cat dog', 'dog'), regexp_instr('aa aaa aaaa', 'a{3}', 1, 2, 1, 'i') from dual",
+	}, {
+		input:  "SELECT REGEXP_REPLACE('a b c', 'b', 'X'), REGEXP_REPLACE('abc def ghi', '[a-z]+', 'X', 1, 3)",
+		output: "select regexp_replace('a b c', 'b', 'X'), regexp_replace('abc def ghi', '[a-z]+', 'X', 1, 3) from dual",
+	}, {
+		input:  "SELECT REGEXP_SUBSTR('abc def ghi', '[a-z]+', 1, 3, 'c')",
+		output: "select regexp_substr('abc def ghi', '[a-z]+', 1, 3, 'c') from dual",
+	}, {
+		input: "select * from t where regexp_like(a, b) and a regexp 'c'",
 	}, {
 		input:  "SELECT JSON_SEARCH(@j, 'all', '%b%', '', '$[3]')",
 		output: "select json_search(@j, 'all', '%b%', '', '$[3]') from dual",
@@ -2940,6 +3368,45 @@ var (
 	}, {
 		input:  "SELECT JSON_UNQUOTE(@j)",
 		output: "select json_unquote(@j) from dual",
+	}, {
+		input:  "SELECT val, ROW_NUMBER() OVER (ORDER BY val) AS 'row_number' FROM numbers",
+		output: "select val, row_number() over (order by val asc) as `row_number` from numbers",
+	}, {
+		input: "select cume_dist() over (), dense_rank() over (), percent_rank() over (), rank() over () from t",
+	}, {
+		input: "select yr, country, product, profit, sum(profit) over (partition by country) as country_profit from sales order by country asc, yr asc, product asc, profit asc",
+	}, {
+		input: "select count(distinct a) over (partition by b, c order by d desc) from t",
+	}, {
+		input: "select first_value(val) over w, last_value(val) respect nulls over w, nth_value(val, 2) from first ignore nulls over w from t window w as (order by val asc)",
+	}, {
+		input:  "select ntile(4) over w, ntile(:n) over w, nth_value(val, @n) over w from t window w as (partition by subject order by val asc rows unbounded preceding)",
+		output: "select ntile(4) over w, ntile(:n) over w, nth_value(val, @n) over w from t window w as (partition by `subject` order by val asc rows unbounded preceding)",
+	}, {
+		input: "select lag(val) over w, lead(val, 1) over w, lag(val, 1, 0) ignore nulls over w from t window w as (partition by a), v as (w order by b asc)",
+	}, {
+		input: "select avg(val) over (order by d asc rows between 1 preceding and current row) from t",
+	}, {
+		input: "select avg(val) over (order by d asc range between interval 1 day preceding and interval 2 day following) from t",
+	}, {
+		input: "select sum(val) over (w rows between unbounded preceding and unbounded following) from t window w as (partition by a order by b asc)",
+	}, {
+		input: "select sum(val) over (rows current row) from t",
+	}, {
+		input:  "select row('a', 'b') = row('a', 'b') from dual",
+		output: "select row('a', 'b') = row('a', 'b') from dual",
+	}, {
+		input:  "select rank, row, rows, lead, window, over from t",
+		output: "select `rank`, `row`, `rows`, `lead`, `window`, `over` from t",
+	}, {
+		input:  "select rank() over w as rank, sum(a) as over from t as window window w as (rows current row)",
+		output: "select rank() over w as `rank`, sum(a) as `over` from t as `window` window w as (rows current row)",
+	}, {
+		input:  "select sum(a) over window from t window window as (order by a)",
+		output: "select sum(a) over `window` from t window `window` as (order by a asc)",
+	}, {
+		input:  "select lag.a from lag join rank on lag.id = rank.id where lead > 1",
+		output: "select `lag`.a from `lag` join `rank` on `lag`.id = `rank`.id where `lead` > 1",
 	}, {
 		input:  "CREATE TABLE ts (id INT, purchased DATE) PARTITION BY RANGE( YEAR(purchased) ) SUBPARTITION BY HASH( TO_DAYS(purchased) ) ( PARTITION p0 VALUES LESS THAN (1990) (SUBPARTITION s0,SUBPARTITION s1),PARTITION p1 VALUES LESS THAN (2000),PARTITION p2 VALUES LESS THAN MAXVALUE (SUBPARTITION s2,SUBPARTITION s3));",
 		output: "create table ts (\n\tid INT,\n\tpurchased DATE\n)\npartition by range (YEAR(purchased)) subpartition by hash (TO_DAYS(purchased))\n(partition p0 values less than (1990) (subpartition s0, subpartition s1),\n partition p1 values less than (2000),\n partition p2 values less than maxvalue (subpartition s2, subpartition `s3`))",
@@ -2977,6 +3444,58 @@ func TestValid(t *testing.T) {
 	}
 }
 
+// TestCompoundStatements tests stored program bodies. They contain ';'
+// separated statements and so can't be part of validSQL, which is also
+// used for the ParseNext multi-statement tests.
+func TestCompoundStatements(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+	}{{
+		input: "create procedure p() label1: loop leave label1; end loop label1",
+	}, {
+		input:  "create procedure p() begin l:loop leave l; end loop l; end",
+		output: "create procedure p() begin l: loop leave l; end loop l; end",
+	}, {
+		input:  "create procedure p() l1:begin l2:while a = :a do set a = 1; end while; end l1",
+		output: "create procedure p() l1: begin l2: while a = :a do set a = 1; end while l2; end l1",
+	}, {
+		input: "create procedure p() while done = 0 do set done = 1; end while",
+	}, {
+		input: "create procedure p() repeat set x = x + 1; until x > 10 end repeat",
+	}, {
+		input:  "create procedure p() if a > 1 then select 1; elseif a < 0 then select 2; else select 3; end if",
+		output: "create procedure p() if a > 1 then select 1 from dual; elseif a < 0 then select 2 from dual; else select 3 from dual; end if",
+	}, {
+		input:  "create procedure p() case a when 1 then select 1; else select 2; end case",
+		output: "create procedure p() case a when 1 then select 1 from dual; else select 2 from dual; end case",
+	}, {
+		input: "create procedure p() begin declare a, b int default 0; declare c cursor for select id from t; declare continue handler for not found set done = true; open c; fetch c into a; close c; end",
+	}, {
+		input:  "create procedure p() begin declare exit handler for sqlexception, 1051, sqlstate value '42S02' resignal; end",
+		output: "create procedure p() begin declare exit handler for sqlexception, 1051, sqlstate '42S02' resignal; end",
+	}, {
+		input: "create trigger t before delete on t for each row begin if old.a = 0 then signal sqlstate '45000'; end if; end",
+	}, {
+		input: "create procedure p() begin start transaction; update t set a = 1; commit; end",
+	}, {
+		input:  "create event e on schedule every 1 day do begin begin work; commit; end",
+		output: "create event e on schedule every 1 day do begin start transaction; commit; end",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			if tcase.output == "" {
+				tcase.output = tcase.input
+			}
+			tree, err := Parse(tcase.input)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, String(tree))
+			_, ok := tree.(DDLStatement)
+			assert.True(t, ok, "%T is not a DDLStatement", tree)
+		})
+	}
+}
+
 // Ensure there is no corruption from using a pooled yyParserImpl in Parse.
 func TestParallelValid(t *testing.T) {
 	parallelism := 100
@@ -3141,6 +3660,26 @@ func TestInvalid(t *testing.T) {
 	}
 }
 
+func TestQuotedUserVariable(t *testing.T) {
+	for _, sql := range []string{
+		"set @'my var' = 1",
+		"set @\"my var\" = 1",
+		"set @`my var` = 1",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			stmt, err := Parse(sql)
+			require.NoError(t, err)
+			name := stmt.(*Set).Exprs[0].Name
+			assert.Equal(t, "@my var", name.String())
+			assert.Equal(t, "set @`my var` = 1", String(stmt))
+
+			reparsed, err := Parse(String(stmt))
+			require.NoError(t, err)
+			assert.True(t, EqualsStatement(stmt, reparsed))
+		})
+	}
+}
+
 func TestIntroducers(t *testing.T) {
 	validSQL := []struct {
 		input  string
@@ -3765,14 +4304,35 @@ func TestSubStr(t *testing.T) {
 }
 
 func TestLoadData(t *testing.T) {
-	validSQL := []string{
+	validSQL := []struct {
+		input, output string
+	}{{
+		input:  "LOAD DATA INFILE 'x.txt' INTO TABLE c",
+		output: "load data infile 'x.txt' into table c",
+	}, {
+		input:  "load data low_priority local infile 'data.txt' replace into table db.t partition (p0, p1) character set utf8mb4 fields terminated by ',' optionally enclosed by '\"' escaped by '\\\\' lines starting by 'xx' terminated by '\\n' ignore 1 lines (a, @b, c) set d = @b * 2, e = now()",
+		output: "load data low_priority local infile 'data.txt' replace into table db.t partition (p0, p1) character set utf8mb4 fields terminated by ',' optionally enclosed by '\\\"' escaped by '\\\\' lines starting by 'xx' terminated by '\\n' ignore 1 lines (a, @b, c) set d = @b * 2, e = now()",
+	}, {
+		input:  "load data concurrent infile 'x' ignore into table t columns escaped by '' terminated by '\\t' ignore 2 rows",
+		output: "load data concurrent infile 'x' ignore into table t fields terminated by '\\t' escaped by '' ignore 2 lines",
+	}, {
+		input:  "load data infile 'x' into table t character set 'latin1' lines terminated by '\\r\\n'",
+		output: "load data infile 'x' into table t character set latin1 lines terminated by '\\r\\n'",
+	}}
+	for _, tcase := range validSQL {
+		tree, err := Parse(tcase.input)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.output, String(tree))
+	}
+
+	// LOAD DATA FROM S3 is accepted but not parsed.
+	s3SQL := []string{
 		"load data from s3 'x.txt'",
 		"load data from s3 manifest 'x.txt'",
 		"load data from s3 file 'x.txt'",
-		"load data infile 'x.txt' into table 'c'",
 		"load data from s3 'x.txt' into table x"}
 
-	for _, tcase := range validSQL {
+	for _, tcase := range s3SQL {
 		_, err := Parse(tcase)
 		require.NoError(t, err)
 	}
@@ -3911,6 +4471,12 @@ func TestCreateTable(t *testing.T) {
 			input: `create table t (
 	p point srid 0,
 	g geometry not null srid 4326
+)`,
+		},
+		{
+			input: `create table t (
+	g geomcollection not null srid 4326,
+	spatial index (g)
 )`,
 		},
 		// test defining column visibility
@@ -4986,6 +5552,60 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select st_geomfromtext('POINT(1 1)', 4326, 'axis-order=lat-long', 1) from t",
+		output: "incorrect parameter count in the call to native function 'st_geomfromtext' at position 69",
+	}, {
+		input:  "select st_contains(a) from t",
+		output: "syntax error at position 22",
+	}, {
+		input:  "change replication source to source_hots = 3306",
+		output: "unknown replication option 'source_hots' at position 48",
+	}, {
+		input:  "start replica until sql_after_gtids",
+		output: "replication option 'sql_after_gtids' requires a value at position 36",
+	}, {
+		input:  "start replica until sql_after_mts_gaps = 1",
+		output: "replication option 'sql_after_mts_gaps' does not take a value at position 43",
+	}, {
+		input:  "start replica user = 'u' source_host = 'db1' for channel c",
+		output: "unknown replication option 'source_host' at position 59 near 'c'",
+	}, {
+		input:  "purge binary logs",
+		output: "syntax error at position 18",
+	}, {
+		input:  "kill 18446744073709551616",
+		output: "processlist id out of range at position 26 near '18446744073709551616'",
+	}, {
+		input:  "kill query",
+		output: "syntax error at position 11",
+	}, {
+		input:  "install plugin p",
+		output: "syntax error at position 17",
+	}, {
+		input:  "install component c",
+		output: "syntax error at position 20 near 'c'",
+	}, {
+		input:  "show profile cpus for query 1",
+		output: "unknown profile type 'cpus' at position 22 near 'for'",
+	}, {
+		input:  "show max(*) warnings",
+		output: "syntax error at position 21 near 'warnings'",
+	}, {
+		input:  "show engine innodb",
+		output: "syntax error at position 19",
+	}, {
+		input:  "select regexp_like('a') from t",
+		output: "incorrect parameter count in the call to native function 'regexp_like' at position 24",
+	}, {
+		input:  "select regexp_instr('a', 'b', 1, 1, 0, 'c', 'd') from t",
+		output: "incorrect parameter count in the call to native function 'regexp_instr' at position 49",
+	}, {
+		input:  "select regexp_replace('a', 'b') from t",
+		output: "incorrect parameter count in the call to native function 'regexp_replace' at position 32",
+	}, {
+		input:  "select regexp_substr('a', 'b', 1, 1, 'c', 'd') from t",
+		output: "incorrect parameter count in the call to native function 'regexp_substr' at position 47",
 	}, {
 		input:  "execute stmt using 1;",
 		output: "syntax error at position 21 near '1'",
@@ -5103,6 +5723,39 @@ var (
 		input:        "create table 2t.3t2 (c1 bigint not null, c2 text, primary key(c1))",
 		output:       "syntax error at position 18 near '.3'",
 		excludeMulti: true,
+	}, {
+		input:  "grant r1@h on t to u",
+		output: "expecting privilege at position 21",
+	}, {
+		input:  "grant select to u",
+		output: "expecting role at position 18",
+	}, {
+		input:  "create user u identified by 'x' retain current password",
+		output: "REPLACE, RETAIN CURRENT PASSWORD and DISCARD OLD PASSWORD are only supported for ALTER USER at position 56",
+	}, {
+		input:  "alter user u discard new password",
+		output: "expecting OLD at position 34 near 'password'",
+	}, {
+		input:  "select a from t group by a with rollupx",
+		output: "syntax error at position 32 near 'with'",
+	}, {
+		input:  "create procedure p() l1: begin end l2",
+		output: "end label does not match the beginning label at position 38 near 'l2'",
+	}, {
+		input:  "alter algorithm = merge event e",
+		output: "ALGORITHM and SQL SECURITY are only supported for views at position 32",
+	}, {
+		input:  "alter trigger t",
+		output: "syntax error at position 14 near 'trigger'",
+	}, {
+		input:  "create or replace procedure p() select 1",
+		output: "OR REPLACE and ALGORITHM are only supported for views at position 41",
+	}, {
+		input:  "create function f(in a int) returns int return a",
+		output: "IN, OUT and INOUT are only supported for procedure parameters at position 49",
+	}, {
+		input:  "create procedure p() signal sqlstate '45000' set foo = 1",
+		output: "unknown condition information item at position 57",
 	}}
 )
 
@@ -5200,14 +5853,14 @@ func TestParseVersionedComments(t *testing.T) {
 	}{
 		{
 			input:        `CREATE TABLE table1 (id int) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 /*!50900 PARTITION BY RANGE (id) (PARTITION x VALUES LESS THAN (5) ENGINE = InnoDB, PARTITION t VALUES LESS THAN (20) ENGINE = InnoDB) */`,
-			mysqlVersion: "50401",
+			mysqlVersion: "5.4.1",
 			output: `create table table1 (
 	id int
 ) ENGINE InnoDB,
   CHARSET utf8mb4`,
 		}, {
 			input:        `CREATE TABLE table1 (id int) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 /*!50900 PARTITION BY RANGE (id) (PARTITION x VALUES LESS THAN (5) ENGINE = InnoDB, PARTITION t VALUES LESS THAN (20) ENGINE = InnoDB) */`,
-			mysqlVersion: "80001",
+			mysqlVersion: "8.0.1",
 			output: `create table table1 (
 	id int
 ) ENGINE InnoDB,
@@ -5220,10 +5873,9 @@ partition by range (id)
 
 	for _, testcase := range testcases {
 		t.Run(testcase.input+":"+testcase.mysqlVersion, func(t *testing.T) {
-			oldMySQLVersion := MySQLVersion
-			defer func() { MySQLVersion = oldMySQLVersion }()
-			MySQLVersion = testcase.mysqlVersion
-			tree, err := Parse(testcase.input)
+			parser, err := NewParser(Options{MySQLServerVersion: testcase.mysqlVersion})
+			require.NoError(t, err)
+			tree, err := parser.Parse(testcase.input)
 			require.NoError(t, err, testcase.input)
 			out := String(tree)
 			require.Equal(t, testcase.output, out)
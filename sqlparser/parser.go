@@ -17,7 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
-	"flag"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -27,13 +27,6 @@ import (
 	"github.com/wind-c/cosqlparser/coerrors"
 )
 
-// MySQLServerVersion is what Vitess will present as it's version during the connection handshake,
-// and as the value to the @@version system variable. If nothing is provided, Vitess will report itself as
-// a specific MySQL version with the vitess version appended to it
-var MySQLServerVersion = flag.String("mysql_server_version", "", "MySQL server version to advertise.")
-
-var versionFlagSync sync.Once
-
 // parserPool is a pool for parser objects.
 var parserPool = sync.Pool{
 	New: func() any {
@@ -47,8 +40,96 @@ type BindVars map[string]struct{}
 // zeroParser is a zero-initialized parser to help reinitialize the parser for pooling.
 var zeroParser yyParserImpl
 
-// MySQLVersion is the version of MySQL that the parser would emulate
-var MySQLVersion = "50709" // default version if nothing else is stated
+// DefaultMySQLServerVersion is the version of MySQL that the package-level functions emulate.
+const DefaultMySQLServerVersion = "5.7.9"
+
+// Options holds the options of a Parser.
+type Options struct {
+	// MySQLServerVersion is the version of MySQL to emulate, e.g. "8.0.30".
+	// It decides which versioned comments like /*!80000 ... */ are parsed.
+	// DefaultMySQLServerVersion is used if it is empty.
+	MySQLServerVersion string
+	// SQLMode holds the flags of sql_mode that change the syntax, see ParseSQLMode.
+	SQLMode SQLMode
+	// Positions records the Span of the statements, expressions, table expressions
+	// and identifiers in the parsed SQL. It is off by default to keep parsing cheap.
+	// Identifiers that hold a Span are only == to identifiers parsed at the same
+	// place, so compare them with their Equals functions when Positions is on.
+	Positions bool
+	// TruncateUILen truncates queries in debug UIs to the given length. 0 means unlimited.
+	TruncateUILen int
+	// TruncateErrLen truncates queries in error logs to the given length. 0 means unlimited.
+	TruncateErrLen int
+}
+
+// Parser parses SQL as a given version of MySQL. A Parser is not
+// modified after its creation, so it can be used concurrently.
+type Parser struct {
+	version        string // the version in the format of versioned comments, e.g. "50709"
+	mode           SQLMode
+	positions      bool
+	truncateUILen  int
+	truncateErrLen int
+}
+
+// NewParser creates a Parser with the given options.
+func NewParser(opts Options) (*Parser, error) {
+	if opts.MySQLServerVersion == "" {
+		opts.MySQLServerVersion = DefaultMySQLServerVersion
+	}
+	version, err := convertMySQLVersionToCommentVersion(opts.MySQLServerVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &Parser{
+		version:        version,
+		mode:           opts.SQLMode,
+		positions:      opts.Positions,
+		truncateUILen:  opts.TruncateUILen,
+		truncateErrLen: opts.TruncateErrLen,
+	}, nil
+}
+
+// defaultOptions are the options of defaultParser, the parser used by the
+// package-level functions.
+var (
+	defaultOptions = Options{MySQLServerVersion: DefaultMySQLServerVersion, TruncateUILen: 512}
+	defaultParser  = mustNewParser(defaultOptions)
+)
+
+func mustNewParser(opts Options) *Parser {
+	parser, err := NewParser(opts)
+	if err != nil {
+		panic(err)
+	}
+	return parser
+}
+
+// DefaultOptions returns the options of the parser used by the package-level
+// functions. By default, they emulate DefaultMySQLServerVersion and truncate
+// queries in debug UIs to 512 bytes.
+func DefaultOptions() Options {
+	return defaultOptions
+}
+
+// SetDefaultOptions sets the options of the parser used by the package-level
+// functions, like Parse and TruncateForUI. It is not safe to call it while
+// they are used, so call it when the program starts, e.g. after parsing its
+// flags:
+//
+//	opts := sqlparser.DefaultOptions()
+//	opts.MySQLServerVersion = *mysqlServerVersion
+//	if err := sqlparser.SetDefaultOptions(opts); err != nil {
+//		log.Fatal(err)
+//	}
+func SetDefaultOptions(opts Options) error {
+	parser, err := NewParser(opts)
+	if err != nil {
+		return err
+	}
+	defaultOptions, defaultParser = opts, parser
+	return nil
+}
 
 // yyParsePooled is a wrapper around yyParse that pools the parser objects. There isn't a
 // particularly good reason to use yyParse directly, since it immediately discards its parser.
@@ -84,7 +165,12 @@ func yyParsePooled(yylex yyLexer) int {
 // is partially parsed but still contains a syntax error, the
 // error is ignored and the DDL is returned anyway.
 func Parse2(sql string) (Statement, BindVars, error) {
-	tokenizer := NewStringTokenizer(sql)
+	return defaultParser.Parse2(sql)
+}
+
+// Parse2 parses the SQL in full like the package-level Parse2, emulating the MySQL version of p.
+func (p *Parser) Parse2(sql string) (Statement, BindVars, error) {
+	tokenizer := p.NewStringTokenizer(sql)
 	if yyParsePooled(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil {
 			if typ, val := tokenizer.Scan(); typ != 0 {
@@ -99,7 +185,7 @@ func Parse2(sql string) (Statement, BindVars, error) {
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, tokenizer.BindVars, nil
 		}
-		return nil, nil, coerrors.New(coerrors.Code_INVALID_ARGUMENT, tokenizer.LastError.Error())
+		return nil, nil, tokenizer.parseError()
 	}
 	if tokenizer.ParseTree == nil {
 		return nil, nil, ErrEmpty
@@ -107,19 +193,6 @@ func Parse2(sql string) (Statement, BindVars, error) {
 	return tokenizer.ParseTree, tokenizer.BindVars, nil
 }
 
-func checkParserVersionFlag() {
-	if flag.Parsed() {
-		versionFlagSync.Do(func() {
-			if *MySQLServerVersion != "" {
-				convVersion, err := convertMySQLVersionToCommentVersion(*MySQLServerVersion)
-				if err == nil {
-					MySQLVersion = convVersion
-				}
-			}
-		})
-	}
-}
-
 // convertMySQLVersionToCommentVersion converts the MySQL version into comment version format.
 func convertMySQLVersionToCommentVersion(version string) (string, error) {
 	var res = make([]int, 3)
@@ -160,7 +233,12 @@ func convertMySQLVersionToCommentVersion(version string) (string, error) {
 
 // ParseExpr parses an expression and transforms it to an AST
 func ParseExpr(sql string) (Expr, error) {
-	stmt, err := Parse("select " + sql)
+	return defaultParser.ParseExpr(sql)
+}
+
+// ParseExpr parses an expression and transforms it to an AST, emulating the MySQL version of p.
+func (p *Parser) ParseExpr(sql string) (Expr, error) {
+	stmt, err := p.Parse("select " + sql)
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +248,27 @@ func ParseExpr(sql string) (Expr, error) {
 
 // Parse behaves like Parse2 but does not return a set of bind variables
 func Parse(sql string) (Statement, error) {
-	stmt, _, err := Parse2(sql)
+	return defaultParser.Parse(sql)
+}
+
+// Parse behaves like Parse2 but does not return a set of bind variables
+func (p *Parser) Parse(sql string) (Statement, error) {
+	stmt, _, err := p.Parse2(sql)
 	return stmt, err
 }
 
 // ParseStrictDDL is the same as Parse except it errors on
 // partially parsed DDL statements.
 func ParseStrictDDL(sql string) (Statement, error) {
-	tokenizer := NewStringTokenizer(sql)
+	return defaultParser.ParseStrictDDL(sql)
+}
+
+// ParseStrictDDL is the same as Parse except it errors on
+// partially parsed DDL statements.
+func (p *Parser) ParseStrictDDL(sql string) (Statement, error) {
+	tokenizer := p.NewStringTokenizer(sql)
 	if yyParsePooled(tokenizer) != 0 {
-		return nil, tokenizer.LastError
+		return nil, tokenizer.parseError()
 	}
 	if tokenizer.ParseTree == nil {
 		return nil, ErrEmpty
@@ -198,17 +287,35 @@ func ParseTokenizer(tokenizer *Tokenizer) int {
 // The tokenizer will always read up to the end of the statement, allowing for
 // the next call to ParseNext to parse any subsequent SQL statements. When
 // there are no more statements to parse, a error of io.EOF is returned.
+// A syntax error is returned as a PositionedErr, and the SyntaxError with
+// the details of it is kept in tokenizer.SyntaxError. If tokenizer.Recover is
+// set, syntax errors are not returned but added to tokenizer.SyntaxErrors,
+// see ParseScript.
 func ParseNext(tokenizer *Tokenizer) (Statement, error) {
-	return parseNext(tokenizer, false)
+	return defaultParser.ParseNext(tokenizer)
+}
+
+// ParseNext parses a single SQL statement from the tokenizer like the
+// package-level ParseNext. The versioned comments of the statement are
+// handled as the MySQL version of p would.
+func (p *Parser) ParseNext(tokenizer *Tokenizer) (Statement, error) {
+	return p.parseNext(tokenizer, false)
 }
 
 // ParseNextStrictDDL is the same as ParseNext except it errors on
 // partially parsed DDL statements.
 func ParseNextStrictDDL(tokenizer *Tokenizer) (Statement, error) {
-	return parseNext(tokenizer, true)
+	return defaultParser.ParseNextStrictDDL(tokenizer)
 }
 
-func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
+// ParseNextStrictDDL is the same as ParseNext except it errors on
+// partially parsed DDL statements.
+func (p *Parser) ParseNextStrictDDL(tokenizer *Tokenizer) (Statement, error) {
+	return p.parseNext(tokenizer, true)
+}
+
+func (p *Parser) parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
+	tokenizer.parser = p
 	if tokenizer.cur() == ';' {
 		tokenizer.skip(1)
 		tokenizer.skipBlank()
@@ -219,7 +326,14 @@ func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
 
 	tokenizer.reset()
 	tokenizer.multi = true
+	start, errs := tokenizer.Pos, len(tokenizer.SyntaxErrors)
 	if yyParsePooled(tokenizer) != 0 {
+		if tokenizer.Recover {
+			// The statement was aborted by the grammar, e.g. for an error
+			// found after it was recognized. Skip the rest of it.
+			tokenizer.skipStatement()
+			return tokenizer.recovered(badStatement(tokenizer), start), nil
+		}
 		if tokenizer.partialDDL != nil && !strict {
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, nil
@@ -227,18 +341,85 @@ func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
 		return nil, tokenizer.LastError
 	}
 	if tokenizer.ParseTree == nil {
-		return ParseNext(tokenizer)
+		return p.ParseNext(tokenizer)
+	}
+	if len(tokenizer.SyntaxErrors) > errs {
+		return tokenizer.recovered(tokenizer.ParseTree, start), nil
 	}
 	return tokenizer.ParseTree, nil
 }
 
+// recovered returns the statement that the parser recovered from syntax
+// errors with. A partially parsed DDL is marked as not fully parsed, and
+// a BadStmt gets the text of the statement that starts at start.
+func (tkn *Tokenizer) recovered(stmt Statement, start int) Statement {
+	switch stmt := stmt.(type) {
+	case *BadStmt:
+		end := start + len(strings.TrimRight(tkn.buf[start:tkn.Pos], " \t\r\n"))
+		stmt.SQL = tkn.buf[start:end]
+		if tkn.parser.positions {
+			stmt.setSpan(tkn.span(yyLoc{Start: start, End: end}))
+		}
+	case DBDDLStatement:
+		stmt.SetFullyParsed(false)
+	case DDLStatement:
+		stmt.SetFullyParsed(false)
+	}
+	return stmt
+}
+
+// ParseScript parses all the statements of sql like repeated calls to ParseNext,
+// but recovers from syntax errors instead of stopping at the first one. The
+// parser skips to the next definition of a CREATE TABLE or option of an ALTER
+// TABLE, and keeps the one with the error as a BadDefinition. Otherwise it
+// skips to the next statement, which follows the END of the body of a stored
+// program. A statement with an error is returned as a partially parsed DDL if
+// it is one, or as a BadStmt holding its text. All the syntax errors are
+// returned in the order they were found.
+func ParseScript(sql string) ([]Statement, []*SyntaxError) {
+	return defaultParser.ParseScript(sql)
+}
+
+// ParseScript parses all the statements of sql like the package-level
+// ParseScript, emulating the MySQL version of p.
+func (p *Parser) ParseScript(sql string) ([]Statement, []*SyntaxError) {
+	tokenizer := p.NewStringTokenizer(sql)
+	tokenizer.Recover = true
+	var stmts []Statement
+	for {
+		stmt, err := p.ParseNext(tokenizer)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// The parser recovers from syntax errors, so report any other
+			// error as one, and skip the statement that failed.
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				syntaxErr = tokenizer.newSyntaxError(err.Error())
+			}
+			tokenizer.SyntaxErrors = append(tokenizer.SyntaxErrors, syntaxErr)
+			tokenizer.skipStatement()
+			continue
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, tokenizer.SyntaxErrors
+}
+
 // ErrEmpty is a sentinel error returned when parsing empty statements.
 var ErrEmpty = coerrors.NewErrorf(coerrors.Code_INVALID_ARGUMENT, coerrors.EmptyQuery, "query was empty")
 
 // SplitStatement returns the first sql statement up to either a ; or EOF
 // and the remainder from the given buffer
 func SplitStatement(blob string) (string, string, error) {
-	tokenizer := NewStringTokenizer(blob)
+	return defaultParser.SplitStatement(blob)
+}
+
+// SplitStatement returns the first sql statement up to either a ; or EOF
+// and the remainder from the given buffer
+func (p *Parser) SplitStatement(blob string) (string, string, error) {
+	tokenizer := p.NewStringTokenizer(blob)
 	tkn := 0
 	for {
 		tkn, _ = tokenizer.Scan()
@@ -258,6 +439,13 @@ func SplitStatement(blob string) (string, string, error) {
 // SplitStatementToPieces split raw sql statement that may have multi sql pieces to sql pieces
 // returns the sql pieces blob contains; or error if sql cannot be parsed
 func SplitStatementToPieces(blob string) (pieces []string, err error) {
+	return defaultParser.SplitStatementToPieces(blob)
+}
+
+// SplitStatementToPieces split raw sql statement that may have multi sql pieces to sql pieces
+// returns the sql pieces blob contains; or error if sql cannot be parsed.
+// A statement that defines a stored program is not split at the ';' of its body.
+func (p *Parser) SplitStatementToPieces(blob string) (pieces []string, err error) {
 	// fast path: the vast majority of SQL statements do not have semicolons in them
 	if blob == "" {
 		return nil, nil
@@ -270,7 +458,7 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 	}
 
 	pieces = make([]string, 0, 16)
-	tokenizer := NewStringTokenizer(blob)
+	tokenizer := p.NewStringTokenizer(blob)
 
 	tkn := 0
 	var stmt string
@@ -279,15 +467,21 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 loop:
 	for {
 		tkn, _ = tokenizer.Scan()
-		switch tkn {
-		case ';':
+		if tkn != COMMENT {
+			tokenizer.trackBlock(tkn)
+		}
+		switch {
+		case tkn == ';' && tokenizer.inBlock():
+			emptyStatement = false
+		case tkn == ';':
+			tokenizer.resetBlocks()
 			stmt = blob[stmtBegin : tokenizer.Pos-1]
 			if !emptyStatement {
 				pieces = append(pieces, stmt)
 				emptyStatement = true
 			}
 			stmtBegin = tokenizer.Pos
-		case 0, eofChar:
+		case tkn == 0, tkn == eofChar:
 			blobTail := tokenizer.Pos - 1
 			if stmtBegin < blobTail {
 				stmt = blob[stmtBegin : blobTail+1]
@@ -305,6 +499,12 @@ loop:
 	return
 }
 
+// IsMySQL80AndAbove returns true if the package-level functions emulate MySQL 8.0 or later.
 func IsMySQL80AndAbove() bool {
-	return MySQLVersion >= "80000"
+	return defaultParser.IsMySQL80AndAbove()
+}
+
+// IsMySQL80AndAbove returns true if p emulates MySQL 8.0 or later.
+func (p *Parser) IsMySQL80AndAbove() bool {
+	return p.version >= "80000"
 }
@@ -1,6 +1,74 @@
 package sqlparser
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParserVersions(t *testing.T) {
+	parser57, err := NewParser(Options{})
+	require.NoError(t, err)
+	parser80, err := NewParser(Options{MySQLServerVersion: "8.0.30"})
+	require.NoError(t, err)
+	assert.False(t, parser57.IsMySQL80AndAbove())
+	assert.True(t, parser80.IsMySQL80AndAbove())
+
+	sql := "select a /*!80000 , b */ from t; select 1 from dual"
+	stmt, err := parser57.Parse("select a /*!80000 , b */ from t")
+	require.NoError(t, err)
+	assert.Equal(t, "select a from t", String(stmt))
+	stmt, err = parser80.Parse("select a /*!80000 , b */ from t")
+	require.NoError(t, err)
+	assert.Equal(t, "select a, b from t", String(stmt))
+
+	tokenizer := parser80.NewStringTokenizer(sql)
+	stmt, err = parser80.ParseNext(tokenizer)
+	require.NoError(t, err)
+	assert.Equal(t, "select a, b from t", String(stmt))
+
+	pieces, err := parser80.SplitStatementToPieces(sql)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"select a /*!80000 , b */ from t", " select 1 from dual"}, pieces)
+
+	expr, err := parser80.ParseExpr("a /*!80000 + 1 */")
+	require.NoError(t, err)
+	assert.Equal(t, "a + 1", String(expr))
+
+	_, err = NewParser(Options{MySQLServerVersion: "unknown"})
+	require.EqualError(t, err, "MySQL version not correctly setup - unknown.")
+}
+
+func TestParserTruncate(t *testing.T) {
+	parser, err := NewParser(Options{TruncateUILen: 20, TruncateErrLen: 30})
+	require.NoError(t, err)
+	query := "select * from t where a = 1 and b = 2"
+	assert.Equal(t, "select * [TRUNCATED]", parser.TruncateForUI(query))
+	assert.Equal(t, "select * from t wh [TRUNCATED]", parser.TruncateForLog(query))
+	assert.Equal(t, query, TruncateForLog(query))
+}
+
+func TestSetDefaultOptions(t *testing.T) {
+	defaults := DefaultOptions()
+	assert.Equal(t, DefaultMySQLServerVersion, defaults.MySQLServerVersion)
+	defer func() {
+		require.NoError(t, SetDefaultOptions(defaults))
+	}()
+
+	opts := defaults
+	opts.MySQLServerVersion = "8.0.30"
+	opts.TruncateErrLen = 30
+	require.NoError(t, SetDefaultOptions(opts))
+	assert.Equal(t, opts, DefaultOptions())
+	stmt, err := Parse("select a /*!80000 , b */ from t")
+	require.NoError(t, err)
+	assert.Equal(t, "select a, b from t", String(stmt))
+	assert.Equal(t, "select * from t wh [TRUNCATED]", TruncateForLog("select * from t where a = 1 and b = 2"))
+
+	require.EqualError(t, SetDefaultOptions(Options{MySQLServerVersion: "unknown"}), "MySQL version not correctly setup - unknown.")
+	assert.Equal(t, opts, DefaultOptions())
+}
 
 func BenchmarkParse(b *testing.B) {
 	b.ReportAllocs()
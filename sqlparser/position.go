@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import "fmt"
+
+// Position is a location in the parsed SQL.
+type Position struct {
+	// Offset is the byte offset, starting at 0.
+	Offset int
+	// Line is the line number, starting at 1.
+	Line int
+	// Column is the number of the character in the line, starting at 1.
+	// Characters are counted as Unicode code points, not bytes.
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span is the part of the parsed SQL that a node was parsed from.
+// End is the position right after the last character of the node.
+//
+// The nodes that can hold a Span embed it in an unexported field, which is a
+// breaking change of the AST API:
+//   - Composite literals of these nodes must name their fields, e.g.
+//     TableName{Name: name, Qualifier: qualifier} instead of
+//     TableName{name, qualifier}.
+//   - The value types, like ColIdent, TableIdent and TableName, compare
+//     the Span too. When Options.Positions is on, == and map keys tell apart
+//     two identifiers that were parsed at different places. Compare them
+//     with ColIdent.Equal, ColIdent.EqualString, EqualsTableIdent or
+//     EqualsTableName instead, or key maps by their String.
+type Span struct {
+	Start, End Position
+}
+
+func (s Span) String() string {
+	return fmt.Sprintf("%v-%v", s.Start, s.End)
+}
+
+// _span is embedded in the nodes that record where they were parsed from
+// when the parser is created with Options.Positions. The name of the field
+// starts with an underscore, so the generated Clone keeps the Span and the
+// generated Equals ignores it.
+type _span struct {
+	span *Span
+}
+
+// Span returns the part of the parsed SQL that the node was parsed from,
+// or nil if the parser did not record it.
+func (s _span) Span() *Span {
+	return s.span
+}
+
+func (s *_span) setSpan(span *Span) {
+	s.span = span
+}
+
+// spanned is implemented by the pointers to the nodes that embed _span.
+type spanned interface {
+	Span() *Span
+	setSpan(span *Span)
+}
+
+// SpanOf returns the part of the parsed SQL that node was parsed from,
+// or nil if the parser did not record it or the node cannot hold it.
+func SpanOf(node SQLNode) *Span {
+	if n, ok := node.(interface{ Span() *Span }); ok {
+		return n.Span()
+	}
+	return nil
+}
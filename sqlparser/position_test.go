@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositions(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	require.NoError(t, err)
+
+	sql := "select a, b + 1 as c\nfrom t1 join t2 on t1.id = t2.id\nwhere ( d = 'x' ) and /*!50000 e */ > 2;"
+	stmt, err := parser.Parse(sql)
+	require.NoError(t, err)
+
+	text := func(node SQLNode) string {
+		span := SpanOf(node)
+		require.NotNil(t, span, "no span for %s", String(node))
+		return sql[span.Start.Offset:span.End.Offset]
+	}
+
+	sel := stmt.(*Select)
+	assert.Equal(t, sql[:len(sql)-1], text(sel))
+	assert.Equal(t, "b + 1", text(sel.SelectExprs[1].(*AliasedExpr).Expr))
+	assert.Equal(t, "c", text(sel.SelectExprs[1].(*AliasedExpr).As))
+
+	join := sel.From[0].(*JoinTableExpr)
+	assert.Equal(t, "t1 join t2 on t1.id = t2.id", text(join))
+	assert.Equal(t, "t2", text(join.RightExpr))
+	assert.Equal(t, "t2", text(join.RightExpr.(*AliasedTableExpr).Expr.(TableName).Name))
+	assert.Equal(t, "t1.id", text(join.Condition.On.(*ComparisonExpr).Left))
+
+	and := sel.Where.Expr.(*AndExpr)
+	assert.Equal(t, "( d = 'x' ) and /*!50000 e */ > 2", text(and))
+	assert.Equal(t, "d = 'x'", text(and.Left))
+	assert.Equal(t, "'x'", text(and.Left.(*ComparisonExpr).Right))
+	assert.Equal(t, "e", text(and.Right.(*ComparisonExpr).Left))
+
+	assert.Equal(t, &Span{
+		Start: Position{Offset: 62, Line: 3, Column: 9},
+		End:   Position{Offset: 69, Line: 3, Column: 16},
+	}, SpanOf(and.Left))
+	assert.Equal(t, "3:9-3:16", SpanOf(and.Left).String())
+}
+
+func TestPositionsUnicode(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	require.NoError(t, err)
+
+	sql := "select 名字 from 用户表\nwhere 'ä' = größe"
+	stmt, err := parser.Parse(sql)
+	require.NoError(t, err)
+
+	sel := stmt.(*Select)
+	assert.Equal(t, "1:8-1:10", SpanOf(sel.SelectExprs[0].(*AliasedExpr).Expr).String())
+	assert.Equal(t, "1:16-1:19", SpanOf(sel.From[0]).String())
+	cmp := sel.Where.Expr.(*ComparisonExpr)
+	assert.Equal(t, "2:7-2:10", SpanOf(cmp.Left).String())
+	assert.Equal(t, &Span{
+		Start: Position{Offset: 42, Line: 2, Column: 13},
+		End:   Position{Offset: 49, Line: 2, Column: 18},
+	}, SpanOf(cmp.Right))
+}
+
+func TestPositionsCloneAndEquals(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	require.NoError(t, err)
+
+	sql := "select a from t where b = 1"
+	withPositions, err := parser.Parse(sql)
+	require.NoError(t, err)
+	withoutPositions, err := Parse(sql)
+	require.NoError(t, err)
+
+	assert.Nil(t, SpanOf(withoutPositions))
+	assert.Nil(t, SpanOf(withoutPositions.(*Select).Where.Expr))
+	assert.True(t, EqualsStatement(withPositions, withoutPositions))
+
+	clone := CloneStatement(withPositions)
+	assert.Equal(t, SpanOf(withPositions), SpanOf(clone))
+	assert.Equal(t, SpanOf(withPositions.(*Select).Where.Expr), SpanOf(clone.(*Select).Where.Expr))
+	assert.Equal(t, "b = 1", sql[SpanOf(clone.(*Select).Where.Expr).Start.Offset:])
+
+	assert.Nil(t, SpanOf(ValTuple{}))
+}
+
+func TestPositionsOfStatements(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	require.NoError(t, err)
+
+	sql := "insert into t values (1);\n  update t set a = 1 where b = 2 ;\nbegin"
+	tokens := parser.NewStringTokenizer(sql)
+	var spans []string
+	for {
+		stmt, err := parser.ParseNext(tokens)
+		if err != nil {
+			break
+		}
+		spans = append(spans, SpanOf(stmt).String())
+	}
+	assert.Equal(t, []string{"1:1-1:25", "2:3-2:33", "3:1-3:6"}, spans)
+}
+
+func TestPositionsOfAllNodes(t *testing.T) {
+	parser, err := NewParser(Options{Positions: true})
+	require.NoError(t, err)
+
+	testcases := []string{
+		"select a, (select max(b) from t2 where t2.c = t1.c) from t1 where exists (select 1 from t3) and d in (select d from t4)",
+		"select x.a from (select a from t1 union select a from t2) as x join t3 on x.a = t3.a",
+		"with cte (a) as (select a from t1), cte2 as (select b from t2 intersect select b from t3) select * from cte, cte2",
+		"(select a from t1 order by a limit 1) union all (select a from t2) except select a from t3",
+		"insert into t select a from t1 union select a from t2",
+		"delete from t where a = 1",
+		"delete a from t as a join t1 on a.id = t1.id where t1.b in (select b from t2)",
+		"update t set a = (select max(a) from t1) where b = 1",
+	}
+	for _, sql := range testcases {
+		t.Run(sql, func(t *testing.T) {
+			stmt, err := parser.Parse(sql)
+			require.NoError(t, err)
+			_ = Walk(func(node SQLNode) (bool, error) {
+				switch node := node.(type) {
+				case ColIdent:
+					if node.IsEmpty() {
+						return true, nil
+					}
+				case TableIdent:
+					if node.IsEmpty() {
+						return true, nil
+					}
+				case TableName:
+					if node.IsEmpty() {
+						return true, nil
+					}
+				}
+				if n, ok := node.(interface{ Span() *Span }); ok {
+					assert.NotNil(t, n.Span(), "no span for %T %s", node, String(node))
+				}
+				return true, nil
+			}, stmt)
+		})
+	}
+	sql := "select a from t1 where b in (select b from t2 union (select b from t3 order by b))"
+	stmt, err := parser.Parse(sql)
+	require.NoError(t, err)
+	text := func(node SQLNode) string {
+		span := SpanOf(node)
+		require.NotNil(t, span, "no span for %s", String(node))
+		return sql[span.Start.Offset:span.End.Offset]
+	}
+	subquery := stmt.(*Select).Where.Expr.(*ComparisonExpr).Right.(*Subquery)
+	assert.Equal(t, "(select b from t2 union (select b from t3 order by b))", text(subquery))
+	union := subquery.Select.(*Union)
+	assert.Equal(t, "select b from t2 union (select b from t3 order by b)", text(union))
+	assert.Equal(t, "select b from t2", text(union.Left))
+	assert.Equal(t, "select b from t3 order by b", text(union.Right))
+
+	sql = "delete from t as x where a = 1"
+	stmt, err = parser.Parse(sql)
+	require.NoError(t, err)
+	assert.Equal(t, "t as x", text(stmt.(*Delete).TableExprs[0]))
+}
+
+// parsePositionsSQL is the query of BenchmarkParse.
+const parsePositionsSQL = "SELECT cola a, colb b FROM topic t  WHERE b > 20 and a between 10 and 20 GROUP BY (a, b) HAVING a > c ORDER BY b"
+
+func TestPositionsOffAllocations(t *testing.T) {
+	parser, err := NewParser(Options{})
+	require.NoError(t, err)
+
+	// The number of allocations of the query before Positions existed: the
+	// spans must not cost any allocation when they are not recorded.
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = parser.Parse(parsePositionsSQL)
+	})
+	assert.LessOrEqual(t, allocs, 47.0)
+}
+
+func BenchmarkParsePositions(b *testing.B) {
+	for _, positions := range []bool{false, true} {
+		parser, err := NewParser(Options{Positions: positions})
+		require.NoError(b, err)
+		name := "off"
+		if positions {
+			name = "on"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = parser.Parse(parsePositionsSQL)
+			}
+		})
+	}
+}
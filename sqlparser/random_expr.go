@@ -263,7 +263,7 @@ func (g *generator) xorExpr() Expr {
 func (g *generator) notExpr() Expr {
 	g.enter()
 	defer g.exit()
-	return &NotExpr{g.booleanExpr()}
+	return &NotExpr{Expr: g.booleanExpr()}
 }
 
 func (g *generator) inExpr() Expr {
@@ -21,6 +21,29 @@ func setParseTree(yylex yyLexer, stmt Statement) {
   yylex.(*Tokenizer).ParseTree = stmt
 }
 
+// setSpan records the position of node if the parser tracks positions. A node
+// that already has a position, like an expression in parentheses, keeps it.
+func setSpan(yylex yyLexer, node any, loc yyLoc) {
+  tkn := yylex.(*Tokenizer)
+  if !tkn.parser.positions {
+    return
+  }
+  if n, ok := node.(spanned); ok && n.Span() == nil {
+    n.setSpan(tkn.span(loc))
+  }
+}
+
+// spanAt returns the Span of loc if the parser tracks positions, or nil. It is
+// used for the identifiers, which are values: passing their address to setSpan
+// would move the parser's $$ to the heap on every parse.
+func spanAt(yylex yyLexer, loc yyLoc) *Span {
+  tkn := yylex.(*Tokenizer)
+  if !tkn.parser.positions {
+    return nil
+  }
+  return tkn.span(loc)
+}
+
 func setAllowComments(yylex yyLexer, allow bool) {
   yylex.(*Tokenizer).AllowComments = allow
 }
@@ -29,6 +52,64 @@ func setDDL(yylex yyLexer, node Statement) {
   yylex.(*Tokenizer).partialDDL = node
 }
 
+// unquoteAddress returns the host part of an account as written after the @,
+// without the surrounding quotes if it was quoted. A quoted host is unescaped
+// as in the sql_mode of the parser.
+func unquoteAddress(yylex yyLexer, address string) string {
+  if len(address) < 2 || (address[0] != '\'' && address[0] != '"') {
+    return address
+  }
+  _, host := yylex.(*Tokenizer).parser.NewStringTokenizer(address).Scan()
+  return host
+}
+
+// unquoteVariable returns the name of a user variable, which is quoted like
+// the host of an account in @'my var'.
+func unquoteVariable(yylex yyLexer, name string) string {
+  return unquoteAddress(yylex, name)
+}
+
+// formatAddress returns the host part of a definer formatted as in the default sql_mode.
+func formatAddress(yylex yyLexer, address string) string {
+  if len(address) > 0 && (address[0] == '\'' || address[0] == '"') {
+    return encodeSQLString(unquoteAddress(yylex, address))
+  }
+  buf := NewTrackedBuffer(nil)
+  formatID(buf, address, NoAt)
+  return buf.String()
+}
+
+// recoverError returns whether the parser recovers from the syntax error that
+// was just reported. If it does not, the error production must abort the parse,
+// so that a failed statement is not mistaken for a parsed one.
+func recoverError(yylex yyLexer) bool {
+  return yylex.(*Tokenizer).Recover
+}
+
+// badStatement returns the statement for a command with a syntax error: the
+// partially parsed DDL if there is one, or a BadStmt otherwise.
+func badStatement(yylex yyLexer) Statement {
+  if ddl := yylex.(*Tokenizer).partialDDL; ddl != nil {
+    return ddl
+  }
+  return &BadStmt{}
+}
+
+// badDefinition returns the placeholder for a definition of a CREATE TABLE or
+// an option of an ALTER TABLE that has a syntax error, and skips the rest of
+// it. The definition starts after the offset start. It returns nil if the
+// definition is missing, like between two commas.
+func badDefinition(yylex yyLexer, start int) *BadDefinition {
+  tkn := yylex.(*Tokenizer)
+  loc := tkn.skipDefinition(start)
+  if loc.Start == loc.End {
+    return nil
+  }
+  def := &BadDefinition{SQL: tkn.buf[loc.Start:loc.End]}
+  setSpan(yylex, def, loc)
+  return def
+}
+
 func incNesting(yylex yyLexer) bool {
   yylex.(*Tokenizer).nesting++
   if yylex.(*Tokenizer).nesting == 200 {
@@ -41,6 +122,14 @@ func decNesting(yylex yyLexer) {
   yylex.(*Tokenizer).nesting--
 }
 
+// realType returns the type that REAL is a synonym for in the SQL mode of the parser.
+func realType(yylex yyLexer, typ string) string {
+  if yylex.(*Tokenizer).parser.mode&SQLModeRealAsFloat != 0 {
+    return "float"
+  }
+  return typ
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -52,8 +141,104 @@ func bindVariable(yylex yyLexer, bvar string) {
   yylex.(*Tokenizer).BindVars[bvar] = struct{}{}
 }
 
+// groupByClause carries the GROUP BY expressions and the WITH ROLLUP
+// modifier from group_by_opt to the SELECT rules.
+type groupByClause struct {
+  exprs  Exprs
+  rollup bool
+}
+
+// roleOrPrivilege is an item of the list following GRANT or REVOKE. Whether the
+// list names roles or privileges is only known once the whole statement is parsed,
+// so an item keeps both interpretations where it can be either.
+type roleOrPrivilege struct {
+  privilege *PrivilegeSpec
+  role      *Account
+}
+
+// toPrivileges returns the list as privileges, or false if any item can only be a role.
+func toPrivileges(list []roleOrPrivilege) (Privileges, bool) {
+  privileges := make(Privileges, 0, len(list))
+  for _, item := range list {
+    if item.privilege == nil {
+      return nil, false
+    }
+    privileges = append(privileges, item.privilege)
+  }
+  return privileges, true
+}
+
+// userOptions carries the options that follow the users of CREATE USER and
+// ALTER USER to the statement.
+type userOptions struct {
+  tls       TLSOptions
+  resources ResourceOptions
+  passwords PasswordOptions
+  lock      AccountLockType
+  comment   string
+  attribute string
+}
+
+// toRoles returns the list as roles, or false if any item can only be a privilege.
+func toRoles(list []roleOrPrivilege) (Accounts, bool) {
+  roles := make(Accounts, 0, len(list))
+  for _, item := range list {
+    if item.role == nil {
+      return nil, false
+    }
+    roles = append(roles, item.role)
+  }
+  return roles, true
+}
+
+// isSignalInformationItem returns whether the name is a condition information item
+// that can be assigned in the SET clause of SIGNAL and RESIGNAL.
+func isSignalInformationItem(name string) bool {
+  switch name {
+  case "class_origin", "subclass_origin", "message_text", "mysql_errno", "constraint_catalog", "constraint_schema",
+    "constraint_name", "catalog_name", "schema_name", "table_name", "column_name", "cursor_name":
+    return true
+  }
+  return false
+}
+
+// checkParamCount reports an error and returns false when the number of arguments
+// in a call to a native function is not between min and max.
+func checkParamCount(yylex yyLexer, name string, exprs Exprs, min, max int) bool {
+  if len(exprs) < min || len(exprs) > max {
+    yylex.Error("incorrect parameter count in the call to native function '" + name + "'")
+    return false
+  }
+  return true
+}
+
+// exprAt returns the i-th expression of the list, or nil if the list is shorter.
+func exprAt(exprs Exprs, i int) Expr {
+  if i < len(exprs) {
+    return exprs[i]
+  }
+  return nil
+}
+
+// setColumnLevel marks a table level target as column level when any of the
+// privileges is granted for specific columns.
+func setColumnLevel(target *GrantTarget, privileges Privileges) *GrantTarget {
+  if target.Level != TablePrivilegeLevel {
+    return target
+  }
+  for _, privilege := range privileges {
+    if len(privilege.Columns) > 0 {
+      target.Level = ColumnPrivilegeLevel
+      break
+    }
+  }
+  return target
+}
+
 %}
 
+%locations
+
 %struct {
   empty         struct{}
   LengthScaleOption LengthScaleOption
@@ -164,7 +349,16 @@ func bindVariable(yylex yyLexer, bvar string) {
   isolationLevel IsolationLevel
   insertAction InsertAction
   scope 	Scope
-  lock 		Lock
+  lockClauses 	LockClauses
+  rowAlias 	*RowAlias
+  xid 		*Xid
+  xaOption 	XAOption
+  killType 	KillType
+  replicaThreads ReplicaThreads
+  replicationOption *ReplicationOption
+  replicationOptions ReplicationOptions
+  lockClause 	*LockClause
+  lockWaitType 	LockWaitType
   joinType  	JoinType
   comparisonExprOperator ComparisonExprOperator
   isExprOperator IsExprOperator
@@ -191,6 +385,61 @@ func bindVariable(yylex yyLexer, bvar string) {
   jtColumnDefinition *JtColumnDefinition
   jtColumnList	[]*JtColumnDefinition
   jtOnResponse	*JtOnResponse
+
+  frameUnitType   FrameUnitType
+  frameClause     *FrameClause
+  framePoint      *FramePoint
+  windowSpecification *WindowSpecification
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
+  overClause      *OverClause
+  nullTreatmentClause *NullTreatmentClause
+  fromFirstLastClause *FromFirstLastClause
+
+  roleOrPrivilege roleOrPrivilege
+  roleOrPrivileges []roleOrPrivilege
+  grantTarget     *GrantTarget
+  account         *Account
+  accounts        Accounts
+  authOption      *AuthOption
+  userSpec        *UserSpec
+  userSpecs       UserSpecs
+  roleSpec        *RoleSpec
+  tlsOption       *TLSOption
+  tlsOptions      TLSOptions
+  resourceOption  *ResourceOption
+  resourceOptions ResourceOptions
+  passwordOption  *PasswordOption
+  userOptions     userOptions
+  renameUserPairs []*RenameUserPair
+
+  compoundStatements     CompoundStatements
+  procParameter          *ProcParameter
+  procParameters         []*ProcParameter
+  procParameterMode      ProcParameterMode
+  routineCharacteristic  *RoutineCharacteristic
+  routineCharacteristics []*RoutineCharacteristic
+  triggerTimingType      TriggerTimingType
+  triggerEventType       TriggerEventType
+  triggerOrder           *TriggerOrder
+  eventSchedule          *EventSchedule
+  eventOnCompletionType  EventOnCompletionType
+  eventStatusType        EventStatusType
+  handlerActionType      HandlerActionType
+  handlerCondition       *HandlerCondition
+  handlerConditions      []*HandlerCondition
+  elseIfs                []*ElseIf
+  caseStmtWhen           *CaseStmtWhen
+  caseStmtWhens          []*CaseStmtWhen
+  signalInfo             *SignalInfo
+  signalInfos            []*SignalInfo
+  loadPriority           LoadPriority
+  loadDuplicateType      LoadDuplicateType
+  loadFields             *LoadFields
+  loadLines              *LoadLines
+  maintenanceOption      MaintenanceOption
+  maintenanceOptions     []MaintenanceOption
+  groupBy                groupByClause
 }
 
 // These precedence rules are there to handle shift-reduce conflicts.
@@ -206,9 +455,21 @@ func bindVariable(yylex yyLexer, bvar string) {
 // In order to ensure lower precedence of reduction, this rule has to come before the precedence declaration of '('.
 // This precedence should not be used anywhere else other than with function names that are non-reserved-keywords.
 %nonassoc <str> FUNCTION_CALL_NON_KEYWORD
+// PROXY_NON_KEYWORD resolves the shift-reduce conflict between GRANT PROXY ON and granting a privilege
+// called proxy. Reducing PROXY to a non-reserved keyword gets a lower precedence than shifting ON.
+%nonassoc <str> PROXY_NON_KEYWORD
+// PASSWORD_NON_KEYWORD resolves the shift-reduce conflict between SET PASSWORD = 'secret' and setting
+// a variable called password. Reducing PASSWORD to a non-reserved keyword gets a lower precedence than
+// shifting '=', so that the statement always changes the password as it does in MySQL.
+%nonassoc <str> PASSWORD_NON_KEYWORD
+// SHOW_SKIP_TO_END resolves the shift-reduce conflict between SHOW COUNT(*) WARNINGS and the catch-all
+// for other SHOW statements. Reducing the skipped tail of the catch-all after an opening parenthesis gets a lower precedence
+// than shifting '*', so that COUNT(*) is parsed rather than skipped.
+%nonassoc <str> SHOW_SKIP_TO_END
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION EXCEPT
+%left <str> INTERSECT
 %token <str> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
@@ -220,8 +481,22 @@ func bindVariable(yylex yyLexer, bvar string) {
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING INPLACE COPY INSTANT ALGORITHM NONE SHARED EXCLUSIVE
 %left <str> SUBQUERY_AS_EXPR
+// EMPTY_LENGTH_OPT resolves the shift-reduce conflict between the length of the return type of a stored function
+// and a function body that starts with a parenthesized query. An omitted length gets a lower precedence than
+// shifting '(', so that RETURNS INT (11) always reads the length.
+%nonassoc <str> EMPTY_LENGTH_OPT
 %left <str> '(' ',' ')'
+// EMPTY_ALIAS_OPT and NO_OVER_CLAUSE resolve the shift-reduce conflicts of WINDOW, ROWS and OVER, which are
+// not reserved keywords. An omitted alias or window name gets a higher precedence than shifting them, so that
+// WINDOW after a select expression or a table starts the WINDOW clause and ROWS at the start of a window starts
+// its frame. A function call without an OVER clause gets a lower one, so that OVER after it starts the clause.
+%nonassoc <str> NO_OVER_CLAUSE
+%nonassoc <str> WINDOW ROWS OVER
+%nonassoc <str> EMPTY_ALIAS_OPT
 %token <str> ID AT_ID AT_AT_ID HEX STRING NCHAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL COMPRESSION
+%token <str> REGEXP_INSTR REGEXP_LIKE REGEXP_REPLACE REGEXP_SUBSTR
+%token <str> MBRCONTAINS MBRCOVEREDBY MBRCOVERS MBRDISJOINT MBREQUALS MBRINTERSECTS MBROVERLAPS MBRTOUCHES MBRWITHIN
+%token <str> ST_ASBINARY ST_ASTEXT ST_CONTAINS ST_CROSSES ST_DISJOINT ST_DISTANCE ST_DISTANCE_SPHERE ST_EQUALS ST_GEOMCOLLFROMTEXT ST_GEOMFROMTEXT ST_INTERSECTS ST_LINEFROMTEXT ST_MLINEFROMTEXT ST_MPOINTFROMTEXT ST_MPOLYFROMTEXT ST_OVERLAPS ST_POINTFROMTEXT ST_POLYFROMTEXT ST_SRID ST_TOUCHES ST_WITHIN
 %token <str> JSON_PRETTY JSON_STORAGE_SIZE JSON_STORAGE_FREE JSON_CONTAINS JSON_CONTAINS_PATH JSON_EXTRACT JSON_KEYS JSON_OVERLAPS JSON_SEARCH JSON_VALUE
 %token <str> EXTRACT
 %token <str> NULL TRUE FALSE OFF
@@ -245,7 +520,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %left <str> OR '|'
 %left <str> XOR
 %left <str> AND
-%right <str> NOT '!'
+%right <str> NOT HIGH_NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
 %left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN
 %left <str> '&'
@@ -253,6 +528,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %left <str> '+' '-'
 %left <str> '*' '/' DIV '%' MOD
 %left <str> '^'
+%left <str> PIPE_CONCAT
 %right <str> '~' UNARY
 %left <str> COLLATE
 %right <str> BINARY UNDERSCORE_ARMSCII8 UNDERSCORE_ASCII UNDERSCORE_BIG5 UNDERSCORE_BINARY UNDERSCORE_CP1250 UNDERSCORE_CP1251
@@ -283,8 +559,33 @@ func bindVariable(yylex yyLexer, bvar string) {
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE CLEANUP THROTTLE UNTHROTTLE EXPIRE RATIO
 
+// Account management tokens
+%token <str> GRANT REVOKE USAGE IDENTIFIED ACCOUNT ROUTINE REPLICATION PROXY ATTRIBUTE
+%token <str> REQUIRE SSL X509 CIPHER ISSUER SUBJECT
+%token <str> MAX_QUERIES_PER_HOUR MAX_UPDATES_PER_HOUR MAX_CONNECTIONS_PER_HOUR MAX_USER_CONNECTIONS
+%token <str> NEVER FAILED_LOGIN_ATTEMPTS PASSWORD_LOCK_TIME
+
+// Stored program tokens
+%token <str> DECLARE CURSOR CONDITION HANDLER CONTINUE EXIT UNDO SQLSTATE SQLWARNING SQLEXCEPTION FOUND
+%token <str> ELSEIF LOOP WHILE REPEAT UNTIL LEAVE ITERATE RETURN RETURNS SIGNAL RESIGNAL FETCH CLOSE
+%token <str> INOUT OUT DETERMINISTIC CONTAINS READS MODIFIES EACH BEFORE PRECEDES FOLLOWS
+%token <str> SCHEDULE AT EVERY STARTS ENDS COMPLETION PRESERVE
+
+// LOAD DATA tokens
+%token <str> INFILE CONCURRENT
+
+// Table maintenance tokens
+%token <str> QUICK FAST MEDIUM CHANGED USE_FRM
+
+// GROUP BY modifier tokens
+%token <str> WITH_ROLLUP
+
 // Transaction Tokens
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
+%token <str> XA XID RESUME SUSPEND MIGRATE ONE PHASE RECOVER
+%token <str> KILL SHUTDOWN INSTALL UNINSTALL PLUGIN SONAME
+%token <str> SOURCE MASTER REPLICA SLAVE STOP RESET PURGE BINLOG EVENTS IO_THREAD SQL_THREAD RELAY_THREAD
+%token <str> GRANTS ERRORS PROFILE PROFILES REPLICAS MUTEX RELAYLOG
 
 // Type Tokens
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -327,9 +628,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <str> UNUSED ARRAY BYTE CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD
-%token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <str> ACTIVE ADMIN AUTOEXTEND_SIZE BUCKETS CLONE COLUMN_FORMAT COMPONENT DEFINITION ENFORCED ENGINE_ATTRIBUTE EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <str> UNUSED ARRAY BYTE CUME_DIST DESCRIPTION DENSE_RANK EMPTY FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD
+%token <str> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW ROW_NUMBER SYSTEM
+%token <str> ACTIVE ADMIN AUTOEXTEND_SIZE BUCKETS CLONE COLUMN_FORMAT COMPONENT CURRENT DEFINITION ENFORCED ENGINE_ATTRIBUTE EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PARTIAL PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
 %token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_ENGINE_ATTRIBUTE SECONDARY_LOAD SECONDARY_UNLOAD SIMPLE SKIP SRID
@@ -355,6 +656,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <integer> partitions_opt algorithm_opt subpartitions_opt partition_max_rows partition_min_rows
 %type <statement> command
 %type <selStmt> query_expression_parens query_expression query_expression_body select_statement query_primary select_stmt_with_into
+%type <selStmt> query_term union_operand intersect_operand
 %type <statement> explain_statement explainable_statement
 %type <statement> prepare_statement
 %type <statement> execute_statement deallocate_statement
@@ -374,10 +676,88 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <databaseOption> collate character_set encryption
 %type <databaseOptions> create_options create_options_opt
 %type <boolean> default_optional first_opt linear_opt jt_exists_opt jt_path_opt partition_storage_opt
-%type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
+%type <statement> analyze_statement show_statement use_statement table_maintenance_statement
+%type <statement> begin_statement start_transaction_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
+%type <statement> xa_statement
+%type <xid> xid
+%type <literal> xid_string
+%type <xaOption> xa_start_option_opt xa_end_option_opt xa_commit_option_opt
+%type <boolean> xa_convert_xid_opt
+%type <statement> kill_statement shutdown_statement install_statement uninstall_statement
+%type <killType> kill_type_opt
+%type <strs> component_list
+%type <statement> change_replication_source_statement start_replica_statement stop_replica_statement
+%type <statement> reset_replication_statement purge_binary_logs_statement
+%type <boolean> replica_or_slave binary_or_master all_opt
+%type <replicaThreads> replica_thread_list_opt replica_thread_list replica_thread
+%type <replicationOptions> replica_until_opt replica_until_option_list replica_connection_option_list_opt
+%type <replicationOptions> replica_connection_option_list replication_option_list
+%type <replicationOption> replica_until_option replica_connection_option replication_option
+%type <expr> replication_option_value
+%type <exprs> server_id_list
+%type <str> channel_opt binlog_in_opt
+%type <literal> binlog_from_opt profile_query_opt
+%type <strs> profile_type_list_opt profile_type_list
+%type <str> profile_type
 %type <statement> lock_statement unlock_statement call_statement
 %type <statement> revert_statement
+%type <statement> grant_statement revoke_statement
+%type <roleOrPrivilege> role_or_privilege
+%type <roleOrPrivileges> role_or_privilege_list
+%type <grantTarget> grant_target priv_level
+%type <account> account_name role_name
+%type <accounts> account_list role_list
+%type <str> account_user role_user auth_plugin
+%type <authOption> auth_option_opt
+%type <userSpec> user_spec
+%type <userSpecs> user_spec_list
+%type <roleSpec> role_spec default_role_spec default_role_opt
+%type <tlsOption> tls_option
+%type <tlsOptions> tls_options_opt tls_option_list
+%type <resourceOption> resource_option
+%type <resourceOptions> resource_options_opt resource_option_list
+%type <passwordOption> password_option
+%type <userOptions> user_options password_lock_options_opt
+%type <renameUserPairs> rename_user_list
+%type <boolean> grant_option_opt admin_option_opt retain_current_password_opt
+%type <str> replace_password_opt
+%type <statement> routine_statement compound_statement labelable_statement event_do_opt
+%type <compoundStatements> compound_statement_list compound_statement_list_opt compound_else_opt
+%type <str> end_label_opt sqlstate_value event_comment_opt
+%type <procParameter> proc_parameter
+%type <procParameters> proc_parameter_list proc_parameter_list_opt
+%type <procParameterMode> proc_parameter_mode_opt
+%type <routineCharacteristic> routine_characteristic
+%type <routineCharacteristics> routine_characteristic_list routine_characteristic_list_opt
+%type <triggerTimingType> trigger_timing
+%type <triggerEventType> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <eventSchedule> event_schedule
+%type <eventOnCompletionType> event_on_completion_opt
+%type <eventStatusType> event_status_opt
+%type <expr> event_starts_opt event_ends_opt declare_default_opt
+%type <handlerActionType> handler_action
+%type <handlerCondition> handler_condition condition_value signal_condition
+%type <handlerConditions> handler_condition_list
+%type <elseIfs> elseif_list elseif_list_opt
+%type <caseStmtWhen> case_stmt_when
+%type <caseStmtWhens> case_stmt_when_list
+%type <signalInfo> signal_info
+%type <signalInfos> signal_info_list signal_info_opt
+%type <empty> fetch_from_opt
+%type <loadPriority> load_priority_opt
+%type <loadDuplicateType> load_duplicate_opt
+%type <loadFields> load_fields_opt load_fields_list load_fields_option
+%type <loadLines> load_lines_opt load_lines_list load_lines_option
+%type <str> load_charset_opt
+%type <literal> load_ignore_lines_opt
+%type <columns> load_column_list load_column_list_opt
+%type <updateExprs> load_set_opt
+%type <boolean> load_local_opt
+%type <literal> histogram_buckets_opt
+%type <empty> table_or_tables
+%type <maintenanceOption> repair_option check_option
+%type <maintenanceOptions> repair_option_list repair_option_list_opt check_option_list check_option_list_opt checksum_option_opt
 %type <strs> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
@@ -393,7 +773,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <intervalType> interval_time_stamp interval
 %type <str> cache_opt separator_opt flush_option for_channel_opt maxvalue
 %type <matchExprOption> match_option
-%type <boolean> distinct_opt union_op replace_opt local_opt
+%type <boolean> distinct_opt union_op intersect_op except_op replace_opt local_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
 %type <selectExpr> select_expression
 %type <strs> select_options flush_option_list
@@ -409,7 +789,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <joinCondition> join_condition join_condition_opt on_expression_opt
 %type <tableNames> table_name_list delete_table_list view_name_list
 %type <joinType> inner_join outer_join straight_join natural_join
-%type <tableName> table_name into_table_name delete_table_name
+%type <tableName> table_name into_table_name delete_table_name event_rename_opt
 %type <aliasedTableName> aliased_table_name
 %type <indexHint> index_hint
 %type <indexHintForType> index_hint_for_opt
@@ -423,7 +803,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
-%type <values> tuple_list
+%type <values> tuple_list row_constructor_list
 %type <valTuple> row_tuple tuple_or_empty
 %type <subquery> subquery
 %type <derivedTable> derived_table
@@ -431,7 +811,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <groupBy> group_by_opt
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list order_by_clause
 %type <order> order
@@ -441,7 +821,23 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <columnTypeOptions> column_attribute_list_opt generated_column_attribute_list_opt
 %type <str> header_opt export_options manifest_opt overwrite_opt format_opt optionally_opt
 %type <str> fields_opts fields_opt_list fields_opt lines_opts lines_opt lines_opt_list
-%type <lock> locking_clause
+%type <lockClauses> locking_clause_list
+%type <rowAlias> row_alias_opt
+%type <lockClause> locking_clause
+%type <lockWaitType> lock_wait_opt
+%type <tableNames> lock_tables_opt
+%type <frameUnitType> frame_units
+%type <frameClause> frame_clause frame_clause_opt
+%type <framePoint> frame_point
+%type <expr> frame_expression null_int_variable_arg default_with_comma_opt
+%type <exprs> window_partition_clause_opt
+%type <colIdent> sql_id_opt
+%type <windowSpecification> window_spec
+%type <windowDefinition> window_definition
+%type <windowDefinitions> window_definition_list window_clause_opt
+%type <overClause> over_clause
+%type <nullTreatmentClause> null_treatment_clause_opt
+%type <fromFirstLastClause> from_first_last_clause_opt
 %type <columns> ins_column_list column_list at_id_list column_list_opt index_list execute_statement_list_opt
 %type <partitions> opt_partition_clause partition_list
 %type <updateExprs> on_dup_opt
@@ -456,9 +852,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <str> for_from from_or_on
 %type <str> default_opt
 %type <ignore> ignore_opt
-%type <str> columns_or_fields extended_opt storage_opt
+%type <str> columns_or_fields storage_opt
 %type <showFilter> like_or_where_opt like_opt
-%type <boolean> exists_opt not_exists_opt enforced enforced_opt temp_opt full_opt
+%type <boolean> exists_opt not_exists_opt enforced enforced_opt temp_opt full_opt extended_opt
 %type <empty> to_opt
 %type <str> reserved_keyword non_reserved_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt
@@ -530,6 +926,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 any_command:
   command semicolon_opt
   {
+    setSpan(yylex, $1, @1)
     setParseTree(yylex, $1)
   }
 
@@ -562,8 +959,18 @@ command:
 | rollback_statement
 | savepoint_statement
 | release_statement
+| xa_statement
+| kill_statement
+| shutdown_statement
+| install_statement
+| uninstall_statement
+| change_replication_source_statement
+| start_replica_statement
+| stop_replica_statement
+| reset_replication_statement
+| purge_binary_logs_statement
 | explain_statement
-| other_statement
+| table_maintenance_statement
 | flush_statement
 | do_statement
 | load_statement
@@ -574,10 +981,19 @@ command:
 | prepare_statement
 | execute_statement
 | deallocate_statement
+| grant_statement
+| revoke_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
 }
+| error
+  {
+    if !recoverError(yylex) {
+      return 1
+    }
+    $$ = badStatement(yylex)
+  }
 
 id_or_var:
   ID
@@ -586,7 +1002,7 @@ id_or_var:
   }
 | AT_ID
   {
-    $$ = NewColIdentWithAt(string($1), SingleAt)
+    $$ = NewColIdentWithAt(unquoteVariable(yylex, $1), SingleAt)
   }
 | AT_AT_ID
   {
@@ -605,15 +1021,173 @@ id_or_var_opt:
 do_statement:
   DO expression_list
   {
-    $$ = &OtherAdmin{}
+    $$ = &DoStmt{Exprs: $2}
   }
 
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt load_local_opt INFILE STRING load_duplicate_opt INTO TABLE table_name opt_partition_clause load_charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_column_list_opt load_set_opt
+  {
+    $$ = &Load{Priority: $3, Local: $4, FileName: $6, Duplicate: $7, Table: $10, Partitions: $11, Charset: $12, Fields: $13, Lines: $14, IgnoreLines: $15, Columns: $16, SetExprs: $17}
+  }
+| LOAD DATA FROM skip_to_end
   {
+    // LOAD DATA FROM S3 is an Aurora extension which is not parsed.
     $$ = &Load{}
   }
 
+load_priority_opt:
+  {
+    $$ = DefaultLoadPriority
+  }
+| LOW_PRIORITY
+  {
+    $$ = LowPriorityLoad
+  }
+| CONCURRENT
+  {
+    $$ = ConcurrentLoad
+  }
+
+load_local_opt:
+  {
+    $$ = false
+  }
+| LOCAL
+  {
+    $$ = true
+  }
+
+load_duplicate_opt:
+  {
+    $$ = NoLoadDuplicate
+  }
+| REPLACE
+  {
+    $$ = ReplaceLoadDuplicate
+  }
+| IGNORE
+  {
+    $$ = IgnoreLoadDuplicate
+  }
+
+load_charset_opt:
+  {
+    $$ = ""
+  }
+| charset_or_character_set charset
+  {
+    $$ = $2
+  }
+
+load_fields_opt:
+  {
+    $$ = nil
+  }
+| columns_or_fields load_fields_list
+  {
+    $$ = $2
+  }
+
+load_fields_list:
+  load_fields_option
+  {
+    $$ = $1
+  }
+| load_fields_list load_fields_option
+  {
+    $1.merge($2)
+    $$ = $1
+  }
+
+load_fields_option:
+  TERMINATED BY STRING
+  {
+    $$ = &LoadFields{Terminated: NewStrLiteral($3)}
+  }
+| ENCLOSED BY STRING
+  {
+    $$ = &LoadFields{Enclosed: NewStrLiteral($3)}
+  }
+| OPTIONALLY ENCLOSED BY STRING
+  {
+    $$ = &LoadFields{Optionally: true, Enclosed: NewStrLiteral($4)}
+  }
+| ESCAPED BY STRING
+  {
+    $$ = &LoadFields{Escaped: NewStrLiteral($3)}
+  }
+
+load_lines_opt:
+  {
+    $$ = nil
+  }
+| LINES load_lines_list
+  {
+    $$ = $2
+  }
+
+load_lines_list:
+  load_lines_option
+  {
+    $$ = $1
+  }
+| load_lines_list load_lines_option
+  {
+    $1.merge($2)
+    $$ = $1
+  }
+
+load_lines_option:
+  STARTING BY STRING
+  {
+    $$ = &LoadLines{Starting: NewStrLiteral($3)}
+  }
+| TERMINATED BY STRING
+  {
+    $$ = &LoadLines{Terminated: NewStrLiteral($3)}
+  }
+
+load_ignore_lines_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = NewIntLiteral($2)
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+load_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb load_column_list closeb
+  {
+    $$ = $2
+  }
+
+load_column_list:
+  sql_id
+  {
+    $$ = Columns{$1}
+  }
+| load_column_list ',' sql_id
+  {
+    $$ = append($1, $3)
+  }
+
+load_set_opt:
+  {
+    $$ = nil
+  }
+| SET update_list
+  {
+    $$ = $2
+  }
+
 with_clause:
   WITH with_list
   {
@@ -656,11 +1230,13 @@ query_expression_parens:
   }
 | openb query_expression closeb
   {
+     setSpan(yylex, $2, @2)
      $$ = $2
   }
-| openb query_expression locking_clause closeb
+| openb query_expression locking_clause_list closeb
   {
     setLockInSelect($2, $3)
+    setSpan(yylex, $2, yyLoc{Start: @2.Start, End: @3.End})
     $$ = $2
   }
 
@@ -727,37 +1303,89 @@ query_expression:
 	$$ = NewSelect(Comments($2), SelectExprs{&Nextval{Expr: $5}}, []string{$3}/*options*/, nil, TableExprs{&AliasedTableExpr{Expr: $7}}, nil/*where*/, nil/*groupBy*/, nil/*having*/)
   }
 
+// INTERSECT binds tighter than UNION and EXCEPT, so a query_expression_body
+// is a UNION or EXCEPT of query_terms, and a query_term is an INTERSECT of
+// query_primaries. A parenthesized operand is reduced with the precedence of
+// UNION, so that an INTERSECT that follows it is shifted instead.
 query_expression_body:
- query_primary
+ query_term %prec UNION
+  {
+	$$ = $1
+  }
+| query_expression_body union_op union_operand
+  {
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+ 	$$ = &Union{Left: $1, Operator: UnionOp, Distinct: $2, Right: $3}
+  }
+| query_expression_parens union_op union_operand
+  {
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+	$$ = &Union{Left: $1, Operator: UnionOp, Distinct: $2, Right: $3}
+  }
+| query_expression_body except_op union_operand
+  {
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+ 	$$ = &Union{Left: $1, Operator: ExceptOp, Distinct: $2, Right: $3}
+  }
+| query_expression_parens except_op union_operand
+  {
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+	$$ = &Union{Left: $1, Operator: ExceptOp, Distinct: $2, Right: $3}
+  }
+
+union_operand:
+  query_term %prec UNION
+  {
+	$$ = $1
+  }
+| query_expression_parens %prec UNION
+  {
+	$$ = $1
+  }
+
+query_term:
+  query_primary
   {
 	$$ = $1
   }
-| query_expression_body union_op query_primary
+| query_term intersect_op intersect_operand
   {
- 	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+	$$ = &Union{Left: $1, Operator: IntersectOp, Distinct: $2, Right: $3}
   }
-| query_expression_parens union_op query_primary
+| query_expression_parens intersect_op intersect_operand
   {
-	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+	setSpan(yylex, $1, @1)
+	setSpan(yylex, $3, @3)
+	$$ = &Union{Left: $1, Operator: IntersectOp, Distinct: $2, Right: $3}
   }
-| query_expression_body union_op query_expression_parens
+
+intersect_operand:
+  query_primary
   {
-  	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+	$$ = $1
   }
-| query_expression_parens union_op query_expression_parens
+| query_expression_parens
   {
-	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+	$$ = $1
   }
 
 select_statement:
 query_expression
   {
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
-| query_expression locking_clause
+| query_expression locking_clause_list
   {
 	setLockInSelect($1, $2)
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
 | query_expression_parens
   {
@@ -766,6 +1394,7 @@ query_expression
 | select_stmt_with_into
   {
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
 
 select_stmt_with_into:
@@ -778,13 +1407,13 @@ select_stmt_with_into:
 	$1.SetInto($2)
 	$$ = $1
   }
-| query_expression into_clause locking_clause
+| query_expression into_clause locking_clause_list
   {
 	$1.SetInto($2)
 	$1.SetLock($3)
 	$$ = $1
   }
-| query_expression locking_clause into_clause
+| query_expression locking_clause_list into_clause
   {
 	$1.SetInto($3)
 	$1.SetLock($2)
@@ -810,14 +1439,38 @@ vstream_statement:
 
 // query_primary is an unparenthesized SELECT with no order by clause or beyond.
 query_primary:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8            9          10
+  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt window_clause_opt
+  {
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8.exprs), NewWhere(HavingClause, $9))
+    sel.WithRollup = $8.rollup
+    sel.Windows = $10
+    $$ = sel
+  }
+| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
+  {
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7.exprs), NewWhere(HavingClause, $8))
+    sel.WithRollup = $7.rollup
+    sel.Windows = $9
+    $$ = sel
+  }
+| VALUES comment_opt row_constructor_list
+  {
+    $$ = &ValuesStatement{Comments: Comments($2).Parsed(), Rows: $3}
+  }
+| TABLE comment_opt table_name
+  {
+    $$ = &TableStatement{Comments: Comments($2).Parsed(), Table: $3}
+  }
+
+row_constructor_list:
+  ROW row_tuple
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    $$ = Values{$2}
   }
-| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+| row_constructor_list ',' ROW row_tuple
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    $$ = append($1, $4)
   }
 
 
@@ -835,15 +1488,15 @@ insert_statement:
     ins.OnDup = OnDup($7)
     $$ = ins
   }
-| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
+| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list row_alias_opt on_dup_opt
   {
     cols := make(Columns, 0, len($7))
-    vals := make(ValTuple, 0, len($8))
+    vals := make(ValTuple, 0, len($7))
     for _, updateList := range $7 {
       cols = append(cols, updateList.Name.Name)
       vals = append(vals, updateList.Expr)
     }
-    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: $4, Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
+    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: $4, Partitions: $5, Columns: cols, Rows: Values{vals}, RowAlias: $8, OnDup: OnDup($9)}
   }
 
 insert_or_replace:
@@ -865,7 +1518,9 @@ update_statement:
 delete_statement:
   with_clause_opt DELETE comment_opt ignore_opt FROM table_name as_opt_id opt_partition_clause where_expression_opt order_by_opt limit_opt
   {
-    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: TableExprs{&AliasedTableExpr{Expr:$6, As: $7}}, Partitions: $8, Where: NewWhere(WhereClause, $9), OrderBy: $10, Limit: $11}
+    tableExpr := &AliasedTableExpr{Expr:$6, As: $7}
+    setSpan(yylex, tableExpr, yyLoc{Start: @6.Start, End: @7.End})
+    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: TableExprs{tableExpr}, Partitions: $8, Where: NewWhere(WhereClause, $9), OrderBy: $10, Limit: $11}
   }
 | with_clause_opt DELETE comment_opt ignore_opt FROM table_name_list USING table_references where_expression_opt
   {
@@ -928,6 +1583,48 @@ set_statement:
   {
     $$ = &Set{Comments: Comments($2).Parsed(), Exprs: $3}
   }
+| SET comment_opt ROLE role_spec
+  {
+    $$ = &SetRole{Roles: $4}
+  }
+| SET comment_opt DEFAULT ROLE default_role_spec TO account_list
+  {
+    $$ = &SetDefaultRole{Roles: $5, To: $7}
+  }
+| SET comment_opt PASSWORD '=' STRING replace_password_opt retain_current_password_opt
+  {
+    $$ = &SetPassword{Password: $5, Replace: $6, RetainCurrent: $7}
+  }
+| SET comment_opt PASSWORD TO RANDOM replace_password_opt retain_current_password_opt
+  {
+    $$ = &SetPassword{RandomPassword: true, Replace: $6, RetainCurrent: $7}
+  }
+| SET comment_opt PASSWORD FOR account_name '=' STRING replace_password_opt retain_current_password_opt
+  {
+    $$ = &SetPassword{User: $5, Password: $7, Replace: $8, RetainCurrent: $9}
+  }
+| SET comment_opt PASSWORD FOR account_name TO RANDOM replace_password_opt retain_current_password_opt
+  {
+    $$ = &SetPassword{User: $5, RandomPassword: true, Replace: $8, RetainCurrent: $9}
+  }
+
+replace_password_opt:
+  {
+    $$ = ""
+  }
+| REPLACE STRING
+  {
+    $$ = $2
+  }
+
+retain_current_password_opt:
+  {
+    $$ = false
+  }
+| RETAIN CURRENT PASSWORD
+  {
+    $$ = true
+  }
 
 set_transaction_statement:
   SET comment_opt set_session_or_global TRANSACTION transaction_chars
@@ -1024,26 +1721,78 @@ create_statement:
     $1.CreateOptions = $2
     $$ = $1
   }
-
-replace_opt:
+| CREATE comment_opt USER not_exists_opt user_spec_list default_role_opt user_options
   {
-    $$ = false
+    for _, user := range $5 {
+      if auth := user.Auth; auth != nil && (auth.Replace != "" || auth.RetainCurrent || auth.DiscardOldPassword) {
+        yylex.Error("REPLACE, RETAIN CURRENT PASSWORD and DISCARD OLD PASSWORD are only supported for ALTER USER")
+        return 1
+      }
+    }
+    $$ = &CreateUser{IfNotExists: $4, Users: $5, DefaultRole: $6, TLSOptions: $7.tls, ResourceOptions: $7.resources, PasswordOptions: $7.passwords, AccountLock: $7.lock, Comment: $7.comment, Attribute: $7.attribute}
   }
-| OR REPLACE
+| CREATE comment_opt ROLE not_exists_opt account_list
   {
-    $$ = true
+    $$ = &CreateRole{IfNotExists: $4, Roles: $5}
   }
-
-vindex_type_opt:
+| CREATE comment_opt replace_opt algorithm_view definer_opt PROCEDURE not_exists_opt table_name '(' proc_parameter_list_opt ')' routine_characteristic_list_opt routine_statement
   {
-    $$ = NewColIdent("")
+    if $3 || $4 != "" {
+      yylex.Error("OR REPLACE and ALGORITHM are only supported for views")
+      return 1
+    }
+    $$ = &CreateProcedure{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Params: $10, Characteristics: $12, Body: $13}
   }
-| USING vindex_type
+| CREATE comment_opt replace_opt algorithm_view definer_opt FUNCTION not_exists_opt table_name '(' proc_parameter_list_opt ')' RETURNS column_type routine_characteristic_list_opt routine_statement
   {
-    $$ = $2
+    if $3 || $4 != "" {
+      yylex.Error("OR REPLACE and ALGORITHM are only supported for views")
+      return 1
+    }
+    for _, param := range $10 {
+      if param.Mode != NoParameterMode {
+        yylex.Error("IN, OUT and INOUT are only supported for procedure parameters")
+        return 1
+      }
+    }
+    $$ = &CreateFunction{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Params: $10, Returns: $13, Characteristics: $14, Body: $15}
   }
-
-vindex_type:
+| CREATE comment_opt replace_opt algorithm_view definer_opt TRIGGER not_exists_opt table_name trigger_timing trigger_event ON table_name FOR EACH ROW trigger_order_opt routine_statement
+  {
+    if $3 || $4 != "" {
+      yylex.Error("OR REPLACE and ALGORITHM are only supported for views")
+      return 1
+    }
+    $$ = &CreateTrigger{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Timing: $9, Event: $10, Table: $12, Order: $16, Body: $17}
+  }
+| CREATE comment_opt replace_opt algorithm_view definer_opt EVENT not_exists_opt table_name ON SCHEDULE event_schedule event_on_completion_opt event_status_opt event_comment_opt DO routine_statement
+  {
+    if $3 || $4 != "" {
+      yylex.Error("OR REPLACE and ALGORITHM are only supported for views")
+      return 1
+    }
+    $$ = &CreateEvent{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Schedule: $11, OnCompletion: $12, Status: $13, Comment: $14, Body: $16}
+  }
+
+replace_opt:
+  {
+    $$ = false
+  }
+| OR REPLACE
+  {
+    $$ = true
+  }
+
+vindex_type_opt:
+  {
+    $$ = NewColIdent("")
+  }
+| USING vindex_type
+  {
+    $$ = $2
+  }
+
+vindex_type:
   sql_id
   {
     $$ = $1
@@ -1290,6 +2039,26 @@ table_column_list:
   {
     $$.AddConstraint($3)
   }
+| error
+  {
+    if !recoverError(yylex) {
+      return 1
+    }
+    $$ = &TableSpec{}
+    if def := badDefinition(yylex, @0.End); def != nil {
+      $$.BadDefinitions = append($$.BadDefinitions, def)
+    }
+  }
+| table_column_list error
+  {
+    if !recoverError(yylex) {
+      return 1
+    }
+    if def := badDefinition(yylex, @1.End); def != nil {
+      def.Index = $$.definitionCount()
+      $$.BadDefinitions = append($$.BadDefinitions, def)
+    }
+  }
 
 // collate_opt has to be in the first rule so that we don't have a shift reduce conflict when seeing a COLLATE
 // with column_attribute_list_opt. Always shifting there would have meant that we would have always ended up using the
@@ -1338,7 +2107,7 @@ column_attribute_list_opt:
     $1.Null = &val
     $$ = $1
   }
-| column_attribute_list_opt NOT NULL
+| column_attribute_list_opt not_keyword NULL
   {
     val := false
     $1.Null = &val
@@ -1452,7 +2221,7 @@ generated_column_attribute_list_opt:
     $1.Null = &val
     $$ = $1
   }
-| generated_column_attribute_list_opt NOT NULL
+| generated_column_attribute_list_opt not_keyword NULL
   {
     val := false
     $1.Null = &val
@@ -1878,7 +2647,7 @@ int_type:
 decimal_type:
 REAL float_length_opt
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = ColumnType{Type: realType(yylex, $1)}
     $$.Length = $2.Length
     $$.Scale = $2.Scale
   }
@@ -2019,6 +2788,10 @@ spatial_type:
   {
     $$ = ColumnType{Type: string($1)}
   }
+| GEOMCOLLECTION
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
 | MULTIPOINT
   {
     $$ = ColumnType{Type: string($1)}
@@ -2044,6 +2817,7 @@ enum_values:
   }
 
 length_opt:
+  %prec EMPTY_LENGTH_OPT
   {
     $$ = nil
   }
@@ -2053,6 +2827,7 @@ length_opt:
   }
 
 float_length_opt:
+  %prec EMPTY_LENGTH_OPT
   {
     $$ = LengthScaleOption{}
   }
@@ -2065,6 +2840,7 @@ float_length_opt:
   }
 
 decimal_length_opt:
+  %prec EMPTY_LENGTH_OPT
   {
     $$ = LengthScaleOption{}
   }
@@ -2487,7 +3263,7 @@ enforced:
   {
     $$ = true
   }
-| NOT ENFORCED
+| not_keyword ENFORCED
   {
     $$ = false
   }
@@ -2784,6 +3560,26 @@ alter_options:
   {
     $$ = append($1,$3)
   }
+| error
+  {
+    if !recoverError(yylex) {
+      return 1
+    }
+    $$ = nil
+    if def := badDefinition(yylex, @0.End); def != nil {
+      $$ = append($$, def)
+    }
+  }
+| alter_options error
+  {
+    if !recoverError(yylex) {
+      return 1
+    }
+    $$ = $1
+    if def := badDefinition(yylex, @1.End); def != nil {
+      $$ = append($$, def)
+    }
+  }
 
 alter_option:
   space_separated_table_option_list
@@ -3013,6 +3809,31 @@ alter_statement:
     $1.UpdateDataDirectory = true
     $$ = $1
   }
+| ALTER comment_opt PROCEDURE table_name routine_characteristic_list_opt
+  {
+    $$ = &AlterRoutine{Comments: Comments($2).Parsed(), Type: ProcedureType, Name: $4, Characteristics: $5}
+  }
+| ALTER comment_opt FUNCTION table_name routine_characteristic_list_opt
+  {
+    $$ = &AlterRoutine{Comments: Comments($2).Parsed(), Type: FunctionType, Name: $4, Characteristics: $5}
+  }
+// ALTER EVENT shares its prefix with ALTER VIEW to avoid conflicts on DEFINER.
+| ALTER comment_opt algorithm_view definer_opt security_view_opt EVENT table_name event_on_completion_opt event_rename_opt event_status_opt event_comment_opt event_do_opt
+  {
+    if $3 != "" || $5 != "" {
+      yylex.Error("ALGORITHM and SQL SECURITY are only supported for views")
+      return 1
+    }
+    $$ = &AlterEvent{Comments: Comments($2).Parsed(), Definer: $4, Name: $7, OnCompletion: $8, RenameTo: $9, Status: $10, Comment: $11, Body: $12}
+  }
+| ALTER comment_opt algorithm_view definer_opt security_view_opt EVENT table_name ON SCHEDULE event_schedule event_on_completion_opt event_rename_opt event_status_opt event_comment_opt event_do_opt
+  {
+    if $3 != "" || $5 != "" {
+      yylex.Error("ALGORITHM and SQL SECURITY are only supported for views")
+      return 1
+    }
+    $$ = &AlterEvent{Comments: Comments($2).Parsed(), Definer: $4, Name: $7, Schedule: $10, OnCompletion: $11, RenameTo: $12, Status: $13, Comment: $14, Body: $15}
+  }
 | ALTER comment_opt VSCHEMA CREATE VINDEX table_name vindex_type_opt vindex_params_opt
   {
     $$ = &AlterVschema{
@@ -3145,6 +3966,14 @@ alter_statement:
       Type: UnthrottleAllMigrationType,
     }
   }
+| ALTER comment_opt USER exists_opt user_spec_list user_options
+  {
+    $$ = &AlterUser{IfExists: $4, Users: $5, TLSOptions: $6.tls, ResourceOptions: $6.resources, PasswordOptions: $6.passwords, AccountLock: $6.lock, Comment: $6.comment, Attribute: $6.attribute}
+  }
+| ALTER comment_opt USER exists_opt account_name DEFAULT ROLE default_role_spec
+  {
+    $$ = &AlterUser{IfExists: $4, Users: UserSpecs{{Account: $5}}, DefaultRole: $8}
+  }
 
 partitions_options_opt:
   {
@@ -3700,6 +4529,10 @@ rename_statement:
   {
     $$ = &RenameTable{TablePairs: $3}
   }
+| RENAME USER rename_user_list
+  {
+    $$ = &RenameUser{UserPairs: $3}
+  }
 
 rename_list:
   table_name TO table_name
@@ -3711,6 +4544,16 @@ rename_list:
     $$ = append($1, &RenameTablePair{FromTable: $3, ToTable: $5})
   }
 
+rename_user_list:
+  account_name TO account_name
+  {
+    $$ = []*RenameUserPair{{FromUser: $1, ToUser: $3}}
+  }
+| rename_user_list ',' account_name TO account_name
+  {
+    $$ = append($1, &RenameUserPair{FromUser: $3, ToUser: $5})
+  }
+
 drop_statement:
   DROP comment_opt temp_opt TABLE exists_opt table_name_list restrict_or_cascade_opt
   {
@@ -3733,6 +4576,30 @@ drop_statement:
   {
     $$ = &DropDatabase{Comments: Comments($2).Parsed(), DBName: $5, IfExists: $4}
   }
+| DROP comment_opt USER exists_opt account_list
+  {
+    $$ = &DropUser{IfExists: $4, Users: $5}
+  }
+| DROP comment_opt ROLE exists_opt account_list
+  {
+    $$ = &DropRole{IfExists: $4, Roles: $5}
+  }
+| DROP comment_opt PROCEDURE exists_opt table_name
+  {
+    $$ = &DropProgram{Comments: Comments($2).Parsed(), Type: ProcedureType, IfExists: $4, Name: $5}
+  }
+| DROP comment_opt FUNCTION exists_opt table_name
+  {
+    $$ = &DropProgram{Comments: Comments($2).Parsed(), Type: FunctionType, IfExists: $4, Name: $5}
+  }
+| DROP comment_opt TRIGGER exists_opt table_name
+  {
+    $$ = &DropProgram{Comments: Comments($2).Parsed(), Type: TriggerType, IfExists: $4, Name: $5}
+  }
+| DROP comment_opt EVENT exists_opt table_name
+  {
+    $$ = &DropProgram{Comments: Comments($2).Parsed(), Type: EventType, IfExists: $4, Name: $5}
+  }
 
 truncate_statement:
   TRUNCATE TABLE table_name
@@ -3744,139 +4611,247 @@ truncate_statement:
     $$ = &TruncateTable{Table: $2}
   }
 analyze_statement:
-  ANALYZE TABLE table_name
+  ANALYZE local_opt table_or_tables table_name_list
+  {
+    $$ = &AnalyzeTable{IsLocal: $2, Tables: $4}
+  }
+| ANALYZE local_opt table_or_tables table_name UPDATE HISTOGRAM ON column_list histogram_buckets_opt
+  {
+    $$ = &AnalyzeTable{IsLocal: $2, Tables: TableNames{$4}, HistogramAction: UpdateHistogram, HistogramColumns: $8, Buckets: $9}
+  }
+| ANALYZE local_opt table_or_tables table_name DROP HISTOGRAM ON column_list
+  {
+    $$ = &AnalyzeTable{IsLocal: $2, Tables: TableNames{$4}, HistogramAction: DropHistogram, HistogramColumns: $8}
+  }
+
+histogram_buckets_opt:
+  {
+    $$ = nil
+  }
+| WITH INTEGRAL BUCKETS
   {
-    $$ = &OtherRead{}
+    $$ = NewIntLiteral($2)
   }
 
+table_or_tables:
+  TABLE
+  {}
+| TABLES
+  {}
+
 show_statement:
   SHOW charset_or_character_set like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Charset, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Charset, Filter: $3}}
   }
 | SHOW COLLATION like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Collation, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Collation, Filter: $3}}
   }
 | SHOW full_opt columns_or_fields from_or_in table_name from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Full: $2, Command: Column, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = &Show{Internal: &ShowBasic{Full: $2, Command: Column, Tbl: $5, DbName: $6, Filter: $7}}
   }
 | SHOW DATABASES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Database, Filter: $3}}
   }
 | SHOW SCHEMAS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Database, Filter: $3}}
   }
 | SHOW KEYSPACES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Keyspace, Filter: $3}}
   }
 | SHOW VITESS_KEYSPACES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: Keyspace, Filter: $3}}
   }
 | SHOW FUNCTION STATUS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Function, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: Function, Filter: $4}}
   }
 | SHOW extended_opt index_symbols from_or_in table_name from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Index, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = &Show{Internal: &ShowBasic{Command: Index, Extended: $2, Tbl: $5, DbName: $6, Filter: $7}}
   }
 | SHOW OPEN TABLES from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: OpenTable, DbName:$4, Filter: $5}}
+    $$ = &Show{Internal: &ShowBasic{Command: OpenTable, DbName:$4, Filter: $5}}
   }
 | SHOW PRIVILEGES
   {
-    $$ = &Show{&ShowBasic{Command: Privilege}}
+    $$ = &Show{Internal: &ShowBasic{Command: Privilege}}
   }
 | SHOW PROCEDURE STATUS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Procedure, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: Procedure, Filter: $4}}
   }
 | SHOW session_or_local_opt STATUS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: StatusSession, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: StatusSession, Filter: $4}}
   }
 | SHOW GLOBAL STATUS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: StatusGlobal, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: StatusGlobal, Filter: $4}}
   }
 | SHOW session_or_local_opt VARIABLES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VariableSession, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: VariableSession, Filter: $4}}
   }
 | SHOW GLOBAL VARIABLES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VariableGlobal, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: VariableGlobal, Filter: $4}}
   }
 | SHOW TABLE STATUS from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: TableStatus, DbName:$4, Filter: $5}}
+    $$ = &Show{Internal: &ShowBasic{Command: TableStatus, DbName:$4, Filter: $5}}
   }
 | SHOW full_opt TABLES from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Table, Full: $2, DbName:$4, Filter: $5}}
+    $$ = &Show{Internal: &ShowBasic{Command: Table, Full: $2, DbName:$4, Filter: $5}}
   }
 | SHOW TRIGGERS from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: Trigger, DbName:$3, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: Trigger, DbName:$3, Filter: $4}}
   }
-| SHOW CREATE DATABASE table_name
+| SHOW CREATE DATABASE not_exists_opt table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateDb, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateDb, IfNotExists: $4, Op: $5}}
+  }
+| SHOW CREATE SCHEMA not_exists_opt table_name
+  {
+    $$ = &Show{Internal: &ShowCreate{Command: CreateDb, IfNotExists: $4, Op: $5}}
   }
 | SHOW CREATE EVENT table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateE, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateE, Op: $4}}
   }
 | SHOW CREATE FUNCTION table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateF, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateF, Op: $4}}
   }
 | SHOW CREATE PROCEDURE table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateProc, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateProc, Op: $4}}
   }
 | SHOW CREATE TABLE table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateTbl, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateTbl, Op: $4}}
   }
 | SHOW CREATE TRIGGER table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateTr, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateTr, Op: $4}}
   }
 | SHOW CREATE VIEW table_name
   {
-    $$ = &Show{&ShowCreate{Command: CreateV, Op: $4}}
+    $$ = &Show{Internal: &ShowCreate{Command: CreateV, Op: $4}}
+  }
+| SHOW CREATE USER account_name
+  {
+    $$ = &Show{Internal: &ShowCreate{Command: CreateUsr, User: $4}}
+  }
+| SHOW FUNCTION CODE table_name
+  {
+    $$ = &Show{Internal: &ShowCreate{Command: FunctionC, Op: $4}}
+  }
+| SHOW PROCEDURE CODE table_name
+  {
+    $$ = &Show{Internal: &ShowCreate{Command: ProcedureC, Op: $4}}
   }
 | SHOW ENGINES
   {
-    $$ = &Show{&ShowBasic{Command: Engines}}
+    $$ = &Show{Internal: &ShowBasic{Command: Engines}}
+  }
+| SHOW STORAGE ENGINES
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Engines}}
+  }
+| SHOW ENGINE sql_id STATUS
+  {
+    $$ = &Show{Internal: &ShowEngine{Engine: $3}}
+  }
+| SHOW ENGINE sql_id MUTEX
+  {
+    $$ = &Show{Internal: &ShowEngine{Engine: $3, Mutex: true}}
+  }
+| SHOW BINARY LOGS
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: BinaryLogs}}
+  }
+| SHOW MASTER LOGS
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: BinaryLogs}}
+  }
+| SHOW MASTER STATUS
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: MasterStatus}}
+  }
+| SHOW replica_or_slave STATUS channel_opt
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: ReplicaStatus, Channel: $4}}
+  }
+| SHOW REPLICAS
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Replicas}}
+  }
+| SHOW SLAVE HOSTS
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Replicas}}
+  }
+| SHOW EVENTS from_database_opt like_or_where_opt
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Events, DbName: $3, Filter: $4}}
+  }
+| SHOW full_opt PROCESSLIST
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Processlist, Full: $2}}
+  }
+| SHOW GRANTS
+  {
+    $$ = &Show{Internal: &ShowGrants{}}
+  }
+| SHOW GRANTS FOR account_name
+  {
+    // SHOW GRANTS FOR CURRENT_USER is the same as SHOW GRANTS
+    if $4.CurrentUser {
+      $$ = &Show{Internal: &ShowGrants{}}
+    } else {
+      $$ = &Show{Internal: &ShowGrants{For: $4}}
+    }
+  }
+| SHOW GRANTS FOR account_name USING account_list
+  {
+    $$ = &Show{Internal: &ShowGrants{For: $4, Using: $6}}
+  }
+| SHOW PROFILES
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Profiles}}
+  }
+| SHOW PROFILE profile_type_list_opt profile_query_opt limit_opt
+  {
+    $$ = &Show{Internal: &ShowProfile{Types: $3, Query: $4, Limit: $5}}
   }
 | SHOW PLUGINS
   {
-    $$ = &Show{&ShowBasic{Command: Plugins}}
+    $$ = &Show{Internal: &ShowBasic{Command: Plugins}}
   }
 | SHOW GLOBAL GTID_EXECUTED from_database_opt
   {
-    $$ = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: GtidExecGlobal, DbName: $4}}
   }
 | SHOW GLOBAL VGTID_EXECUTED from_database_opt
   {
-    $$ = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: VGtidExecGlobal, DbName: $4}}
   }
 | SHOW VITESS_METADATA VARIABLES like_opt
   {
-    $$ = &Show{&ShowBasic{Command: VitessVariables, Filter: $4}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessVariables, Filter: $4}}
   }
 | SHOW VITESS_MIGRATIONS from_database_opt like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
   }
 | SHOW VITESS_MIGRATION STRING LOGS
   {
@@ -3888,84 +4863,151 @@ show_statement:
   }
 | SHOW VITESS_REPLICATION_STATUS like_opt
   {
-    $$ = &Show{&ShowBasic{Command: VitessReplicationStatus, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessReplicationStatus, Filter: $3}}
   }
 | SHOW VSCHEMA TABLES
   {
-    $$ = &Show{&ShowBasic{Command: VschemaTables}}
+    $$ = &Show{Internal: &ShowBasic{Command: VschemaTables}}
   }
 | SHOW VSCHEMA VINDEXES
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes}}
+    $$ = &Show{Internal: &ShowBasic{Command: VschemaVindexes}}
   }
 | SHOW VSCHEMA VINDEXES from_or_on table_name
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Tbl: $5}}
+    $$ = &Show{Internal: &ShowBasic{Command: VschemaVindexes, Tbl: $5}}
+  }
+| SHOW WARNINGS limit_opt
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Warnings, Limit: $3}}
+  }
+| SHOW ERRORS limit_opt
+  {
+    $$ = &Show{Internal: &ShowBasic{Command: Errors, Limit: $3}}
   }
-| SHOW WARNINGS
+| SHOW id_or_var openb '*' closeb WARNINGS
   {
-    $$ = &Show{&ShowBasic{Command: Warnings}}
+    if $2.Lowered() != "count" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &Show{Internal: &ShowBasic{Command: CountWarnings}}
+  }
+| SHOW id_or_var openb '*' closeb ERRORS
+  {
+    if $2.Lowered() != "count" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &Show{Internal: &ShowBasic{Command: CountErrors}}
   }
 | SHOW VITESS_SHARDS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VitessShards, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessShards, Filter: $3}}
   }
 | SHOW VITESS_TABLETS like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VitessTablets, Filter: $3}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessTablets, Filter: $3}}
   }
 | SHOW VITESS_TARGET
   {
-    $$ = &Show{&ShowBasic{Command: VitessTarget}}
+    $$ = &Show{Internal: &ShowBasic{Command: VitessTarget}}
   }
 /*
  * Catch-all for show statements without vitess keywords:
  */
 | SHOW id_or_var ddl_skip_to_end
   {
-    $$ = &Show{&ShowOther{Command: string($2.String())}}
+    $$ = &Show{Internal: &ShowOther{Command: string($2.String())}}
   }
-| SHOW CREATE USER ddl_skip_to_end
-  {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
-   }
 | SHOW BINARY id_or_var ddl_skip_to_end /* SHOW BINARY ... */
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + $3.String()}}
+    $$ = &Show{Internal: &ShowOther{Command: string($2) + " " + $3.String()}}
   }
-| SHOW BINARY LOGS ddl_skip_to_end /* SHOW BINARY LOGS */
+| SHOW BINLOG EVENTS binlog_in_opt binlog_from_opt limit_opt
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
+    $$ = &Show{Internal: &ShowBinlogEvents{LogName: $4, Position: $5, Limit: $6}}
   }
-| SHOW ENGINE ddl_skip_to_end
+| SHOW RELAYLOG EVENTS binlog_in_opt binlog_from_opt limit_opt channel_opt
   {
-    $$ = &Show{&ShowOther{Command: string($2)}}
+    $$ = &Show{Internal: &ShowBinlogEvents{Relaylog: true, LogName: $4, Position: $5, Limit: $6, Channel: $7}}
   }
-| SHOW FUNCTION CODE table_name
+
+profile_type_list_opt:
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
+    $$ = nil
   }
-| SHOW PROCEDURE CODE table_name
+| profile_type_list
+
+profile_type_list:
+  profile_type
+  {
+    $$ = []string{$1}
+  }
+| profile_type_list ',' profile_type
+  {
+    $$ = append($1, $3)
+  }
+
+profile_type:
+  ALL
+  {
+    $$ = "all"
+  }
+| sql_id
+  {
+    ty, err := checkProfileType($1.Lowered())
+    if err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = ty
+  }
+| sql_id sql_id
+  {
+    ty, err := checkProfileType($1.Lowered() + " " + $2.Lowered())
+    if err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = ty
+  }
+
+profile_query_opt:
+  {
+    $$ = nil
+  }
+| FOR QUERY INTEGRAL
+  {
+    $$ = NewIntLiteral($3)
+  }
+
+binlog_in_opt:
+  {
+    $$ = ""
+  }
+| IN STRING
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
+    $$ = $2
   }
-| SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
+
+binlog_from_opt:
   {
-    $$ = &Show{&ShowOther{Command: string($3)}}
+    $$ = nil
   }
-| SHOW STORAGE ddl_skip_to_end
+| FROM INTEGRAL
   {
-    $$ = &Show{&ShowOther{Command: string($2)}}
+    $$ = NewIntLiteral($2)
   }
 
 extended_opt:
   /* empty */
   {
-    $$ = ""
+    $$ = false
   }
   | EXTENDED
   {
-    $$ = "extended "
+    $$ = true
   }
 
 full_opt:
@@ -4069,6 +5111,15 @@ begin_statement:
   {
     $$ = &Begin{}
   }
+| start_transaction_statement
+
+// start_transaction_statement is a begin_statement that can be used in the
+// body of a stored program, where BEGIN starts a BEGIN ... END block.
+start_transaction_statement:
+  BEGIN WORK
+  {
+    $$ = &Begin{}
+  }
 | START TRANSACTION
   {
     $$ = &Begin{}
@@ -4113,356 +5164,2042 @@ release_statement:
     $$ = &Release{Name: $3}
   }
 
-explain_format_opt:
+xa_statement:
+  XA START xid xa_start_option_opt
   {
-    $$ = EmptyType
+    $$ = &XATransaction{Action: XAStart, Xid: $3, Option: $4}
   }
-| FORMAT '=' JSON
+| XA BEGIN xid xa_start_option_opt
   {
-    $$ = JSONType
+    $$ = &XATransaction{Action: XAStart, Xid: $3, Option: $4}
   }
-| FORMAT '=' TREE
+| XA END xid xa_end_option_opt
   {
-    $$ = TreeType
+    $$ = &XATransaction{Action: XAEnd, Xid: $3, Option: $4}
   }
-| FORMAT '=' VITESS
+| XA PREPARE xid
   {
-    $$ = VitessType
+    $$ = &XATransaction{Action: XAPrepare, Xid: $3}
   }
-| FORMAT '=' TRADITIONAL
+| XA COMMIT xid xa_commit_option_opt
   {
-    $$ = TraditionalType
+    $$ = &XATransaction{Action: XACommit, Xid: $3, Option: $4}
   }
-| ANALYZE
+| XA ROLLBACK xid
   {
-    $$ = AnalyzeType
+    $$ = &XATransaction{Action: XARollback, Xid: $3}
+  }
+| XA RECOVER xa_convert_xid_opt
+  {
+    $$ = &XATransaction{Action: XARecover, ConvertXid: $3}
   }
 
-explain_synonyms:
-  EXPLAIN
+xid:
+  xid_string
   {
-    $$ = $1
+    $$ = &Xid{Gtrid: $1}
   }
-| DESCRIBE
+| xid_string ',' xid_string
   {
-    $$ = $1
+    $$ = &Xid{Gtrid: $1, Bqual: $3}
   }
-| DESC
+| xid_string ',' xid_string ',' INTEGRAL
   {
-    $$ = $1
+    $$ = &Xid{Gtrid: $1, Bqual: $3, FormatID: NewIntLiteral($5)}
   }
 
-explainable_statement:
-  select_statement
+xid_string:
+  STRING
   {
-    $$ = $1
+    $$ = NewStrLiteral($1)
   }
-| update_statement
+| HEX
   {
-    $$ = $1
+    $$ = NewHexLiteral($1)
   }
-| insert_statement
+| HEXNUM
   {
-    $$ = $1
+    $$ = NewHexNumLiteral($1)
   }
-| delete_statement
+| BIT_LITERAL
   {
-    $$ = $1
+    $$ = NewBitLiteral($1)
   }
 
-wild_opt:
+xa_start_option_opt:
   {
-    $$ = ""
+    $$ = NoXAOption
   }
-| sql_id
+| JOIN
   {
-    $$ = $1.val
+    $$ = JoinXAOption
   }
-| STRING
+| RESUME
   {
-    $$ = encodeSQLString($1)
+    $$ = ResumeXAOption
   }
 
-explain_statement:
-  explain_synonyms table_name wild_opt
+xa_end_option_opt:
   {
-    $$ = &ExplainTab{Table: $2, Wild: $3}
+    $$ = NoXAOption
   }
-| explain_synonyms explain_format_opt explainable_statement
+| SUSPEND
   {
-    $$ = &ExplainStmt{Type: $2, Statement: $3}
+    $$ = SuspendXAOption
+  }
+| SUSPEND FOR MIGRATE
+  {
+    $$ = SuspendForMigrateXAOption
   }
 
-other_statement:
-  REPAIR skip_to_end
+xa_commit_option_opt:
   {
-    $$ = &OtherAdmin{}
+    $$ = NoXAOption
   }
-| OPTIMIZE skip_to_end
+| ONE PHASE
   {
-    $$ = &OtherAdmin{}
+    $$ = OnePhaseXAOption
   }
 
-lock_statement:
-  LOCK TABLES lock_table_list
+xa_convert_xid_opt:
   {
-    $$ = &LockTables{Tables: $3}
+    $$ = false
+  }
+| CONVERT XID
+  {
+    $$ = true
+  }
+
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    processlistID, err := convertStringToUint64($3)
+    if err != nil {
+      yylex.Error("processlist id out of range")
+      return 1
+    }
+    $$ = &Kill{Type: $2, ProcesslistID: processlistID}
+  }
+
+kill_type_opt:
+  {
+    $$ = ConnectionKill
+  }
+| CONNECTION
+  {
+    $$ = ConnectionKill
+  }
+| QUERY
+  {
+    $$ = QueryKill
+  }
+
+shutdown_statement:
+  SHUTDOWN
+  {
+    $$ = &Shutdown{}
+  }
+
+install_statement:
+  INSTALL PLUGIN sql_id SONAME STRING
+  {
+    $$ = &InstallPlugin{Name: $3, Library: $5}
+  }
+| INSTALL COMPONENT component_list
+  {
+    $$ = &InstallComponent{Components: $3}
+  }
+
+uninstall_statement:
+  UNINSTALL PLUGIN sql_id
+  {
+    $$ = &UninstallPlugin{Name: $3}
+  }
+| UNINSTALL COMPONENT component_list
+  {
+    $$ = &UninstallComponent{Components: $3}
+  }
+
+component_list:
+  STRING
+  {
+    $$ = []string{$1}
+  }
+| component_list ',' STRING
+  {
+    $$ = append($1, $3)
+  }
+
+change_replication_source_statement:
+  CHANGE REPLICATION SOURCE TO replication_option_list channel_opt
+  {
+    if err := checkReplicationOptions($5, changeReplicationOptions); err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = &ChangeReplicationSource{Options: $5, Channel: $6}
+  }
+| CHANGE MASTER TO replication_option_list channel_opt
+  {
+    if err := checkReplicationOptions($4, changeReplicationOptions); err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = &ChangeReplicationSource{Master: true, Options: $4, Channel: $5}
+  }
+
+start_replica_statement:
+  START replica_or_slave replica_thread_list_opt replica_until_opt replica_connection_option_list_opt channel_opt
+  {
+    if err := checkReplicationOptions($4, untilReplicationOptions); err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    if err := checkReplicationOptions($5, replicaConnectionOptions); err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = &StartReplica{Slave: $2, Threads: $3, Until: $4, ConnectionOptions: $5, Channel: $6}
+  }
+
+stop_replica_statement:
+  STOP replica_or_slave replica_thread_list_opt channel_opt
+  {
+    $$ = &StopReplica{Slave: $2, Threads: $3, Channel: $4}
+  }
+
+reset_replication_statement:
+  RESET replica_or_slave all_opt channel_opt
+  {
+    $$ = &ResetReplica{Slave: $2, All: $3, Channel: $4}
+  }
+| RESET MASTER
+  {
+    $$ = &ResetMaster{}
+  }
+| RESET MASTER TO INTEGRAL
+  {
+    $$ = &ResetMaster{To: NewIntLiteral($4)}
+  }
+
+purge_binary_logs_statement:
+  PURGE binary_or_master LOGS TO STRING
+  {
+    $$ = &PurgeBinaryLogs{Master: $2, To: $5}
+  }
+| PURGE binary_or_master LOGS BEFORE expression
+  {
+    $$ = &PurgeBinaryLogs{Master: $2, Before: $5}
+  }
+
+replica_or_slave:
+  REPLICA
+  {
+    $$ = false
+  }
+| SLAVE
+  {
+    $$ = true
+  }
+
+binary_or_master:
+  BINARY
+  {
+    $$ = false
+  }
+| MASTER
+  {
+    $$ = true
+  }
+
+all_opt:
+  {
+    $$ = false
+  }
+| ALL
+  {
+    $$ = true
+  }
+
+replica_thread_list_opt:
+  {
+    $$ = 0
+  }
+| replica_thread_list
+  {
+    $$ = $1
+  }
+
+replica_thread_list:
+  replica_thread
+  {
+    $$ = $1
+  }
+| replica_thread_list ',' replica_thread
+  {
+    $$ = $1 | $3
+  }
+
+replica_thread:
+  IO_THREAD
+  {
+    $$ = IOThread
+  }
+| RELAY_THREAD
+  {
+    $$ = IOThread
+  }
+| SQL_THREAD
+  {
+    $$ = SQLThread
+  }
+
+replica_until_opt:
+  {
+    $$ = nil
+  }
+| UNTIL replica_until_option_list
+  {
+    $$ = $2
+  }
+
+replica_until_option_list:
+  replica_until_option
+  {
+    $$ = ReplicationOptions{$1}
+  }
+| replica_until_option_list ',' replica_until_option
+  {
+    $$ = append($1, $3)
+  }
+
+replica_until_option:
+  replication_option
+  {
+    $$ = $1
+  }
+| sql_id
+  {
+    $$ = &ReplicationOption{Name: $1.Lowered()}
+  }
+
+replica_connection_option_list_opt:
+  {
+    $$ = nil
+  }
+| replica_connection_option_list
+  {
+    $$ = $1
+  }
+
+replica_connection_option_list:
+  replica_connection_option
+  {
+    $$ = ReplicationOptions{$1}
+  }
+| replica_connection_option_list replica_connection_option
+  {
+    $$ = append($1, $2)
+  }
+
+replica_connection_option:
+  USER '=' STRING
+  {
+    $$ = &ReplicationOption{Name: "user", Value: NewStrLiteral($3)}
+  }
+| PASSWORD '=' STRING
+  {
+    $$ = &ReplicationOption{Name: "password", Value: NewStrLiteral($3)}
+  }
+| ID '=' STRING
+  {
+    $$ = &ReplicationOption{Name: NewColIdent($1).Lowered(), Value: NewStrLiteral($3)}
+  }
+
+replication_option_list:
+  replication_option
+  {
+    $$ = ReplicationOptions{$1}
+  }
+| replication_option_list ',' replication_option
+  {
+    $$ = append($1, $3)
+  }
+
+replication_option:
+  sql_id '=' replication_option_value
+  {
+    $$ = &ReplicationOption{Name: $1.Lowered(), Value: $3}
+  }
+
+replication_option_value:
+  STRING
+  {
+    $$ = NewStrLiteral($1)
+  }
+| INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| DECIMAL
+  {
+    $$ = NewDecimalLiteral($1)
+  }
+| NULL
+  {
+    $$ = &NullVal{}
+  }
+| '(' ')'
+  {
+    $$ = ValTuple{}
+  }
+| '(' server_id_list ')'
+  {
+    $$ = ValTuple($2)
+  }
+
+server_id_list:
+  INTEGRAL
+  {
+    $$ = Exprs{NewIntLiteral($1)}
+  }
+| server_id_list ',' INTEGRAL
+  {
+    $$ = append($1, NewIntLiteral($3))
+  }
+
+channel_opt:
+  {
+    $$ = ""
+  }
+| FOR CHANNEL sql_id
+  {
+    $$ = $3.String()
+  }
+| FOR CHANNEL STRING
+  {
+    $$ = $3
+  }
+
+explain_format_opt:
+  {
+    $$ = EmptyType
+  }
+| FORMAT '=' JSON
+  {
+    $$ = JSONType
+  }
+| FORMAT '=' TREE
+  {
+    $$ = TreeType
+  }
+| FORMAT '=' VITESS
+  {
+    $$ = VitessType
+  }
+| FORMAT '=' TRADITIONAL
+  {
+    $$ = TraditionalType
+  }
+| ANALYZE
+  {
+    $$ = AnalyzeType
+  }
+
+explain_synonyms:
+  EXPLAIN
+  {
+    $$ = $1
+  }
+| DESCRIBE
+  {
+    $$ = $1
+  }
+| DESC
+  {
+    $$ = $1
+  }
+
+explainable_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| update_statement
+  {
+    $$ = $1
+  }
+| insert_statement
+  {
+    $$ = $1
+  }
+| delete_statement
+  {
+    $$ = $1
+  }
+
+wild_opt:
+  {
+    $$ = ""
+  }
+| sql_id
+  {
+    $$ = $1.val
+  }
+| STRING
+  {
+    $$ = encodeSQLString($1)
+  }
+
+explain_statement:
+  explain_synonyms table_name wild_opt
+  {
+    $$ = &ExplainTab{Table: $2, Wild: $3}
+  }
+| explain_synonyms explain_format_opt explainable_statement
+  {
+    $$ = &ExplainStmt{Type: $2, Statement: $3}
+  }
+
+table_maintenance_statement:
+  OPTIMIZE local_opt table_or_tables table_name_list
+  {
+    $$ = &OptimizeTable{IsLocal: $2, Tables: $4}
+  }
+| REPAIR local_opt table_or_tables table_name_list repair_option_list_opt
+  {
+    $$ = &RepairTable{IsLocal: $2, Tables: $4, Options: $5}
+  }
+| CHECK table_or_tables table_name_list check_option_list_opt
+  {
+    $$ = &CheckTable{Tables: $3, Options: $4}
+  }
+| CHECKSUM table_or_tables table_name_list checksum_option_opt
+  {
+    $$ = &ChecksumTable{Tables: $3, Options: $4}
+  }
+
+repair_option_list_opt:
+  {
+    $$ = nil
+  }
+| repair_option_list
+  {
+    $$ = $1
+  }
+
+repair_option_list:
+  repair_option
+  {
+    $$ = []MaintenanceOption{$1}
+  }
+| repair_option_list repair_option
+  {
+    $$ = append($1, $2)
+  }
+
+repair_option:
+  QUICK
+  {
+    $$ = QuickOption
+  }
+| EXTENDED
+  {
+    $$ = ExtendedOption
+  }
+| USE_FRM
+  {
+    $$ = UseFrmOption
+  }
+
+check_option_list_opt:
+  {
+    $$ = nil
+  }
+| check_option_list
+  {
+    $$ = $1
+  }
+
+check_option_list:
+  check_option
+  {
+    $$ = []MaintenanceOption{$1}
+  }
+| check_option_list check_option
+  {
+    $$ = append($1, $2)
+  }
+
+check_option:
+  FOR UPGRADE
+  {
+    $$ = ForUpgradeOption
+  }
+| QUICK
+  {
+    $$ = QuickOption
+  }
+| FAST
+  {
+    $$ = FastOption
+  }
+| MEDIUM
+  {
+    $$ = MediumOption
+  }
+| EXTENDED
+  {
+    $$ = ExtendedOption
+  }
+| CHANGED
+  {
+    $$ = ChangedOption
+  }
+
+checksum_option_opt:
+  {
+    $$ = nil
+  }
+| QUICK
+  {
+    $$ = []MaintenanceOption{QuickOption}
+  }
+| EXTENDED
+  {
+    $$ = []MaintenanceOption{ExtendedOption}
+  }
+
+lock_statement:
+  LOCK TABLES lock_table_list
+  {
+    $$ = &LockTables{Tables: $3}
+  }
+
+lock_table_list:
+  lock_table
+  {
+    $$ = TableAndLockTypes{$1}
+  }
+| lock_table_list ',' lock_table
+  {
+    $$ = append($1, $3)
+  }
+
+lock_table:
+  aliased_table_name lock_type
+  {
+    $$ = &TableAndLockType{Table:$1, Lock:$2}
+  }
+
+lock_type:
+  READ
+  {
+    $$ = Read
+  }
+| READ LOCAL
+  {
+    $$ = ReadLocal
+  }
+| WRITE
+  {
+    $$ = Write
+  }
+| LOW_PRIORITY WRITE
+  {
+    $$ = LowPriorityWrite
+  }
+
+unlock_statement:
+  UNLOCK TABLES
+  {
+    $$ = &UnlockTables{}
+  }
+
+revert_statement:
+  REVERT comment_opt VITESS_MIGRATION STRING
+  {
+    $$ = &RevertMigration{Comments: Comments($2).Parsed(), UUID: string($4)}
+  }
+
+flush_statement:
+  FLUSH local_opt flush_option_list
+  {
+    $$ = &Flush{IsLocal: $2, FlushOptions:$3}
+  }
+| FLUSH local_opt TABLES
+  {
+    $$ = &Flush{IsLocal: $2}
+  }
+| FLUSH local_opt TABLES WITH READ LOCK
+  {
+    $$ = &Flush{IsLocal: $2, WithLock:true}
+  }
+| FLUSH local_opt TABLES table_name_list
+  {
+    $$ = &Flush{IsLocal: $2, TableNames:$4}
+  }
+| FLUSH local_opt TABLES table_name_list WITH READ LOCK
+  {
+    $$ = &Flush{IsLocal: $2, TableNames:$4, WithLock:true}
+  }
+| FLUSH local_opt TABLES table_name_list FOR EXPORT
+  {
+    $$ = &Flush{IsLocal: $2, TableNames:$4, ForExport:true}
+  }
+
+flush_option_list:
+  flush_option
+  {
+    $$ = []string{$1}
+  }
+| flush_option_list ',' flush_option
+  {
+    $$ = append($1,$3)
+  }
+
+flush_option:
+  BINARY LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| ENGINE LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| ERROR LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| GENERAL LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| HOSTS
+  {
+    $$ = string($1)
+  }
+| LOGS
+  {
+    $$ = string($1)
+  }
+| PRIVILEGES
+  {
+    $$ = string($1)
+  }
+| RELAY LOGS for_channel_opt
+  {
+    $$ = string($1) + " " + string($2) + $3
+  }
+| SLOW LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| OPTIMIZER_COSTS
+  {
+    $$ = string($1)
+  }
+| STATUS
+  {
+    $$ = string($1)
+  }
+| USER_RESOURCES
+  {
+    $$ = string($1)
+  }
+
+local_opt:
+  {
+    $$ = false
+  }
+| LOCAL
+  {
+    $$ = true
+  }
+| NO_WRITE_TO_BINLOG
+  {
+    $$ = true
+  }
+
+for_channel_opt:
+  {
+    $$ = ""
+  }
+| FOR CHANNEL id_or_var
+  {
+    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
+  }
+
+comment_opt:
+  {
+    setAllowComments(yylex, true)
+  }
+  comment_list
+  {
+    $$ = $2
+    setAllowComments(yylex, false)
+  }
+
+comment_list:
+  {
+    $$ = nil
+  }
+| comment_list COMMENT
+  {
+    $$ = append($1, $2)
+  }
+
+union_op:
+  UNION
+  {
+    $$ = true
+  }
+| UNION ALL
+  {
+    $$ = false
+  }
+| UNION DISTINCT
+  {
+    $$ = true
+  }
+
+intersect_op:
+  INTERSECT
+  {
+    $$ = true
+  }
+| INTERSECT ALL
+  {
+    $$ = false
+  }
+| INTERSECT DISTINCT
+  {
+    $$ = true
+  }
+
+except_op:
+  EXCEPT
+  {
+    $$ = true
+  }
+| EXCEPT ALL
+  {
+    $$ = false
+  }
+| EXCEPT DISTINCT
+  {
+    $$ = true
+  }
+
+cache_opt:
+{
+  $$ = ""
+}
+| SQL_NO_CACHE
+{
+  $$ = SQLNoCacheStr
+}
+| SQL_CACHE
+{
+  $$ = SQLCacheStr
+}
+
+distinct_opt:
+  {
+    $$ = false
+  }
+| DISTINCT
+  {
+    $$ = true
+  }
+| DISTINCTROW
+  {
+    $$ = true
+  }
+
+prepare_statement:
+  PREPARE comment_opt sql_id FROM text_literal_or_arg
+  {
+    $$ = &PrepareStmt{Name:$3, Comments: Comments($2).Parsed(), Statement:$5}
+  }
+| PREPARE comment_opt sql_id FROM AT_ID
+  {
+    $$ = &PrepareStmt{
+    	Name:$3,
+    	Comments: Comments($2).Parsed(),
+    	Statement: &ColName{
+    		Name: NewColIdentWithAt(unquoteVariable(yylex, $5), SingleAt),
+    	},
+    }
+  }
+
+execute_statement:
+  EXECUTE comment_opt sql_id execute_statement_list_opt
+  {
+    $$ = &ExecuteStmt{Name:$3, Comments: Comments($2).Parsed(), Arguments: $4}
+  }
+
+execute_statement_list_opt:
+  {
+    $$ = nil
+  }
+| USING at_id_list
+  {
+    $$ = $2
+  }
+
+deallocate_statement:
+  DEALLOCATE comment_opt PREPARE sql_id
+  {
+    $$ = &DeallocateStmt{Type:DeallocateType, Comments: Comments($2).Parsed(), Name:$4}
+  }
+| DROP comment_opt PREPARE sql_id
+  {
+    $$ = &DeallocateStmt{Type: DropType, Comments: Comments($2).Parsed(), Name: $4}
+  }
+
+grant_statement:
+  GRANT role_or_privilege_list ON grant_target TO account_list grant_option_opt
+  {
+    privileges, ok := toPrivileges($2)
+    if !ok {
+      yylex.Error("expecting privilege")
+      return 1
+    }
+    $$ = &Grant{Privileges: privileges, Target: setColumnLevel($4, privileges), To: $6, WithGrantOption: $7}
+  }
+| GRANT PROXY ON account_name TO account_list grant_option_opt
+  {
+    $$ = &Grant{Proxy: $4, To: $6, WithGrantOption: $7}
+  }
+| GRANT role_or_privilege_list TO account_list admin_option_opt
+  {
+    roles, ok := toRoles($2)
+    if !ok {
+      yylex.Error("expecting role")
+      return 1
+    }
+    $$ = &Grant{Roles: roles, To: $4, WithAdminOption: $5}
+  }
+
+revoke_statement:
+  REVOKE exists_opt role_or_privilege_list ON grant_target FROM account_list
+  {
+    privileges, ok := toPrivileges($3)
+    if !ok {
+      yylex.Error("expecting privilege")
+      return 1
+    }
+    $$ = &Revoke{IfExists: $2, Privileges: privileges, Target: setColumnLevel($5, privileges), From: $7}
+  }
+| REVOKE exists_opt PROXY ON account_name FROM account_list
+  {
+    $$ = &Revoke{IfExists: $2, Proxy: $5, From: $7}
+  }
+| REVOKE exists_opt role_or_privilege_list FROM account_list
+  {
+    // REVOKE ALL PRIVILEGES, GRANT OPTION FROM ... is the only form without ON
+    if roles, ok := toRoles($3); ok {
+      $$ = &Revoke{IfExists: $2, Roles: roles, From: $5}
+    } else if privileges, ok := toPrivileges($3); ok {
+      $$ = &Revoke{IfExists: $2, Privileges: privileges, From: $5}
+    } else {
+      yylex.Error("expecting role")
+      return 1
+    }
+  }
+
+role_or_privilege_list:
+  role_or_privilege
+  {
+    $$ = []roleOrPrivilege{$1}
+  }
+| role_or_privilege_list ',' role_or_privilege
+  {
+    $$ = append($1, $3)
+  }
+
+role_or_privilege:
+  sql_id column_list_opt
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: $1.Lowered(), Columns: $2}}
+    if $2 == nil {
+      $$.role = &Account{User: $1.String()}
+    }
+  }
+| sql_id AT_ID
+  {
+    $$ = roleOrPrivilege{role: &Account{User: $1.String(), Host: unquoteAddress(yylex, $2)}}
+  }
+| STRING
+  {
+    $$ = roleOrPrivilege{role: &Account{User: $1}}
+  }
+| STRING AT_ID
+  {
+    $$ = roleOrPrivilege{role: &Account{User: $1, Host: unquoteAddress(yylex, $2)}}
+  }
+| SELECT column_list_opt
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "select", Columns: $2}}
+  }
+| INSERT column_list_opt
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "insert", Columns: $2}}
+  }
+| UPDATE column_list_opt
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "update", Columns: $2}}
+  }
+| DELETE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "delete"}}
+  }
+| CREATE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create"}}
+  }
+| CREATE TEMPORARY TABLES
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create temporary tables"}}
+  }
+| CREATE VIEW
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create view"}}
+  }
+| CREATE ROUTINE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create routine"}}
+  }
+| CREATE USER
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create user"}}
+  }
+| CREATE TABLESPACE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create tablespace"}}
+  }
+| CREATE ROLE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "create role"}}
+  }
+| ALTER
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "alter"}}
+  }
+| ALTER ROUTINE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "alter routine"}}
+  }
+| DROP
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "drop"}}
+  }
+| DROP ROLE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "drop role"}}
+  }
+| INDEX
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "index"}}
+  }
+| SHOW DATABASES
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "show databases"}}
+  }
+| SHOW VIEW
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "show view"}}
+  }
+| LOCK TABLES
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "lock tables"}}
+  }
+| REPLICATION ID
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "replication " + NewColIdent($2).Lowered()}}
+  }
+| REPLICATION SLAVE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "replication slave"}}
+  }
+| USAGE
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "usage"}}
+  }
+| GRANT OPTION
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "grant option"}}
+  }
+| ALL
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "all"}}
+  }
+| ALL PRIVILEGES
+  {
+    $$ = roleOrPrivilege{privilege: &PrivilegeSpec{Name: "all"}}
+  }
+
+grant_target:
+  priv_level
+  {
+    $$ = $1
+  }
+| TABLE priv_level
+  {
+    $2.ObjectType = TableObjectType
+    $$ = $2
+  }
+| FUNCTION priv_level
+  {
+    $2.ObjectType = FunctionObjectType
+    if $2.Level == TablePrivilegeLevel {
+      $2.Level = RoutinePrivilegeLevel
+    }
+    $$ = $2
+  }
+| PROCEDURE priv_level
+  {
+    $2.ObjectType = ProcedureObjectType
+    if $2.Level == TablePrivilegeLevel {
+      $2.Level = RoutinePrivilegeLevel
+    }
+    $$ = $2
+  }
+
+priv_level:
+  '*'
+  {
+    $$ = &GrantTarget{Level: DatabasePrivilegeLevel}
+  }
+| '*' '.' '*'
+  {
+    $$ = &GrantTarget{Level: GlobalPrivilegeLevel}
+  }
+| table_id '.' '*'
+  {
+    $$ = &GrantTarget{Level: DatabasePrivilegeLevel, Database: $1}
+  }
+| table_name
+  {
+    $$ = &GrantTarget{Level: TablePrivilegeLevel, Database: $1.Qualifier, Name: $1.Name}
+  }
+
+grant_option_opt:
+  {
+    $$ = false
+  }
+| WITH GRANT OPTION
+  {
+    $$ = true
+  }
+
+admin_option_opt:
+  {
+    $$ = false
+  }
+| WITH ADMIN OPTION
+  {
+    $$ = true
+  }
+
+account_list:
+  account_name
+  {
+    $$ = Accounts{$1}
+  }
+| account_list ',' account_name
+  {
+    $$ = append($1, $3)
+  }
+
+account_name:
+  account_user
+  {
+    $$ = &Account{User: $1}
+  }
+| account_user AT_ID
+  {
+    $$ = &Account{User: $1, Host: unquoteAddress(yylex, $2)}
+  }
+| CURRENT_USER func_paren_opt
+  {
+    $$ = &Account{CurrentUser: true}
+  }
+
+account_user:
+  ID
+| STRING
+| non_reserved_keyword
+
+role_list:
+  role_name
+  {
+    $$ = Accounts{$1}
+  }
+| role_list ',' role_name
+  {
+    $$ = append($1, $3)
+  }
+
+// role_name is an account_name without the non reserved keywords, which would
+// be ambiguous with NONE in a role specification
+role_name:
+  role_user
+  {
+    $$ = &Account{User: $1}
+  }
+| role_user AT_ID
+  {
+    $$ = &Account{User: $1, Host: unquoteAddress(yylex, $2)}
+  }
+
+role_user:
+  ID
+| STRING
+
+user_spec_list:
+  user_spec
+  {
+    $$ = UserSpecs{$1}
+  }
+| user_spec_list ',' user_spec
+  {
+    $$ = append($1, $3)
+  }
+
+user_spec:
+  account_name auth_option_opt
+  {
+    $$ = &UserSpec{Account: $1, Auth: $2}
+  }
+
+auth_option_opt:
+  {
+    $$ = nil
+  }
+| IDENTIFIED BY STRING replace_password_opt retain_current_password_opt
+  {
+    $$ = &AuthOption{AuthString: $3, Replace: $4, RetainCurrent: $5}
+  }
+| IDENTIFIED BY RANDOM PASSWORD replace_password_opt retain_current_password_opt
+  {
+    $$ = &AuthOption{RandomPassword: true, Replace: $5, RetainCurrent: $6}
+  }
+| IDENTIFIED WITH auth_plugin
+  {
+    $$ = &AuthOption{Plugin: $3}
+  }
+| IDENTIFIED WITH auth_plugin BY STRING replace_password_opt retain_current_password_opt
+  {
+    $$ = &AuthOption{Plugin: $3, AuthString: $5, Replace: $6, RetainCurrent: $7}
+  }
+| IDENTIFIED WITH auth_plugin BY RANDOM PASSWORD replace_password_opt retain_current_password_opt
+  {
+    $$ = &AuthOption{Plugin: $3, RandomPassword: true, Replace: $7, RetainCurrent: $8}
+  }
+| IDENTIFIED WITH auth_plugin AS STRING
+  {
+    $$ = &AuthOption{Plugin: $3, AuthString: $5, Hashed: true}
+  }
+| DISCARD sql_id PASSWORD
+  {
+    if $2.Lowered() != "old" {
+      yylex.Error("expecting OLD")
+      return 1
+    }
+    $$ = &AuthOption{DiscardOldPassword: true}
+  }
+
+// SHOW CREATE USER prints the plugin as a string
+auth_plugin:
+  sql_id
+  {
+    $$ = $1.String()
+  }
+| STRING
+
+role_spec:
+  DEFAULT
+  {
+    $$ = &RoleSpec{Type: DefaultRoleSpec}
+  }
+| NONE
+  {
+    $$ = &RoleSpec{Type: NoneRoleSpec}
+  }
+| ALL
+  {
+    $$ = &RoleSpec{Type: AllRoleSpec}
+  }
+| ALL EXCEPT role_list
+  {
+    $$ = &RoleSpec{Type: AllExceptRoleSpec, Roles: $3}
+  }
+| role_list
+  {
+    $$ = &RoleSpec{Type: ListRoleSpec, Roles: $1}
+  }
+
+default_role_spec:
+  NONE
+  {
+    $$ = &RoleSpec{Type: NoneRoleSpec}
+  }
+| ALL
+  {
+    $$ = &RoleSpec{Type: AllRoleSpec}
+  }
+| role_list
+  {
+    $$ = &RoleSpec{Type: ListRoleSpec, Roles: $1}
+  }
+
+default_role_opt:
+  {
+    $$ = nil
+  }
+| DEFAULT ROLE role_list
+  {
+    $$ = &RoleSpec{Type: ListRoleSpec, Roles: $3}
+  }
+
+user_options:
+  tls_options_opt resource_options_opt password_lock_options_opt
+  {
+    $$ = $3
+    $$.tls = $1
+    $$.resources = $2
+  }
+| tls_options_opt resource_options_opt password_lock_options_opt COMMENT_KEYWORD STRING
+  {
+    $$ = $3
+    $$.tls = $1
+    $$.resources = $2
+    $$.comment = $5
+  }
+| tls_options_opt resource_options_opt password_lock_options_opt ATTRIBUTE STRING
+  {
+    $$ = $3
+    $$.tls = $1
+    $$.resources = $2
+    $$.attribute = $5
+  }
+
+tls_options_opt:
+  {
+    $$ = nil
+  }
+| REQUIRE NONE
+  {
+    $$ = TLSOptions{{Type: RequireNone}}
+  }
+| REQUIRE tls_option_list
+  {
+    $$ = $2
+  }
+
+tls_option_list:
+  tls_option
+  {
+    $$ = TLSOptions{$1}
+  }
+| tls_option_list tls_option
+  {
+    $$ = append($1, $2)
+  }
+| tls_option_list AND tls_option
+  {
+    $$ = append($1, $3)
+  }
+
+tls_option:
+  SSL
+  {
+    $$ = &TLSOption{Type: RequireSSL}
+  }
+| X509
+  {
+    $$ = &TLSOption{Type: RequireX509}
+  }
+| CIPHER STRING
+  {
+    $$ = &TLSOption{Type: RequireCipher, Value: $2}
+  }
+| ISSUER STRING
+  {
+    $$ = &TLSOption{Type: RequireIssuer, Value: $2}
+  }
+| SUBJECT STRING
+  {
+    $$ = &TLSOption{Type: RequireSubject, Value: $2}
+  }
+
+resource_options_opt:
+  {
+    $$ = nil
+  }
+| WITH resource_option_list
+  {
+    $$ = $2
+  }
+
+resource_option_list:
+  resource_option
+  {
+    $$ = ResourceOptions{$1}
+  }
+| resource_option_list resource_option
+  {
+    $$ = append($1, $2)
+  }
+
+resource_option:
+  MAX_QUERIES_PER_HOUR INTEGRAL
+  {
+    $$ = &ResourceOption{Type: MaxQueriesPerHour, Count: convertStringToInt($2)}
+  }
+| MAX_UPDATES_PER_HOUR INTEGRAL
+  {
+    $$ = &ResourceOption{Type: MaxUpdatesPerHour, Count: convertStringToInt($2)}
+  }
+| MAX_CONNECTIONS_PER_HOUR INTEGRAL
+  {
+    $$ = &ResourceOption{Type: MaxConnectionsPerHour, Count: convertStringToInt($2)}
+  }
+| MAX_USER_CONNECTIONS INTEGRAL
+  {
+    $$ = &ResourceOption{Type: MaxUserConnections, Count: convertStringToInt($2)}
+  }
+
+// The password and lock options can be given in any order.
+password_lock_options_opt:
+  {
+    $$ = userOptions{}
+  }
+| password_lock_options_opt password_option
+  {
+    $$ = $1
+    $$.passwords = append($$.passwords, $2)
+  }
+| password_lock_options_opt ACCOUNT LOCK
+  {
+    $$ = $1
+    $$.lock = LockAccount
+  }
+| password_lock_options_opt ACCOUNT UNLOCK
+  {
+    $$ = $1
+    $$.lock = UnlockAccount
+  }
+
+password_option:
+  PASSWORD EXPIRE
+  {
+    $$ = &PasswordOption{Type: PasswordExpire}
+  }
+| PASSWORD EXPIRE DEFAULT
+  {
+    $$ = &PasswordOption{Type: PasswordExpireDefault}
+  }
+| PASSWORD EXPIRE NEVER
+  {
+    $$ = &PasswordOption{Type: PasswordExpireNever}
+  }
+| PASSWORD EXPIRE INTERVAL INTEGRAL DAY
+  {
+    $$ = &PasswordOption{Type: PasswordExpireInterval, Value: convertStringToInt($4)}
+  }
+| PASSWORD HISTORY DEFAULT
+  {
+    $$ = &PasswordOption{Type: PasswordHistoryDefault}
+  }
+| PASSWORD HISTORY INTEGRAL
+  {
+    $$ = &PasswordOption{Type: PasswordHistory, Value: convertStringToInt($3)}
+  }
+| PASSWORD REUSE INTERVAL DEFAULT
+  {
+    $$ = &PasswordOption{Type: PasswordReuseIntervalDefault}
+  }
+| PASSWORD REUSE INTERVAL INTEGRAL DAY
+  {
+    $$ = &PasswordOption{Type: PasswordReuseInterval, Value: convertStringToInt($4)}
+  }
+| PASSWORD REQUIRE CURRENT
+  {
+    $$ = &PasswordOption{Type: PasswordRequireCurrent}
+  }
+| PASSWORD REQUIRE CURRENT DEFAULT
+  {
+    $$ = &PasswordOption{Type: PasswordRequireCurrentDefault}
+  }
+| PASSWORD REQUIRE CURRENT OPTIONAL
+  {
+    $$ = &PasswordOption{Type: PasswordRequireCurrentOptional}
+  }
+| FAILED_LOGIN_ATTEMPTS INTEGRAL
+  {
+    $$ = &PasswordOption{Type: FailedLoginAttempts, Value: convertStringToInt($2)}
+  }
+| PASSWORD_LOCK_TIME INTEGRAL
+  {
+    $$ = &PasswordOption{Type: PasswordLockTime, Value: convertStringToInt($2)}
+  }
+| PASSWORD_LOCK_TIME UNBOUNDED
+  {
+    $$ = &PasswordOption{Type: PasswordLockTimeUnbounded}
   }
 
-lock_table_list:
-  lock_table
+proc_parameter_list_opt:
   {
-    $$ = TableAndLockTypes{$1}
+    $$ = nil
   }
-| lock_table_list ',' lock_table
+| proc_parameter_list
+
+proc_parameter_list:
+  proc_parameter
+  {
+    $$ = []*ProcParameter{$1}
+  }
+| proc_parameter_list ',' proc_parameter
   {
     $$ = append($1, $3)
   }
 
-lock_table:
-  aliased_table_name lock_type
+proc_parameter:
+  proc_parameter_mode_opt sql_id column_type
   {
-    $$ = &TableAndLockType{Table:$1, Lock:$2}
+    $$ = &ProcParameter{Mode: $1, Name: $2, Type: $3}
   }
 
-lock_type:
-  READ
+proc_parameter_mode_opt:
   {
-    $$ = Read
+    $$ = NoParameterMode
   }
-| READ LOCAL
+| IN
   {
-    $$ = ReadLocal
+    $$ = InParameterMode
   }
-| WRITE
+| OUT
   {
-    $$ = Write
+    $$ = OutParameterMode
   }
-| LOW_PRIORITY WRITE
+| INOUT
   {
-    $$ = LowPriorityWrite
+    $$ = InOutParameterMode
   }
 
-unlock_statement:
-  UNLOCK TABLES
+routine_characteristic_list_opt:
   {
-    $$ = &UnlockTables{}
+    $$ = nil
   }
+| routine_characteristic_list
 
-revert_statement:
-  REVERT comment_opt VITESS_MIGRATION STRING
+routine_characteristic_list:
+  routine_characteristic
   {
-    $$ = &RevertMigration{Comments: Comments($2).Parsed(), UUID: string($4)}
+    $$ = []*RoutineCharacteristic{$1}
+  }
+| routine_characteristic_list routine_characteristic
+  {
+    $$ = append($1, $2)
   }
 
-flush_statement:
-  FLUSH local_opt flush_option_list
+routine_characteristic:
+  COMMENT_KEYWORD STRING
   {
-    $$ = &Flush{IsLocal: $2, FlushOptions:$3}
+    $$ = &RoutineCharacteristic{Type: CommentCharacteristic, Comment: $2}
   }
-| FLUSH local_opt TABLES
+| LANGUAGE SQL
   {
-    $$ = &Flush{IsLocal: $2}
+    $$ = &RoutineCharacteristic{Type: LanguageSQLCharacteristic}
   }
-| FLUSH local_opt TABLES WITH READ LOCK
+| DETERMINISTIC
   {
-    $$ = &Flush{IsLocal: $2, WithLock:true}
+    $$ = &RoutineCharacteristic{Type: DeterministicCharacteristic}
   }
-| FLUSH local_opt TABLES table_name_list
+| not_keyword DETERMINISTIC
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4}
+    $$ = &RoutineCharacteristic{Type: NotDeterministicCharacteristic}
   }
-| FLUSH local_opt TABLES table_name_list WITH READ LOCK
+| CONTAINS SQL
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4, WithLock:true}
+    $$ = &RoutineCharacteristic{Type: ContainsSQLCharacteristic}
   }
-| FLUSH local_opt TABLES table_name_list FOR EXPORT
+| NO SQL
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4, ForExport:true}
+    $$ = &RoutineCharacteristic{Type: NoSQLCharacteristic}
+  }
+| READS SQL DATA
+  {
+    $$ = &RoutineCharacteristic{Type: ReadsSQLDataCharacteristic}
+  }
+| MODIFIES SQL DATA
+  {
+    $$ = &RoutineCharacteristic{Type: ModifiesSQLDataCharacteristic}
+  }
+| SQL SECURITY DEFINER
+  {
+    $$ = &RoutineCharacteristic{Type: SQLSecurityDefinerCharacteristic}
+  }
+| SQL SECURITY INVOKER
+  {
+    $$ = &RoutineCharacteristic{Type: SQLSecurityInvokerCharacteristic}
   }
 
-flush_option_list:
-  flush_option
+trigger_timing:
+  BEFORE
   {
-    $$ = []string{$1}
+    $$ = BeforeTrigger
   }
-| flush_option_list ',' flush_option
+| AFTER
   {
-    $$ = append($1,$3)
+    $$ = AfterTrigger
   }
 
-flush_option:
-  BINARY LOGS
+trigger_event:
+  INSERT
   {
-    $$ = string($1) + " " + string($2)
+    $$ = InsertTrigger
   }
-| ENGINE LOGS
+| UPDATE
   {
-    $$ = string($1) + " " + string($2)
+    $$ = UpdateTrigger
   }
-| ERROR LOGS
+| DELETE
   {
-    $$ = string($1) + " " + string($2)
+    $$ = DeleteTrigger
   }
-| GENERAL LOGS
+
+trigger_order_opt:
   {
-    $$ = string($1) + " " + string($2)
+    $$ = nil
   }
-| HOSTS
+| FOLLOWS sql_id
   {
-    $$ = string($1)
+    $$ = &TriggerOrder{Type: FollowsTrigger, OtherTrigger: $2}
   }
-| LOGS
+| PRECEDES sql_id
   {
-    $$ = string($1)
+    $$ = &TriggerOrder{Type: PrecedesTrigger, OtherTrigger: $2}
   }
-| PRIVILEGES
+
+event_schedule:
+  AT expression
+  {
+    $$ = &EventSchedule{At: $2}
+  }
+| EVERY simple_expr sql_id event_starts_opt event_ends_opt
+  {
+    $$ = &EventSchedule{Every: $2, EveryUnit: $3.String(), Starts: $4, Ends: $5}
+  }
+
+event_starts_opt:
+  {
+    $$ = nil
+  }
+| STARTS expression
+  {
+    $$ = $2
+  }
+
+event_ends_opt:
+  {
+    $$ = nil
+  }
+| ENDS expression
+  {
+    $$ = $2
+  }
+
+event_on_completion_opt:
+  {
+    $$ = NoOnCompletion
+  }
+| ON COMPLETION PRESERVE
+  {
+    $$ = OnCompletionPreserve
+  }
+| ON COMPLETION not_keyword PRESERVE
+  {
+    $$ = OnCompletionNotPreserve
+  }
+
+event_rename_opt:
+  {
+    $$ = TableName{}
+  }
+| RENAME TO table_name
+  {
+    $$ = $3
+  }
+
+event_do_opt:
+  {
+    $$ = nil
+  }
+| DO routine_statement
+  {
+    $$ = $2
+  }
+
+event_status_opt:
+  {
+    $$ = NoEventStatus
+  }
+| ENABLE
+  {
+    $$ = EnableEvent
+  }
+| DISABLE
+  {
+    $$ = DisableEvent
+  }
+| DISABLE ON sql_id
+  {
+    if replica := $3.Lowered(); replica != "slave" && replica != "replica" {
+      yylex.Error("expecting SLAVE or REPLICA")
+      return 1
+    }
+    $$ = DisableOnReplicaEvent
+  }
+
+event_comment_opt:
+  {
+    $$ = ""
+  }
+| COMMENT_KEYWORD STRING
+  {
+    $$ = $2
+  }
+
+routine_statement:
+  compound_statement
+| select_statement
+  {
+    $$ = $1
+  }
+| insert_statement
+| update_statement
+| delete_statement
+| set_statement
+| set_transaction_statement
+| start_transaction_statement
+| create_statement
+| alter_statement
+| rename_statement
+| drop_statement
+| truncate_statement
+| analyze_statement
+| show_statement
+| use_statement
+| commit_statement
+| rollback_statement
+| savepoint_statement
+| release_statement
+| explain_statement
+| table_maintenance_statement
+| flush_statement
+| do_statement
+| lock_statement
+| unlock_statement
+| call_statement
+| prepare_statement
+| execute_statement
+| deallocate_statement
+| grant_statement
+| revoke_statement
+
+compound_statement_list_opt:
+  {
+    $$ = nil
+  }
+| compound_statement_list
+
+compound_statement_list:
+  routine_statement ';'
+  {
+    $$ = CompoundStatements{$1}
+  }
+| compound_statement_list routine_statement ';'
+  {
+    $$ = append($1, $2)
+  }
+
+compound_statement:
+  labelable_statement
+| ID ':' labelable_statement end_label_opt
+  {
+    label := NewColIdent(string($1))
+    if $4 != "" && !label.EqualString($4) {
+      yylex.Error("end label does not match the beginning label")
+      return 1
+    }
+    switch stmt := $3.(type) {
+    case *BeginEndBlock:
+      stmt.Label = label
+    case *LoopStmt:
+      stmt.Label = label
+    case *WhileStmt:
+      stmt.Label = label
+    case *RepeatStmt:
+      stmt.Label = label
+    }
+    $$ = $3
+  }
+| IF expression THEN compound_statement_list elseif_list_opt compound_else_opt END IF
+  {
+    $$ = &IfStmt{Cond: $2, Statements: $4, ElseIfs: $5, Else: $6}
+  }
+| CASE expression_opt case_stmt_when_list compound_else_opt END CASE
+  {
+    $$ = &CaseStmt{Expr: $2, Whens: $3, Else: $4}
+  }
+| DECLARE column_list column_type declare_default_opt
+  {
+    $$ = &DeclareVar{Names: $2, Type: $3, Default: $4}
+  }
+| DECLARE sql_id CONDITION FOR condition_value
+  {
+    $$ = &DeclareCondition{Name: $2, Condition: $5}
+  }
+| DECLARE sql_id CURSOR FOR select_statement
+  {
+    $$ = &DeclareCursor{Name: $2, Select: $5}
+  }
+| DECLARE handler_action HANDLER FOR handler_condition_list routine_statement
+  {
+    $$ = &DeclareHandler{Action: $2, Conditions: $5, Statement: $6}
+  }
+| LEAVE sql_id
+  {
+    $$ = &LeaveStmt{Label: $2}
+  }
+| ITERATE sql_id
+  {
+    $$ = &IterateStmt{Label: $2}
+  }
+| RETURN expression
+  {
+    $$ = &ReturnStmt{Expr: $2}
+  }
+| OPEN sql_id
+  {
+    $$ = &OpenCursor{Name: $2}
+  }
+| CLOSE sql_id
+  {
+    $$ = &CloseCursor{Name: $2}
+  }
+| FETCH fetch_from_opt sql_id INTO column_list
+  {
+    $$ = &FetchCursor{Name: $3, Into: $5}
+  }
+| SIGNAL signal_condition signal_info_opt
+  {
+    $$ = &Signal{Condition: $2, Info: $3}
+  }
+| RESIGNAL signal_info_opt
+  {
+    $$ = &Signal{Resignal: true, Info: $2}
+  }
+| RESIGNAL signal_condition signal_info_opt
+  {
+    $$ = &Signal{Resignal: true, Condition: $2, Info: $3}
+  }
+
+labelable_statement:
+  BEGIN compound_statement_list_opt END
+  {
+    $$ = &BeginEndBlock{Statements: $2}
+  }
+| LOOP compound_statement_list END LOOP
+  {
+    $$ = &LoopStmt{Statements: $2}
+  }
+| WHILE expression DO compound_statement_list END WHILE
+  {
+    $$ = &WhileStmt{Cond: $2, Statements: $4}
+  }
+| REPEAT compound_statement_list UNTIL expression END REPEAT
+  {
+    $$ = &RepeatStmt{Statements: $2, Until: $4}
+  }
+
+end_label_opt:
+  {
+    $$ = ""
+  }
+| ID
   {
     $$ = string($1)
   }
-| RELAY LOGS for_channel_opt
+
+elseif_list_opt:
   {
-    $$ = string($1) + " " + string($2) + $3
+    $$ = nil
   }
-| SLOW LOGS
+| elseif_list
+
+elseif_list:
+  ELSEIF expression THEN compound_statement_list
   {
-    $$ = string($1) + " " + string($2)
+    $$ = []*ElseIf{{Cond: $2, Statements: $4}}
   }
-| OPTIMIZER_COSTS
+| elseif_list ELSEIF expression THEN compound_statement_list
   {
-    $$ = string($1)
+    $$ = append($1, &ElseIf{Cond: $3, Statements: $5})
   }
-| STATUS
+
+compound_else_opt:
   {
-    $$ = string($1)
+    $$ = nil
   }
-| USER_RESOURCES
+| ELSE compound_statement_list
   {
-    $$ = string($1)
+    $$ = $2
   }
 
-local_opt:
+case_stmt_when_list:
+  case_stmt_when
   {
-    $$ = false
+    $$ = []*CaseStmtWhen{$1}
   }
-| LOCAL
+| case_stmt_when_list case_stmt_when
   {
-    $$ = true
+    $$ = append($1, $2)
   }
-| NO_WRITE_TO_BINLOG
+
+case_stmt_when:
+  WHEN expression THEN compound_statement_list
   {
-    $$ = true
+    $$ = &CaseStmtWhen{Cond: $2, Statements: $4}
   }
 
-for_channel_opt:
+declare_default_opt:
   {
-    $$ = ""
+    $$ = nil
   }
-| FOR CHANNEL id_or_var
+| DEFAULT expression
   {
-    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
+    $$ = $2
   }
 
-comment_opt:
+handler_action:
+  CONTINUE
   {
-    setAllowComments(yylex, true)
+    $$ = ContinueHandler
   }
-  comment_list
+| EXIT
   {
-    $$ = $2
-    setAllowComments(yylex, false)
+    $$ = ExitHandler
+  }
+| UNDO
+  {
+    $$ = UndoHandler
   }
 
-comment_list:
+handler_condition_list:
+  handler_condition
   {
-    $$ = nil
+    $$ = []*HandlerCondition{$1}
   }
-| comment_list COMMENT
+| handler_condition_list ',' handler_condition
   {
-    $$ = append($1, $2)
+    $$ = append($1, $3)
   }
 
-union_op:
-  UNION
+handler_condition:
+  condition_value
+| sql_id
   {
-    $$ = true
+    $$ = &HandlerCondition{Type: NamedCondition, Name: $1}
   }
-| UNION ALL
+| SQLWARNING
   {
-    $$ = false
+    $$ = &HandlerCondition{Type: SQLWarningCondition}
   }
-| UNION DISTINCT
+| not_keyword FOUND
   {
-    $$ = true
+    $$ = &HandlerCondition{Type: NotFoundCondition}
   }
-
-cache_opt:
-{
-  $$ = ""
-}
-| SQL_NO_CACHE
-{
-  $$ = SQLNoCacheStr
-}
-| SQL_CACHE
-{
-  $$ = SQLCacheStr
-}
-
-distinct_opt:
+| SQLEXCEPTION
   {
-    $$ = false
+    $$ = &HandlerCondition{Type: SQLExceptionCondition}
   }
-| DISTINCT
+
+condition_value:
+  INTEGRAL
   {
-    $$ = true
+    $$ = &HandlerCondition{Type: ErrorCodeCondition, Value: $1}
   }
-| DISTINCTROW
+| sqlstate_value
   {
-    $$ = true
+    $$ = &HandlerCondition{Type: SQLStateCondition, Value: $1}
   }
 
-prepare_statement:
-  PREPARE comment_opt sql_id FROM text_literal_or_arg
+sqlstate_value:
+  SQLSTATE STRING
   {
-    $$ = &PrepareStmt{Name:$3, Comments: Comments($2).Parsed(), Statement:$5}
+    $$ = $2
   }
-| PREPARE comment_opt sql_id FROM AT_ID
+| SQLSTATE sql_id STRING
   {
-    $$ = &PrepareStmt{
-    	Name:$3,
-    	Comments: Comments($2).Parsed(),
-    	Statement: &ColName{
-    		Name: NewColIdentWithAt(string($5), SingleAt),
-    	},
+    if !$2.EqualString("value") {
+      yylex.Error("expecting VALUE")
+      return 1
     }
+    $$ = $3
   }
 
-execute_statement:
-  EXECUTE comment_opt sql_id execute_statement_list_opt
+signal_condition:
+  sqlstate_value
   {
-    $$ = &ExecuteStmt{Name:$3, Comments: Comments($2).Parsed(), Arguments: $4}
+    $$ = &HandlerCondition{Type: SQLStateCondition, Value: $1}
+  }
+| sql_id
+  {
+    $$ = &HandlerCondition{Type: NamedCondition, Name: $1}
   }
 
-execute_statement_list_opt:
+signal_info_opt:
   {
     $$ = nil
   }
-| USING at_id_list
+| SET signal_info_list
   {
     $$ = $2
   }
 
-deallocate_statement:
-  DEALLOCATE comment_opt PREPARE sql_id
+signal_info_list:
+  signal_info
   {
-    $$ = &DeallocateStmt{Type:DeallocateType, Comments: Comments($2).Parsed(), Name:$4}
+    $$ = []*SignalInfo{$1}
   }
-| DROP comment_opt PREPARE sql_id
+| signal_info_list ',' signal_info
   {
-    $$ = &DeallocateStmt{Type: DropType, Comments: Comments($2).Parsed(), Name: $4}
+    $$ = append($1, $3)
   }
 
+signal_info:
+  sql_id '=' expression
+  {
+    name := $1.Lowered()
+    if !isSignalInformationItem(name) {
+      yylex.Error("unknown condition information item")
+      return 1
+    }
+    $$ = &SignalInfo{Name: name, Value: $3}
+  }
+
+fetch_from_opt:
+  {}
+| FROM
+  {}
+| NEXT FROM
+  {}
+
 select_expression_list_opt:
   {
     $$ = nil
@@ -4552,6 +7289,7 @@ select_expression:
   }
 
 as_ci_opt:
+  %prec EMPTY_ALIAS_OPT
   {
     $$ = ColIdent{}
   }
@@ -4604,27 +7342,33 @@ table_factor:
   aliased_table_name
   {
     $$ = $1
+    setSpan(yylex, $$, @$)
   }
 | derived_table as_opt table_id column_list_opt
   {
     $$ = &AliasedTableExpr{Expr:$1, As: $3, Columns: $4}
+    setSpan(yylex, $$, @$)
   }
 | openb table_references closeb
   {
     $$ = &ParenTableExpr{Exprs: $2}
+    setSpan(yylex, $$, @$)
   }
 | json_table_function
   {
     $$ = $1
+    setSpan(yylex, $$, @$)
   }
 
 derived_table:
   openb query_expression closeb
   {
+    setSpan(yylex, $2, @2)
     $$ = &DerivedTable{Lateral: false, Select: $2}
   }
 | LATERAL openb query_expression closeb
   {
+    setSpan(yylex, $3, @3)
     $$ = &DerivedTable{Lateral: true, Select: $3}
   }
 
@@ -4660,11 +7404,11 @@ column_list:
 at_id_list:
   AT_ID
   {
-    $$ = Columns{NewColIdentWithAt(string($1), SingleAt)}
+    $$ = Columns{NewColIdentWithAt(unquoteVariable(yylex, $1), SingleAt)}
   }
 | column_list ',' AT_ID
   {
-    $$ = append($$, NewColIdentWithAt(string($3), SingleAt))
+    $$ = append($$, NewColIdentWithAt(unquoteVariable(yylex, $3), SingleAt))
   }
 
 index_list:
@@ -4706,18 +7450,22 @@ join_table:
   table_reference inner_join table_factor join_condition_opt
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setSpan(yylex, $$, @$)
   }
 | table_reference straight_join table_factor on_expression_opt
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setSpan(yylex, $$, @$)
   }
 | table_reference outer_join table_reference join_condition
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setSpan(yylex, $$, @$)
   }
 | table_reference natural_join table_factor
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
+    setSpan(yylex, $$, @$)
   }
 
 join_condition:
@@ -4744,6 +7492,7 @@ as_opt:
   { $$ = struct{}{} }
 
 as_opt_id:
+  %prec EMPTY_ALIAS_OPT
   {
     $$ = NewTableIdent("")
   }
@@ -4905,35 +7654,46 @@ where_expression_opt:
     $$ = $2
   }
 
+not_keyword:
+  NOT
+| HIGH_NOT
+
 /* all possible expressions */
 expression:
   expression OR expression %prec OR
   {
 	$$ = &OrExpr{Left: $1, Right: $3}
+	setSpan(yylex, $$, @$)
   }
 | expression XOR expression %prec XOR
   {
 	$$ = &XorExpr{Left: $1, Right: $3}
+	setSpan(yylex, $$, @$)
   }
 | expression AND expression %prec AND
   {
 	$$ = &AndExpr{Left: $1, Right: $3}
+	setSpan(yylex, $$, @$)
   }
 | NOT expression %prec NOT
   {
 	  $$ = &NotExpr{Expr: $2}
+	  setSpan(yylex, $$, @$)
   }
 | bool_pri IS is_suffix %prec IS
   {
 	 $$ = &IsExpr{Left: $1, Right: $3}
+	 setSpan(yylex, $$, @$)
   }
 | bool_pri %prec EXPRESSION_PREC_SETTER
   {
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
 | expression MEMBER OF openb expression closeb
   {
     $$ = &MemberOfExpr{Value: $1, JSONArr:$5 }
+    setSpan(yylex, $$, @$)
   }
 
 
@@ -4941,234 +7701,475 @@ bool_pri:
 bool_pri IS NULL %prec IS
   {
 	 $$ = &IsExpr{Left: $1, Right: IsNullOp}
+	 setSpan(yylex, $$, @$)
   }
-| bool_pri IS NOT NULL %prec IS
+| bool_pri IS not_keyword NULL %prec IS
   {
   	$$ = &IsExpr{Left: $1, Right: IsNotNullOp}
+  	setSpan(yylex, $$, @$)
   }
 | bool_pri compare predicate
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
+	setSpan(yylex, $$, @$)
   }
 | predicate %prec EXPRESSION_PREC_SETTER
   {
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
 
 predicate:
 bit_expr IN col_tuple
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: InOp, Right: $3}
+	setSpan(yylex, $$, @$)
   }
-| bit_expr NOT IN col_tuple
+| bit_expr not_keyword IN col_tuple
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: NotInOp, Right: $4}
+	setSpan(yylex, $$, @$)
   }
 | bit_expr BETWEEN bit_expr AND predicate
   {
 	 $$ = &BetweenExpr{Left: $1, IsBetween: true, From: $3, To: $5}
+	 setSpan(yylex, $$, @$)
   }
-| bit_expr NOT BETWEEN bit_expr AND predicate
+| bit_expr not_keyword BETWEEN bit_expr AND predicate
   {
 	$$ = &BetweenExpr{Left: $1, IsBetween: false, From: $4, To: $6}
+	setSpan(yylex, $$, @$)
   }
 | bit_expr LIKE simple_expr
   {
 	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
-| bit_expr NOT LIKE simple_expr
+| bit_expr not_keyword LIKE simple_expr
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4}
+	setSpan(yylex, $$, @$)
   }
 | bit_expr LIKE simple_expr ESCAPE simple_expr %prec LIKE
   {
 	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3, Escape: $5}
+	  setSpan(yylex, $$, @$)
   }
-| bit_expr NOT LIKE simple_expr ESCAPE simple_expr %prec LIKE
+| bit_expr not_keyword LIKE simple_expr ESCAPE simple_expr %prec LIKE
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4, Escape: $6}
+	setSpan(yylex, $$, @$)
   }
 | bit_expr REGEXP bit_expr
   {
 	$$ = &ComparisonExpr{Left: $1, Operator: RegexpOp, Right: $3}
+	setSpan(yylex, $$, @$)
   }
-| bit_expr NOT REGEXP bit_expr
+| bit_expr not_keyword REGEXP bit_expr
   {
 	 $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpOp, Right: $4}
+	 setSpan(yylex, $$, @$)
   }
 | bit_expr %prec EXPRESSION_PREC_SETTER
  {
 	$$ = $1
+	setSpan(yylex, $$, @$)
  }
 
 bit_expr:
 bit_expr '|' bit_expr %prec '|'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: BitOrOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '&' bit_expr %prec '&'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: BitAndOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr SHIFT_LEFT bit_expr %prec SHIFT_LEFT
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: ShiftLeftOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr SHIFT_RIGHT bit_expr %prec SHIFT_RIGHT
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: ShiftRightOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '+' bit_expr %prec '+'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: PlusOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '-' bit_expr %prec '-'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: MinusOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '*' bit_expr %prec '*'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: MultOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '/' bit_expr %prec '/'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: DivOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '%' bit_expr %prec '%'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr DIV bit_expr %prec DIV
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: IntDivOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr MOD bit_expr %prec MOD
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | bit_expr '^' bit_expr %prec '^'
   {
 	  $$ = &BinaryExpr{Left: $1, Operator: BitXorOp, Right: $3}
+	  setSpan(yylex, $$, @$)
   }
 | simple_expr %prec EXPRESSION_PREC_SETTER
   {
 	$$ = $1
+	setSpan(yylex, $$, @$)
   }
 
 simple_expr:
 function_call_keyword
   {
   	$$ = $1
+  	setSpan(yylex, $$, @$)
   }
 | function_call_nonkeyword
   {
   	$$ = $1
+  	setSpan(yylex, $$, @$)
   }
 | function_call_generic
   {
   	$$ = $1
+  	setSpan(yylex, $$, @$)
   }
 | function_call_conflict
   {
   	$$ = $1
+  	setSpan(yylex, $$, @$)
   }
 | simple_expr COLLATE charset %prec UNARY
   {
-	$$ = &CollateExpr{Expr: $1, Collation: $3}
+	$$ = &CollateExpr{Expr: $1, Collation: $3}
+	setSpan(yylex, $$, @$)
+  }
+| literal_or_null
+  {
+  	$$ = $1
+  	setSpan(yylex, $$, @$)
+  }
+| column_name
+  {
+  	$$ = $1
+  	setSpan(yylex, $$, @$)
+  }
+| '+' simple_expr %prec UNARY
+  {
+	$$= $2; // TODO: do we really want to ignore unary '+' before any kind of literals?
+	setSpan(yylex, $$, @$)
+  }
+| '-' simple_expr %prec UNARY
+  {
+	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
+	setSpan(yylex, $$, @$)
+  }
+| '~' simple_expr %prec UNARY
+  {
+	$$ = &UnaryExpr{Operator: TildaOp, Expr: $2}
+	setSpan(yylex, $$, @$)
+  }
+| '!' simple_expr %prec UNARY
+  {
+    $$ = &UnaryExpr{Operator: BangOp, Expr: $2}
+    setSpan(yylex, $$, @$)
+  }
+| HIGH_NOT simple_expr %prec UNARY
+  {
+    $$ = &NotExpr{Expr: $2}
+    setSpan(yylex, $$, @$)
+  }
+| simple_expr PIPE_CONCAT simple_expr
+  {
+    $$ = &FuncExpr{Name: NewColIdent("concat"), Exprs: SelectExprs{&AliasedExpr{Expr: $1}, &AliasedExpr{Expr: $3}}}
+    setSpan(yylex, $$, @$)
+  }
+| subquery
+  {
+	$$= $1
+	setSpan(yylex, $$, @$)
+  }
+| tuple_expression
+  {
+	$$ = $1
+	setSpan(yylex, $$, @$)
+  }
+| EXISTS subquery
+  {
+	$$ = &ExistsExpr{Subquery: $2}
+	setSpan(yylex, $$, @$)
+  }
+| MATCH openb select_expression_list closeb AGAINST openb bit_expr match_option closeb
+  {
+  $$ = &MatchExpr{Columns: $3, Expr: $7, Option: $8}
+  setSpan(yylex, $$, @$)
+  }
+| CAST openb expression AS convert_type closeb
+  {
+    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    setSpan(yylex, $$, @$)
+  }
+| CONVERT openb expression ',' convert_type closeb
+  {
+    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    setSpan(yylex, $$, @$)
+  }
+| CONVERT openb expression USING charset closeb
+  {
+    $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
+    setSpan(yylex, $$, @$)
+  }
+| BINARY simple_expr %prec UNARY
+  {
+    // From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
+    // To convert a string expression to a binary string, these constructs are equivalent:
+    //    CAST(expr AS BINARY)
+    //    BINARY expr
+    $$ = &ConvertExpr{Expr: $2, Type: &ConvertType{Type: $1}}
+    setSpan(yylex, $$, @$)
+  }
+| DEFAULT default_opt
+  {
+	 $$ = &Default{ColName: $2}
+	 setSpan(yylex, $$, @$)
+  }
+| INTERVAL simple_expr sql_id
+  {
+	// This rule prevents the usage of INTERVAL
+	// as a function. If support is needed for that,
+	// we'll need to revisit this. The solution
+	// will be non-trivial because of grammar conflicts.
+	$$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+	setSpan(yylex, $$, @$)
+  }
+| column_name JSON_EXTRACT_OP text_literal_or_arg
+  {
+	$$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
+	setSpan(yylex, $$, @$)
+  }
+| column_name JSON_UNQUOTE_EXTRACT_OP text_literal_or_arg
+  {
+	$$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
+	setSpan(yylex, $$, @$)
+  }
+
+trim_type:
+  BOTH
+  {
+    $$ = BothTrimType
+  }
+| LEADING
+  {
+    $$ = LeadingTrimType
+  }
+| TRAILING
+  {
+    $$ = TrailingTrimType
+  }
+
+null_treatment_clause_opt:
+  {
+    $$ = nil
+  }
+| RESPECT NULLS
+  {
+    $$ = &NullTreatmentClause{Type: RespectNullsType}
+  }
+| IGNORE NULLS
+  {
+    $$ = &NullTreatmentClause{Type: IgnoreNullsType}
+  }
+
+from_first_last_clause_opt:
+  {
+    $$ = nil
+  }
+| FROM FIRST
+  {
+    $$ = &FromFirstLastClause{Type: FromFirstType}
+  }
+| FROM LAST
+  {
+    $$ = &FromFirstLastClause{Type: FromLastType}
+  }
+
+null_int_variable_arg:
+  null_as_literal
+  {
+    $$ = $1
+  }
+| INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| AT_ID
+  {
+    $$ = &ColName{Name: NewColIdentWithAt(unquoteVariable(yylex, $1), SingleAt)}
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+
+default_with_comma_opt:
+  {
+    $$ = nil
+  }
+| ',' expression
+  {
+    $$ = $2
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  sql_id_opt window_partition_clause_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+sql_id_opt:
+  %prec EMPTY_ALIAS_OPT
+  {
+    $$ = ColIdent{}
+  }
+| sql_id
+  {
+    $$ = $1
   }
-| literal_or_null
+
+window_partition_clause_opt:
   {
-  	$$ = $1
+    $$ = nil
   }
-| column_name
+| PARTITION BY expression_list
   {
-  	$$ = $1
+    $$ = $3
   }
-| '+' simple_expr %prec UNARY
+
+frame_clause_opt:
   {
-	$$= $2; // TODO: do we really want to ignore unary '+' before any kind of literals?
+    $$ = nil
   }
-| '-' simple_expr %prec UNARY
+| frame_clause
   {
-	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
+    $$ = $1
   }
-| '~' simple_expr %prec UNARY
+
+frame_clause:
+  frame_units frame_point
   {
-	$$ = &UnaryExpr{Operator: TildaOp, Expr: $2}
+    $$ = &FrameClause{Unit: $1, Start: $2}
   }
-| '!' simple_expr %prec UNARY
+| frame_units BETWEEN frame_point AND frame_point
   {
-    $$ = &UnaryExpr{Operator: BangOp, Expr: $2}
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
   }
-| subquery
+
+frame_units:
+  ROWS
   {
-	$$= $1
+    $$ = FrameRowsType
   }
-| tuple_expression
+| RANGE
   {
-	$$ = $1
+    $$ = FrameRangeType
   }
-| EXISTS subquery
+
+frame_point:
+  CURRENT ROW
   {
-	$$ = &ExistsExpr{Subquery: $2}
+    $$ = &FramePoint{Type: CurrentRowType}
   }
-| MATCH openb select_expression_list closeb AGAINST openb bit_expr match_option closeb
+| UNBOUNDED PRECEDING
   {
-  $$ = &MatchExpr{Columns: $3, Expr: $7, Option: $8}
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
   }
-| CAST openb expression AS convert_type closeb
+| UNBOUNDED FOLLOWING
   {
-    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    $$ = &FramePoint{Type: UnboundedFollowingType}
   }
-| CONVERT openb expression ',' convert_type closeb
+| frame_expression PRECEDING
   {
-    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
   }
-| CONVERT openb expression USING charset closeb
+| frame_expression FOLLOWING
   {
-    $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
   }
-| BINARY simple_expr %prec UNARY
+
+frame_expression:
+  NUM_literal
   {
-    // From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
-    // To convert a string expression to a binary string, these constructs are equivalent:
-    //    CAST(expr AS BINARY)
-    //    BINARY expr
-    $$ = &ConvertExpr{Expr: $2, Type: &ConvertType{Type: $1}}
+    $$ = $1
   }
-| DEFAULT default_opt
+| VALUE_ARG
   {
-	 $$ = &Default{ColName: $2}
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
   }
 | INTERVAL simple_expr sql_id
   {
-	// This rule prevents the usage of INTERVAL
-	// as a function. If support is needed for that,
-	// we'll need to revisit this. The solution
-	// will be non-trivial because of grammar conflicts.
-	$$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
   }
-| column_name JSON_EXTRACT_OP text_literal_or_arg
+
+window_clause_opt:
   {
-	$$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
+    $$ = nil
   }
-| column_name JSON_UNQUOTE_EXTRACT_OP text_literal_or_arg
+| WINDOW window_definition_list
   {
-	$$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
+    $$ = $2
   }
 
-trim_type:
-  BOTH
+window_definition_list:
+  window_definition
   {
-    $$ = BothTrimType
+    $$ = WindowDefinitions{$1}
   }
-| LEADING
+| window_definition_list ',' window_definition
   {
-    $$ = LeadingTrimType
+    $$ = append($1, $3)
   }
-| TRAILING
+
+window_definition:
+  sql_id AS openb window_spec closeb
   {
-    $$ = TrailingTrimType
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
   }
 
 default_opt:
@@ -5197,7 +8198,7 @@ is_suffix:
   {
     $$ = IsTrueOp
   }
-| NOT TRUE
+| not_keyword TRUE
   {
     $$ = IsNotTrueOp
   }
@@ -5205,7 +8206,7 @@ is_suffix:
   {
     $$ = IsFalseOp
   }
-| NOT FALSE
+| not_keyword FALSE
   {
     $$ = IsNotFalseOp
   }
@@ -5258,7 +8259,8 @@ col_tuple:
 subquery:
   query_expression_parens %prec SUBQUERY_AS_EXPR
   {
-  	$$ = &Subquery{$1}
+  	$$ = &Subquery{Select: $1}
+  	setSpan(yylex, $$, @$)
   }
 
 expression_list:
@@ -5276,11 +8278,11 @@ expression_list:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb %prec NO_OVER_CLAUSE
   {
     $$ = &FuncExpr{Name: $1, Exprs: $3}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb %prec NO_OVER_CLAUSE
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
   }
@@ -5288,6 +8290,14 @@ function_call_generic:
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
+| sql_id openb DISTINCT select_expression_list closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
+  }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
@@ -5302,10 +8312,70 @@ function_call_keyword:
   {
     $$ = &FuncExpr{Name: NewColIdent("left"), Exprs: $3}
   }
+| CUME_DIST openb closeb over_clause
+  {
+    $$ = &ArgumentLessWindowExpr{Type: CumeDistExprType, OverClause: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &ArgumentLessWindowExpr{Type: DenseRankExprType, OverClause: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &ArgumentLessWindowExpr{Type: PercentRankExprType, OverClause: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &ArgumentLessWindowExpr{Type: RankExprType, OverClause: $4}
+  }
+| ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &ArgumentLessWindowExpr{Type: RowNumberExprType, OverClause: $4}
+  }
+| FIRST_VALUE openb expression closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &FirstOrLastValueExpr{Type: FirstValueExprType, Expr: $3, NullTreatmentClause: $5, OverClause: $6}
+  }
+| LAST_VALUE openb expression closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &FirstOrLastValueExpr{Type: LastValueExprType, Expr: $3, NullTreatmentClause: $5, OverClause: $6}
+  }
+| NTILE openb null_int_variable_arg closeb over_clause
+  {
+    $$ = &NtileExpr{N: $3, OverClause: $5}
+  }
+| NTH_VALUE openb expression ',' null_int_variable_arg closeb from_first_last_clause_opt null_treatment_clause_opt over_clause
+  {
+    $$ = &NTHValueExpr{Expr: $3, N: $5, FromFirstLastClause: $7, NullTreatmentClause: $8, OverClause: $9}
+  }
+| LAG openb expression closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &LagLeadExpr{Type: LagExprType, Expr: $3, NullTreatmentClause: $5, OverClause: $6}
+  }
+| LAG openb expression ',' null_int_variable_arg default_with_comma_opt closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &LagLeadExpr{Type: LagExprType, Expr: $3, N: $5, Default: $6, NullTreatmentClause: $8, OverClause: $9}
+  }
+| LEAD openb expression closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &LagLeadExpr{Type: LeadExprType, Expr: $3, NullTreatmentClause: $5, OverClause: $6}
+  }
+| LEAD openb expression ',' null_int_variable_arg default_with_comma_opt closeb null_treatment_clause_opt over_clause
+  {
+    $$ = &LagLeadExpr{Type: LeadExprType, Expr: $3, N: $5, Default: $6, NullTreatmentClause: $8, OverClause: $9}
+  }
 | RIGHT openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
   }
+| ROW openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("row"), Exprs: $3}
+  }
+| REPEAT openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("repeat"), Exprs: $3}
+  }
 | SUBSTRING openb expression ',' expression ',' expression closeb
   {
     $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
@@ -5326,6 +8396,10 @@ function_call_keyword:
   {
     $$ = &GroupConcatExpr{Distinct: $3, Exprs: $4, OrderBy: $5, Separator: $6, Limit: $7}
   }
+| GROUPING openb expression_list closeb
+  {
+    $$ = &GroupingExpr{Exprs: $3}
+  }
 | CASE expression_opt when_expression_list else_expression_opt END
   {
     $$ = &CaseExpr{Expr: $2, Whens: $3, Else: $4}
@@ -5480,6 +8554,198 @@ UTC_DATE func_paren_opt
   {
     $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
   }
+| ST_GEOMFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_geomfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: GeometryFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_GEOMCOLLFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_geomcollfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: GeometryCollectionFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_POINTFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_pointfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: PointFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_LINEFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_linefromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: LineStringFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_POLYFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_polyfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: PolygonFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_MPOINTFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_mpointfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: MultiPointFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_MLINEFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_mlinefromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: MultiLineStringFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_MPOLYFROMTEXT openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "st_mpolyfromtext", $3, 1, 3) {
+      return 1
+    }
+    $$ = &GeomFromTextExpr{Type: MultiPolygonFromText, WktText: $3[0], Srid: exprAt($3, 1), AxisOrderOpt: exprAt($3, 2)}
+  }
+| ST_CONTAINS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: ContainsRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_CROSSES openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: CrossesRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_DISJOINT openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: DisjointRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_EQUALS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: EqualsRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_INTERSECTS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: IntersectsRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_OVERLAPS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: OverlapsRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_TOUCHES openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: TouchesRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_WITHIN openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: WithinRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRCONTAINS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRContainsRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRCOVEREDBY openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRCoveredByRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRCOVERS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRCoversRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRDISJOINT openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRDisjointRelation, Geom1: $3, Geom2: $5}
+  }
+| MBREQUALS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBREqualsRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRINTERSECTS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRIntersectsRelation, Geom1: $3, Geom2: $5}
+  }
+| MBROVERLAPS openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBROverlapsRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRTOUCHES openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRTouchesRelation, Geom1: $3, Geom2: $5}
+  }
+| MBRWITHIN openb expression ',' expression closeb
+  {
+    $$ = &GeomRelationExpr{Type: MBRWithinRelation, Geom1: $3, Geom2: $5}
+  }
+| ST_DISTANCE openb expression ',' expression closeb
+  {
+    $$ = &GeomDistanceExpr{Geom1: $3, Geom2: $5}
+  }
+| ST_DISTANCE openb expression ',' expression ',' expression closeb
+  {
+    $$ = &GeomDistanceExpr{Geom1: $3, Geom2: $5, Unit: $7}
+  }
+| ST_DISTANCE_SPHERE openb expression ',' expression closeb
+  {
+    $$ = &GeomDistanceSphereExpr{Geom1: $3, Geom2: $5}
+  }
+| ST_DISTANCE_SPHERE openb expression ',' expression ',' expression closeb
+  {
+    $$ = &GeomDistanceSphereExpr{Geom1: $3, Geom2: $5, Radius: $7}
+  }
+| ST_SRID openb expression closeb
+  {
+    $$ = &GeomSRIDExpr{Geom: $3}
+  }
+| ST_SRID openb expression ',' expression closeb
+  {
+    $$ = &GeomSRIDExpr{Geom: $3, Srid: $5}
+  }
+| ST_ASTEXT openb expression closeb
+  {
+    $$ = &GeomFormatExpr{FormatType: AsTextFormat, Geom: $3}
+  }
+| ST_ASTEXT openb expression ',' expression closeb
+  {
+    $$ = &GeomFormatExpr{FormatType: AsTextFormat, Geom: $3, AxisOrderOpt: $5}
+  }
+| ST_ASBINARY openb expression closeb
+  {
+    $$ = &GeomFormatExpr{FormatType: AsBinaryFormat, Geom: $3}
+  }
+| ST_ASBINARY openb expression ',' expression closeb
+  {
+    $$ = &GeomFormatExpr{FormatType: AsBinaryFormat, Geom: $3, AxisOrderOpt: $5}
+  }
+| REGEXP_LIKE openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "regexp_like", $3, 2, 3) {
+      return 1
+    }
+    $$ = &RegexpLikeExpr{Expr: $3[0], Pattern: $3[1], MatchType: exprAt($3, 2)}
+  }
+| REGEXP_INSTR openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "regexp_instr", $3, 2, 6) {
+      return 1
+    }
+    $$ = &RegexpInstrExpr{Expr: $3[0], Pattern: $3[1], Position: exprAt($3, 2), Occurrence: exprAt($3, 3), ReturnOption: exprAt($3, 4), MatchType: exprAt($3, 5)}
+  }
+| REGEXP_REPLACE openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "regexp_replace", $3, 3, 6) {
+      return 1
+    }
+    $$ = &RegexpReplaceExpr{Expr: $3[0], Pattern: $3[1], Repl: $3[2], Position: exprAt($3, 3), Occurrence: exprAt($3, 4), MatchType: exprAt($3, 5)}
+  }
+| REGEXP_SUBSTR openb expression_list closeb
+  {
+    if !checkParamCount(yylex, "regexp_substr", $3, 2, 5) {
+      return 1
+    }
+    $$ = &RegexpSubstrExpr{Expr: $3[0], Pattern: $3[1], Position: exprAt($3, 2), Occurrence: exprAt($3, 3), MatchType: exprAt($3, 4)}
+  }
 | JSON_DEPTH openb expression closeb
   {
     $$ = &JSONAttributesExpr{Type:DepthAttributeType, JSONDoc:$3}
@@ -5825,7 +9091,7 @@ convert_type:
   }
 | REAL
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = &ConvertType{Type: realType(yylex, $1)}
   }
 
 
@@ -5908,11 +9174,25 @@ num_val:
 
 group_by_opt:
   {
-    $$ = nil
+    $$ = groupByClause{}
   }
-| GROUP BY expression_list
+| group_by_start expression_list
   {
-    $$ = $3
+    yylex.(*Tokenizer).groupBys--
+    $$ = groupByClause{exprs: $2}
+  }
+| group_by_start expression_list WITH_ROLLUP
+  {
+    yylex.(*Tokenizer).groupBys--
+    $$ = groupByClause{exprs: $2, rollup: true}
+  }
+
+// group_by_start tells the tokenizer that a GROUP BY list follows, which may
+// end with WITH ROLLUP.
+group_by_start:
+  GROUP BY
+  {
+    yylex.(*Tokenizer).groupBys++
   }
 
 having_opt:
@@ -6153,17 +9433,53 @@ address_opt:
   }
 | AT_ID
   {
-    $$ = formatAddress($1)
+    $$ = formatAddress(yylex, $1)
+  }
+
+locking_clause_list:
+  locking_clause
+  {
+    $$ = LockClauses{$1}
+  }
+| locking_clause_list locking_clause
+  {
+    $$ = append($1, $2)
   }
 
 locking_clause:
-FOR UPDATE
+FOR UPDATE lock_tables_opt lock_wait_opt
+  {
+    $$ = &LockClause{Lock: ForUpdateLock, Tables: $3, Wait: $4}
+  }
+| FOR SHARE lock_tables_opt lock_wait_opt
   {
-    $$ = ForUpdateLock
+    $$ = &LockClause{Lock: ForShareLock, Tables: $3, Wait: $4}
   }
 | LOCK IN SHARE MODE
   {
-    $$ = ShareModeLock
+    $$ = &LockClause{Lock: ShareModeLock}
+  }
+
+lock_tables_opt:
+  {
+    $$ = nil
+  }
+| OF table_name_list
+  {
+    $$ = $2
+  }
+
+lock_wait_opt:
+  {
+    $$ = DefaultWait
+  }
+| NOWAIT
+  {
+    $$ = NoWait
+  }
+| SKIP LOCKED
+  {
+    $$ = SkipLocked
   }
 
 into_clause:
@@ -6179,6 +9495,10 @@ $$ = &SelectInto{Type:IntoDumpfile, FileName:encodeSQLString($3), Charset:Column
 {
 $$ = &SelectInto{Type:IntoOutfile, FileName:encodeSQLString($3), Charset:$4, FormatOption:"", ExportOption:$5, Manifest:"", Overwrite:""}
 }
+| INTO column_list
+{
+$$ = &SelectInto{Type:IntoVariables, Variables:$2}
+}
 
 format_opt:
   {
@@ -6313,21 +9633,21 @@ optionally_opt:
 // Because the rules are together, the parser can keep shifting
 // the tokens until it disambiguates a as sql_id and select as keyword.
 insert_data:
-  VALUES tuple_list
+  VALUES tuple_list row_alias_opt
   {
-    $$ = &Insert{Rows: $2}
+    $$ = &Insert{Rows: $2, RowAlias: $3}
   }
 | select_statement
   {
     $$ = &Insert{Rows: $1}
   }
-| openb ins_column_list closeb VALUES tuple_list
+| openb ins_column_list closeb VALUES tuple_list row_alias_opt
   {
-    $$ = &Insert{Columns: $2, Rows: $5}
+    $$ = &Insert{Columns: $2, Rows: $5, RowAlias: $6}
   }
-| openb closeb VALUES tuple_list
+| openb closeb VALUES tuple_list row_alias_opt
   {
-    $$ = &Insert{Rows: $4}
+    $$ = &Insert{Rows: $4, RowAlias: $5}
   }
 | openb ins_column_list closeb select_statement
   {
@@ -6352,6 +9672,15 @@ ins_column_list:
     $$ = append($$, $5)
   }
 
+row_alias_opt:
+  {
+    $$ = nil
+  }
+| AS table_id column_list_opt
+  {
+    $$ = &RowAlias{TableName: $2, Columns: $3}
+  }
+
 on_dup_opt:
   {
     $$ = nil
@@ -6435,6 +9764,10 @@ set_expression:
   {
     $$ = &SetExpr{Name: $1, Scope: ImplicitScope, Expr: $3}
   }
+| table_id '.' reserved_sql_id '=' expression
+  {
+    $$ = &SetExpr{Qualifier: $1, Name: $3, Scope: ImplicitScope, Expr: $5}
+  }
 | charset_or_character_set_or_names charset_value collate_opt
   {
     $$ = &SetExpr{Name: NewColIdent(string($1)), Scope: ImplicitScope, Expr: $2}
@@ -6486,7 +9819,7 @@ exists_opt:
 
 not_exists_opt:
   { $$ = false }
-| IF NOT EXISTS
+| IF not_keyword EXISTS
   { $$ = true }
 
 ignore_opt:
@@ -6531,10 +9864,12 @@ sql_id:
   id_or_var
   {
     $$ = $1
+    $$.setSpan(spanAt(yylex, @$))
   }
 | non_reserved_keyword
   {
     $$ = NewColIdent(string($1))
+    $$.setSpan(spanAt(yylex, @$))
   }
 
 reserved_sql_id:
@@ -6542,16 +9877,19 @@ reserved_sql_id:
 | reserved_keyword
   {
     $$ = NewColIdent(string($1))
+    $$.setSpan(spanAt(yylex, @$))
   }
 
 table_id:
   id_or_var
   {
     $$ = NewTableIdent(string($1.String()))
+    $$.setSpan(spanAt(yylex, @$))
   }
 | non_reserved_keyword
   {
     $$ = NewTableIdent(string($1))
+    $$.setSpan(spanAt(yylex, @$))
   }
 
 table_id_opt:
@@ -6569,6 +9907,7 @@ reserved_table_id:
 | reserved_keyword
   {
     $$ = NewTableIdent(string($1))
+    $$.setSpan(spanAt(yylex, @$))
   }
 /*
   These are not all necessarily reserved in MySQL, but some are.
@@ -6586,6 +9925,7 @@ reserved_keyword:
 | AND
 | AS
 | ASC
+| BEFORE
 | BETWEEN
 | BINARY
 | BOTH
@@ -6597,62 +9937,72 @@ reserved_keyword:
 | CHECK
 | COLLATE
 | COLUMN
+| CONDITION
+| CONTINUE
 | CONVERT
 | CREATE
 | CROSS
-| CUME_DIST
 | CURRENT_DATE
 | CURRENT_TIME
 | CURRENT_TIMESTAMP
 | CURRENT_USER
+| CURSOR
 | SUBSTR
 | SUBSTRING
 | DATABASE
 | DATABASES
+| DECLARE
 | DEFAULT
 | DELETE
-| DENSE_RANK
 | DESC
 | DESCRIBE
+| DETERMINISTIC
 | DISTINCT
 | DISTINCTROW
 | DIV
 | DROP
+| EACH
 | ELSE
+| ELSEIF
 | EMPTY
 | ESCAPE
+| EXCEPT
 | EXISTS
+| EXIT
 | EXPLAIN
 | EXTRACT
 | FALSE
-| FIRST_VALUE
+| FETCH
 | FOR
 | FORCE
 | FOREIGN
 | FROM
 | FULLTEXT
 | GENERATED
+| GRANT
 | GROUP
-| GROUPING
 | GROUPS
 | HAVING
 | IF
 | IGNORE
 | IN
 | INDEX
+| INFILE
 | INNER
+| INOUT
 | INSERT
+| INTERSECT
 | INTERVAL
 | INTO
 | IS
+| ITERATE
 | JOIN
 | JSON_TABLE
 | KEY
-| LAG
-| LAST_VALUE
+| KILL
 | LATERAL
-| LEAD
 | LEADING
+| LEAVE
 | LEFT
 | LIKE
 | LIMIT
@@ -6660,17 +10010,17 @@ reserved_keyword:
 | LOCALTIME
 | LOCALTIMESTAMP
 | LOCK
+| LOOP
 | LOW_PRIORITY
 | MATCH
 | MAXVALUE
 | MOD
+| MODIFIES
 | NATURAL
 | NEXT // next should be doable as non-reserved, but is not due to the special `select next num_val` query that vitess supports
 | NO_WRITE_TO_BINLOG
 | NOT
 | NOW
-| NTH_VALUE
-| NTILE
 | NULL
 | OF
 | OFF
@@ -6678,28 +10028,37 @@ reserved_keyword:
 | OPTIMIZER_COSTS
 | OR
 | ORDER
+| OUT
 | OUTER
 | OUTFILE
-| OVER
 | PARTITION
-| PERCENT_RANK
 | PRIMARY
+| PURGE
 | RANGE
-| RANK
 | READ
+| READS
 | RECURSIVE
 | REGEXP
 | RENAME
+| REPEAT
 | REPLACE
+| REQUIRE
+| RESIGNAL
+| RETURN
+| REVOKE
 | RIGHT
-| ROW_NUMBER
 | SCHEMA
 | SCHEMAS
 | SELECT
 | SEPARATOR
 | SET
 | SHOW
+| SIGNAL
 | SPATIAL
+| SQLEXCEPTION
+| SQLSTATE
+| SQLWARNING
+| SSL
 | STORED
 | STRAIGHT_JOIN
 | SYSTEM
@@ -6710,10 +10069,12 @@ reserved_keyword:
 | TO
 | TRAILING
 | TRUE
+| UNDO
 | UNION
 | UNIQUE
 | UNLOCK
 | UPDATE
+| USAGE
 | USE
 | USING
 | UTC_DATE
@@ -6724,7 +10085,7 @@ reserved_keyword:
 | WITH
 | WHEN
 | WHERE
-| WINDOW
+| WHILE
 | WRITE
 | XOR
 
@@ -6737,6 +10098,7 @@ reserved_keyword:
 */
 non_reserved_keyword:
   AGAINST
+| ACCOUNT
 | ACTION
 | ACTIVE
 | ADMIN
@@ -6744,11 +10106,14 @@ non_reserved_keyword:
 | ALGORITHM
 | ALWAYS
 | ASCII
+| AT
+| ATTRIBUTE
 | AUTO_INCREMENT
 | AUTOEXTEND_SIZE
 | AVG_ROW_LENGTH
 | BEGIN
 | BIGINT
+| BINLOG
 | BIT
 | BLOB
 | BOOL
@@ -6758,12 +10123,15 @@ non_reserved_keyword:
 | CANCEL
 | CASCADE
 | CASCADED
+| CHANGED
 | CHANNEL
 | CHAR
 | CHARSET
 | CHECKSUM
+| CIPHER
 | CLEANUP
 | CLONE
+| CLOSE
 | COALESCE
 | CODE
 | COLLATION
@@ -6774,12 +10142,17 @@ non_reserved_keyword:
 | COMMITTED
 | COMPACT
 | COMPLETE
+| COMPLETION
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
 | CONNECTION
+| CONTAINS
 | COPY
 | CSV
+| CUME_DIST %prec FUNCTION_CALL_NON_KEYWORD
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -6788,6 +10161,7 @@ non_reserved_keyword:
 | DELAY_KEY_WRITE
 | DEFINER
 | DEFINITION
+| DENSE_RANK %prec FUNCTION_CALL_NON_KEYWORD
 | DESCRIPTION
 | DIRECTORY
 | DISABLE
@@ -6802,14 +10176,18 @@ non_reserved_keyword:
 | ENCLOSED
 | ENCRYPTION
 | END
+| ENDS
 | ENFORCED
 | ENGINE
 | ENGINE_ATTRIBUTE
 | ENGINES
 | ENUM
 | ERROR
+| ERRORS
 | ESCAPED
 | EVENT
+| EVENTS
+| EVERY
 | EXCHANGE
 | EXCLUDE
 | EXCLUSIVE
@@ -6818,13 +10196,18 @@ non_reserved_keyword:
 | EXPIRE
 | EXPORT
 | EXTENDED
+| FAILED_LOGIN_ATTEMPTS
+| FAST
+| FIRST_VALUE %prec FUNCTION_CALL_NON_KEYWORD
 | FLOAT_TYPE
 | FIELDS
 | FIRST
 | FIXED
 | FLUSH
 | FOLLOWING
+| FOLLOWS
 | FORMAT
+| FOUND
 | FULL
 | FUNCTION
 | GENERAL
@@ -6833,23 +10216,30 @@ non_reserved_keyword:
 | GEOMETRYCOLLECTION
 | GET_MASTER_PUBLIC_KEY
 | GLOBAL
+| GRANTS
+| GROUPING %prec FUNCTION_CALL_NON_KEYWORD
 | GTID_EXECUTED
+| HANDLER
 | HASH
 | HEADER
 | HISTOGRAM
 | HISTORY
 | HOSTS
+| IDENTIFIED
 | IMPORT
 | INACTIVE
 | INPLACE
 | INSERT_METHOD
+| INSTALL
 | INSTANT
 | INT
 | INTEGER
 | INVISIBLE
 | INVOKER
 | INDEXES
+| IO_THREAD
 | ISOLATION
+| ISSUER
 | JSON
 | JSON_ARRAY %prec FUNCTION_CALL_NON_KEYWORD
 | JSON_ARRAY_APPEND %prec FUNCTION_CALL_NON_KEYWORD
@@ -6882,9 +10272,12 @@ non_reserved_keyword:
 | KEY_BLOCK_SIZE
 | KEYS
 | KEYSPACES
+| LAG %prec FUNCTION_CALL_NON_KEYWORD
 | LANGUAGE
 | LAST
 | LAST_INSERT_ID
+| LAST_VALUE %prec FUNCTION_CALL_NON_KEYWORD
+| LEAD %prec FUNCTION_CALL_NON_KEYWORD
 | LESS
 | LEVEL
 | LINES
@@ -6898,36 +10291,57 @@ non_reserved_keyword:
 | LONGTEXT
 | LTRIM %prec FUNCTION_CALL_NON_KEYWORD
 | MANIFEST
+| MASTER
 | MASTER_COMPRESSION_ALGORITHMS
 | MASTER_PUBLIC_KEY_PATH
 | MASTER_TLS_CIPHERSUITES
 | MASTER_ZSTD_COMPRESSION_LEVEL
+| MAX_CONNECTIONS_PER_HOUR
+| MAX_QUERIES_PER_HOUR
 | MAX_ROWS
+| MAX_UPDATES_PER_HOUR
+| MAX_USER_CONNECTIONS
+| MBRCONTAINS %prec FUNCTION_CALL_NON_KEYWORD
+| MBRCOVEREDBY %prec FUNCTION_CALL_NON_KEYWORD
+| MBRCOVERS %prec FUNCTION_CALL_NON_KEYWORD
+| MBRDISJOINT %prec FUNCTION_CALL_NON_KEYWORD
+| MBREQUALS %prec FUNCTION_CALL_NON_KEYWORD
+| MBRINTERSECTS %prec FUNCTION_CALL_NON_KEYWORD
+| MBROVERLAPS %prec FUNCTION_CALL_NON_KEYWORD
+| MBRTOUCHES %prec FUNCTION_CALL_NON_KEYWORD
+| MBRWITHIN %prec FUNCTION_CALL_NON_KEYWORD
+| MEDIUM
 | MEDIUMBLOB
 | MEDIUMINT
 | MEDIUMTEXT
 | MEMORY
 | MEMBER
 | MERGE
+| MIGRATE
 | MIN_ROWS
 | MODE
 | MODIFY
 | MULTILINESTRING
 | MULTIPOINT
 | MULTIPOLYGON
+| MUTEX
 | NAME
 | NAMES
 | NCHAR
 | NESTED
 | NETWORK_NAMESPACE
+| NEVER
 | NOWAIT
 | NO
 | NONE
+| NTH_VALUE %prec FUNCTION_CALL_NON_KEYWORD
+| NTILE %prec FUNCTION_CALL_NON_KEYWORD
 | NULLS
 | NUMERIC
 | OFFSET
 | OJ
 | OLD
+| ONE
 | OPEN
 | OPTION
 | OPTIONAL
@@ -6937,18 +10351,25 @@ non_reserved_keyword:
 | ONLY
 | OPTIMIZE
 | OTHERS
+| OVER
 | OVERWRITE
 | PACK_KEYS
 | PARSER
 | PARTIAL
 | PARTITIONING
 | PARTITIONS
-| PASSWORD
+| PASSWORD %prec PASSWORD_NON_KEYWORD
+| PASSWORD_LOCK_TIME
 | PATH
+| PERCENT_RANK %prec FUNCTION_CALL_NON_KEYWORD
 | PERSIST
 | PERSIST_ONLY
+| PHASE
+| PLUGIN
+| PRECEDES
 | PRECEDING
 | PREPARE
+| PRESERVE
 | PRIVILEGE_CHECKS_USER
 | PRIVILEGES
 | PROCESS
@@ -6957,33 +10378,56 @@ non_reserved_keyword:
 | POLYGON
 | PROCEDURE
 | PROCESSLIST
+| PROFILE
+| PROFILES
+| PROXY %prec PROXY_NON_KEYWORD
 | QUERY
+| QUICK
 | RANDOM
+| RANK %prec FUNCTION_CALL_NON_KEYWORD
 | RATIO
 | REAL
 | REBUILD
+| RECOVER
 | REDUNDANT
+| REGEXP_INSTR %prec FUNCTION_CALL_NON_KEYWORD
+| REGEXP_LIKE %prec FUNCTION_CALL_NON_KEYWORD
+| REGEXP_REPLACE %prec FUNCTION_CALL_NON_KEYWORD
+| REGEXP_SUBSTR %prec FUNCTION_CALL_NON_KEYWORD
 | REFERENCE
 | REFERENCES
 | RELAY
+| RELAYLOG
+| RELAY_THREAD
 | REMOVE
 | REORGANIZE
 | REPAIR
 | REPEATABLE
+| REPLICA
+| REPLICAS
+| REPLICATION
+| RESET
 | RESTRICT
 | REQUIRE_ROW_FORMAT
 | RESOURCE
 | RESPECT
 | RESTART
+| RESUME
 | RETAIN
 | RETRY
 | RETURNING
+| RETURNS
 | REUSE
 | ROLE
 | ROLLBACK
+| ROUTINE
+| ROW %prec FUNCTION_CALL_NON_KEYWORD
+| ROWS
 | ROW_FORMAT
+| ROW_NUMBER %prec FUNCTION_CALL_NON_KEYWORD
 | RTRIM %prec FUNCTION_CALL_NON_KEYWORD
 | S3
+| SCHEDULE
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_ENGINE_ATTRIBUTE
@@ -6995,23 +10439,53 @@ non_reserved_keyword:
 | SERIALIZABLE
 | SHARE
 | SHARED
+| SHUTDOWN
 | SIGNED
 | SIMPLE
 | SKIP
+| SLAVE
 | SLOW
 | SMALLINT
+| SONAME
+| SOURCE
 | SQL
+| SQL_THREAD
 | SRID
 | START
 | STARTING
+| STARTS
 | STATS_AUTO_RECALC
 | STATS_PERSISTENT
 | STATS_SAMPLE_PAGES
 | STATUS
+| STOP
 | STORAGE
 | STREAM
+| ST_ASBINARY %prec FUNCTION_CALL_NON_KEYWORD
+| ST_ASTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_CONTAINS %prec FUNCTION_CALL_NON_KEYWORD
+| ST_CROSSES %prec FUNCTION_CALL_NON_KEYWORD
+| ST_DISJOINT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_DISTANCE %prec FUNCTION_CALL_NON_KEYWORD
+| ST_DISTANCE_SPHERE %prec FUNCTION_CALL_NON_KEYWORD
+| ST_EQUALS %prec FUNCTION_CALL_NON_KEYWORD
+| ST_GEOMCOLLFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_GEOMFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_INTERSECTS %prec FUNCTION_CALL_NON_KEYWORD
+| ST_LINEFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_MLINEFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_MPOINTFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_MPOLYFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_OVERLAPS %prec FUNCTION_CALL_NON_KEYWORD
+| ST_POINTFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_POLYFROMTEXT %prec FUNCTION_CALL_NON_KEYWORD
+| ST_SRID %prec FUNCTION_CALL_NON_KEYWORD
+| ST_TOUCHES %prec FUNCTION_CALL_NON_KEYWORD
+| ST_WITHIN %prec FUNCTION_CALL_NON_KEYWORD
+| SUBJECT
 | SUBPARTITION
 | SUBPARTITIONS
+| SUSPEND
 | TABLES
 | TABLESPACE
 | TEMPORARY
@@ -7037,12 +10511,15 @@ non_reserved_keyword:
 | UNCOMMITTED
 | UNDEFINED
 | UNICODE
+| UNINSTALL
 | UNSIGNED
 | UNTHROTTLE
+| UNTIL
 | UNUSED
 | UPGRADE
 | USER
 | USER_RESOURCES
+| USE_FRM
 | VALIDATION
 | VARBINARY
 | VARCHAR
@@ -7065,8 +10542,12 @@ non_reserved_keyword:
 | VITESS_THROTTLED_APPS
 | VSCHEMA
 | WARNINGS
+| WINDOW
 | WITHOUT
 | WORK
+| X509
+| XA
+| XID
 | YEAR
 | ZEROFILL
 | DAY
@@ -7113,7 +10594,7 @@ ddl_skip_to_end:
   {
     skipToEnd(yylex)
   }
-| openb
+| openb %prec SHOW_SKIP_TO_END
   {
     skipToEnd(yylex)
   }
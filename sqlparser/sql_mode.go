@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+
+	"github.com/wind-c/cosqlparser/coerrors"
+)
+
+// SQLMode is a set of the MySQL sql_mode flags that change how SQL is lexed,
+// parsed and formatted. The other flags of sql_mode do not affect the syntax.
+type SQLMode uint32
+
+// Constants for the flags of SQLMode
+const (
+	// SQLModeANSIQuotes treats '"' as an identifier quote character instead of a string quote character.
+	SQLModeANSIQuotes SQLMode = 1 << iota
+	// SQLModeNoBackslashEscapes treats '\' as an ordinary character in strings.
+	SQLModeNoBackslashEscapes
+	// SQLModePipesAsConcat treats || as the string concatenation operator instead of OR.
+	SQLModePipesAsConcat
+	// SQLModeHighNotPrecedence gives NOT the precedence of '!', so that NOT a BETWEEN b AND c
+	// is parsed as (NOT a) BETWEEN b AND c.
+	SQLModeHighNotPrecedence
+	// SQLModeIgnoreSpace permits spaces between a built-in function name and '(',
+	// which makes the names of the built-in functions reserved words.
+	SQLModeIgnoreSpace
+	// SQLModeRealAsFloat treats REAL as a synonym for FLOAT instead of DOUBLE.
+	SQLModeRealAsFloat
+)
+
+// sqlModeFlags maps the sql_mode names to the flags they set. The names that
+// are not relevant to the syntax are mapped to 0.
+var sqlModeFlags = map[string]SQLMode{
+	"ANSI_QUOTES":                SQLModeANSIQuotes,
+	"NO_BACKSLASH_ESCAPES":       SQLModeNoBackslashEscapes,
+	"PIPES_AS_CONCAT":            SQLModePipesAsConcat,
+	"HIGH_NOT_PRECEDENCE":        SQLModeHighNotPrecedence,
+	"IGNORE_SPACE":               SQLModeIgnoreSpace,
+	"REAL_AS_FLOAT":              SQLModeRealAsFloat,
+	"ANSI":                       SQLModeRealAsFloat | SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"DB2":                        SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"MAXDB":                      SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"MSSQL":                      SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"ORACLE":                     SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"POSTGRESQL":                 SQLModePipesAsConcat | SQLModeANSIQuotes | SQLModeIgnoreSpace,
+	"ALLOW_INVALID_DATES":        0,
+	"ERROR_FOR_DIVISION_BY_ZERO": 0,
+	"MYSQL323":                   0,
+	"MYSQL40":                    0,
+	"NO_AUTO_CREATE_USER":        0,
+	"NO_AUTO_VALUE_ON_ZERO":      0,
+	"NO_DIR_IN_CREATE":           0,
+	"NO_ENGINE_SUBSTITUTION":     0,
+	"NO_FIELD_OPTIONS":           0,
+	"NO_KEY_OPTIONS":             0,
+	"NO_TABLE_OPTIONS":           0,
+	"NO_UNSIGNED_SUBTRACTION":    0,
+	"NO_ZERO_DATE":               0,
+	"NO_ZERO_IN_DATE":            0,
+	"ONLY_FULL_GROUP_BY":         0,
+	"PAD_CHAR_TO_FULL_LENGTH":    0,
+	"STRICT_ALL_TABLES":          0,
+	"STRICT_TRANS_TABLES":        0,
+	"TIME_TRUNCATE_FRACTIONAL":   0,
+	"TRADITIONAL":                0,
+}
+
+// ParseSQLMode parses a value of the sql_mode system variable,
+// e.g. "ANSI_QUOTES,STRICT_TRANS_TABLES", into a SQLMode.
+func ParseSQLMode(value string) (SQLMode, error) {
+	var mode SQLMode
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		flags, ok := sqlModeFlags[name]
+		if !ok {
+			return 0, coerrors.Errorf(coerrors.Code_INVALID_ARGUMENT, "unknown sql_mode '%s'", name)
+		}
+		mode |= flags
+	}
+	return mode, nil
+}
+
+// ignoreSpaceFunctions are the built-in functions whose names become
+// reserved words with SQLModeIgnoreSpace.
+var ignoreSpaceFunctions = map[string]bool{
+	"adddate":      true,
+	"bit_and":      true,
+	"bit_or":       true,
+	"bit_xor":      true,
+	"cast":         true,
+	"count":        true,
+	"curdate":      true,
+	"curtime":      true,
+	"date_add":     true,
+	"date_sub":     true,
+	"extract":      true,
+	"group_concat": true,
+	"max":          true,
+	"mid":          true,
+	"min":          true,
+	"now":          true,
+	"position":     true,
+	"session_user": true,
+	"std":          true,
+	"stddev":       true,
+	"stddev_pop":   true,
+	"stddev_samp":  true,
+	"subdate":      true,
+	"substr":       true,
+	"substring":    true,
+	"sum":          true,
+	"sysdate":      true,
+	"system_user":  true,
+	"trim":         true,
+	"variance":     true,
+	"var_pop":      true,
+	"var_samp":     true,
+}
+
+// isIgnoreSpaceFunction returns true if name is reserved with SQLModeIgnoreSpace.
+func isIgnoreSpaceFunction(name string) bool {
+	return ignoreSpaceFunctions[strings.ToLower(name)]
+}
+
+// encodeSQLStringNoBackslashEscapes encodes the string as a SQL string for
+// SQLModeNoBackslashEscapes, where a quote can only be escaped by doubling it.
+func encodeSQLStringNoBackslashEscapes(val string) string {
+	return "'" + strings.ReplaceAll(val, "'", "''") + "'"
+}
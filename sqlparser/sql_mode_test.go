@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSQLMode(t *testing.T) {
+	mode, err := ParseSQLMode("ansi, strict_trans_tables,,HIGH_NOT_PRECEDENCE")
+	require.NoError(t, err)
+	assert.Equal(t, SQLModeRealAsFloat|SQLModePipesAsConcat|SQLModeANSIQuotes|SQLModeIgnoreSpace|SQLModeHighNotPrecedence, mode)
+
+	mode, err = ParseSQLMode("")
+	require.NoError(t, err)
+	assert.Equal(t, SQLMode(0), mode)
+
+	_, err = ParseSQLMode("ANSI_QUOTES,NO_SUCH_MODE")
+	require.EqualError(t, err, "unknown sql_mode 'NO_SUCH_MODE'")
+}
+
+func TestSQLModeParsing(t *testing.T) {
+	testcases := []struct {
+		mode   SQLMode
+		input  string
+		output string
+		// modeOutput is the formatted statement in the same sql_mode; it defaults to output.
+		modeOutput string
+	}{{
+		mode:   SQLModeANSIQuotes,
+		input:  `select "a", 'b' from "my table" where "c" = 'd'`,
+		output: "select a, 'b' from `my table` where c = 'd'",
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `select 'a\nb', 'it''s' from t`,
+		output:     `select 'a\\nb', 'it\'s' from t`,
+		modeOutput: `select 'a\nb', 'it''s' from t`,
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `show tables like 'a\_b'`,
+		output:     `show tables like 'a\\_b'`,
+		modeOutput: `show tables like 'a\_b'`,
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `create definer = 'a\b'@'h\k' view v as select 1 from dual`,
+		output:     `create definer = 'a\\b'@'h\\k' view v as select 1 from dual`,
+		modeOutput: `create definer = 'a\b'@'h\k' view v as select 1 from dual`,
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `drop user 'a\b'@'h\k'`,
+		output:     `drop user 'a\\b'@'h\\k'`,
+		modeOutput: `drop user 'a\b'@'h\k'`,
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `create table t (b set('a''b', 'c\d'))`,
+		output:     "create table t (\n\tb set('a\\'b', 'c\\\\d')\n)",
+		modeOutput: "create table t (\n\tb set('a''b', 'c\\d')\n)",
+	}, {
+		mode:       SQLModeNoBackslashEscapes,
+		input:      `create table t (e enum('x''y'))`,
+		output:     "create table t (\n\te enum('x\\'y')\n)",
+		modeOutput: "create table t (\n\te enum('x''y')\n)",
+	}, {
+		mode:   SQLModePipesAsConcat,
+		input:  "select a || b || 'c' from t where d || e = f",
+		output: "select concat(concat(a, b), 'c') from t where concat(d, e) = f",
+	}, {
+		mode:       SQLModeHighNotPrecedence,
+		input:      "select not a between b and c from t where not d = e and f is not null and g not in (1)",
+		output:     "select (not a) between b and c from t where (not d) = e and f is not null and g not in (1)",
+		modeOutput: "select not a between b and c from t where not d = e and f is not null and g not in (1)",
+	}, {
+		mode:       SQLModeIgnoreSpace,
+		input:      "select count (*), t.count, `sum` from t",
+		output:     "select count(*), t.count, sum from t",
+		modeOutput: "select count(*), t.`count`, `sum` from t",
+	}, {
+		mode:   SQLModeRealAsFloat,
+		input:  "create table t (a real, b double)",
+		output: "create table t (\n\ta float,\n\tb double\n)",
+	}, {
+		mode:   SQLModeRealAsFloat,
+		input:  "select cast(a as real) from t",
+		output: "select convert(a, float) from t",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			parser, err := NewParser(Options{SQLMode: tcase.mode})
+			require.NoError(t, err)
+			stmt, err := parser.Parse(tcase.input)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, String(stmt))
+
+			modeOutput := tcase.modeOutput
+			if modeOutput == "" {
+				modeOutput = tcase.output
+			}
+			assert.Equal(t, modeOutput, parser.String(stmt))
+
+			// The statement formatted in the sql_mode parses back to the same AST.
+			again, err := parser.Parse(modeOutput)
+			require.NoError(t, err)
+			assert.True(t, EqualsStatement(stmt, again), "got %s", String(again))
+		})
+	}
+}
+
+func TestSQLModeErrors(t *testing.T) {
+	testcases := []struct {
+		mode  SQLMode
+		input string
+		err   string
+	}{{
+		mode:  SQLModeIgnoreSpace,
+		input: "select count from t",
+		err:   "syntax error at position 13 near 'count'",
+	}, {
+		mode:  SQLModeANSIQuotes,
+		input: `select * from t into outfile "f"`,
+		err:   "syntax error at position 33 near 'f'",
+	}, {
+		mode:  SQLModeNoBackslashEscapes,
+		input: `select 'a\'b' from t`,
+		err:   "syntax error at position 14",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			parser, err := NewParser(Options{SQLMode: tcase.mode})
+			require.NoError(t, err)
+			_, err = parser.Parse(tcase.input)
+			require.EqualError(t, err, tcase.err)
+
+			// None of the statements is an error in the default sql_mode.
+			_, err = Parse(tcase.input)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLModeFormat(t *testing.T) {
+	format := func(mode SQLMode, node SQLNode) string {
+		buf := NewTrackedBuffer(nil)
+		buf.SetSQLMode(mode)
+		buf.Myprintf("%v", node)
+		return buf.String()
+	}
+
+	not := &NotExpr{Expr: &ComparisonExpr{Operator: EqualOp, Left: NewColName("a"), Right: NewColName("b")}}
+	assert.Equal(t, "not a = b", format(0, not))
+	assert.Equal(t, "not (a = b)", format(SQLModeHighNotPrecedence, not))
+
+	str := NewStrLiteral(`it's a \ `)
+	assert.Equal(t, `'it\'s a \\ '`, format(0, str))
+	assert.Equal(t, `'it''s a \ '`, format(SQLModeNoBackslashEscapes, str))
+
+	col := NewColName("count")
+	assert.Equal(t, "`count`", format(SQLModeIgnoreSpace, col))
+	ansi, err := ParseSQLMode("ANSI")
+	require.NoError(t, err)
+	assert.Equal(t, "`count`", format(ansi, col))
+
+	typ := &ColumnType{Type: "real"}
+	assert.Equal(t, "real", format(0, typ))
+	assert.Equal(t, "double", format(SQLModeRealAsFloat, typ))
+}
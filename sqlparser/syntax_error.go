@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/wind-c/cosqlparser/coerrors"
+)
+
+const (
+	// ERParseError is the MySQL error number of syntax errors.
+	ERParseError = 1064
+	// SSSyntaxErrorOrAccessViolation is the SQLSTATE of syntax errors.
+	SSSyntaxErrorOrAccessViolation = "42000"
+)
+
+// SyntaxError is the error returned by Parse when the SQL cannot be parsed.
+// Use errors.As to get it from an error.
+type SyntaxError struct {
+	// Message describes the error, e.g. "syntax error".
+	Message string
+	// Position is where the token that caused the error starts.
+	Position
+	// Near is the value of the token that caused the error, or "" at the end of the SQL.
+	Near string
+	// Expected holds the tokens that the grammar accepts instead of Near.
+	// It is nil if they are not known, e.g. for the errors that are found
+	// after a statement is recognized.
+	Expected []string
+	// SQL is the SQL that was parsed.
+	SQL string
+
+	end int // the offset of the end of the token
+	pos int // the position of the error in the messages of PositionedErr
+}
+
+// newSyntaxError returns a SyntaxError for the last scanned token.
+func (tkn *Tokenizer) newSyntaxError(msg string) *SyntaxError {
+	loc := tkn.lastLoc()
+	var expected []string
+	for _, token := range tkn.expecting {
+		if len(token) == 3 && token[0] == '\'' && token[2] == '\'' {
+			token = token[1:2]
+		}
+		expected = append(expected, token)
+	}
+	return &SyntaxError{
+		Message:  msg,
+		Position: tkn.position(loc.Start),
+		Near:     tkn.lastToken,
+		Expected: expected,
+		SQL:      tkn.buf,
+		end:      loc.End,
+		pos:      tkn.Pos + 1,
+	}
+}
+
+// Error returns the same message as PositionedErr.
+func (e *SyntaxError) Error() string {
+	return PositionedErr{Err: e.Message, Pos: e.pos, Near: e.Near}.Error()
+}
+
+// Number returns the MySQL error number of the error.
+func (e *SyntaxError) Number() int {
+	return ERParseError
+}
+
+// SQLState returns the SQLSTATE of the error.
+func (e *SyntaxError) SQLState() string {
+	return SSSyntaxErrorOrAccessViolation
+}
+
+// Cause returns the error with the code and the state of coerrors, so that
+// coerrors.Code and coerrors.ErrState work with a SyntaxError.
+func (e *SyntaxError) Cause() error {
+	return coerrors.NewErrorf(coerrors.Code_INVALID_ARGUMENT, coerrors.SyntaxError, "%s", e.Error())
+}
+
+// Snippet returns the line of the SQL where the error was found, with carets
+// under the token that caused it:
+//
+//	select a from t wher b = 1
+//	                     ^
+func (e *SyntaxError) Snippet() string {
+	start := strings.LastIndexByte(e.SQL[:e.Offset], '\n') + 1
+	end := strings.IndexByte(e.SQL[start:], '\n')
+	if end < 0 {
+		end = len(e.SQL)
+	} else {
+		end += start
+	}
+	line := strings.TrimSuffix(e.SQL[start:end], "\r")
+
+	var buf strings.Builder
+	buf.WriteString(line)
+	buf.WriteByte('\n')
+	for _, ch := range e.SQL[start:e.Offset] {
+		if ch == '\t' {
+			buf.WriteByte('\t')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	tokenEnd := e.end
+	if tokenEnd > start+len(line) {
+		tokenEnd = start + len(line)
+	}
+	carets := 1
+	if tokenEnd > e.Offset {
+		carets = utf8.RuneCountInString(e.SQL[e.Offset:tokenEnd])
+	}
+	buf.WriteString(strings.Repeat("^", carets))
+	return buf.String()
+}
+
+// parseError returns the error of a failed parse.
+func (tkn *Tokenizer) parseError() error {
+	if tkn.SyntaxError != nil {
+		return tkn.SyntaxError
+	}
+	return coerrors.New(coerrors.Code_INVALID_ARGUMENT, tkn.LastError.Error())
+}
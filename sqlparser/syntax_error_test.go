@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wind-c/cosqlparser/coerrors"
+)
+
+func TestSyntaxError(t *testing.T) {
+	testcases := []struct {
+		input    string
+		err      string
+		position Position
+		near     string
+		expected []string
+		snippet  string
+	}{{
+		input:    "select a, from t",
+		err:      "syntax error at position 15 near 'from'",
+		position: Position{Offset: 10, Line: 1, Column: 11},
+		near:     "from",
+		expected: []string{"(", "ID", "NULL"},
+		snippet:  "select a, from t\n          ^^^^",
+	}, {
+		input:    "select a\nfrom t\n\twhere b = = 1",
+		err:      "syntax error at position 29",
+		position: Position{Offset: 27, Line: 3, Column: 12},
+		expected: []string{"(", "ID", "NULL"},
+		snippet:  "\twhere b = = 1\n\t          ^",
+	}, {
+		input:    "select * from t where a = 'x",
+		err:      "syntax error at position 29 near 'x'",
+		position: Position{Offset: 26, Line: 1, Column: 27},
+		near:     "x",
+		expected: []string{"(", "STRING"},
+		snippet:  "select * from t where a = 'x\n                          ^^",
+	}, {
+		input:    "select * from t join",
+		err:      "syntax error at position 21",
+		position: Position{Offset: 20, Line: 1, Column: 21},
+		expected: []string{"(", "ID", "LATERAL"},
+		snippet:  "select * from t join\n                    ^",
+	}, {
+		input:    "select 名字 from 用户表\nwhere 'ä' = = größe",
+		err:      "syntax error at position 44",
+		position: Position{Offset: 42, Line: 2, Column: 13},
+		expected: []string{"(", "ID"},
+		snippet:  "where 'ä' = = größe\n            ^",
+	}, {
+		input:    "show profile foo",
+		err:      "unknown profile type 'foo' at position 17",
+		position: Position{Offset: 16, Line: 1, Column: 17},
+		snippet:  "show profile foo\n                ^",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			_, err := Parse(tcase.input)
+			require.EqualError(t, err, tcase.err)
+
+			var syntaxErr *SyntaxError
+			require.True(t, errors.As(err, &syntaxErr))
+			assert.Equal(t, tcase.position, syntaxErr.Position)
+			assert.Equal(t, tcase.near, syntaxErr.Near)
+			for _, token := range tcase.expected {
+				assert.Contains(t, syntaxErr.Expected, token)
+			}
+			if tcase.expected == nil {
+				assert.Empty(t, syntaxErr.Expected)
+			}
+			assert.Equal(t, tcase.input, syntaxErr.SQL)
+			assert.Equal(t, tcase.snippet, syntaxErr.Snippet())
+
+			assert.Equal(t, ERParseError, syntaxErr.Number())
+			assert.Equal(t, SSSyntaxErrorOrAccessViolation, syntaxErr.SQLState())
+			assert.Equal(t, coerrors.Code_INVALID_ARGUMENT, coerrors.Code(err))
+			assert.Equal(t, coerrors.SyntaxError, coerrors.ErrState(err))
+		})
+	}
+}
+
+func TestSyntaxErrorOfParseNext(t *testing.T) {
+	tokenizer := NewStringTokenizer("select 1; select 2 from; select 3")
+	_, err := ParseNext(tokenizer)
+	require.NoError(t, err)
+
+	_, err = ParseNext(tokenizer)
+	require.EqualError(t, err, "syntax error at position 24")
+	require.NotNil(t, tokenizer.SyntaxError)
+	assert.Equal(t, Position{Offset: 23, Line: 1, Column: 24}, tokenizer.SyntaxError.Position)
+	assert.Equal(t, "select 1; select 2 from; select 3\n                       ^", tokenizer.SyntaxError.Snippet())
+}
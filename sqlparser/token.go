@@ -18,8 +18,10 @@ package sqlparser
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/wind-c/cosqlparser/sqltypes"
 )
@@ -34,16 +36,40 @@ type Tokenizer struct {
 	AllowComments       bool
 	SkipSpecialComments bool
 	SkipToEnd           bool
-	LastError           error
-	ParseTree           Statement
-	BindVars            map[string]struct{}
+	// Recover makes ParseNext recover from syntax errors, see ParseScript.
+	Recover     bool
+	LastError   error
+	SyntaxError *SyntaxError
+	ParseTree   Statement
+	BindVars    map[string]struct{}
+
+	// SyntaxErrors holds all the syntax errors found when Recover is set.
+	SyntaxErrors []*SyntaxError
 
 	lastToken      string
 	posVarIndex    int
 	partialDDL     Statement
 	nesting        int
-	multi          bool
 	specialComment *Tokenizer
+	parser         *Parser
+	tokenStart     int    // the position of the last scanned token
+	offset         int    // the offset of buf in the parsed SQL, for special comments
+	lineStarts     *[]int // the offsets of the lines of buf, computed on demand
+	expecting      []string
+
+	// The statement being parsed may define a stored program, whose body has
+	// BEGIN ... END blocks of statements separated by ';'. blocks is the depth
+	// of the blocks that are open, so that such a ';' does not end the
+	// statement. See trackBlock.
+	prevTyp      int
+	blocks       int
+	maybeProgram bool
+	inProgram    bool
+	multi        bool // ';' ends the input, see SplitStatementToPieces
+
+	// groupBys is the number of GROUP BY lists that are being parsed. WITH
+	// ROLLUP is only scanned as a single token after such a list, see skipRollup.
+	groupBys int
 
 	Pos int
 	buf string
@@ -52,11 +78,16 @@ type Tokenizer struct {
 // NewStringTokenizer creates a new Tokenizer for the
 // sql string.
 func NewStringTokenizer(sql string) *Tokenizer {
-	checkParserVersionFlag()
+	return defaultParser.NewStringTokenizer(sql)
+}
 
+// NewStringTokenizer creates a new Tokenizer for the
+// sql string, emulating the MySQL version and SQL mode of p.
+func (p *Parser) NewStringTokenizer(sql string) *Tokenizer {
 	return &Tokenizer{
 		buf:      sql,
 		BindVars: make(map[string]struct{}),
+		parser:   p,
 	}
 }
 
@@ -74,6 +105,7 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		}
 		typ, val = tkn.Scan()
 	}
+	tkn.trackBlock(typ)
 	if typ == 0 || typ == ';' || typ == LEX_ERROR {
 		// If encounter end of statement or invalid token,
 		// we should not accept partially parsed DDLs. They
@@ -82,10 +114,41 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		tkn.partialDDL = nil
 	}
 	lval.str = val
+	lval.loc = tkn.lastLoc()
 	tkn.lastToken = val
 	return typ
 }
 
+// lastLoc returns the offsets of the last scanned token in the parsed SQL.
+func (tkn *Tokenizer) lastLoc() yyLoc {
+	if tkn.specialComment != nil {
+		return tkn.specialComment.lastLoc()
+	}
+	return yyLoc{Start: tkn.offset + tkn.tokenStart, End: tkn.offset + tkn.Pos}
+}
+
+// position returns the Position of an offset in the parsed SQL.
+func (tkn *Tokenizer) position(offset int) Position {
+	if tkn.lineStarts == nil {
+		lineStarts := []int{0}
+		for i := 0; i < len(tkn.buf); i++ {
+			if tkn.buf[i] == '\n' {
+				lineStarts = append(lineStarts, i+1)
+			}
+		}
+		tkn.lineStarts = &lineStarts
+	}
+	lineStarts := *tkn.lineStarts
+	line := sort.SearchInts(lineStarts, offset+1)
+	column := utf8.RuneCountInString(tkn.buf[lineStarts[line-1]:offset]) + 1
+	return Position{Offset: offset, Line: line, Column: column}
+}
+
+// span returns the Span of the offsets in loc.
+func (tkn *Tokenizer) span(loc yyLoc) *Span {
+	return &Span{Start: tkn.position(loc.Start), End: tkn.position(loc.End)}
+}
+
 // PositionedErr holds context related to parser errors
 type PositionedErr struct {
 	Err  string
@@ -100,9 +163,26 @@ func (p PositionedErr) Error() string {
 	return fmt.Sprintf("%s at position %v", p.Err, p.Pos)
 }
 
+// Expecting is called by go yacc before Error with the tokens
+// that were expected instead of the last one.
+func (tkn *Tokenizer) Expecting(tokens []string) {
+	tkn.expecting = tokens
+}
+
 // Error is called by go yacc if there's a parsing error.
 func (tkn *Tokenizer) Error(err string) {
 	tkn.LastError = PositionedErr{Err: err, Pos: tkn.Pos + 1, Near: tkn.lastToken}
+	tkn.SyntaxError = tkn.newSyntaxError(err)
+	tkn.expecting = nil
+	if tkn.Recover {
+		// The error productions of the grammar re-sync the parser. There are
+		// none in the body of a stored program, so skip to the end of it.
+		tkn.SyntaxErrors = append(tkn.SyntaxErrors, tkn.SyntaxError)
+		if tkn.inBlock() {
+			tkn.skipStatement()
+		}
+		return
+	}
 
 	// Try and re-sync to the next statement
 	tkn.skipStatement()
@@ -115,6 +195,7 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		// Enter specialComment scan mode.
 		// for scanning such kind of comment: /*! MySQL-specific code */
 		specialComment := tkn.specialComment
+		specialComment.groupBys = tkn.groupBys
 		tok, val := specialComment.Scan()
 		if tok != 0 {
 			// return the specialComment scan result as the result
@@ -125,6 +206,7 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenStart = tkn.Pos
 	switch ch := tkn.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -137,7 +219,17 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		var tBytes string
 		if tkn.cur() == '`' {
 			tkn.skip(1)
-			tID, tBytes = tkn.scanLiteralIdentifier()
+			tID, tBytes = tkn.scanLiteralIdentifier('`')
+		} else if delim := tkn.cur(); tokenID == AT_ID && (delim == '\'' || delim == '"') {
+			// a quoted host name like 'user'@'%' or a quoted user variable
+			// like @'my var', keep the quotes so that the value can be told
+			// apart from an unquoted identifier; the grammar unquotes it
+			start := tkn.Pos
+			tkn.skip(1)
+			if tID, _ = tkn.scanString(delim, AT_ID); tID == LEX_ERROR {
+				return tID, ""
+			}
+			return tokenID, tkn.buf[start:tkn.Pos]
 		} else if tkn.cur() == eofChar {
 			return LEX_ERROR, ""
 		} else {
@@ -147,7 +239,7 @@ func (tkn *Tokenizer) Scan() (int, string) {
 			return tID, ""
 		}
 		return tokenID, tBytes
-	case isLetter(ch):
+	case isLetter(ch) || tkn.identifierRuneLen() > 0:
 		if ch == 'X' || ch == 'x' {
 			if tkn.peek(1) == '\'' {
 				tkn.skip(2)
@@ -163,18 +255,32 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		// N\'literal' is used to create a string in the national character set
 		if ch == 'N' || ch == 'n' {
 			nxt := tkn.peek(1)
-			if nxt == '\'' || nxt == '"' {
+			if nxt == '\'' || (nxt == '"' && tkn.parser.mode&SQLModeANSIQuotes == 0) {
 				tkn.skip(2)
 				return tkn.scanString(nxt, NCHAR_STRING)
 			}
 		}
-		return tkn.scanIdentifier(false)
+		start := tkn.Pos
+		typ, val := tkn.scanIdentifier(false)
+		if typ == WITH && tkn.groupBys > 0 && tkn.skipRollup() {
+			return WITH_ROLLUP, "with rollup"
+		}
+		if typ == NOT && tkn.parser.mode&SQLModeHighNotPrecedence != 0 {
+			return HIGH_NOT, val
+		}
+		if tkn.parser.mode&SQLModeIgnoreSpace != 0 && isIgnoreSpaceFunction(val) {
+			// The names of these functions are reserved words, unless they are qualified
+			if (start == 0 || tkn.buf[start-1] != '.') && !tkn.nextIsOpenParen() {
+				return LEX_ERROR, val
+			}
+		}
+		return typ, val
 	case isDigit(ch):
 		return tkn.scanNumber()
 	case ch == ':':
 		return tkn.scanBindVar()
 	case ch == ';':
-		if tkn.multi {
+		if tkn.multi && !tkn.inBlock() {
 			// In multi mode, ';' is treated as EOF. So, we don't advance.
 			// Repeated calls to Scan will keep returning 0 until ParseNext
 			// forces the advance.
@@ -202,6 +308,9 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		case '|':
 			if tkn.cur() == '|' {
 				tkn.skip(1)
+				if tkn.parser.mode&SQLModePipesAsConcat != 0 {
+					return PIPE_CONCAT, ""
+				}
 				return OR, ""
 			}
 			return int(ch), ""
@@ -285,26 +394,139 @@ func (tkn *Tokenizer) Scan() (int, string) {
 			}
 			return int(ch), ""
 		case '\'', '"':
+			if ch == '"' && tkn.parser.mode&SQLModeANSIQuotes != 0 {
+				return tkn.scanLiteralIdentifier(ch)
+			}
 			return tkn.scanString(ch, STRING)
 		case '`':
-			return tkn.scanLiteralIdentifier()
+			return tkn.scanLiteralIdentifier(ch)
 		default:
+			if ch >= utf8.RuneSelf {
+				// return the whole character rather than its first byte
+				_, size := utf8.DecodeRuneInString(tkn.buf[tkn.Pos-1:])
+				tkn.skip(size - 1)
+				return LEX_ERROR, tkn.buf[tkn.Pos-size : tkn.Pos]
+			}
 			return LEX_ERROR, string(byte(ch))
 		}
 	}
 }
 
-// skipStatement scans until end of statement.
+// skipStatement scans until end of statement. The end of a statement that
+// defines a stored program is after the END of its outermost block.
 func (tkn *Tokenizer) skipStatement() int {
 	tkn.SkipToEnd = false
 	for {
 		typ, _ := tkn.Scan()
-		if typ == 0 || typ == ';' || typ == LEX_ERROR {
+		tkn.trackBlock(typ)
+		if typ == 0 || (typ == ';' || typ == LEX_ERROR) && !tkn.inBlock() {
 			return typ
 		}
 	}
 }
 
+// skipDefinition scans to the end of a definition of a CREATE TABLE or an
+// option of an ALTER TABLE that has a syntax error, and returns the offsets of
+// its text, which starts after the offset start. The token that ends it, a ','
+// or a ')' that is not nested in the definition, is scanned again next.
+func (tkn *Tokenizer) skipDefinition(start int) yyLoc {
+	end := tkn.tokenStart
+	depth := tkn.parser.parenDepth(tkn.buf[start:end])
+	switch {
+	case tkn.prevTyp == '(':
+		end = tkn.skipNested(depth + 1)
+	case tkn.prevTyp == ')' && depth > 0:
+		end = tkn.skipNested(depth - 1)
+	case tkn.prevTyp == ',' && depth > 0:
+		end = tkn.skipNested(depth)
+	case tkn.prevTyp == ',', tkn.prevTyp == ')', tkn.prevTyp == ';', tkn.prevTyp == 0:
+		// The token with the error ends the definition.
+	default:
+		end = tkn.skipNested(depth)
+	}
+	text := strings.TrimLeft(tkn.buf[start:end], " \t\r\n,")
+	start = end - len(text)
+	return yyLoc{Start: start, End: start + len(strings.TrimRight(text, " \t\r\n"))}
+}
+
+// skipNested scans to the next ',' or ')' that is not nested in parentheses,
+// given the depth of the parentheses that are open, and returns its offset.
+// The ',' or ')' is scanned again next.
+func (tkn *Tokenizer) skipNested(depth int) int {
+	for {
+		pos := tkn.Pos
+		typ, _ := tkn.Scan()
+		switch {
+		case typ == '(':
+			depth++
+		case typ == ')' && depth > 0:
+			depth--
+		case typ == ')', typ == ',' && depth == 0, typ == ';', typ == 0, typ == LEX_ERROR:
+			tkn.Pos = pos
+			return tkn.tokenStart
+		}
+	}
+}
+
+// parenDepth returns the number of the parentheses in sql that are not closed.
+func (p *Parser) parenDepth(sql string) int {
+	tokens := p.NewStringTokenizer(sql)
+	depth := 0
+	for {
+		switch typ, _ := tokens.Scan(); typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case 0, LEX_ERROR:
+			return depth
+		}
+	}
+}
+
+// trackBlock updates the depth of the blocks in the body of a stored program
+// with the scanned token typ. A BEGIN or a CASE opens a block and an END closes
+// it, except the END of an IF, LOOP, WHILE or REPEAT, and a BEGIN WORK.
+func (tkn *Tokenizer) trackBlock(typ int) {
+	prev := tkn.prevTyp
+	tkn.prevTyp = typ
+	if !tkn.inProgram {
+		switch {
+		case prev == 0:
+			tkn.maybeProgram = typ == CREATE || typ == ALTER
+		case typ == PROCEDURE, typ == FUNCTION, typ == TRIGGER, typ == EVENT:
+			tkn.inProgram = tkn.maybeProgram
+		case typ == '(' && prev != CURRENT_USER, typ == TABLE, typ == VIEW, typ == INDEX:
+			// the ( of DEFINER = CURRENT_USER() comes before the PROCEDURE
+			tkn.maybeProgram = false
+		}
+		return
+	}
+	switch typ {
+	case BEGIN:
+		tkn.blocks++
+	case WORK:
+		if prev == BEGIN {
+			tkn.blocks--
+		}
+	case CASE:
+		if prev != END {
+			tkn.blocks++
+		}
+	case IF, LOOP, WHILE, REPEAT:
+		if prev == END {
+			tkn.blocks++
+		}
+	case END:
+		tkn.blocks--
+	}
+}
+
+// inBlock returns whether the scanned tokens are inside a block of a stored program.
+func (tkn *Tokenizer) inBlock() bool {
+	return tkn.blocks > 0
+}
+
 // skipBlank skips the cursor while it finds whitespace
 func (tkn *Tokenizer) skipBlank() {
 	ch := tkn.cur()
@@ -314,18 +536,45 @@ func (tkn *Tokenizer) skipBlank() {
 	}
 }
 
+// nextIsOpenParen returns true if the next token starts with '('.
+func (tkn *Tokenizer) nextIsOpenParen() bool {
+	pos := tkn.Pos
+	tkn.skipBlank()
+	next := tkn.cur()
+	tkn.Pos = pos
+	return next == '('
+}
+
+// skipRollup skips a ROLLUP keyword that follows the current position.
+// WITH ROLLUP is scanned as a single token because a GROUP BY that is
+// followed by WITH can't otherwise be told apart from the WITH CHECK OPTION
+// of a view definition. It is only called in a GROUP BY list, so that a
+// common table expression can still be named rollup.
+func (tkn *Tokenizer) skipRollup() bool {
+	pos := tkn.Pos
+	tkn.skipBlank()
+	start := tkn.Pos
+	for isLetter(tkn.cur()) || isDigit(tkn.cur()) {
+		tkn.skip(1)
+	}
+	if keywordASCIIMatch(tkn.buf[start:tkn.Pos], "rollup") {
+		return true
+	}
+	tkn.Pos = pos
+	return false
+}
+
 // scanIdentifier scans a language keyword or @-encased variable
 func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 	start := tkn.Pos
-	tkn.skip(1)
-
-	for {
-		ch := tkn.cur()
-		if !isLetter(ch) && !isDigit(ch) && !(isVariable && isCarat(ch)) {
-			break
-		}
+	if tkn.cur() < utf8.RuneSelf {
 		tkn.skip(1)
+	} else {
+		_, size := utf8.DecodeRuneInString(tkn.buf[tkn.Pos:])
+		tkn.skip(size)
 	}
+	tkn.skipIdentifier(isVariable)
+
 	keywordName := tkn.buf[start:tkn.Pos]
 	if keywordID, found := keywordLookupTable.LookupString(keywordName); found {
 		return keywordID, keywordName
@@ -337,6 +586,23 @@ func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 	return ID, keywordName
 }
 
+// skipIdentifier skips the characters of an unquoted identifier at the cursor.
+func (tkn *Tokenizer) skipIdentifier(isVariable bool) {
+	for {
+		ch := tkn.cur()
+		if isLetter(ch) || isDigit(ch) || (isVariable && isCarat(ch)) {
+			tkn.skip(1)
+			continue
+		}
+		// ASCII characters are handled above, the others are decoded from UTF-8
+		size := tkn.identifierRuneLen()
+		if size == 0 {
+			return
+		}
+		tkn.skip(size)
+	}
+}
+
 // scanHex scans a hex numeral; assumes x' or X' has already been scanned
 func (tkn *Tokenizer) scanHex() (int, string) {
 	start := tkn.Pos
@@ -364,26 +630,27 @@ func (tkn *Tokenizer) scanBitLiteral() (int, string) {
 	return BIT_LITERAL, bit
 }
 
-// scanLiteralIdentifierSlow scans an identifier surrounded by backticks which may
-// contain escape sequences instead of it. This method is only called from
-// scanLiteralIdentifier once the first escape sequence is found in the identifier.
+// scanLiteralIdentifierSlow scans an identifier surrounded by the given `delim`, which
+// is a backtick or a double quote with SQLModeANSIQuotes, and which may contain escape
+// sequences instead of it. This method is only called from scanLiteralIdentifier once
+// the first escape sequence is found in the identifier.
 // The provided `buf` contains the contents of the identifier that have been scanned
 // so far.
-func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder) (int, string) {
+func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder, delim uint16) (int, string) {
 	backTickSeen := true
 	for {
 		if backTickSeen {
-			if tkn.cur() != '`' {
+			if tkn.cur() != delim {
 				break
 			}
 			backTickSeen = false
-			buf.WriteByte('`')
+			buf.WriteByte(byte(delim))
 			tkn.skip(1)
 			continue
 		}
 		// The previous char was not a backtick.
 		switch tkn.cur() {
-		case '`':
+		case delim:
 			backTickSeen = true
 		case eofChar:
 			// Premature EOF.
@@ -397,15 +664,16 @@ func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder) (int, stri
 	return ID, buf.String()
 }
 
-// scanLiteralIdentifier scans an identifier enclosed by backticks. If the identifier
-// is a simple literal, it'll be returned as a slice of the input buffer. If the identifier
+// scanLiteralIdentifier scans an identifier enclosed by the given `delim`, which is a
+// backtick or a double quote with SQLModeANSIQuotes. If the identifier is a simple
+// literal, it'll be returned as a slice of the input buffer. If the identifier
 // contains escape sequences, this function will fall back to scanLiteralIdentifierSlow
-func (tkn *Tokenizer) scanLiteralIdentifier() (int, string) {
+func (tkn *Tokenizer) scanLiteralIdentifier(delim uint16) (int, string) {
 	start := tkn.Pos
 	for {
 		switch tkn.cur() {
-		case '`':
-			if tkn.peek(1) != '`' {
+		case delim:
+			if tkn.peek(1) != delim {
 				if tkn.Pos == start {
 					return LEX_ERROR, ""
 				}
@@ -416,7 +684,7 @@ func (tkn *Tokenizer) scanLiteralIdentifier() (int, string) {
 			var buf strings.Builder
 			buf.WriteString(tkn.buf[start:tkn.Pos])
 			tkn.skip(1)
-			return tkn.scanLiteralIdentifierSlow(&buf)
+			return tkn.scanLiteralIdentifierSlow(&buf, delim)
 		case eofChar:
 			// Premature EOF.
 			return LEX_ERROR, tkn.buf[start:tkn.Pos]
@@ -435,6 +703,10 @@ func (tkn *Tokenizer) scanBindVar() (int, string) {
 	if tkn.cur() == ':' {
 		token = LIST_ARG
 		tkn.skip(1)
+	} else if !isLetter(tkn.cur()) || tkn.inProgram && tkn.atLabeledStatement() {
+		// A lone ':' terminates the label of a compound statement, as does a
+		// ':' right before one in the body of a stored program, like l:LOOP.
+		return ':', ":"
 	}
 	if !isLetter(tkn.cur()) {
 		return LEX_ERROR, tkn.buf[start:tkn.Pos]
@@ -449,6 +721,17 @@ func (tkn *Tokenizer) scanBindVar() (int, string) {
 	return token, tkn.buf[start:tkn.Pos]
 }
 
+// atLabeledStatement returns whether the word at the cursor starts a compound
+// statement that can have a label.
+func (tkn *Tokenizer) atLabeledStatement() bool {
+	dist := 0
+	for ch := tkn.peek(dist); isLetter(ch) || isDigit(ch); ch = tkn.peek(dist) {
+		dist++
+	}
+	typ, found := keywordLookupTable.LookupString(tkn.buf[tkn.Pos : tkn.Pos+dist])
+	return found && (typ == BEGIN || typ == LOOP || typ == WHILE || typ == REPEAT)
+}
+
 // scanMantissa scans a sequence of numeric characters with the same base.
 // This is a helper function only called from the numeric scanners
 func (tkn *Tokenizer) scanMantissa(base int) {
@@ -499,20 +782,14 @@ exponent:
 	}
 
 exit:
-	if isLetter(tkn.cur()) {
+	if isLetter(tkn.cur()) || tkn.identifierRuneLen() > 0 {
 		// A letter cannot immediately follow a float number.
 		if token == FLOAT || token == DECIMAL {
 			return LEX_ERROR, tkn.buf[start:tkn.Pos]
 		}
 		// A letter seen after a few numbers means that we should parse this
 		// as an identifier and not a number.
-		for {
-			ch := tkn.cur()
-			if !isLetter(ch) && !isDigit(ch) {
-				break
-			}
-			tkn.skip(1)
-		}
+		tkn.skipIdentifier(false)
 		return ID, tkn.buf[start:tkn.Pos]
 	}
 
@@ -536,6 +813,10 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, string) {
 			fallthrough
 
 		case '\\':
+			if tkn.cur() == '\\' && tkn.parser.mode&SQLModeNoBackslashEscapes != 0 {
+				// a backslash is an ordinary character
+				break
+			}
 			var buffer strings.Builder
 			buffer.WriteString(tkn.buf[start:tkn.Pos])
 			return tkn.scanStringSlow(&buffer, delim, typ)
@@ -579,7 +860,7 @@ func (tkn *Tokenizer) scanStringSlow(buffer *strings.Builder, delim uint16, typ
 		}
 		tkn.skip(1) // Read one past the delim or escape character.
 
-		if ch == '\\' {
+		if ch == '\\' && tkn.parser.mode&SQLModeNoBackslashEscapes == 0 {
 			if tkn.cur() == eofChar {
 				// String terminates mid escape character.
 				return LEX_ERROR, buffer.String()
@@ -655,11 +936,13 @@ func (tkn *Tokenizer) scanMySQLSpecificComment() (int, string) {
 		tkn.skip(1)
 	}
 
-	commentVersion, sql := ExtractMysqlComment(tkn.buf[start:tkn.Pos])
+	comment := tkn.buf[start:tkn.Pos]
+	commentVersion, sql := ExtractMysqlComment(comment)
 
-	if MySQLVersion >= commentVersion {
+	if tkn.parser.version >= commentVersion {
 		// Only add the special comment to the tokenizer if the version of MySQL is higher or equal to the comment version
-		tkn.specialComment = NewStringTokenizer(sql)
+		tkn.specialComment = tkn.parser.NewStringTokenizer(sql)
+		tkn.specialComment.offset = tkn.offset + start + strings.LastIndex(comment[:len(comment)-2], sql)
 	}
 
 	return tkn.Scan()
@@ -680,6 +963,19 @@ func (tkn *Tokenizer) peek(dist int) uint16 {
 	return uint16(tkn.buf[tkn.Pos+dist])
 }
 
+// identifierRuneLen returns the length in bytes of the character at the cursor
+// if it is a non-ASCII character allowed in unquoted identifiers, or 0 otherwise.
+func (tkn *Tokenizer) identifierRuneLen() int {
+	if tkn.Pos >= len(tkn.buf) || tkn.buf[tkn.Pos] < utf8.RuneSelf {
+		return 0
+	}
+	r, size := utf8.DecodeRuneInString(tkn.buf[tkn.Pos:])
+	if !isIdentifierRune(r) {
+		return 0
+	}
+	return size
+}
+
 // reset clears any internal state.
 func (tkn *Tokenizer) reset() {
 	tkn.ParseTree = nil
@@ -688,12 +984,28 @@ func (tkn *Tokenizer) reset() {
 	tkn.posVarIndex = 0
 	tkn.nesting = 0
 	tkn.SkipToEnd = false
+	tkn.resetBlocks()
+	tkn.groupBys = 0
+}
+
+// resetBlocks clears the state of trackBlock for the next statement.
+func (tkn *Tokenizer) resetBlocks() {
+	tkn.prevTyp = 0
+	tkn.maybeProgram = false
+	tkn.inProgram = false
+	tkn.blocks = 0
 }
 
 func isLetter(ch uint16) bool {
 	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' || ch == '$'
 }
 
+// isIdentifierRune returns whether r is one of the non-ASCII characters that MySQL
+// allows in unquoted identifiers, which are U+0080 to U+FFFF.
+func isIdentifierRune(r rune) bool {
+	return r >= utf8.RuneSelf && r <= 0xFFFF && r != utf8.RuneError
+}
+
 func isCarat(ch uint16) bool {
 	return ch == '.' || ch == '\'' || ch == '"' || ch == '`'
 }
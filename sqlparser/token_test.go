@@ -72,6 +72,14 @@ func TestLiteralID(t *testing.T) {
 		in:  "@@`@x @y`",
 		id:  AT_AT_ID,
 		out: "@x @y",
+	}, {
+		in:  "@'%'",
+		id:  AT_ID,
+		out: "'%'",
+	}, {
+		in:  "@'10.0.%",
+		id:  LEX_ERROR,
+		out: "",
 	}}
 
 	for _, tcase := range testcases {
@@ -84,6 +92,60 @@ func TestLiteralID(t *testing.T) {
 	}
 }
 
+func TestUnicodeID(t *testing.T) {
+	testcases := []struct {
+		in  string
+		id  int
+		out string
+	}{{
+		in:  "名字",
+		id:  ID,
+		out: "名字",
+	}, {
+		in:  "Straße1 from",
+		id:  ID,
+		out: "Straße1",
+	}, {
+		in:  "größe=1",
+		id:  ID,
+		out: "größe",
+	}, {
+		in:  "1名字",
+		id:  ID,
+		out: "1名字",
+	}, {
+		in:  "@变量",
+		id:  AT_ID,
+		out: "变量",
+	}, {
+		in:  "@@ä.ö",
+		id:  AT_AT_ID,
+		out: "ä.ö",
+	}, {
+		// characters outside of the Basic Multilingual Plane are not allowed
+		in:  "a😀",
+		id:  ID,
+		out: "a",
+	}, {
+		in:  "😀",
+		id:  LEX_ERROR,
+		out: "😀",
+	}, {
+		in:  "\xffa",
+		id:  LEX_ERROR,
+		out: "\xff",
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tkn := NewStringTokenizer(tcase.in)
+			id, out := tkn.Scan()
+			require.Equal(t, tcase.id, id)
+			require.Equal(t, tcase.out, out)
+		})
+	}
+}
+
 func tokenName(id int) string {
 	if id == STRING {
 		return "STRING"
@@ -237,8 +299,7 @@ func TestVersion(t *testing.T) {
 
 	for _, tcase := range testcases {
 		t.Run(tcase.version+"_"+tcase.in, func(t *testing.T) {
-			MySQLVersion = tcase.version
-			tok := NewStringTokenizer(tcase.in)
+			tok := (&Parser{version: tcase.version}).NewStringTokenizer(tcase.in)
 			for _, expectedID := range tcase.id {
 				id, _ := tok.Scan()
 				require.Equal(t, expectedID, id)
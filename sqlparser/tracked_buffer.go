@@ -43,6 +43,7 @@ type TrackedBuffer struct {
 	literal       func(string) (int, error)
 	escape        bool
 	fast          bool
+	mode          SQLMode
 }
 
 // NewTrackedBuffer creates a new TrackedBuffer.
@@ -90,6 +91,15 @@ func (buf *TrackedBuffer) SetEscapeAllIdentifiers(enable bool) {
 	buf.escape = enable
 }
 
+// SetSQLMode sets the SQL mode that the formatted SQL must be valid for. For example, with
+// SQLModeNoBackslashEscapes strings are written without backslash escapes. By default, the
+// SQL is formatted for an empty SQL mode.
+// Enabling this option will prevent the optimized fastFormat routines from running.
+func (buf *TrackedBuffer) SetSQLMode(mode SQLMode) {
+	buf.fast = false
+	buf.mode = mode
+}
+
 // WriteNode function, initiates the writing of a single SQLNode tree by passing
 // through to Myprintf with a default format string
 func (buf *TrackedBuffer) WriteNode(node SQLNode) *TrackedBuffer {
@@ -115,7 +125,7 @@ func (buf *TrackedBuffer) printExpr(currentExpr Expr, expr Expr, left bool) {
 	if precedenceFor(currentExpr) == Syntactic {
 		expr.formatFast(buf)
 	} else {
-		needParens := needParens(currentExpr, expr, left)
+		needParens := buf.needParens(currentExpr, expr, left)
 		if needParens {
 			buf.WriteByte('(')
 		}
@@ -184,7 +194,7 @@ func (buf *TrackedBuffer) astPrintf(currentNode SQLNode, format string, values .
 			if expr == nil {
 				buf.formatter(value.(SQLNode))
 			} else {
-				needParens := needParens(currentExpr, expr, left)
+				needParens := buf.needParens(currentExpr, expr, left)
 				if needParens {
 					buf.WriteByte('(')
 				}
@@ -230,7 +240,7 @@ func (buf *TrackedBuffer) formatter(node SQLNode) {
 // op is the operator we are printing
 // val is the value we are checking if we need parens around or not
 // left let's us know if the value is on the lhs or rhs of the operator
-func needParens(op, val Expr, left bool) bool {
+func (buf *TrackedBuffer) needParens(op, val Expr, left bool) bool {
 	// Values are atomic and never need parens
 	if IsValue(val) {
 		return false
@@ -240,8 +250,8 @@ func needParens(op, val Expr, left bool) bool {
 		return true
 	}
 
-	opBinding := precedenceFor(op)
-	valBinding := precedenceFor(val)
+	opBinding := buf.precedenceFor(op)
+	valBinding := buf.precedenceFor(val)
 
 	if opBinding == Syntactic || valBinding == Syntactic {
 		return false
@@ -256,6 +266,35 @@ func needParens(op, val Expr, left bool) bool {
 	return valBinding >= opBinding
 }
 
+// precedenceFor returns the precedence of an expression in the SQL mode of the buffer.
+func (buf *TrackedBuffer) precedenceFor(in Expr) Precendence {
+	if _, isNot := in.(*NotExpr); isNot && buf.mode&SQLModeHighNotPrecedence != 0 {
+		// NOT has the same precedence as '!'
+		return P3
+	}
+	return precedenceFor(in)
+}
+
+// encodeSQLString encodes the string as a SQL string for the SQL mode of the buffer.
+func (buf *TrackedBuffer) encodeSQLString(val string) string {
+	if buf.mode&SQLModeNoBackslashEscapes != 0 {
+		return encodeSQLStringNoBackslashEscapes(val)
+	}
+	return encodeSQLString(val)
+}
+
+// requoteSQLString returns val, a string literal or an identifier that was
+// formatted as in the default sql_mode, formatted for the sql_mode of buf.
+func (buf *TrackedBuffer) requoteSQLString(val string) string {
+	if buf.mode&SQLModeNoBackslashEscapes == 0 || !strings.HasPrefix(val, "'") {
+		return val
+	}
+	if typ, str := NewStringTokenizer(val).Scan(); typ == STRING {
+		return encodeSQLStringNoBackslashEscapes(str)
+	}
+	return val
+}
+
 func areBothISExpr(op Expr, val Expr) bool {
 	_, isOpIS := op.(*IsExpr)
 	if isOpIS {
@@ -295,6 +334,18 @@ func String(node SQLNode) string {
 	return buf.String()
 }
 
+// String returns a string representation of an SQLNode that is valid in the SQL mode of p.
+func (p *Parser) String(node SQLNode) string {
+	if p.mode == 0 {
+		return String(node)
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.SetSQLMode(p.mode)
+	buf.formatter(node)
+	return buf.String()
+}
+
 // CanonicalString returns a canonical string representation of an SQLNode where all identifiers
 // are always escaped and all SQL syntax is in uppercase. This matches the canonical output from MySQL.
 func CanonicalString(node SQLNode) string {
@@ -16,18 +16,6 @@ limitations under the License.
 
 package sqlparser
 
-import (
-	"flag"
-)
-
-var (
-	// TruncateUILen truncate queries in debug UIs to the given length. 0 means unlimited.
-	TruncateUILen = flag.Int("sql-max-length-ui", 512, "truncate queries in debug UIs to the given length (default 512)")
-
-	// TruncateErrLen truncate queries in error logs to the given length. 0 means unlimited.
-	TruncateErrLen = flag.Int("sql-max-length-errors", 0, "truncate queries in error logs to the given length (default unlimited)")
-)
-
 func truncateQuery(query string, max int) string {
 	sql, comments := SplitMarginComments(query)
 
@@ -41,12 +29,22 @@ func truncateQuery(query string, max int) string {
 // TruncateForUI is used when displaying queries on various Vitess status pages
 // to keep the pages small enough to load and render properly
 func TruncateForUI(query string) string {
-	return truncateQuery(query, *TruncateUILen)
+	return defaultParser.TruncateForUI(query)
+}
+
+// TruncateForUI truncates the query to the TruncateUILen option of p.
+func (p *Parser) TruncateForUI(query string) string {
+	return truncateQuery(query, p.truncateUILen)
 }
 
 // TruncateForLog is used when displaying queries as part of error logs
 // to avoid overwhelming logging systems with potentially long queries and
 // bind value data.
 func TruncateForLog(query string) string {
-	return truncateQuery(query, *TruncateErrLen)
+	return defaultParser.TruncateForLog(query)
+}
+
+// TruncateForLog truncates the query to the TruncateErrLen option of p.
+func (p *Parser) TruncateForLog(query string) string {
+	return truncateQuery(query, p.truncateErrLen)
 }